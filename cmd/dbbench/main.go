@@ -20,6 +20,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/rocksdb"
 )
 
 func pack4(s []byte) []byte {
@@ -398,7 +399,7 @@ func main() {
 			return
 		}
 	case "rocksdb":
-		db, err = rawdb.NewRocksDBDatabase(dbPath, 1024, 1024, "", false)
+		db, err = rawdb.NewRocksDBDatabase(dbPath, 1024, 1024, "", false, rocksdb.DefaultConfig)
 		if err != nil {
 			fmt.Printf("Cannot open DB %s: %v\n", dbPath, err)
 			return
@@ -36,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state/snapshot"
 	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/rocksdb"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/olekukonko/tablewriter"
@@ -72,6 +73,8 @@ Remove blockchain and state databases`,
 			dbImportCmd,
 			dbExportCmd,
 			dbMetadataCmd,
+			dbConvertCmd,
+			dbBackupCmd,
 		},
 	}
 	dbInspectCmd = cli.Command{
@@ -251,6 +254,56 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		},
 		Description: "Shows metadata about the chain status.",
 	}
+	dbBackupCmd = cli.Command{
+		Action:    utils.MigrateFlags(dbBackup),
+		Name:      "backup",
+		Usage:     "Create a consistent copy of the chain database in a new datadir",
+		ArgsUsage: "<datadir>",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.AncientFlag,
+			utils.SyncModeFlag,
+			utils.CacheFlag,
+			utils.CacheDatabaseFlag,
+		},
+		Description: `This command creates a RocksDB checkpoint of the chain database, hard
+linking the table files if on the same file system, and copies the ancient data
+up to the matching number of items, so that <datadir> can be started as is. Use
+admin.backupChainData(<datadir>) while gwemix is running.`,
+	}
+	dbConvertToFlag = cli.StringFlag{
+		Name:  "to",
+		Usage: "Database engine to convert to (leveldb, rocksdb, pebble)",
+	}
+	dbConvertSamplesFlag = cli.Uint64Flag{
+		Name:  "samples",
+		Usage: "Verify the value hashes of every n'th key",
+		Value: 1000,
+	}
+	dbConvertCmd = cli.Command{
+		Action: utils.MigrateFlags(dbConvert),
+		Name:   "convert",
+		Usage:  "Convert the chain database to another database engine",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.AncientFlag,
+			utils.CacheFlag,
+			utils.CacheDatabaseFlag,
+			utils.RocksDBCompressionFlag,
+			utils.RocksDBWriteBufferFlag,
+			utils.RocksDBBackgroundJobsFlag,
+			utils.RocksDBRateLimitFlag,
+			utils.RocksDBColumnFamiliesFlag,
+			dbConvertToFlag,
+			dbConvertSamplesFlag,
+		},
+		Description: `This command copies all the key-value pairs of the chain database into a
+new database of the given engine next to it, i.e. 'chaindata.convert', verifies
+the key counts and the sampled value hashes, and then swaps the databases. The
+old database is kept as 'chaindata.<engine>', and the ancient store is moved
+over if it's in the chain database directory. An interrupted conversion is
+resumed when run again. gwemix must not be running.`,
+	}
 )
 
 func removeDB(ctx *cli.Context) error {
@@ -388,6 +441,101 @@ func dbCompact(ctx *cli.Context) error {
 	return nil
 }
 
+// dbConvert converts the chain database to another database engine.
+func dbConvert(ctx *cli.Context) error {
+	to := ctx.String(dbConvertToFlag.Name)
+	if to != rawdb.EngineLevelDB && to != rawdb.EngineRocksDB && to != rawdb.EnginePebble {
+		return fmt.Errorf("invalid database engine %q, want %s, %s or %s", to, rawdb.EngineLevelDB, rawdb.EngineRocksDB, rawdb.EnginePebble)
+	}
+	stack, config := makeConfigNode(ctx)
+	defer stack.Close()
+
+	var (
+		path    = stack.ResolvePath("chaindata")
+		tmpPath = path + ".convert"
+		from    = rawdb.DatabaseEngine(path)
+		cache   = ctx.GlobalInt(utils.CacheFlag.Name) * ctx.GlobalInt(utils.CacheDatabaseFlag.Name) / 100
+		handles = utils.MakeDatabaseHandles()
+	)
+	switch from {
+	case "":
+		return fmt.Errorf("no chain database in %s", path)
+	case to:
+		return fmt.Errorf("chain database is %s already", to)
+	}
+	if backup := path + "." + from; common.FileExist(backup) {
+		return fmt.Errorf("%s exists, remove it to convert again", backup)
+	}
+	if engine := rawdb.DatabaseEngine(tmpPath); engine != "" && engine != to {
+		return fmt.Errorf("%s is a %s database, remove it to convert to %s", tmpPath, engine, to)
+	}
+	log.Info("Converting chain database", "from", from, "to", to, "path", path)
+	if err := convertDatabase(from, path, to, tmpPath, cache, handles, config.Node.RocksDB, ctx.Uint64(dbConvertSamplesFlag.Name)); err != nil {
+		return err
+	}
+
+	// Move the ancient store along if it's in the chain database
+	if ancient := config.Eth.DatabaseFreezer; ancient == "" || filepath.Join(path, "ancient") == config.Node.ResolvePath(ancient) {
+		if common.FileExist(filepath.Join(path, "ancient")) {
+			if err := os.Rename(filepath.Join(path, "ancient"), filepath.Join(tmpPath, "ancient")); err != nil {
+				return err
+			}
+		}
+	}
+	backup := path + "." + from
+	if err := os.Rename(path, backup); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	log.Info("Converted chain database", "engine", to, "path", path, "old", backup)
+	return nil
+}
+
+// convertDatabase copies the key-value pairs of the database at path into
+// the one at tmpPath, and verifies the copy.
+func convertDatabase(from, path, to, tmpPath string, cache, handles int, rocksdbCfg rocksdb.Config, samples uint64) error {
+	src, err := rawdb.NewKeyValueStore(from, path, cache/2, handles/2, "", true, rocksdbCfg)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", path, err)
+	}
+	defer src.Close()
+	dst, err := rawdb.NewKeyValueStore(to, tmpPath, cache/2, handles/2, "", false, rocksdbCfg)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", tmpPath, err)
+	}
+	defer dst.Close()
+
+	if _, err := rawdb.ConvertDatabase(src, dst); err != nil {
+		return err
+	}
+	return rawdb.VerifyConversion(src, dst, samples)
+}
+
+// dbBackup creates a consistent copy of the chain database in a new datadir.
+func dbBackup(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	dir := ctx.Args().Get(0)
+	if common.FileExist(dir) {
+		return fmt.Errorf("%s exists", dir)
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	rel, err := filepath.Rel(stack.DataDir(), stack.ResolvePath("chaindata"))
+	if err != nil {
+		return err
+	}
+	// checkpoints aren't supported by read-only RocksDB
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	return rawdb.BackupDatabase(db, filepath.Join(dir, rel))
+}
+
 // dbGet shows the value of a given database key
 func dbGet(ctx *cli.Context) error {
 	if ctx.NArg() != 1 {
@@ -0,0 +1,839 @@
+// governancecmd.go
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/wemix/governance"
+	"github.com/ethereum/go-ethereum/wemix/metclient"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// gwemix wemix gov propose|vote|status|list
+var (
+	govSignerFlags = []cli.Flag{
+		urlFlag,
+		registryFlag,
+		accountFileFlag,
+		utils.PasswordFileFlag,
+		utils.ExternalSignerFlag,
+		fromAddrFlag,
+		gasFlag,
+		gasPriceFlag,
+		dryRunFlag,
+	}
+	govMemberFlags = []cli.Flag{
+		stakerFlag,
+		voterFlag,
+		rewardFlag,
+		nodeNameFlag,
+		enodeFlag,
+		nodeIpFlag,
+		nodePortFlag,
+		lockAmountFlag,
+		memoFlag,
+		durationFlag,
+	}
+
+	govCommand = cli.Command{
+		Name:  "gov",
+		Usage: "Governance ballots",
+		Description: `
+Propose, vote on and inspect the governance ballots.
+
+The transactions are signed with the keystore file given with --account, or
+by the external signer, e.g. clef, given with --signer for the account
+--from. With --dry-run, they're checked with eth_call instead of being sent.
+The governance contracts are found from the genesis block unless --registry
+is given.`,
+		Subcommands: []cli.Command{
+			{
+				Name:  "propose",
+				Usage: "Propose a new ballot",
+				Subcommands: []cli.Command{
+					{
+						Name:   "add-member",
+						Usage:  "Propose to add a member",
+						Action: utils.MigrateFlags(proposeAddMember),
+						Flags:  append(govMemberFlags, govSignerFlags...),
+						Description: `
+    gwemix wemix gov propose add-member --url <url> --staker <address> [--voter <address> --reward <address>] --node-name <name> --enode <id> --node-ip <ip> --node-port <port> [--lock <amount> --memo <memo> --duration <seconds>] <signer flags>
+
+The voter and the reward addresses default to the staker, the lock amount
+to the minimum staking, and the duration to the minimum voting duration.`,
+					},
+					{
+						Name:   "remove-member",
+						Usage:  "Propose to remove a member",
+						Action: utils.MigrateFlags(proposeRemoveMember),
+						Flags:  append([]cli.Flag{stakerFlag, lockAmountFlag, memoFlag, durationFlag}, govSignerFlags...),
+						Description: `
+    gwemix wemix gov propose remove-member --url <url> --staker <address> [--lock <amount> --memo <memo> --duration <seconds>] <signer flags>
+
+The lock amount is the amount to unlock, the minimum staking by default.`,
+					},
+					{
+						Name:   "change-member",
+						Usage:  "Propose to replace a member or to change its node",
+						Action: utils.MigrateFlags(proposeChangeMember),
+						Flags:  append(append([]cli.Flag{oldStakerFlag}, govMemberFlags...), govSignerFlags...),
+						Description: `
+    gwemix wemix gov propose change-member --url <url> --old-staker <address> --staker <address> ... <signer flags>
+
+Takes the same flags as add-member for the new member.`,
+					},
+					{
+						Name:   "change-env",
+						Usage:  "Propose to change an environment variable",
+						Action: utils.MigrateFlags(proposeChangeEnv),
+						Flags:  append([]cli.Flag{envNameFlag, envTypeFlag, envValueFlag, memoFlag, durationFlag}, govSignerFlags...),
+						Description: `
+    gwemix wemix gov propose change-env --url <url> --env <name> [--type <type>] --value <value>[,<value>...] [--memo <memo> --duration <seconds>] <signer flags>
+
+The type is one of int, uint, address, bytes32, bytes and string, uint by
+default. The values of the variables with more than one value, e.g.
+blockRewardDistributionMethod, are separated with commas.`,
+					},
+					{
+						Name:   "change-gov",
+						Usage:  "Propose to upgrade the governance contract",
+						Action: utils.MigrateFlags(proposeChangeGov),
+						Flags:  append([]cli.Flag{newGovFlag, memoFlag, durationFlag}, govSignerFlags...),
+						Description: `
+    gwemix wemix gov propose change-gov --url <url> --new-gov <address> [--memo <memo> --duration <seconds>] <signer flags>
+
+Proposes to upgrade the governance contract to the new implementation.`,
+					},
+				},
+			},
+			{
+				Name:      "vote",
+				Usage:     "Vote on a ballot",
+				ArgsUsage: "<ballot-id> <yes|no>",
+				Action:    utils.MigrateFlags(voteBallot),
+				Flags:     govSignerFlags,
+				Description: `
+    gwemix wemix gov vote --url <url> <signer flags> <ballot-id> <yes|no>
+
+Votes on the ballot with the voter account.`,
+			},
+			{
+				Name:      "status",
+				Usage:     "Show a ballot and its voting result",
+				ArgsUsage: "<ballot-id>",
+				Action:    utils.MigrateFlags(showBallot),
+				Flags:     []cli.Flag{urlFlag, registryFlag, jsonFlag},
+				Description: `
+    gwemix wemix gov status --url <url> [--json] <ballot-id>`,
+			},
+			{
+				Name:   "list",
+				Usage:  "List the ballots",
+				Action: utils.MigrateFlags(listBallots),
+				Flags:  []cli.Flag{urlFlag, registryFlag, allFlag, jsonFlag},
+				Description: `
+    gwemix wemix gov list --url <url> [--all] [--json]
+
+Lists the ballots not finalized yet, or all of them with --all. The one in
+voting is marked with '*'.`,
+			},
+		},
+	}
+
+	registryFlag = cli.StringFlag{
+		Name:  "registry",
+		Usage: "address of the governance registry",
+	}
+	accountFileFlag = cli.StringFlag{
+		Name:  "account",
+		Usage: "keystore file of the account to sign with",
+	}
+	fromAddrFlag = cli.StringFlag{
+		Name:  "from",
+		Usage: "address of the account in the external signer",
+	}
+	dryRunFlag = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "check the transaction with eth_call without sending it",
+	}
+	stakerFlag = cli.StringFlag{
+		Name:  "staker",
+		Usage: "staker address of the member",
+	}
+	oldStakerFlag = cli.StringFlag{
+		Name:  "old-staker",
+		Usage: "staker address of the member to replace",
+	}
+	voterFlag = cli.StringFlag{
+		Name:  "voter",
+		Usage: "voter address of the member",
+	}
+	rewardFlag = cli.StringFlag{
+		Name:  "reward",
+		Usage: "reward address of the member",
+	}
+	nodeNameFlag = cli.StringFlag{
+		Name:  "node-name",
+		Usage: "name of the node",
+	}
+	enodeFlag = cli.StringFlag{
+		Name:  "enode",
+		Usage: "id of the node, in 128 hex digits",
+	}
+	nodeIpFlag = cli.StringFlag{
+		Name:  "node-ip",
+		Usage: "ip address of the node",
+	}
+	nodePortFlag = cli.IntFlag{
+		Name:  "node-port",
+		Usage: "p2p port of the node",
+		Value: 8589,
+	}
+	lockAmountFlag = cli.StringFlag{
+		Name:  "lock",
+		Usage: "amount to lock or unlock in wei",
+	}
+	memoFlag = cli.StringFlag{
+		Name:  "memo",
+		Usage: "memo of the ballot",
+	}
+	durationFlag = cli.Uint64Flag{
+		Name:  "duration",
+		Usage: "voting duration in seconds",
+	}
+	envNameFlag = cli.StringFlag{
+		Name:  "env",
+		Usage: "name of the environment variable",
+	}
+	envTypeFlag = cli.StringFlag{
+		Name:  "type",
+		Usage: "type of the environment variable",
+		Value: "uint",
+	}
+	envValueFlag = cli.StringFlag{
+		Name:  "value",
+		Usage: "comma-separated values of the environment variable",
+	}
+	newGovFlag = cli.StringFlag{
+		Name:  "new-gov",
+		Usage: "address of the new governance contract",
+	}
+	allFlag = cli.BoolFlag{
+		Name:  "all",
+		Usage: "include the finalized ballots",
+	}
+	jsonFlag = cli.BoolFlag{
+		Name:  "json",
+		Usage: "print in json",
+	}
+)
+
+// govSession is a connection to a node with the governance contracts.
+type govSession struct {
+	ctx           context.Context
+	cli           *ethclient.Client
+	gov           common.Address
+	ballotStorage common.Address
+	abi           *abi.ABI
+}
+
+func newGovSession(ctx context.Context, cliCtx *cli.Context) (*govSession, error) {
+	url := cliCtx.String(urlFlag.Name)
+	if url == "" {
+		return nil, fmt.Errorf("URL is not given")
+	}
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	s := &govSession{ctx: ctx, cli: client}
+	opts := &bind.CallOpts{Context: ctx}
+
+	var registry common.Address
+	if addr := cliCtx.String(registryFlag.Name); addr != "" {
+		if registry, err = parseAddress(registryFlag.Name, addr); err != nil {
+			return nil, err
+		}
+	} else {
+		genesis, err := client.HeaderByNumber(ctx, common.Big0)
+		if err != nil {
+			return nil, err
+		}
+		if registry, err = governance.FindRegistry(opts, client, genesis.Coinbase); err != nil {
+			return nil, err
+		}
+	}
+	reg := governance.RegistryAt(registry, client)
+	if s.gov, err = reg.GetContractAddress(opts, governance.GovernanceContractName); err != nil {
+		return nil, err
+	}
+	if s.ballotStorage, err = reg.GetContractAddress(opts, governance.BallotStorageName); err != nil {
+		return nil, err
+	}
+	if s.abi, err = governance.GovImpMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *govSession) callOpts() *bind.CallOpts {
+	return &bind.CallOpts{Context: s.ctx}
+}
+
+func (s *govSession) govCaller() *governance.GovImpCaller {
+	return governance.GovImpAt(s.gov, s.cli)
+}
+
+func (s *govSession) ballotStorageCaller() *governance.BallotStorageCaller {
+	return governance.BallotStorageAt(s.ballotStorage, s.cli)
+}
+
+// transact calls the method of the governance contract, with eth_call if
+// --dry-run is given. It returns the receipt, nil for dry runs.
+func (s *govSession) transact(cliCtx *cli.Context, method string, args ...interface{}) (*types.Receipt, error) {
+	data, err := s.abi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if cliCtx.Bool(dryRunFlag.Name) {
+		from, err := govSender(cliCtx)
+		if err != nil {
+			return nil, err
+		}
+		out, err := s.cli.CallContract(s.ctx, ethereum.CallMsg{From: from, To: &s.gov, Data: data}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%s would fail: %w", method, err)
+		}
+		res, err := s.abi.Unpack(method, out)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("%s from %s would succeed", method, from.Hex())
+		if len(res) > 0 {
+			fmt.Printf(", returning %v", res[0])
+		}
+		fmt.Println(".")
+		return nil, nil
+	}
+
+	opts, err := govTransactOpts(s.ctx, cliCtx, s.cli)
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(s.gov, *s.abi, s.cli, s.cli, s.cli)
+	tx, err := contract.RawTransact(opts, data)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("%s sent, waiting for transaction %s...\n", method, tx.Hash().Hex())
+	return waitMined(s.ctx, s.cli, tx)
+}
+
+// duration returns the --duration, or the minimum voting duration.
+func (s *govSession) duration(cliCtx *cli.Context) (*big.Int, error) {
+	if d := cliCtx.Uint64(durationFlag.Name); d != 0 {
+		return new(big.Int).SetUint64(d), nil
+	}
+	return s.govCaller().GetMinVotingDuration(s.callOpts())
+}
+
+// lockAmount returns the --lock, or the minimum staking.
+func (s *govSession) lockAmount(cliCtx *cli.Context) (*big.Int, error) {
+	if v := cliCtx.String(lockAmountFlag.Name); v != "" {
+		amount, ok := new(big.Int).SetString(v, 0)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("Invalid lock amount %q", v)
+		}
+		return amount, nil
+	}
+	return s.govCaller().GetMinStaking(s.callOpts())
+}
+
+// memberInfo returns the member in the flags.
+func (s *govSession) memberInfo(cliCtx *cli.Context) (*governance.GovImpMemberInfo, error) {
+	staker, err := parseAddress(stakerFlag.Name, cliCtx.String(stakerFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	info := &governance.GovImpMemberInfo{
+		Staker: staker,
+		Voter:  staker,
+		Reward: staker,
+		Name:   []byte(cliCtx.String(nodeNameFlag.Name)),
+		Ip:     []byte(cliCtx.String(nodeIpFlag.Name)),
+		Port:   big.NewInt(int64(cliCtx.Int(nodePortFlag.Name))),
+		Memo:   []byte(cliCtx.String(memoFlag.Name)),
+	}
+	if v := cliCtx.String(voterFlag.Name); v != "" {
+		if info.Voter, err = parseAddress(voterFlag.Name, v); err != nil {
+			return nil, err
+		}
+	}
+	if v := cliCtx.String(rewardFlag.Name); v != "" {
+		if info.Reward, err = parseAddress(rewardFlag.Name, v); err != nil {
+			return nil, err
+		}
+	}
+	if len(info.Name) == 0 || len(info.Ip) == 0 {
+		return nil, fmt.Errorf("Node name or ip is not given")
+	}
+	id := strings.TrimPrefix(cliCtx.String(enodeFlag.Name), "0x")
+	if len(id) != 128 {
+		return nil, fmt.Errorf("Not a node id: %q", id)
+	}
+	if info.Enode, err = hex.DecodeString(id); err != nil {
+		return nil, err
+	}
+	if info.LockAmount, err = s.lockAmount(cliCtx); err != nil {
+		return nil, err
+	}
+	if info.Duration, err = s.duration(cliCtx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// proposed reports the ballot created by the proposal.
+func (s *govSession) proposed(receipt *types.Receipt) error {
+	if receipt == nil {
+		return nil
+	}
+	filterer, err := governance.NewBallotStorageFilterer(s.ballotStorage, nil)
+	if err != nil {
+		return err
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != s.ballotStorage {
+			continue
+		}
+		if ev, err := filterer.ParseBallotCreated(*l); err == nil {
+			fmt.Printf("Ballot %d created in block %d.\n", ev.BallotId, receipt.BlockNumber)
+			return nil
+		}
+	}
+	return errors.New("No ballot created")
+}
+
+// govSender returns the address of the account to send the transactions
+// from.
+func govSender(cliCtx *cli.Context) (common.Address, error) {
+	if from := cliCtx.String(fromAddrFlag.Name); from != "" {
+		return parseAddress(fromAddrFlag.Name, from)
+	}
+	fn := cliCtx.String(accountFileFlag.Name)
+	if fn == "" {
+		return common.Address{}, errors.New("Neither --account nor --from is given")
+	}
+	// the address of the keystore file doesn't need the password
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return common.Address{}, err
+	}
+	var key struct {
+		Address string `json:"address"`
+	}
+	if err = json.Unmarshal(data, &key); err != nil {
+		return common.Address{}, err
+	}
+	return parseAddress("keystore address", key.Address)
+}
+
+// govTransactOpts returns the options to sign the transactions with the
+// keystore file or the external signer.
+func govTransactOpts(ctx context.Context, cliCtx *cli.Context, client *ethclient.Client) (*bind.TransactOpts, error) {
+	var opts *bind.TransactOpts
+	if endpoint := cliCtx.String(utils.ExternalSignerFlag.Name); endpoint != "" {
+		from, err := parseAddress(fromAddrFlag.Name, cliCtx.String(fromAddrFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		signer, err := external.NewExternalSigner(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		opts = bind.NewClefTransactor(signer, accounts.Account{Address: from})
+	} else if fn := cliCtx.String(accountFileFlag.Name); fn != "" {
+		passwd := utils.GetPassPhraseWithList("", false, 0, utils.MakePasswordList(cliCtx))
+		key, err := metclient.LoadAccount(passwd, fn)
+		if err != nil {
+			return nil, err
+		}
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if opts, err = bind.NewKeyedTransactorWithChainID(key.PrivateKey, chainID); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, errors.New("Neither --account nor --signer is given")
+	}
+
+	opts.Context = ctx
+	if gas := cliCtx.Int(gasFlag.Name); gas > 0 {
+		opts.GasLimit = uint64(gas)
+	}
+	if gasPrice := cliCtx.Int(gasPriceFlag.Name); gasPrice > 0 {
+		opts.GasPrice = big.NewInt(int64(gasPrice))
+	}
+	return opts, nil
+}
+
+func parseAddress(name, addr string) (common.Address, error) {
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("Invalid %s address %q", name, addr)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+// packEnvValue encodes the comma-separated values of an environment
+// variable as the governance contract decodes them.
+func packEnvValue(typ int, value string) ([]byte, error) {
+	switch typ {
+	case governance.EnvTypeBytes:
+		return hexutil.Decode(value)
+	case governance.EnvTypeString:
+		return []byte(value), nil
+	}
+
+	var b []byte
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		switch typ {
+		case governance.EnvTypeInt, governance.EnvTypeUint:
+			n, ok := new(big.Int).SetString(v, 0)
+			if !ok || (typ == governance.EnvTypeUint && n.Sign() < 0) {
+				return nil, fmt.Errorf("Invalid number %q", v)
+			}
+			b = append(b, math.U256Bytes(n)...)
+		case governance.EnvTypeAddress:
+			addr, err := parseAddress("value", v)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, common.LeftPadBytes(addr[:], 32)...)
+		case governance.EnvTypeBytes32:
+			v32, err := hexutil.Decode(v)
+			if err != nil || len(v32) > 32 {
+				return nil, fmt.Errorf("Invalid bytes32 %q", v)
+			}
+			b = append(b, common.RightPadBytes(v32, 32)...)
+		default:
+			return nil, fmt.Errorf("Invalid type %d", typ)
+		}
+	}
+	return b, nil
+}
+
+// formatEnvValue is the reverse of packEnvValue.
+func formatEnvValue(typ string, value []byte) string {
+	switch governance.EnvType(typ) {
+	case governance.EnvTypeInt, governance.EnvTypeUint, governance.EnvTypeAddress:
+		if len(value) == 0 || len(value)%32 != 0 {
+			break
+		}
+		var vs []string
+		for i := 0; i < len(value); i += 32 {
+			word := value[i : i+32]
+			switch governance.EnvType(typ) {
+			case governance.EnvTypeInt:
+				vs = append(vs, math.S256(new(big.Int).SetBytes(word)).String())
+			case governance.EnvTypeUint:
+				vs = append(vs, new(big.Int).SetBytes(word).String())
+			default:
+				vs = append(vs, common.BytesToAddress(word).Hex())
+			}
+		}
+		return strings.Join(vs, ",")
+	case governance.EnvTypeString:
+		return fmt.Sprintf("%q", value)
+	}
+	return hexutil.Encode(value)
+}
+
+func proposeAddMember(cliCtx *cli.Context) error {
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	info, err := s.memberInfo(cliCtx)
+	if err != nil {
+		return err
+	}
+	receipt, err := s.transact(cliCtx, "addProposalToAddMember", *info)
+	if err != nil {
+		return err
+	}
+	return s.proposed(receipt)
+}
+
+func proposeRemoveMember(cliCtx *cli.Context) error {
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	staker, err := parseAddress(stakerFlag.Name, cliCtx.String(stakerFlag.Name))
+	if err != nil {
+		return err
+	}
+	lockAmount, err := s.lockAmount(cliCtx)
+	if err != nil {
+		return err
+	}
+	duration, err := s.duration(cliCtx)
+	if err != nil {
+		return err
+	}
+	receipt, err := s.transact(cliCtx, "addProposalToRemoveMember", staker,
+		lockAmount, []byte(cliCtx.String(memoFlag.Name)), duration)
+	if err != nil {
+		return err
+	}
+	return s.proposed(receipt)
+}
+
+func proposeChangeMember(cliCtx *cli.Context) error {
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	oldStaker, err := parseAddress(oldStakerFlag.Name, cliCtx.String(oldStakerFlag.Name))
+	if err != nil {
+		return err
+	}
+	info, err := s.memberInfo(cliCtx)
+	if err != nil {
+		return err
+	}
+	receipt, err := s.transact(cliCtx, "addProposalToChangeMember", *info, oldStaker)
+	if err != nil {
+		return err
+	}
+	return s.proposed(receipt)
+}
+
+func proposeChangeEnv(cliCtx *cli.Context) error {
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	name := cliCtx.String(envNameFlag.Name)
+	if name == "" {
+		return fmt.Errorf("Environment variable name is not given")
+	}
+	typ := governance.EnvType(cliCtx.String(envTypeFlag.Name))
+	if typ == governance.EnvTypeInvalid {
+		return fmt.Errorf("Invalid type %q", cliCtx.String(envTypeFlag.Name))
+	}
+	value, err := packEnvValue(typ, cliCtx.String(envValueFlag.Name))
+	if err != nil {
+		return err
+	}
+	duration, err := s.duration(cliCtx)
+	if err != nil {
+		return err
+	}
+	receipt, err := s.transact(cliCtx, "addProposalToChangeEnv",
+		crypto.Keccak256Hash([]byte(name)), big.NewInt(int64(typ)), value,
+		[]byte(cliCtx.String(memoFlag.Name)), duration)
+	if err != nil {
+		return err
+	}
+	return s.proposed(receipt)
+}
+
+func proposeChangeGov(cliCtx *cli.Context) error {
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	newGov, err := parseAddress(newGovFlag.Name, cliCtx.String(newGovFlag.Name))
+	if err != nil {
+		return err
+	}
+	duration, err := s.duration(cliCtx)
+	if err != nil {
+		return err
+	}
+	receipt, err := s.transact(cliCtx, "addProposalToChangeGov", newGov,
+		[]byte(cliCtx.String(memoFlag.Name)), duration)
+	if err != nil {
+		return err
+	}
+	return s.proposed(receipt)
+}
+
+func voteBallot(cliCtx *cli.Context) error {
+	if len(cliCtx.Args()) != 2 {
+		return fmt.Errorf("Invalid Arguments")
+	}
+	id, ok := new(big.Int).SetString(cliCtx.Args()[0], 0)
+	if !ok || id.Sign() <= 0 {
+		return fmt.Errorf("Invalid ballot id %q", cliCtx.Args()[0])
+	}
+	var approval bool
+	switch strings.ToLower(cliCtx.Args()[1]) {
+	case "yes", "y", "accept":
+		approval = true
+	case "no", "n", "reject":
+		approval = false
+	default:
+		return fmt.Errorf("Invalid vote %q, yes or no", cliCtx.Args()[1])
+	}
+
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	b, err := governance.ReadBallot(s.callOpts(), s.ballotStorageCaller(), id)
+	if err != nil {
+		return err
+	}
+	if b.IsFinalized {
+		return fmt.Errorf("Ballot %d is already %s", id, b.State)
+	}
+	receipt, err := s.transact(cliCtx, "vote", id, approval)
+	if err != nil || receipt == nil {
+		return err
+	}
+	if b, err = governance.ReadBallot(s.callOpts(), s.ballotStorageCaller(), id); err != nil {
+		return err
+	}
+	fmt.Printf("Voted in block %d.\n", receipt.BlockNumber)
+	printBallot(b, false)
+	return nil
+}
+
+func showBallot(cliCtx *cli.Context) error {
+	if len(cliCtx.Args()) != 1 {
+		return fmt.Errorf("Invalid Arguments")
+	}
+	id, ok := new(big.Int).SetString(cliCtx.Args()[0], 0)
+	if !ok || id.Sign() <= 0 {
+		return fmt.Errorf("Invalid ballot id %q", cliCtx.Args()[0])
+	}
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	b, err := governance.ReadBallot(s.callOpts(), s.ballotStorageCaller(), id)
+	if err != nil {
+		return err
+	}
+	if cliCtx.Bool(jsonFlag.Name) {
+		return printJson(b)
+	}
+	printBallot(b, false)
+	return nil
+}
+
+func listBallots(cliCtx *cli.Context) error {
+	s, err := newGovSession(context.Background(), cliCtx)
+	if err != nil {
+		return err
+	}
+	count, err := s.govCaller().BallotLength(s.callOpts())
+	if err != nil {
+		return err
+	}
+	inVoting, err := s.govCaller().GetBallotInVoting(s.callOpts())
+	if err != nil {
+		return err
+	}
+	ballots, err := governance.ReadBallots(s.callOpts(), s.ballotStorageCaller(), 1, count.Uint64())
+	if err != nil {
+		return err
+	}
+
+	var list []*governance.Ballot
+	for _, b := range ballots {
+		if cliCtx.Bool(allFlag.Name) || !b.IsFinalized {
+			list = append(list, b)
+		}
+	}
+	if cliCtx.Bool(jsonFlag.Name) {
+		return printJson(list)
+	}
+	for _, b := range list {
+		printBallot(b, b.Id.Cmp(inVoting) == 0)
+	}
+	fmt.Printf("%d of %d ballots\n", len(list), len(ballots))
+	return nil
+}
+
+func printJson(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// printBallot prints the ballot, marked with '*' if it's in voting.
+func printBallot(b *governance.Ballot, inVoting bool) {
+	mark := " "
+	if inVoting {
+		mark = "*"
+	}
+	fmt.Printf("%s%d %s %s: accepts %v rejects %v of %v voters, by %s",
+		mark, b.Id, b.Type, b.State, b.PowerOfAccepts, b.PowerOfRejects,
+		b.TotalVoters, b.Creator.Hex())
+	if b.StartTime != nil && b.StartTime.Sign() > 0 {
+		fmt.Printf(", %s - %s", formatTime(b.StartTime), formatTime(b.EndTime))
+	} else {
+		fmt.Printf(", for %vs", b.Duration)
+	}
+	fmt.Println()
+
+	switch {
+	case b.Member != nil:
+		m := b.Member
+		if m.OldStaker != (common.Address{}) {
+			fmt.Printf("    old staker %s\n", m.OldStaker.Hex())
+		}
+		fmt.Printf("    staker %s voter %s reward %s lock %v\n",
+			m.NewStaker.Hex(), m.NewVoter.Hex(), m.NewReward.Hex(), m.LockAmount)
+		if len(m.Enode) > 0 {
+			fmt.Printf("    node %s enode://%x@%s:%v\n", m.Name, []byte(m.Enode), m.Ip, m.Port)
+		}
+	case b.Env != nil:
+		fmt.Printf("    %s (%s) = %s\n", b.Env.Name, b.Env.Type, formatEnvValue(b.Env.Type, b.Env.Value))
+	case b.NewGov != nil:
+		fmt.Printf("    new governance %s\n", b.NewGov.Hex())
+	}
+	if b.Memo != "" {
+		fmt.Printf("    memo %q\n", b.Memo)
+	}
+}
+
+func formatTime(t *big.Int) string {
+	if t == nil || !t.IsInt64() {
+		return "?"
+	}
+	return time.Unix(t.Int64(), 0).UTC().Format(time.RFC3339)
+}
+
+// EOF
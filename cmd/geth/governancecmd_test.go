@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/wemix/governance"
+)
+
+func TestPackEnvValue(t *testing.T) {
+	tests := []struct {
+		typ    string
+		value  string
+		packed string
+		format string
+	}{
+		{"uint", "3", "0x0000000000000000000000000000000000000000000000000000000000000003", "3"},
+		{"uint", "4000, 1000,0x9c4", "0x0000000000000000000000000000000000000000000000000000000000000fa0" +
+			"00000000000000000000000000000000000000000000000000000000000003e8" +
+			"00000000000000000000000000000000000000000000000000000000000009c4", "4000,1000,2500"},
+		{"int", "-1", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "-1"},
+		{"address", "0x00000000000000000000000000000000000000aa", "0x00000000000000000000000000000000000000000000000000000000000000aa", "0x00000000000000000000000000000000000000AA"},
+		{"bytes32", "0x0102", "0x0102000000000000000000000000000000000000000000000000000000000000", "0x0102000000000000000000000000000000000000000000000000000000000000"},
+		{"bytes", "0x0102", "0x0102", "0x0102"},
+		{"string", "a,b", "0x612c62", `"a,b"`},
+	}
+	for _, tt := range tests {
+		packed, err := packEnvValue(governance.EnvType(tt.typ), tt.value)
+		if err != nil {
+			t.Fatalf("%s %q: %v", tt.typ, tt.value, err)
+		}
+		if have := hexutil.Encode(packed); have != tt.packed {
+			t.Errorf("%s %q: packed %s, want %s", tt.typ, tt.value, have, tt.packed)
+		}
+		if have := formatEnvValue(tt.typ, packed); have != tt.format {
+			t.Errorf("%s %q: formatted %s, want %s", tt.typ, tt.value, have, tt.format)
+		}
+	}
+
+	for _, tt := range []struct {
+		typ   string
+		value string
+	}{
+		{"uint", "-1"},
+		{"uint", "1,x"},
+		{"address", "0x01"},
+		{"bytes32", "0x000000000000000000000000000000000000000000000000000000000000000000"},
+		{"invalid", "1"},
+	} {
+		if _, err := packEnvValue(governance.EnvType(tt.typ), tt.value); err == nil {
+			t.Errorf("%s %q: no error", tt.typ, tt.value)
+		}
+	}
+}
@@ -10,12 +10,16 @@ import (
 	"math/big"
 	"os"
 	"reflect"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/wemix/governance"
 	"github.com/ethereum/go-ethereum/wemix/metclient"
 	"gopkg.in/urfave/cli.v1"
 )
@@ -78,7 +82,22 @@ func getInitialGovernanceMembersAndNodes(configJsFile string) (nodes []byte, sta
 	return
 }
 
-// governance-contract.js config.js
+// waitMined waits for a transaction to be mined, and checks its status.
+func waitMined(ctx context.Context, cli *ethclient.Client, tx *types.Transaction) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+	receipt, err := bind.WaitMined(ctx, cli, tx)
+	if err != nil {
+		return nil, err
+	} else if receipt.Status != types.ReceiptStatusSuccessful {
+		fmt.Printf("Transaction %v failed with status %d.\n",
+			tx.Hash().Hex(), receipt.Status)
+		return nil, fmt.Errorf("Transaction failed with status %d.", receipt.Status)
+	}
+	return receipt, nil
+}
+
+// config.js account-file
 func deployGovernanceContracts(cliCtx *cli.Context) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -97,7 +116,7 @@ func deployGovernanceContracts(cliCtx *cli.Context) error {
 		gasPrice = 80000000000
 	}
 
-	if len(url) == 0 || len(cliCtx.Args()) != 3 {
+	if len(url) == 0 || len(cliCtx.Args()) != 2 {
 		return fmt.Errorf("Invalid Arguments")
 	}
 
@@ -106,7 +125,7 @@ func deployGovernanceContracts(cliCtx *cli.Context) error {
 		return fmt.Errorf("Invalid Arguments")
 	}
 
-	contractsFile, configJsFile, accountFile := cliCtx.Args()[0], cliCtx.Args()[1], cliCtx.Args()[2]
+	configJsFile, accountFile := cliCtx.Args()[0], cliCtx.Args()[1]
 
 	// account
 	var from *keystore.Key
@@ -122,7 +141,7 @@ func deployGovernanceContracts(cliCtx *cli.Context) error {
 	)
 	membersAndNodes, stakes, rewardPoolAccount, maintenanceAccount, err = getInitialGovernanceMembersAndNodes(configJsFile)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	// cli connection
@@ -132,200 +151,175 @@ func deployGovernanceContracts(cliCtx *cli.Context) error {
 		return err
 	}
 
-	// contract variables
-	registry := &metclient.RemoteContract{Cli: cli, From: from, Gas: gas}
-	envStorageImp := &metclient.RemoteContract{Cli: cli, From: from, Gas: gas}
-	staking := &metclient.RemoteContract{Cli: cli, From: from, Gas: gas}
-	ballotStorage := &metclient.RemoteContract{Cli: cli, From: from, Gas: gas}
-	envStorage := &metclient.RemoteContract{Cli: cli, From: from, Gas: gas}
-	govImp := &metclient.RemoteContract{Cli: cli, From: from, Gas: gas}
-	gov := &metclient.RemoteContract{Cli: cli, From: from, Gas: gas}
-
-	// load contract codes
-	var contracts map[string]*metclient.ContractData
-	if fin, e2 := os.Open(contractsFile); e2 != nil {
-		return e2
-	} else {
-		defer fin.Close()
-		if contracts, err = metclient.LoadJsContract(fin); err != nil {
-			return nil
-		}
+	// transaction options, nonces are assigned here to send transactions
+	// without waiting for the previous ones
+	chainID, err := cli.ChainID(ctx)
+	if err != nil {
+		return err
 	}
-
-	// check if contracts exist
-	contractNames := []string{"Registry", "EnvStorageImp", "Staking", "BallotStorage", "EnvStorage", "GovImp", "Gov"}
-	for _, name := range contractNames {
-		if _, ok := contracts[name]; !ok {
-			return fmt.Errorf("Cannot find %s contract", name)
-		}
+	nonce, err := cli.PendingNonceAt(ctx, from.Address)
+	if err != nil {
+		return err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(from.PrivateKey, chainID)
+	if err != nil {
+		return err
+	}
+	opts.Context = ctx
+	opts.GasLimit = uint64(gas)
+	opts.GasPrice = big.NewInt(int64(gasPrice))
+	txOpts := func() *bind.TransactOpts {
+		o := *opts
+		o.Nonce = new(big.Int).SetUint64(nonce)
+		nonce++
+		return &o
 	}
-	registry.Abi = contracts["Registry"].Abi
-	envStorageImp.Abi = contracts["EnvStorageImp"].Abi
-	staking.Abi = contracts["Staking"].Abi
-	ballotStorage.Abi = contracts["BallotStorage"].Abi
-	envStorage.Abi = contracts["EnvStorage"].Abi
-	govImp.Abi = contracts["GovImp"].Abi
-	gov.Abi = contracts["Gov"].Abi
 
-	txs := make([]common.Hash, 10)
+	var (
+		registry, envStorageImp, staking, ballotStorage, envStorage, govImp, gov common.Address
+		txs                                                                      [5]*types.Transaction
+	)
 
 	// 1. deploy Registry and EnvStorageImp contracts
-	ixTxs := 0
 	fmt.Println("Deploying Registry...")
-	if txs[ixTxs], err = metclient.Deploy(ctx, cli, from, contracts["Registry"], nil, gas, gasPrice); err != nil {
+	if _, txs[0], _, err = governance.DeployRegistry(txOpts(), cli); err != nil {
 		return err
 	}
-	ixTxs++
 	fmt.Println("Deploying EnvStorageImp...")
-	if txs[ixTxs], err = metclient.Deploy(ctx, cli, from, contracts["EnvStorageImp"], nil, gas, gasPrice); err != nil {
+	if _, txs[1], _, err = governance.DeployEnvStorageImp(txOpts(), cli); err != nil {
 		return err
 	}
 
 	fmt.Print("Waiting for receipts...")
-	for i := ixTxs; i >= 0; i-- {
-		var receipt *types.Receipt
-		receipt, err = metclient.GetContractReceipt(ctx, cli, txs[i], 200, 300)
-		if err != nil {
+	for i, addr := range []*common.Address{&registry, &envStorageImp} {
+		if *addr, err = bind.WaitDeployed(ctx, cli, txs[i]); err != nil {
 			return err
 		}
-		switch i {
-		case 0:
-			registry.To = &receipt.ContractAddress
-		case 1:
-			envStorageImp.To = &receipt.ContractAddress
-		}
 	}
 	fmt.Println("good.")
 
 	// 2. deploy Staking, BallotStorage, EnvStorage, GovImp, Gov
-	ixTxs = 0
 	fmt.Println("Deploying Staking...")
-	if txs[ixTxs], err = metclient.Deploy(ctx, cli, from, contracts["Staking"],
-		[]interface{}{registry.To, stakes}, gas, gasPrice); err != nil {
+	if _, txs[0], _, err = governance.DeployStaking(txOpts(), cli, registry, stakes); err != nil {
 		return err
 	}
-	ixTxs++
 	fmt.Println("Deploying BalloStorage...")
-	if txs[ixTxs], err = metclient.Deploy(ctx, cli, from, contracts["BallotStorage"],
-		[]interface{}{registry.To}, gas, gasPrice); err != nil {
+	if _, txs[1], _, err = governance.DeployBallotStorage(txOpts(), cli, registry); err != nil {
 		return err
 	}
-	ixTxs++
 	fmt.Println("Deploying EnvStorage...")
-	if txs[ixTxs], err = metclient.Deploy(ctx, cli, from, contracts["EnvStorage"],
-		[]interface{}{registry.To, envStorageImp.To}, gas, gasPrice); err != nil {
+	if _, txs[2], _, err = governance.DeployEnvStorage(txOpts(), cli, envStorageImp); err != nil {
 		return err
 	}
-	ixTxs++
 	fmt.Println("Deploying GovImp...")
-	if txs[ixTxs], err = metclient.Deploy(ctx, cli, from, contracts["GovImp"], nil, gas, gasPrice); err != nil {
-		return err
-	}
-	ixTxs++
-	fmt.Println("Deploying Gov...")
-	if txs[ixTxs], err = metclient.Deploy(ctx, cli, from, contracts["Gov"],
-		nil, gas, gasPrice); err != nil {
+	if _, txs[3], _, err = governance.DeployGovImp(txOpts(), cli); err != nil {
 		return err
 	}
-	fmt.Println("Gov tx is", txs[ixTxs].Hex())
 
 	fmt.Printf("Waiting for receipts...")
-	for i := ixTxs; i >= 0; i-- {
-		var receipt *types.Receipt
-		receipt, err = metclient.GetContractReceipt(ctx, cli, txs[i], 200, 300)
-		if err != nil {
+	for i, addr := range []*common.Address{&staking, &ballotStorage, &envStorage, &govImp} {
+		if *addr, err = bind.WaitDeployed(ctx, cli, txs[i]); err != nil {
 			return err
 		}
-		switch i {
-		case 0:
-			staking.To = &receipt.ContractAddress
-		case 1:
-			ballotStorage.To = &receipt.ContractAddress
-		case 2:
-			envStorage.To = &receipt.ContractAddress
-		case 3:
-			govImp.To = &receipt.ContractAddress
-		case 4:
-			gov.To = &receipt.ContractAddress
-		}
 	}
-	fmt.Printf("good. Governance address %v.\n", gov.To.Hex())
+	fmt.Println("good.")
 
-	// 3. setup registry
-	fmt.Println("Setting registry...")
-	ixTxs = 0
-	if txs[ixTxs], err = metclient.SendContract(ctx, registry, "setContractDomain", []interface{}{metclient.ToBytes32("Staking"), staking.To}); err != nil {
-		return err
-	}
-	ixTxs++
-	if txs[ixTxs], err = metclient.SendContract(ctx, registry, "setContractDomain", []interface{}{metclient.ToBytes32("BallotStorage"), ballotStorage.To}); err != nil {
+	fmt.Println("Deploying Gov...")
+	if _, txs[0], _, err = governance.DeployGov(txOpts(), cli, govImp); err != nil {
 		return err
 	}
-	ixTxs++
-	if txs[ixTxs], err = metclient.SendContract(ctx, registry, "setContractDomain", []interface{}{metclient.ToBytes32("EnvStorage"), envStorage.To}); err != nil {
+	fmt.Println("Gov tx is", txs[0].Hash().Hex())
+	if gov, err = bind.WaitDeployed(ctx, cli, txs[0]); err != nil {
 		return err
 	}
-	ixTxs++
-	if txs[ixTxs], err = metclient.SendContract(ctx, registry, "setContractDomain", []interface{}{metclient.ToBytes32("GovernanceContract"), gov.To}); err != nil {
+	fmt.Printf("good. Governance address %v.\n", gov.Hex())
+
+	// 3. setup registry
+	fmt.Println("Setting registry...")
+	reg, err := governance.NewRegistryTransactor(registry, cli)
+	if err != nil {
 		return err
 	}
-	if rewardPoolAccount != nil {
-		ixTxs++
-		if txs[ixTxs], err = metclient.SendContract(ctx, registry, "setContractDomain", []interface{}{metclient.ToBytes32("RewardPool"), rewardPoolAccount}); err != nil {
-			return err
+	domains := []struct {
+		name [32]byte
+		addr *common.Address
+	}{
+		{governance.StakingName, &staking},
+		{governance.BallotStorageName, &ballotStorage},
+		{governance.EnvStorageName, &envStorage},
+		{governance.GovernanceContractName, &gov},
+		{governance.RewardPoolName, rewardPoolAccount},
+		{governance.MaintenanceName, maintenanceAccount},
+	}
+	for _, domain := range domains {
+		if domain.addr == nil {
+			continue
 		}
-	}
-	if maintenanceAccount != nil {
-		ixTxs++
-		if txs[ixTxs], err = metclient.SendContract(ctx, registry, "setContractDomain", []interface{}{metclient.ToBytes32("Maintenance"), maintenanceAccount}); err != nil {
+		if _, err = reg.SetContractDomain(txOpts(), domain.name, *domain.addr); err != nil {
 			return err
 		}
 	}
 
 	// no need to wait for the receipts for the above
 
-	// 4. deposit staking - not needed
-	fmt.Println("Depositing stakes...")
-
-	// 5. Gov.initOnce()
-	fmt.Printf("Initializing governance members and nodes...")
-	if txs[0], err = metclient.SendContract(ctx, gov, "initOnce", []interface{}{registry.To, govImp.To, membersAndNodes}); err != nil {
+	// 4. initialize environment storage data
+	fmt.Printf("Initializing environment storage.\n")
+	env, err := governance.NewEnvStorageImpTransactor(envStorage, cli)
+	if err != nil {
 		return err
 	}
-
-	if receipt, err2 := metclient.GetReceipt(ctx, cli, txs[0], 200, 300); err2 != nil {
-		return err2
-	} else if receipt.Status != 1 {
-		fmt.Printf("Transaction %v failed with status %d.\n",
-			txs[0].Hex(), receipt.Status)
-		return fmt.Errorf("Transaction failed with status %d.", receipt.Status)
+	var (
+		envNames  [][32]byte
+		envValues []*big.Int
+	)
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{"blocksPer", "1"},
+		{"ballotDurationMin", "86400"},
+		{"ballotDurationMax", "604800"},
+		{"stakingMin", "4980000000000000000000000"},
+		{"stakingMax", "39840000000000000000000000"},
+		{"MaxIdleBlockInterval", "5"},
+		{"blockCreationTime", "1000"},
+		{"blockRewardAmount", "1000000000000000000"}, // 1 wemix
+		{"maxPriorityFeePerGas", "100000000000"},     // 100 gwei
+		{"blockRewardDistributionBlockProducer", "4000"},
+		{"blockRewardDistributionStakingReward", "1000"},
+		{"blockRewardDistributionEcosystem", "2500"},
+		{"blockRewardDistributionMaintenance", "2500"},
+		{"maxBaseFee", "50000000000000"}, // 50000 gwei
+		{"blockGasLimit", "105000000"},   // 5000 * 21000
+		{"baseFeeMaxChangeRate", "55"},
+		{"gasTargetPercentage", "30"},
+	} {
+		value, _ := new(big.Int).SetString(v.value, 10)
+		envNames = append(envNames, crypto.Keccak256Hash([]byte(v.name)))
+		envValues = append(envValues, value)
+	}
+	if txs[0], err = env.Initialize(txOpts(), registry, envNames, envValues); err != nil {
+		return err
 	}
-	fmt.Println("good.")
+	if _, err = waitMined(ctx, cli, txs[0]); err != nil {
+		return err
+	}
+
+	// 5. deposit staking - not needed
 
-	// 6. initialize environment storage data:
-	// blocksPer, ballotDurationMin, ballotDurationMax, stakingMin, stakingMax,
-	// gasPrice
-	defaultBlocksPer := big.NewInt(100)
-	defaultBallotDurationMin := big.NewInt(86400)
-	defaultBallotDurationMax := big.NewInt(604800)
-	defaultStakingMin, _ := big.NewInt(0).SetString("4980000000000000000000000", 0)
-	defaultStakingMax, _ := big.NewInt(0).SetString("39840000000000000000000000", 0)
-	defaultGasPrice := big.NewInt(80000000000)
-	defaultMaxIdleBlockInterval := big.NewInt(5)
-	envDefaults := []interface{}{
-		defaultBlocksPer,
-		defaultBallotDurationMin,
-		defaultBallotDurationMax,
-		defaultStakingMin,
-		defaultStakingMax,
-		defaultGasPrice,
-		defaultMaxIdleBlockInterval,
+	// 6. Gov.initOnce()
+	fmt.Printf("Initializing governance members and nodes...")
+	govTx, err := governance.NewGovImpTransactor(gov, cli)
+	if err != nil {
+		return err
 	}
-	fmt.Printf("Initializing environment storage.\n")
-	envStorageImp.To = envStorage.To
-	if txs[0], err = metclient.SendContract(ctx, envStorageImp, "initialize", envDefaults); err != nil {
+	if txs[0], err = govTx.InitOnce(txOpts(), registry, membersAndNodes); err != nil {
 		return err
 	}
+	if _, err = waitMined(ctx, cli, txs[0]); err != nil {
+		return err
+	}
+	fmt.Println("good.")
 
 	// 7. print the addresses
 	fmt.Printf(`{
@@ -337,8 +331,8 @@ func deployGovernanceContracts(cliCtx *cli.Context) error {
   "GOV_IMP_ADDRESS": "%s"
 }
 `,
-		registry.To.Hex(), staking.To.Hex(), envStorage.To.Hex(),
-		ballotStorage.To.Hex(), gov.To.Hex(), govImp.To.Hex())
+		registry.Hex(), staking.Hex(), envStorage.Hex(),
+		ballotStorage.Hex(), gov.Hex(), govImp.Hex())
 
 	return nil
 }
@@ -98,6 +98,7 @@ var (
 		utils.TxPoolAccountQueueFlag,
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolLifetimeFlag,
+		utils.TxPoolSenderHintSampleFlag,
 		utils.SyncModeFlag,
 		utils.ExitWhenSyncedFlag,
 		utils.GCModeFlag,
@@ -217,6 +218,12 @@ var (
 		utils.BlocksPerTurn,
 		utils.NonceLimit,
 		utils.UseRocksDb,
+		utils.RocksDBCompressionFlag,
+		utils.RocksDBWriteBufferFlag,
+		utils.RocksDBBackgroundJobsFlag,
+		utils.RocksDBRateLimitFlag,
+		utils.RocksDBColumnFamiliesFlag,
+		utils.RewardIndexFlag,
 		utils.PrefetchCount,
 		utils.LogFlag,
 		utils.MaxTxsPerBlock,
@@ -377,11 +384,14 @@ func geth(ctx *cli.Context) error {
 func startNode(ctx *cli.Context, stack *node.Node, backend ethapi.Backend, isConsole bool) {
 	debug.Memsize.Add("node", stack)
 
+	// Set up wemix admin before syncing any block
+	wemix.InitAdmin(stack, ctx.GlobalString(utils.DataDirFlag.Name), backend)
+
 	// Start up the node itself
 	utils.StartNode(ctx, stack, isConsole)
 
 	// Start wemix admin
-	wemix.StartAdmin(stack, ctx.GlobalString(utils.DataDirFlag.Name))
+	wemix.StartAdmin()
 
 	// Unlock any account specifically requested
 	unlockAccounts(ctx, stack)
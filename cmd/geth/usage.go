@@ -106,6 +106,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.TxPoolAccountQueueFlag,
 			utils.TxPoolGlobalQueueFlag,
 			utils.TxPoolLifetimeFlag,
+			utils.TxPoolSenderHintSampleFlag,
 		},
 	},
 	{
@@ -222,21 +223,18 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 		Name: "WEMIX",
 		Flags: []cli.Flag{
 			utils.ConsensusMethodFlag,
-			utils.FixedDifficultyFlag,
-			utils.FixedGasLimitFlag,
-			utils.MaxIdleBlockInterval,
-			utils.BlocksPerTurn,
 			utils.NonceLimit,
+			utils.MaxTxsPerBlock,
+			utils.Hub,
 			utils.UseRocksDb,
+			utils.RocksDBCompressionFlag,
+			utils.RocksDBWriteBufferFlag,
+			utils.RocksDBBackgroundJobsFlag,
+			utils.RocksDBRateLimitFlag,
+			utils.RocksDBColumnFamiliesFlag,
+			utils.RewardIndexFlag,
 			utils.PrefetchCount,
 			utils.LogFlag,
-			utils.MaxTxsPerBlock,
-			utils.Hub,
-			utils.BlockInterval,
-			utils.BlockTimeAdjBlocks,
-			utils.BlockMinBuildTime,
-			utils.BlockMinBuildTxs,
-			utils.BlockTrailTime,
 		},
 	},
 	{
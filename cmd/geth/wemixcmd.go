@@ -29,6 +29,8 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rpc"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
 	"github.com/ethereum/go-ethereum/wemix/metclient"
 	"gopkg.in/urfave/cli.v1"
 )
@@ -42,7 +44,8 @@ var (
 		Category:  "WEMIX COMMANDS",
 		Description: `
 
-Wemix helper commands, create a new account, a new node id, a new genesis file, or a new admin contract file.`,
+Wemix helper commands, create a new account, a new node id, a new genesis file, or a new admin contract file,
+or propose and vote on governance ballots.`,
 		Subcommands: []cli.Command{
 			{
 				Name:   "new-account",
@@ -160,12 +163,32 @@ Download a genesis file from a peer to initialize.`,
 					gasPriceFlag,
 				},
 				Description: `
-    geth wemix deploy-governance [--password value] [--url <url>] [--gas <gas>] [--gasprice <gas-price>] <contract-js-file> <config.js> <account-file>
+    geth wemix deploy-governance [--password value] [--url <url>] [--gas <gas>] [--gasprice <gas-price>] <config.js> <account-file>
 
 Deploy governance contracts.
 To give password in command line, use "--password <(echo <password>)".
 `,
 			},
+			{
+				Name:   "verify-rewards",
+				Usage:  "Verify block rewards in a range of blocks",
+				Action: utils.MigrateFlags(verifyRewards),
+				Flags: []cli.Flag{
+					urlFlag,
+					fromFlag,
+					toFlag,
+				},
+				Description: `
+    geth wemix verify-rewards --url <url> [--from <block>] [--to <block>]
+
+Verify the fees and rewards of blocks in the given range against the
+governance state with admin.verifyBlockRewards of the given node, and report
+blocks with discrepancies. Each block is replayed against the state of its
+parent, and the resulting balances and state root are compared with the
+imported ones. The admin API has to be available at <url>,
+e.g. gwemix.ipc. --to defaults to the latest block.`,
+			},
+			govCommand,
 		},
 	}
 
@@ -197,6 +220,15 @@ To give password in command line, use "--password <(echo <password>)".
 		Name:  "url",
 		Usage: "url of gwemix node",
 	}
+	fromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "first block number",
+		Value: 1,
+	}
+	toFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "last block number",
+	}
 )
 
 func newAccount(ctx *cli.Context) error {
@@ -590,6 +622,53 @@ func downloadGenesis(ctx *cli.Context) error {
 	return nil
 }
 
+func verifyRewards(ctx *cli.Context) error {
+	url := ctx.String(urlFlag.Name)
+	if url == "" {
+		return fmt.Errorf("URL is not given")
+	}
+
+	cli, err := rpc.Dial(url)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	from, to := ctx.Uint64(fromFlag.Name), ctx.Uint64(toFlag.Name)
+	if !ctx.IsSet(toFlag.Name) {
+		var head hexutil.Uint64
+		if err = cli.Call(&head, "eth_blockNumber"); err != nil {
+			return err
+		}
+		to = uint64(head)
+	}
+	if from == 0 || from > to {
+		return fmt.Errorf("Invalid block range: %d - %d", from, to)
+	}
+
+	failed := 0
+	for num := from; num <= to; num++ {
+		var r wemixapi.BlockRewardsReport
+		if err = cli.Call(&r, "admin_verifyBlockRewards", hexutil.Uint64(num)); err != nil {
+			return err
+		}
+		if r.Status {
+			continue
+		}
+		failed++
+		fmt.Printf("block %d: error=%q message=%q\n", num, r.Error, r.Message)
+		for _, d := range r.Discrepancies {
+			fmt.Printf("    %s %s: expected %v actual %v\n", d.Kind, d.Addr.Hex(), d.Expected, d.Actual)
+		}
+	}
+
+	fmt.Printf("verified %d blocks from %d to %d: %d failed\n", to-from+1, from, to, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d blocks failed verification", failed)
+	}
+	return nil
+}
+
 // borrowed from https://github.com/charlanxcc/logrot
 func parseSize(size string) (int, error) {
 	m := 1
@@ -393,6 +393,11 @@ var (
 		Usage: "Maximum amount of time non-executable transaction are queued",
 		Value: ethconfig.Defaults.TxPool.Lifetime,
 	}
+	TxPoolSenderHintSampleFlag = cli.Uint64Flag{
+		Name:  "txpool.senderhintsample",
+		Usage: "Percentage of the transaction senders hinted by the partners to verify (0-100)",
+		Value: ethconfig.Defaults.TxPool.SenderHintSample,
+	}
 	// Performance tuning settings
 	CacheFlag = cli.IntFlag{
 		Name:  "cache",
@@ -798,39 +803,50 @@ var (
 	// Wemix flags
 	ConsensusMethodFlag = cli.IntFlag{
 		Name:  "consensusmethod",
-		Usage: "Wemix consensus method (integer, 1=PoW, 2=PoA, 3=ETCD, 4=PBFT)",
+		Usage: "Wemix consensus method for the chains without it in the chain config (integer, 1=PoW, 2=ETCD, 3=PoA, 4=PBFT)",
 		Value: 2,
 	}
-	FixedDifficultyFlag = cli.Uint64Flag{
-		Name:  "fixeddifficulty",
-		Usage: "Fixed difficulty to disable PoW",
-		Value: params.FixedDifficulty,
-	}
-	FixedGasLimitFlag = cli.Uint64Flag{
-		Name:  "fixedgaslimit",
-		Usage: "Fixed gas limit to control block size better",
-		Value: params.FixedGasLimit,
-	}
-	MaxIdleBlockInterval = cli.Uint64Flag{
-		Name:  "maxidleblockinterval",
-		Usage: "Interval to generate empty block",
-		Value: params.MaxIdleBlockInterval,
-	}
-	BlocksPerTurn = cli.Uint64Flag{
-		Name:  "blocksperturn",
-		Usage: "Number of blocks per turn for PoA",
-		Value: params.BlocksPerTurn,
-	}
 	NonceLimit = cli.Uint64Flag{
 		Name:  "noncelimit",
-		Usage: "Nonce limit for non-governing accounts",
-		Value: params.NonceLimit,
+		Usage: "Nonce limit for non-governing accounts (default = the chain config's)",
+	}
+	MaxTxsPerBlock = cli.IntFlag{
+		Name:  "maxtxsperblock",
+		Usage: "Max # of transactions in a block (default = the chain config's)",
+	}
+	Hub = cli.StringFlag{
+		Name:  "hub",
+		Usage: "Comma separated ids of the transaction relay hubs by preference, or \"governance\" for the governance nodes",
 	}
 	UseRocksDb = cli.IntFlag{
 		Name:  "userocksdb",
-		Usage: "LevelDB (0) or RocksDB (1)",
+		Usage: "LevelDB (0), RocksDB (1) or Pebble (2)",
 		Value: params.UseRocksDb,
 	}
+	RocksDBCompressionFlag = cli.StringFlag{
+		Name:  "rocksdb.compression",
+		Usage: "Comma separated RocksDB compression per level, the last one for the deeper levels (none, snappy, lz4, lz4hc, zstd)",
+	}
+	RocksDBWriteBufferFlag = cli.IntFlag{
+		Name:  "rocksdb.writebuffer",
+		Usage: "RocksDB memtable size in megabytes (default = a quarter of the database cache)",
+	}
+	RocksDBBackgroundJobsFlag = cli.IntFlag{
+		Name:  "rocksdb.backgroundjobs",
+		Usage: "Maximum number of concurrent RocksDB flushes and compactions (default = RocksDB default)",
+	}
+	RocksDBRateLimitFlag = cli.IntFlag{
+		Name:  "rocksdb.ratelimit",
+		Usage: "RocksDB flush and compaction write rate limit in megabytes per second (default = unlimited)",
+	}
+	RocksDBColumnFamiliesFlag = cli.BoolFlag{
+		Name:  "rocksdb.columnfamilies",
+		Usage: "Keep trie nodes, receipts and snapshot data in separate RocksDB column families (new databases only)",
+	}
+	RewardIndexFlag = cli.BoolFlag{
+		Name:  "rewardindex",
+		Usage: "Index the block rewards and fees by address for wemix_getRewardHistory",
+	}
 	PrefetchCount = cli.IntFlag{
 		Name:  "prefetchcount",
 		Usage: "Transaction prefetch count for faster db read",
@@ -841,41 +857,6 @@ var (
 		Usage: "Rotating log file: <file-name>,<count>,<size>",
 		Value: "log,5,10M",
 	}
-	MaxTxsPerBlock = cli.IntFlag{
-		Name:  "maxtxsperblock",
-		Usage: "Max # of transactions in a block",
-		Value: params.MaxTxsPerBlock,
-	}
-	Hub = cli.StringFlag{
-		Name:  "hub",
-		Usage: "Id of message hub",
-		Value: params.Hub,
-	}
-	BlockInterval = cli.Int64Flag{
-		Name:  "wemix.block.interval",
-		Usage: "Block generation interval in seconds",
-		Value: params.BlockInterval,
-	}
-	BlockTimeAdjBlocks = cli.Int64Flag{
-		Name:  "wemix.block.timeadjblocks",
-		Usage: "Block interval to ajdust timestamp",
-		Value: params.BlockTimeAdjBlocks,
-	}
-	BlockMinBuildTime = cli.Int64Flag{
-		Name:  "wemix.block.minbuildtime",
-		Usage: "Minimum block generation time in ms",
-		Value: params.BlockMinBuildTime,
-	}
-	BlockMinBuildTxs = cli.Int64Flag{
-		Name:  "wemix.block.minbuildtxs",
-		Usage: "Minimum txs in a block with pending txs",
-		Value: params.BlockMinBuildTxs,
-	}
-	BlockTrailTime = cli.Int64Flag{
-		Name:  "wemix.block.trailtime",
-		Usage: "Time to leave for block data transfer in ms",
-		Value: params.BlockTrailTime,
-	}
 )
 
 // MakeDataDir retrieves the currently requested data directory, terminating
@@ -1305,6 +1286,7 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	setNodeUserIdent(ctx, cfg)
 	setDataDir(ctx, cfg)
 	setSmartCard(ctx, cfg)
+	setRocksDB(ctx, cfg)
 
 	if ctx.GlobalIsSet(ExternalSignerFlag.Name) {
 		cfg.ExternalSigner = ctx.GlobalString(ExternalSignerFlag.Name)
@@ -1330,6 +1312,28 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	}
 }
 
+// setRocksDB applies the RocksDB profile flags to the config.
+func setRocksDB(ctx *cli.Context, cfg *node.Config) {
+	if ctx.GlobalIsSet(RocksDBCompressionFlag.Name) {
+		cfg.RocksDB.Compression = SplitAndTrim(ctx.GlobalString(RocksDBCompressionFlag.Name))
+	}
+	if ctx.GlobalIsSet(RocksDBWriteBufferFlag.Name) {
+		cfg.RocksDB.WriteBufferSize = ctx.GlobalInt(RocksDBWriteBufferFlag.Name)
+	}
+	if ctx.GlobalIsSet(RocksDBBackgroundJobsFlag.Name) {
+		cfg.RocksDB.MaxBackgroundJobs = ctx.GlobalInt(RocksDBBackgroundJobsFlag.Name)
+	}
+	if ctx.GlobalIsSet(RocksDBRateLimitFlag.Name) {
+		cfg.RocksDB.RateLimit = ctx.GlobalInt(RocksDBRateLimitFlag.Name)
+	}
+	if ctx.GlobalIsSet(RocksDBColumnFamiliesFlag.Name) {
+		cfg.RocksDB.ColumnFamilies = ctx.GlobalBool(RocksDBColumnFamiliesFlag.Name)
+	}
+	if err := cfg.RocksDB.Validate(); err != nil {
+		Fatalf("Invalid RocksDB config: %v", err)
+	}
+}
+
 func setSmartCard(ctx *cli.Context, cfg *node.Config) {
 	// Skip enabling smartcards if no path is set
 	path := ctx.GlobalString(SmartCardDaemonPathFlag.Name)
@@ -1438,6 +1442,9 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.GlobalDuration(TxPoolLifetimeFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolSenderHintSampleFlag.Name) {
+		cfg.SenderHintSample = ctx.GlobalUint64(TxPoolSenderHintSampleFlag.Name)
+	}
 }
 
 func setEthash(ctx *cli.Context, cfg *ethconfig.Config) {
@@ -1808,52 +1815,33 @@ func SetWemixConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 	if ctx.GlobalIsSet(ConsensusMethodFlag.Name) {
 		params.ConsensusMethod = ctx.GlobalInt(ConsensusMethodFlag.Name)
 	} else {
-		params.ConsensusMethod = params.ConsensusPoA
-	}
-	if ctx.GlobalIsSet(FixedDifficultyFlag.Name) {
-		params.FixedDifficulty = ctx.GlobalUint64(FixedDifficultyFlag.Name)
+		params.ConsensusMethod = params.ConsensusETCD
 	}
-	if ctx.GlobalIsSet(FixedGasLimitFlag.Name) {
-		params.FixedGasLimit = ctx.GlobalUint64(FixedGasLimitFlag.Name)
-	}
-	if ctx.GlobalIsSet(MaxIdleBlockInterval.Name) {
-		params.MaxIdleBlockInterval = ctx.GlobalUint64(MaxIdleBlockInterval.Name)
+	if ctx.GlobalIsSet(UseRocksDb.Name) {
+		params.UseRocksDb = ctx.GlobalInt(UseRocksDb.Name)
 	}
-	if ctx.GlobalIsSet(BlocksPerTurn.Name) {
-		params.BlocksPerTurn = ctx.GlobalUint64(BlocksPerTurn.Name)
+	if ctx.GlobalIsSet(RewardIndexFlag.Name) {
+		cfg.RewardIndex = ctx.GlobalBool(RewardIndexFlag.Name)
 	}
 	if ctx.GlobalIsSet(NonceLimit.Name) {
-		params.NonceLimit = ctx.GlobalUint64(NonceLimit.Name)
-	}
-	if ctx.GlobalIsSet(UseRocksDb.Name) {
-		params.UseRocksDb = ctx.GlobalInt(UseRocksDb.Name)
+		cfg.TxPool.NonceLimit = ctx.GlobalUint64(NonceLimit.Name)
 	}
 	if ctx.GlobalIsSet(MaxTxsPerBlock.Name) {
-		params.MaxTxsPerBlock = ctx.GlobalInt(MaxTxsPerBlock.Name)
+		cfg.Miner.MaxTxsPerBlock = ctx.GlobalInt(MaxTxsPerBlock.Name)
 	}
 	if ctx.GlobalIsSet(Hub.Name) {
-		params.Hub = ctx.GlobalString(Hub.Name)
+		cfg.Hubs = SplitAndTrim(ctx.GlobalString(Hub.Name))
 	}
-	if ctx.GlobalIsSet(BlockInterval.Name) {
-		params.BlockInterval = ctx.GlobalInt64(BlockInterval.Name)
-	}
-	if ctx.GlobalIsSet(BlockTimeAdjBlocks.Name) {
-		params.BlockTimeAdjBlocks = ctx.GlobalInt64(BlockTimeAdjBlocks.Name)
-	}
-	if ctx.GlobalIsSet(BlockMinBuildTime.Name) {
-		params.BlockMinBuildTime = ctx.GlobalInt64(BlockMinBuildTime.Name)
-	}
-	if ctx.GlobalIsSet(BlockMinBuildTxs.Name) {
-		params.BlockMinBuildTxs = ctx.GlobalInt64(BlockMinBuildTxs.Name)
-	}
-	if ctx.GlobalIsSet(BlockTrailTime.Name) {
-		params.BlockTrailTime = ctx.GlobalInt64(BlockTrailTime.Name)
+	for _, flag := range DeprecatedWemixFlags {
+		if name := strings.Split(flag.GetName(), ",")[0]; ctx.GlobalIsSet(name) {
+			log.Warn(fmt.Sprintf("The --%s flag is deprecated and ignored, set it in the wemix section of the genesis config", name))
+		}
 	}
 
 	if params.ConsensusMethod == params.ConsensusInvalid {
 		params.ConsensusMethod = params.ConsensusPoW
 	}
-	if params.ConsensusMethod <= params.ConsensusInvalid || params.ConsensusMethod >= params.ConsensusETCD {
+	if params.ConsensusMethod <= params.ConsensusInvalid || params.ConsensusMethod > params.ConsensusPBFT {
 		Fatalf("Invalid Consensus Method: %d", ctx.GlobalString(ConsensusMethodFlag.Name))
 	}
 	params.WemixGenesisFile = filepath.Join(ctx.GlobalString(DataDirFlag.Name), "genesis.json")
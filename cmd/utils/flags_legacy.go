@@ -32,9 +32,23 @@ var ShowDeprecated = cli.Command{
 	Description: "Show flags that have been deprecated and will soon be removed",
 }
 
-var DeprecatedFlags = []cli.Flag{
+var DeprecatedFlags = append([]cli.Flag{
 	LegacyMinerGasTargetFlag,
 	NoUSBFlag,
+}, DeprecatedWemixFlags...)
+
+// DeprecatedWemixFlags are the wemix consensus parameters moved to the chain
+// config, params.WemixConfig.
+var DeprecatedWemixFlags = []cli.Flag{
+	FixedDifficultyFlag,
+	FixedGasLimitFlag,
+	MaxIdleBlockInterval,
+	BlocksPerTurn,
+	BlockInterval,
+	BlockTimeAdjBlocks,
+	BlockMinBuildTime,
+	BlockMinBuildTxs,
+	BlockTrailTime,
 }
 
 var (
@@ -49,6 +63,44 @@ var (
 		Usage: "Target gas floor for mined blocks (deprecated)",
 		Value: ethconfig.Defaults.Miner.GasFloor,
 	}
+
+	// (Deprecated Oct 2022, moved to the wemix section of the chain config)
+	FixedDifficultyFlag = cli.Uint64Flag{
+		Name:  "fixeddifficulty",
+		Usage: "Fixed difficulty to disable PoW (deprecated)",
+	}
+	FixedGasLimitFlag = cli.Uint64Flag{
+		Name:  "fixedgaslimit",
+		Usage: "Fixed gas limit to control block size better (deprecated)",
+	}
+	MaxIdleBlockInterval = cli.Uint64Flag{
+		Name:  "maxidleblockinterval",
+		Usage: "Interval to generate empty block (deprecated)",
+	}
+	BlocksPerTurn = cli.Uint64Flag{
+		Name:  "blocksperturn",
+		Usage: "Number of blocks per turn for PoA (deprecated)",
+	}
+	BlockInterval = cli.Int64Flag{
+		Name:  "wemix.block.interval",
+		Usage: "Block generation interval in seconds (deprecated)",
+	}
+	BlockTimeAdjBlocks = cli.Int64Flag{
+		Name:  "wemix.block.timeadjblocks",
+		Usage: "Block interval to ajdust timestamp (deprecated)",
+	}
+	BlockMinBuildTime = cli.Int64Flag{
+		Name:  "wemix.block.minbuildtime",
+		Usage: "Minimum block generation time in ms (deprecated)",
+	}
+	BlockMinBuildTxs = cli.Int64Flag{
+		Name:  "wemix.block.minbuildtxs",
+		Usage: "Minimum txs in a block with pending txs (deprecated)",
+	}
+	BlockTrailTime = cli.Int64Flag{
+		Name:  "wemix.block.trailtime",
+		Usage: "Time to leave for block data transfer in ms (deprecated)",
+	}
 )
 
 // showDeprecated displays deprecated flags that will be soon removed from the codebase.
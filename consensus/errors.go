@@ -16,7 +16,11 @@
 
 package consensus
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
 
 var (
 	// ErrUnknownAncestor is returned when validating a block requires an ancestor
@@ -37,4 +41,24 @@ var (
 
 	// ErrUnauthorized is returned if a block's minerNodeId or minerNodeSig is invalid.
 	ErrUnauthorized = errors.New("unauthorized block")
+
+	// ErrInvalidRewards is returned if a block's rewards, coinbase or credited
+	// balances don't match the ones calculated from the governance state.
+	ErrInvalidRewards = errors.New("invalid rewards")
 )
+
+// RewardsError is returned if a block's rewards distribution doesn't match the
+// one calculated from the governance state of its parent block. It wraps
+// ErrInvalidRewards.
+type RewardsError struct {
+	Number *big.Int
+	Reason string
+}
+
+func (e *RewardsError) Error() string {
+	return fmt.Sprintf("%v in block %v: %s", ErrInvalidRewards, e.Number, e.Reason)
+}
+
+func (e *RewardsError) Unwrap() error {
+	return ErrInvalidRewards
+}
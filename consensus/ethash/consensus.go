@@ -34,7 +34,6 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
-	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -42,7 +41,6 @@ import (
 var (
 	FrontierBlockReward           = big.NewInt(5e+18) // Block reward in wei for successfully mining a block
 	ByzantiumBlockReward          = big.NewInt(3e+18) // Block reward in wei for successfully mining a block upward from Byzantium
-	WemixBlockReward              = big.NewInt(0)     // Block reward in wei for Wemix
 	ConstantinopleBlockReward     = big.NewInt(2e+18) // Block reward in wei for successfully mining a block upward from Constantinople
 	maxUncles                     = 2                 // Maximum number of uncles allowed in a single block
 	allowedFutureBlockTimeSeconds = int64(15)         // Max seconds from current time allowed for blocks, before they're considered future blocks
@@ -273,7 +271,7 @@ func (ethash *Ethash) verifyHeader(chain consensus.ChainHeaderReader, header, pa
 			return consensus.ErrFutureBlock
 		}
 	}
-	if wemixminer.IsPoW() && header.Time <= parent.Time {
+	if header.Time <= parent.Time {
 		return errOlderBlockTime
 	}
 	// Verify the block's difficulty based on its timestamp and parent's difficulty
@@ -320,10 +318,6 @@ func (ethash *Ethash) verifyHeader(chain consensus.ChainHeaderReader, header, pa
 	if err := misc.VerifyForkHashes(chain.Config(), header, uncle); err != nil {
 		return err
 	}
-	// Wemix: Check if it's generated and signed by a registered node
-	if !wemixminer.IsPoW() && !wemixminer.VerifyBlockSig(header.Number, header.MinerNodeId, header.Root, header.MinerNodeSig) {
-		return consensus.ErrUnauthorized
-	}
 	return nil
 }
 
@@ -338,10 +332,6 @@ func (ethash *Ethash) CalcDifficulty(chain consensus.ChainHeaderReader, time uin
 // the difficulty that a new block should have when created at time
 // given the parent block's time and difficulty.
 func CalcDifficulty(config *params.ChainConfig, time uint64, parent *types.Header) *big.Int {
-	if !wemixminer.IsPoW() {
-		return big1
-	}
-
 	next := new(big.Int).Add(parent.Number, big1)
 	switch {
 	case config.IsArrowGlacier(next):
@@ -548,37 +538,33 @@ func (ethash *Ethash) verifySeal(chain consensus.ChainHeaderReader, header *type
 		digest []byte
 		result []byte
 	)
-	if wemixminer.IsPoW() {
-		// If fast-but-heavy PoW verification was requested, use an ethash dataset
-		if fulldag {
-			dataset := ethash.dataset(number, true)
-			if dataset.generated() {
-				digest, result = hashimotoFull(dataset.dataset, ethash.SealHash(header).Bytes(), header.Nonce.Uint64())
-
-				// Datasets are unmapped in a finalizer. Ensure that the dataset stays alive
-				// until after the call to hashimotoFull so it's not unmapped while being used.
-				runtime.KeepAlive(dataset)
-			} else {
-				// Dataset not yet generated, don't hang, use a cache instead
-				fulldag = false
-			}
+	// If fast-but-heavy PoW verification was requested, use an ethash dataset
+	if fulldag {
+		dataset := ethash.dataset(number, true)
+		if dataset.generated() {
+			digest, result = hashimotoFull(dataset.dataset, ethash.SealHash(header).Bytes(), header.Nonce.Uint64())
+
+			// Datasets are unmapped in a finalizer. Ensure that the dataset stays alive
+			// until after the call to hashimotoFull so it's not unmapped while being used.
+			runtime.KeepAlive(dataset)
+		} else {
+			// Dataset not yet generated, don't hang, use a cache instead
+			fulldag = false
 		}
-		// If slow-but-light PoW verification was requested (or DAG not yet ready), use an ethash cache
-		if !fulldag {
-			cache := ethash.cache(number)
-
-			size := datasetSize(number)
-			if ethash.config.PowMode == ModeTest {
-				size = 32 * 1024
-			}
-			digest, result = hashimotoLight(size, cache.cache, ethash.SealHash(header).Bytes(), header.Nonce.Uint64())
+	}
+	// If slow-but-light PoW verification was requested (or DAG not yet ready), use an ethash cache
+	if !fulldag {
+		cache := ethash.cache(number)
 
-			// Caches are unmapped in a finalizer. Ensure that the cache stays alive
-			// until after the call to hashimotoLight so it's not unmapped while being used.
-			runtime.KeepAlive(cache)
+		size := datasetSize(number)
+		if ethash.config.PowMode == ModeTest {
+			size = 32 * 1024
 		}
-	} else {
-		digest, result = hashimeta(ethash.SealHash(header).Bytes(), header.Nonce.Uint64())
+		digest, result = hashimotoLight(size, cache.cache, ethash.SealHash(header).Bytes(), header.Nonce.Uint64())
+
+		// Caches are unmapped in a finalizer. Ensure that the cache stays alive
+		// until after the call to hashimotoLight so it's not unmapped while being used.
+		runtime.KeepAlive(cache)
 	}
 	// Verify the calculated values against the ones provided in the header
 	if !bytes.Equal(header.MixDigest[:], digest) {
@@ -606,9 +592,7 @@ func (ethash *Ethash) Prepare(chain consensus.ChainHeaderReader, header *types.H
 // setting the final state on the header
 func (ethash *Ethash) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) error {
 	// Accumulate any block and uncle rewards and commit the final state root
-	if err := accumulateRewards(chain.Config(), state, header, uncles); err != nil {
-		return err
-	}
+	accumulateRewards(chain.Config(), state, header, uncles)
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	return nil
 }
@@ -617,20 +601,7 @@ func (ethash *Ethash) Finalize(chain consensus.ChainHeaderReader, header *types.
 // uncle rewards, setting the final state and assembling the block.
 func (ethash *Ethash) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
 	// Finalize block
-	if err := ethash.Finalize(chain, header, state, txs, uncles); err != nil {
-		return nil, err
-	}
-
-	// sign header.Root with node's private key
-	if !wemixminer.IsPoW() {
-		nodeId, sig, err := wemixminer.SignBlock(header.Root)
-		if err != nil {
-			return nil, err
-		} else {
-			header.MinerNodeId = nodeId
-			header.MinerNodeSig = sig
-		}
-	}
+	ethash.Finalize(chain, header, state, txs, uncles)
 
 	// Header seems complete, assemble into a block and return
 	return types.NewBlock(header, txs, uncles, receipts, trie.NewStackTrie(nil)), nil
@@ -672,7 +643,7 @@ var (
 // AccumulateRewards credits the coinbase of the given block with the mining
 // reward. The total reward consists of the static block reward and rewards for
 // included uncles. The coinbase of each uncle block is also rewarded.
-func accumulateRewards(config *params.ChainConfig, state *state.StateDB, header *types.Header, uncles []*types.Header) error {
+func accumulateRewards(config *params.ChainConfig, state *state.StateDB, header *types.Header, uncles []*types.Header) {
 	// Select the correct block reward based on chain progression
 	blockReward := FrontierBlockReward
 	if config.IsByzantium(header.Number) {
@@ -681,45 +652,18 @@ func accumulateRewards(config *params.ChainConfig, state *state.StateDB, header
 	if config.IsConstantinople(header.Number) {
 		blockReward = ConstantinopleBlockReward
 	}
+	// Accumulate the rewards for the miner and any included uncles
+	reward := new(big.Int).Set(blockReward)
+	r := new(big.Int)
+	for _, uncle := range uncles {
+		r.Add(uncle.Number, big8)
+		r.Sub(r, header.Number)
+		r.Mul(r, blockReward)
+		r.Div(r, big8)
+		state.AddBalance(uncle.Coinbase, r)
 
-	if wemixminer.IsPoW() {
-		// Accumulate the rewards for the miner and any included uncles
-		reward := new(big.Int).Set(blockReward)
-		r := new(big.Int)
-		for _, uncle := range uncles {
-			r.Add(uncle.Number, big8)
-			r.Sub(r, header.Number)
-			r.Mul(r, blockReward)
-			r.Div(r, big8)
-			state.AddBalance(uncle.Coinbase, r)
-
-			r.Div(blockReward, big32)
-			reward.Add(reward, r)
-		}
-		state.AddBalance(header.Coinbase, reward)
-	} else {
-		blockReward = WemixBlockReward
-		coinbase, rewards, err := wemixminer.CalculateRewards(
-			header.Number, blockReward, header.Fees,
-			func(addr common.Address, amt *big.Int) {
-				state.AddBalance(addr, amt)
-			})
-		if err == nil {
-			header.Rewards = rewards
-			if coinbase != nil {
-				header.Coinbase = *coinbase
-			}
-		} else {
-			if err == wemixminer.ErrNotInitialized {
-				reward := new(big.Int)
-				if header.Fees != nil {
-					reward.Add(blockReward, header.Fees)
-				}
-				state.AddBalance(header.Coinbase, reward)
-				return nil
-			}
-			return err
-		}
+		r.Div(blockReward, big32)
+		reward.Add(reward, r)
 	}
-	return nil
+	state.AddBalance(header.Coinbase, reward)
 }
@@ -34,7 +34,6 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/types"
-	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
 )
 
 const (
@@ -50,30 +49,6 @@ var (
 // Seal implements consensus.Engine, attempting to find a nonce that satisfies
 // the block's difficulty requirements.
 func (ethash *Ethash) Seal(chain consensus.ChainHeaderReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
-	if !wemixminer.IsPoW() {
-		// In Wemix, return immediately
-		ethash.lock.Lock()
-		if ethash.rand == nil {
-			seed, err := crand.Int(crand.Reader, big.NewInt(math.MaxInt64))
-			if err != nil {
-				ethash.lock.Unlock()
-				return err
-			}
-			ethash.rand = rand.New(rand.NewSource(seed.Int64()))
-		}
-		ethash.lock.Unlock()
-		abort := make(chan struct{})
-		found := make(chan *types.Block)
-		go ethash.mine(block, 0, uint64(ethash.rand.Int63()), abort, found)
-		result := <-found
-		select {
-		case results <- result:
-		default:
-			ethash.config.Log.Warn("Sealing result is not read by miner", "sealhash", ethash.SealHash(block.Header()))
-		}
-		return nil
-	}
-
 	// If we're running a fake PoW, simply return a 0 nonce immediately
 	if ethash.config.PowMode == ModeFake || ethash.config.PowMode == ModeFullFake {
 		header := block.Header()
@@ -161,11 +136,8 @@ func (ethash *Ethash) mine(block *types.Block, id int, seed uint64, abort chan s
 		hash    = ethash.SealHash(header).Bytes()
 		target  = new(big.Int).Div(two256, header.Difficulty)
 		number  = header.Number.Uint64()
-		dataset *dataset
-	)
-	if wemixminer.IsPoW() {
 		dataset = ethash.dataset(number, false)
-	}
+	)
 	// Start generating random nonces until we abort or find a good one
 	var (
 		attempts  = int64(0)
@@ -191,12 +163,7 @@ search:
 				attempts = 0
 			}
 			// Compute the PoW value of this nonce
-			var digest, result []byte
-			if wemixminer.IsPoW() {
-				digest, result = hashimotoFull(dataset.dataset, hash, nonce)
-			} else {
-				digest, result = hashimeta(hash, nonce)
-			}
+			digest, result := hashimotoFull(dataset.dataset, hash, nonce)
 			if powBuffer.SetBytes(result).Cmp(target) <= 0 {
 				// Correct nonce found, create a new header with it
 				header = types.CopyHeader(header)
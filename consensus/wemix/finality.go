@@ -0,0 +1,108 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package wemix
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	errUnknownVoter   = errors.New("unknown finality voter")
+	errDuplicateVoter = errors.New("duplicate finality voter")
+	errNoQuorum       = errors.New("not enough finality votes")
+)
+
+// finalityParent returns the parent of the block voted for, whose governance
+// state the voters are checked against.
+func finalityParent(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (*types.Header, error) {
+	header := chain.GetHeader(hash, number)
+	if header == nil || number == 0 {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	return parent, nil
+}
+
+// FinalityQuorum returns the # of votes required for the block, i.e. more
+// than 2/3 of the nodes allowed to sign the block.
+func (w *Wemix) FinalityQuorum(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (int, error) {
+	backend := w.getBackend()
+	if backend == nil {
+		return 0, errNoBackend
+	}
+	parent, err := finalityParent(chain, number, hash)
+	if err != nil {
+		return 0, err
+	}
+	n, err := backend.NumBlockSigners(parent)
+	if err != nil {
+		return 0, err
+	}
+	return n*2/3 + 1, nil
+}
+
+// SignFinalityVote signs a vote for the block with the node key.
+func (w *Wemix) SignFinalityVote(typ uint8, number uint64, hash common.Hash) (*types.FinalityVote, error) {
+	backend := w.getBackend()
+	if backend == nil {
+		return nil, errNoBackend
+	}
+	vote := &types.FinalityVote{Type: typ, Number: number, Hash: hash}
+	var err error
+	if vote.NodeId, vote.Sig, err = backend.SignBlock(vote.SigHash()); err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+// VerifyFinalityVote checks if the vote is signed by one of the nodes allowed
+// to sign the block. The governance state of the parent block is required.
+func (w *Wemix) VerifyFinalityVote(chain consensus.ChainHeaderReader, vote *types.FinalityVote) error {
+	backend := w.getBackend()
+	if backend == nil {
+		return errNoBackend
+	}
+	if err := vote.Verify(); err != nil {
+		return err
+	}
+	parent, err := finalityParent(chain, vote.Number, vote.Hash)
+	if err != nil {
+		return err
+	}
+	if ok, err := backend.IsBlockSigner(parent, vote.NodeId); err != nil {
+		return err
+	} else if !ok {
+		return errUnknownVoter
+	}
+	return nil
+}
+
+// VerifyFinalityCert checks if the commit certificate has valid precommits
+// of more than 2/3 of the nodes allowed to sign the block.
+func (w *Wemix) VerifyFinalityCert(chain consensus.ChainHeaderReader, cert *types.FinalityCert) error {
+	quorum, err := w.FinalityQuorum(chain, cert.Number, cert.Hash)
+	if err != nil {
+		return err
+	}
+	voters := make(map[string]bool)
+	for _, vote := range cert.Precommits() {
+		if voters[string(vote.NodeId)] {
+			return errDuplicateVoter
+		}
+		voters[string(vote.NodeId)] = true
+		if err := w.VerifyFinalityVote(chain, vote); err != nil {
+			return err
+		}
+	}
+	if len(voters) < quorum {
+		return errNoQuorum
+	}
+	return nil
+}
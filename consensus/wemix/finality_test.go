@@ -0,0 +1,137 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package wemix
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// testChain is a chain of the given headers.
+type testChain struct {
+	headers map[common.Hash]*types.Header
+}
+
+func newTestChain(headers ...*types.Header) *testChain {
+	c := &testChain{headers: make(map[common.Hash]*types.Header)}
+	for _, h := range headers {
+		c.headers[h.Hash()] = h
+	}
+	return c
+}
+
+func (c *testChain) Config() *params.ChainConfig  { return params.TestChainConfig }
+func (c *testChain) CurrentHeader() *types.Header { return nil }
+
+func (c *testChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if h := c.headers[hash]; h != nil && h.Number.Uint64() == number {
+		return h
+	}
+	return nil
+}
+
+func (c *testChain) GetHeaderByNumber(number uint64) *types.Header {
+	for _, h := range c.headers {
+		if h.Number.Uint64() == number {
+			return h
+		}
+	}
+	return nil
+}
+
+func (c *testChain) GetHeaderByHash(hash common.Hash) *types.Header { return c.headers[hash] }
+func (c *testChain) GetTd(hash common.Hash, number uint64) *big.Int { return nil }
+
+// testVoters is a governance of several nodes, signing with one of them.
+type testVoters struct {
+	testBackend
+	keys []*ecdsa.PrivateKey
+}
+
+func (b *testVoters) IsBlockSigner(parent *types.Header, nodeId []byte) (bool, error) {
+	for _, key := range b.keys {
+		if bytes.Equal(nodeId, crypto.FromECDSAPub(&key.PublicKey)[1:]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (b *testVoters) NumBlockSigners(parent *types.Header) (int, error) {
+	return len(b.keys), nil
+}
+
+func (b *testVoters) MinerSchedule(parent *types.Header) ([][]byte, uint64, error) {
+	var ids [][]byte
+	for _, key := range b.keys {
+		ids = append(ids, crypto.FromECDSAPub(&key.PublicKey)[1:])
+	}
+	return ids, 1, nil
+}
+
+func TestVerifyFinalityCert(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 4)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	outsider, _ := crypto.GenerateKey()
+
+	engines := make([]*Wemix, len(keys)+1)
+	for i, key := range append(keys, outsider) {
+		engines[i] = New(params.ConsensusPBFT)
+		engines[i].SetBackend(&testVoters{testBackend: testBackend{key: key}, keys: keys})
+	}
+	parent := &types.Header{Number: big.NewInt(9), Difficulty: big.NewInt(1)}
+	block := &types.Header{Number: big.NewInt(10), Difficulty: big.NewInt(1), ParentHash: parent.Hash()}
+	chain := newTestChain(parent, block)
+	hash := block.Hash()
+	if quorum, _ := engines[0].FinalityQuorum(chain, 10, hash); quorum != 3 {
+		t.Fatalf("quorum mismatch: have %d, want 3", quorum)
+	}
+	if _, err := engines[0].FinalityQuorum(chain, 10, common.HexToHash("0x01")); err == nil {
+		t.Fatalf("quorum of unknown block returned")
+	}
+
+	sign := func(signers ...int) *types.FinalityCert {
+		cert := &types.FinalityCert{Number: 10, Hash: hash}
+		for _, i := range signers {
+			vote, err := engines[i].SignFinalityVote(types.Precommit, 10, hash)
+			if err != nil {
+				t.Fatalf("failed to sign vote: %v", err)
+			}
+			cert.Sigs = append(cert.Sigs, &types.FinalitySig{NodeId: vote.NodeId, Sig: vote.Sig})
+		}
+		return cert
+	}
+	prevote := func() *types.FinalityCert {
+		vote, _ := engines[2].SignFinalityVote(types.Prevote, 10, hash)
+		cert := sign(0, 1)
+		cert.Sigs = append(cert.Sigs, &types.FinalitySig{NodeId: vote.NodeId, Sig: vote.Sig})
+		return cert
+	}
+
+	tests := []struct {
+		name string
+		cert *types.FinalityCert
+		err  error
+	}{
+		{"quorum", sign(0, 1, 2), nil},
+		{"all", sign(3, 2, 1, 0), nil},
+		{"short", sign(0, 1), errNoQuorum},
+		{"duplicate", sign(0, 1, 1), errDuplicateVoter},
+		{"outsider", sign(0, 1, 4), errUnknownVoter},
+		{"prevote", prevote(), types.ErrInvalidVoteSig},
+	}
+	for _, tt := range tests {
+		if err := engines[0].VerifyFinalityCert(chain, tt.cert); err != tt.err {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.err, err)
+		}
+	}
+}
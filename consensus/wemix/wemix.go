@@ -0,0 +1,603 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+// Package wemix implements the Wemix consensus engine, where blocks are
+// generated and signed by the nodes registered in the governance contracts,
+// and the rewards are distributed as agreed in the governance.
+package wemix
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+	"golang.org/x/crypto/sha3"
+)
+
+// Wemix protocol constants.
+var (
+	BlockReward                   = big.NewInt(0) // Block reward in wei, the actual rewards are set in the governance
+	allowedFutureBlockTimeSeconds = int64(15)     // Max seconds from current time allowed for blocks, before they're considered future blocks
+
+	big1 = big.NewInt(1)
+)
+
+// Various error messages to mark blocks invalid. These should be private to
+// prevent engine specific errors from being referenced in the remainder of the
+// codebase, inherently breaking if the engine is swapped out. Please put common
+// error types into the consensus package.
+var (
+	errUnclesNotAllowed  = errors.New("uncles not allowed")
+	errInvalidDifficulty = errors.New("invalid difficulty")
+	errInvalidMixDigest  = errors.New("invalid mix digest")
+	errNoBackend         = errors.New("wemix backend not set")
+	errOutOfTurn         = errors.New("block signed out of turn")
+)
+
+// ErrStateUnavailable is returned by the backend if the governance can't be
+// read as the state of the parent block is not available, i.e. the parent is
+// not imported yet or its state is pruned.
+var ErrStateUnavailable = errors.New("state unavailable")
+
+// Backend provides the governance dependent parts of the consensus, i.e.
+// block signer membership, rewards distribution and block signing.
+type Backend interface {
+	// IsBlockSigner checks if the node, identified by its public key, is
+	// allowed to sign the child block of 'parent'. The governance is read
+	// from the state of that very parent, not the canonical block at its
+	// height. ErrStateUnavailable is returned if the state is not available.
+	IsBlockSigner(parent *types.Header, nodeId []byte) (bool, error)
+
+	// NumBlockSigners returns the # of nodes allowed to sign the child block
+	// of 'parent'.
+	NumBlockSigners(parent *types.Header) (int, error)
+
+	// MinerSchedule returns the public keys of the nodes taking turns to
+	// sign the blocks following 'parent' in the order of their names, and
+	// the # of blocks in a turn, as in the governance at that very parent.
+	// No nodes are returned before governance is established.
+	MinerSchedule(parent *types.Header) (nodeIds [][]byte, blocksPer uint64, err error)
+
+	// CalculateRewards calculates the rewards distribution of the child
+	// block of 'parent', credits them with addBalance if not nil, and
+	// returns the coinbase and the json encoded rewards. ErrNotInitialized
+	// is returned if the governance is not available.
+	CalculateRewards(parent *types.Header, blockReward, fees *big.Int, addBalance func(common.Address, *big.Int)) (*common.Address, []byte, error)
+
+	// VerifyRewards compares the json encoded rewards in a block header
+	// against the expected ones.
+	VerifyRewards(num *big.Int, expected, actual []byte) error
+
+	// SignBlock signs the hash with the node key, and returns the node's
+	// public key and the signature.
+	SignBlock(hash common.Hash) (nodeId, sig []byte, err error)
+}
+
+// Wemix is the consensus engine for Wemix. Until a backend is set, blocks are
+// checked for valid signatures only, rewards go to the coinbase, and the
+// blocks carrying rewards fail with errNoBackend.
+type Wemix struct {
+	method  int // params.ConsensusPoA, ConsensusETCD or ConsensusPBFT
+	lock    sync.RWMutex
+	backend Backend
+}
+
+// New creates a Wemix consensus engine with the given consensus method.
+func New(method int) *Wemix {
+	return &Wemix{method: method}
+}
+
+// ConsensusMethod returns the consensus method of the engine.
+func (w *Wemix) ConsensusMethod() int {
+	return w.method
+}
+
+// FromEngine returns the Wemix engine, possibly wrapped in the beacon engine,
+// or nil if the engine is not a Wemix one.
+func FromEngine(engine consensus.Engine) *Wemix {
+	if b, ok := engine.(*beacon.Beacon); ok {
+		engine = b.InnerEngine()
+	}
+	w, _ := engine.(*Wemix)
+	return w
+}
+
+// SetBackend sets the governance backend of the engine.
+func (w *Wemix) SetBackend(backend Backend) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.backend = backend
+}
+
+func (w *Wemix) getBackend() Backend {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.backend
+}
+
+// Author implements consensus.Engine, returning the header's coinbase.
+func (w *Wemix) Author(header *types.Header) (common.Address, error) {
+	return header.Coinbase, nil
+}
+
+// VerifyHeader checks whether a header conforms to the consensus rules.
+func (w *Wemix) VerifyHeader(chain consensus.ChainHeaderReader, header *types.Header, seal bool) error {
+	// Short circuit if the header is known, or its parent not
+	number := header.Number.Uint64()
+	if chain.GetHeader(header.Hash(), number) != nil {
+		return nil
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	return w.verifyHeader(chain, header, parent, seal, time.Now().Unix())
+}
+
+// VerifyHeaders is similar to VerifyHeader, but verifies a batch of headers
+// concurrently. The method returns a quit channel to abort the operations and
+// a results channel to retrieve the async verifications.
+func (w *Wemix) VerifyHeaders(chain consensus.ChainHeaderReader, headers []*types.Header, seals []bool) (chan<- struct{}, <-chan error) {
+	if len(headers) == 0 {
+		return make(chan struct{}), make(chan error)
+	}
+
+	// Spawn as many workers as allowed threads
+	workers := runtime.GOMAXPROCS(0)
+	if len(headers) < workers {
+		workers = len(headers)
+	}
+
+	// Create a task channel and spawn the verifiers
+	var (
+		inputs  = make(chan int)
+		done    = make(chan int, workers)
+		errors  = make([]error, len(headers))
+		abort   = make(chan struct{})
+		unixNow = time.Now().Unix()
+	)
+	for i := 0; i < workers; i++ {
+		go func() {
+			for index := range inputs {
+				errors[index] = w.verifyHeaderWorker(chain, headers, seals, index, unixNow)
+				done <- index
+			}
+		}()
+	}
+
+	errorsOut := make(chan error, len(headers))
+	go func() {
+		defer close(inputs)
+		var (
+			in, out = 0, 0
+			checked = make([]bool, len(headers))
+			inputs  = inputs
+		)
+		for {
+			select {
+			case inputs <- in:
+				if in++; in == len(headers) {
+					// Reached end of headers. Stop sending to workers.
+					inputs = nil
+				}
+			case index := <-done:
+				for checked[index] = true; checked[out]; out++ {
+					errorsOut <- errors[out]
+					if out == len(headers)-1 {
+						return
+					}
+				}
+			case <-abort:
+				return
+			}
+		}
+	}()
+	return abort, errorsOut
+}
+
+func (w *Wemix) verifyHeaderWorker(chain consensus.ChainHeaderReader, headers []*types.Header, seals []bool, index int, unixNow int64) error {
+	var parent *types.Header
+	if index == 0 {
+		parent = chain.GetHeader(headers[0].ParentHash, headers[0].Number.Uint64()-1)
+	} else if headers[index-1].Hash() == headers[index].ParentHash {
+		parent = headers[index-1]
+	}
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	return w.verifyHeader(chain, headers[index], parent, seals[index], unixNow)
+}
+
+// VerifyUncles implements consensus.Engine, always returning an error for any
+// uncles as Wemix doesn't permit uncles.
+func (w *Wemix) VerifyUncles(chain consensus.ChainReader, block *types.Block) error {
+	if len(block.Uncles()) > 0 {
+		return errUnclesNotAllowed
+	}
+	return nil
+}
+
+// verifyHeader checks whether a header conforms to the consensus rules.
+// Unlike ethash, blocks can share the parent's timestamp.
+func (w *Wemix) verifyHeader(chain consensus.ChainHeaderReader, header, parent *types.Header, seal bool, unixNow int64) error {
+	// Ensure that the header's extra-data section is of a reasonable size
+	if uint64(len(header.Extra)) > params.MaximumExtraDataSize {
+		return fmt.Errorf("extra-data too long: %d > %d", len(header.Extra), params.MaximumExtraDataSize)
+	}
+	// Verify the header's timestamp
+	if header.Time > uint64(unixNow+allowedFutureBlockTimeSeconds) {
+		return consensus.ErrFutureBlock
+	}
+	// Verify the block's difficulty, which is fixed
+	if header.Difficulty == nil || header.Difficulty.Cmp(big1) != 0 {
+		return errInvalidDifficulty
+	}
+	// Verify that the gas limit is <= 2^63-1
+	if header.GasLimit > params.MaxGasLimit {
+		return fmt.Errorf("invalid gasLimit: have %v, max %v", header.GasLimit, params.MaxGasLimit)
+	}
+	// Verify that the gasUsed is <= gasLimit
+	if header.GasUsed > header.GasLimit {
+		return fmt.Errorf("invalid gasUsed: have %d, gasLimit %d", header.GasUsed, header.GasLimit)
+	}
+	// Verify the block's gas usage and (if applicable) verify the base fee.
+	if !chain.Config().IsLondon(header.Number) {
+		// Verify BaseFee not present before EIP-1559 fork.
+		if header.BaseFee != nil {
+			return fmt.Errorf("invalid baseFee before fork: have %d, expected 'nil'", header.BaseFee)
+		}
+		if err := misc.VerifyGaslimit(parent.GasLimit, header.GasLimit); err != nil {
+			return err
+		}
+	} else if err := misc.VerifyEip1559Header(chain.Config(), parent, header); err != nil {
+		// Verify the header's EIP-1559 attributes.
+		return err
+	}
+	// Verify that the block number is parent's +1
+	if diff := new(big.Int).Sub(header.Number, parent.Number); diff.Cmp(big1) != 0 {
+		return consensus.ErrInvalidNumber
+	}
+	// Verify the engine specific seal securing the block
+	if seal {
+		if err := w.verifySeal(header); err != nil {
+			return err
+		}
+	}
+	// If all checks passed, validate any special fields for hard forks
+	if err := misc.VerifyDAOHeaderExtraData(chain.Config(), header); err != nil {
+		return err
+	}
+	if err := misc.VerifyForkHashes(chain.Config(), header, false); err != nil {
+		return err
+	}
+	// Check if it's generated and signed by a registered node in its turn.
+	// If the state of the parent is not available yet, e.g. in a batch of
+	// headers, the signer's membership is checked in Finalize.
+	if err := w.verifyBlockSig(chain.Config(), header, parent); err != nil && err != ErrStateUnavailable {
+		return err
+	}
+	return nil
+}
+
+// verifySeal checks whether the mix digest of a block is the hash of its seal
+// hash and nonce. There's no proof-of-work given the fixed difficulty of 1.
+func (w *Wemix) verifySeal(header *types.Header) error {
+	digest := hashimeta(w.SealHash(header).Bytes(), header.Nonce.Uint64())
+	if !bytes.Equal(header.MixDigest[:], digest) {
+		return errInvalidMixDigest
+	}
+	return nil
+}
+
+// verifyBlockSig checks if the block is signed by the node in the header, and
+// the node is allowed to sign the block on top of the parent, in its turn if
+// the nodes take turns.
+func (w *Wemix) verifyBlockSig(config *params.ChainConfig, header, parent *types.Header) error {
+	pubKey, err := crypto.Ecrecover(header.Root.Bytes(), header.MinerNodeSig)
+	if err != nil || header.MinerNodeId == nil || len(pubKey) <= 1 || !bytes.Equal(header.MinerNodeId, pubKey[1:]) {
+		return consensus.ErrUnauthorized
+	}
+	if backend := w.getBackend(); backend != nil {
+		ok, err := backend.IsBlockSigner(parent, header.MinerNodeId)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return consensus.ErrUnauthorized
+		}
+		if w.rotates() {
+			return w.verifyTurn(backend, config, header, parent)
+		}
+	}
+	return nil
+}
+
+// rotates returns true if the nodes take turns to sign the blocks by the
+// schedule, i.e. with ConsensusPoA or ConsensusPBFT, rather than the raft
+// leader signing them.
+func (w *Wemix) rotates() bool {
+	return w.method == params.ConsensusPoA || w.method == params.ConsensusPBFT
+}
+
+// verifyTurn checks if the block is signed by the node in turn, or by a node
+// after it in the schedule once the ones ahead of it timed out.
+func (w *Wemix) verifyTurn(backend Backend, config *params.ChainConfig, header, parent *types.Header) error {
+	schedule, blocksPer, err := backend.MinerSchedule(parent)
+	if err != nil {
+		return err
+	}
+	if len(schedule) == 0 {
+		return nil // the boot node before governance
+	}
+	offset := TurnOffset(schedule, blocksPer, header.Number.Uint64(), header.MinerNodeId)
+	if offset < 0 {
+		return consensus.ErrUnauthorized
+	}
+	if earliest := TurnTime(config, parent, offset); header.Time < earliest {
+		return fmt.Errorf("%w: %d nodes ahead at %d, allowed from %d", errOutOfTurn, offset, header.Time, earliest)
+	}
+	return nil
+}
+
+// TurnOffset returns how many nodes the node is after the one in turn to
+// sign the block 'number' in the schedule, or -1 if it's not in it. Each of
+// the nodes signs blocksPer blocks in its turn.
+func TurnOffset(schedule [][]byte, blocksPer, number uint64, nodeId []byte) int {
+	if len(schedule) == 0 {
+		return -1
+	}
+	if blocksPer == 0 {
+		blocksPer = 1
+	}
+	ix := int(number / blocksPer % uint64(len(schedule)))
+	for j := range schedule {
+		if bytes.Equal(schedule[(ix+j)%len(schedule)], nodeId) {
+			return j
+		}
+	}
+	return -1
+}
+
+// TurnTime returns the earliest timestamp of the child block of 'parent'
+// signed by the node 'offset' nodes after the one in turn, i.e. each node
+// takes over after the one ahead of it doesn't sign for MinerTurnTimeout.
+func TurnTime(config *params.ChainConfig, parent *types.Header, offset int) uint64 {
+	num := new(big.Int).Add(parent.Number, big1)
+	return parent.Time + uint64(offset)*config.Wemix.Params(num).MinerTurnTimeout
+}
+
+// Prepare implements consensus.Engine, initializing the difficulty field of a
+// header.
+func (w *Wemix) Prepare(chain consensus.ChainHeaderReader, header *types.Header) error {
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	header.Difficulty = w.CalcDifficulty(chain, header.Time, parent)
+	return nil
+}
+
+// Finalize implements consensus.Engine, distributing the block rewards and
+// setting the final state on the header. The rewards, coinbase and signer of
+// the header being imported are verified with its parent in place.
+func (w *Wemix) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) error {
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	// the signer's membership can't be checked in header verification if
+	// the parent is not imported yet, check it again
+	if err := w.verifyBlockSig(chain.Config(), header, parent); err != nil {
+		return err
+	}
+	return w.finalize(chain, header, parent, state, true)
+}
+
+// finalize distributes the rewards and commits the final state root. If
+// verify is set, i.e. the header is being imported rather than built
+// locally, the rewards and coinbase in the header are checked against the
+// calculated ones.
+func (w *Wemix) finalize(chain consensus.ChainHeaderReader, header, parent *types.Header, state *state.StateDB, verify bool) error {
+	if err := w.distributeRewards(header, parent, state, verify); err != nil {
+		return err
+	}
+	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
+	return nil
+}
+
+// FinalizeAndAssemble implements consensus.Engine, distributing the block
+// rewards, setting the final state, signing and assembling the block.
+func (w *Wemix) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
+	// Finalize block
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	if err := w.finalize(chain, header, parent, state, false); err != nil {
+		return nil, err
+	}
+
+	// sign header.Root with node's private key
+	backend := w.getBackend()
+	if backend == nil {
+		return nil, errNoBackend
+	}
+	nodeId, sig, err := backend.SignBlock(header.Root)
+	if err != nil {
+		return nil, err
+	}
+	// not to build on a block the others reject, e.g. by a leader removed
+	// from the governance before it's caught up with the removal
+	if ok, err := backend.IsBlockSigner(parent, nodeId); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, consensus.ErrUnauthorized
+	}
+	header.MinerNodeId = nodeId
+	header.MinerNodeSig = sig
+
+	// Header seems complete, assemble into a block and return
+	return types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil)), nil
+}
+
+// distributeRewards credits the rewards distributed according to the
+// governance state of the parent block, and if verify is set, checks the
+// rewards and coinbase in the header against the distributed ones. The
+// credited balances themselves are covered by the state root check. If
+// governance is not established yet, block reward and fees go to the
+// coinbase, and the header should carry no rewards. Any other failure to
+// read the governance rejects the block.
+func (w *Wemix) distributeRewards(header, parent *types.Header, state *state.StateDB, verify bool) error {
+	err := wemixminer.ErrNotInitialized
+	var (
+		coinbase *common.Address
+		rewards  []byte
+	)
+	backend := w.getBackend()
+	if backend == nil && verify && len(header.Rewards) > 0 {
+		return errNoBackend
+	} else if backend != nil {
+		coinbase, rewards, err = backend.CalculateRewards(
+			parent, BlockReward, header.Fees,
+			func(addr common.Address, amt *big.Int) {
+				state.AddBalance(addr, amt)
+			})
+	}
+	if err == wemixminer.ErrNotInitialized {
+		if verify && len(header.Rewards) > 0 {
+			return &consensus.RewardsError{
+				Number: header.Number,
+				Reason: "rewards before governance is established",
+			}
+		}
+		reward := new(big.Int)
+		if header.Fees != nil {
+			reward.Add(BlockReward, header.Fees)
+		}
+		state.AddBalance(header.Coinbase, reward)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if verify {
+		if coinbase != nil && header.Coinbase != *coinbase {
+			return &consensus.RewardsError{
+				Number: header.Number,
+				Reason: fmt.Sprintf("coinbase mismatch: have %v, want %v", header.Coinbase, *coinbase),
+			}
+		}
+		if err := backend.VerifyRewards(header.Number, rewards, header.Rewards); err != nil {
+			return err
+		}
+	}
+	header.Rewards = rewards
+	if coinbase != nil {
+		header.Coinbase = *coinbase
+	}
+	return nil
+}
+
+// Seal implements consensus.Engine, setting the nonce and mix digest of the
+// block. There's no proof-of-work to search for.
+func (w *Wemix) Seal(chain consensus.ChainHeaderReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
+	header := block.Header()
+	var seed [8]byte
+	if _, err := crand.Read(seed[:]); err != nil {
+		return err
+	}
+	nonce := binary.LittleEndian.Uint64(seed[:])
+	header.Nonce = types.EncodeNonce(nonce)
+	header.MixDigest = common.BytesToHash(hashimeta(w.SealHash(header).Bytes(), nonce))
+
+	select {
+	case results <- block.WithSeal(header):
+	default:
+		log.Warn("Sealing result is not read by miner", "sealhash", w.SealHash(header))
+	}
+	return nil
+}
+
+// SealHash returns the hash of a block prior to it being sealed, identical to
+// the one of ethash.
+func (w *Wemix) SealHash(header *types.Header) (hash common.Hash) {
+	hasher := sha3.NewLegacyKeccak256()
+
+	enc := []interface{}{
+		header.ParentHash,
+		header.UncleHash,
+		header.Coinbase,
+		header.Root,
+		header.TxHash,
+		header.ReceiptHash,
+		header.Bloom,
+		header.Difficulty,
+		header.Number,
+		header.GasLimit,
+		header.GasUsed,
+		header.Time,
+		header.Extra,
+	}
+	if header.BaseFee != nil {
+		enc = append(enc, header.BaseFee)
+	}
+	rlp.Encode(hasher, enc)
+	hasher.Sum(hash[:0])
+	return hash
+}
+
+// CalcDifficulty is the difficulty adjustment algorithm, which always returns
+// 1 in Wemix.
+func (w *Wemix) CalcDifficulty(chain consensus.ChainHeaderReader, time uint64, parent *types.Header) *big.Int {
+	return new(big.Int).Set(big1)
+}
+
+// APIs implements consensus.Engine, returning no APIs as the Wemix specific
+// ones are served by the governance backend.
+func (w *Wemix) APIs(chain consensus.ChainHeaderReader) []rpc.API {
+	return nil
+}
+
+// Close implements consensus.Engine. It's a noop for Wemix as there are no
+// background threads.
+func (w *Wemix) Close() error {
+	return nil
+}
+
+// RewardsReady checks if the rewards of the child block of 'parent' can be
+// calculated, i.e. the governance state of the parent is available.
+func (w *Wemix) RewardsReady(parent *types.Header) bool {
+	backend := w.getBackend()
+	if backend == nil {
+		return false
+	}
+	_, _, err := backend.CalculateRewards(parent, BlockReward, big.NewInt(0), nil)
+	return err == nil
+}
+
+// hashimeta is a simple hash of the seal hash and nonce, which stands in for
+// the ethash mix digest.
+func hashimeta(hash []byte, nonce uint64) []byte {
+	seed := make([]byte, 40)
+	copy(seed, hash)
+	binary.LittleEndian.PutUint64(seed[32:], nonce)
+	return crypto.Keccak256(seed)
+}
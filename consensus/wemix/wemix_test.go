@@ -0,0 +1,262 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package wemix
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+// testBackend is a governance with a single signer, which gives all the
+// rewards to the coinbase, or fails to read the governance with err.
+type testBackend struct {
+	key      *ecdsa.PrivateKey
+	nodeKey  *ecdsa.PrivateKey // of the local node, the signer's if nil
+	coinbase common.Address
+	err      error
+}
+
+func (b *testBackend) IsBlockSigner(parent *types.Header, nodeId []byte) (bool, error) {
+	if b.err == ErrStateUnavailable {
+		return false, b.err
+	}
+	return bytes.Equal(nodeId, crypto.FromECDSAPub(&b.key.PublicKey)[1:]), nil
+}
+
+func (b *testBackend) NumBlockSigners(parent *types.Header) (int, error) {
+	return 1, nil
+}
+
+func (b *testBackend) MinerSchedule(parent *types.Header) ([][]byte, uint64, error) {
+	return [][]byte{crypto.FromECDSAPub(&b.key.PublicKey)[1:]}, 1, nil
+}
+
+func (b *testBackend) CalculateRewards(parent *types.Header, blockReward, fees *big.Int, addBalance func(common.Address, *big.Int)) (*common.Address, []byte, error) {
+	if b.err != nil {
+		return nil, nil, b.err
+	}
+	reward := new(big.Int).Add(blockReward, fees)
+	if addBalance != nil {
+		addBalance(b.coinbase, reward)
+	}
+	rewards, _ := json.Marshal(map[common.Address]*big.Int{b.coinbase: reward})
+	return &b.coinbase, rewards, nil
+}
+
+func (b *testBackend) VerifyRewards(num *big.Int, expected, actual []byte) error {
+	if !bytes.Equal(expected, actual) {
+		return &consensus.RewardsError{Number: num, Reason: "mismatch"}
+	}
+	return nil
+}
+
+func (b *testBackend) SignBlock(hash common.Hash) ([]byte, []byte, error) {
+	key := b.key
+	if b.nodeKey != nil {
+		key = b.nodeKey
+	}
+	sig, err := crypto.Sign(hash.Bytes(), key)
+	return crypto.FromECDSAPub(&key.PublicKey)[1:], sig, err
+}
+
+func TestSeal(t *testing.T) {
+	w := New(params.ConsensusETCD)
+	header := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(1)}
+	results := make(chan *types.Block, 1)
+	if err := w.Seal(nil, types.NewBlockWithHeader(header), results, nil); err != nil {
+		t.Fatalf("failed to seal block: %v", err)
+	}
+	sealed := (<-results).Header()
+	if err := w.verifySeal(sealed); err != nil {
+		t.Fatalf("sealed block rejected: %v", err)
+	}
+	sealed.Nonce = types.EncodeNonce(sealed.Nonce.Uint64() + 1)
+	if err := w.verifySeal(sealed); err != errInvalidMixDigest {
+		t.Fatalf("tampered seal: expected %v, got %v", errInvalidMixDigest, err)
+	}
+}
+
+func TestVerifyBlockSig(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	w := New(params.ConsensusETCD)
+
+	parent := &types.Header{Number: big.NewInt(0)}
+	header := &types.Header{Number: big.NewInt(1), Root: common.HexToHash("0x01")}
+	header.MinerNodeId, header.MinerNodeSig, _ = (&testBackend{key: other}).SignBlock(header.Root)
+
+	// without a backend, only the signature is checked
+	if err := w.verifyBlockSig(params.TestChainConfig, header, parent); err != nil {
+		t.Fatalf("valid signature rejected without backend: %v", err)
+	}
+	backend := &testBackend{key: key}
+	w.SetBackend(backend)
+	if err := w.verifyBlockSig(params.TestChainConfig, header, parent); err != consensus.ErrUnauthorized {
+		t.Fatalf("unregistered signer: expected %v, got %v", consensus.ErrUnauthorized, err)
+	}
+	header.MinerNodeId, header.MinerNodeSig, _ = (&testBackend{key: key}).SignBlock(header.Root)
+	if err := w.verifyBlockSig(params.TestChainConfig, header, parent); err != nil {
+		t.Fatalf("registered signer rejected: %v", err)
+	}
+	// the membership can't be told without the parent state
+	backend.err = ErrStateUnavailable
+	if err := w.verifyBlockSig(params.TestChainConfig, header, parent); err != ErrStateUnavailable {
+		t.Fatalf("unavailable parent state: expected %v, got %v", ErrStateUnavailable, err)
+	}
+	backend.err = nil
+	header.Root = common.HexToHash("0x02")
+	if err := w.verifyBlockSig(params.TestChainConfig, header, parent); err != consensus.ErrUnauthorized {
+		t.Fatalf("signature of another root: expected %v, got %v", consensus.ErrUnauthorized, err)
+	}
+}
+
+func TestVerifyTurn(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	sign := func(header *types.Header, i int) {
+		header.MinerNodeId, header.MinerNodeSig, _ = (&testBackend{key: keys[i]}).SignBlock(header.Root)
+	}
+	timeout := params.TestChainConfig.Wemix.Params(nil).MinerTurnTimeout
+
+	w := New(params.ConsensusPoA)
+	w.SetBackend(&testVoters{keys: keys})
+	// block 4 is keys[1]'s, keys[2] takes over after a timeout, keys[0]
+	// after two
+	parent := &types.Header{Number: big.NewInt(3), Time: 100}
+	tests := []struct {
+		signer int
+		delay  uint64
+		err    error
+	}{
+		{1, 0, nil},
+		{2, 0, errOutOfTurn},
+		{2, timeout - 1, errOutOfTurn},
+		{2, timeout, nil},
+		{0, timeout, errOutOfTurn},
+		{0, 2 * timeout, nil},
+	}
+	for _, tt := range tests {
+		header := &types.Header{Number: big.NewInt(4), Root: common.HexToHash("0x01"), Time: parent.Time + tt.delay}
+		sign(header, tt.signer)
+		if err := w.verifyBlockSig(params.TestChainConfig, header, parent); !errors.Is(err, tt.err) {
+			t.Errorf("signer %d after %ds: expected %v, got %v", tt.signer, tt.delay, tt.err, err)
+		}
+	}
+
+	// the raft leader signs the blocks out of the schedule
+	w = New(params.ConsensusETCD)
+	w.SetBackend(&testVoters{keys: keys})
+	header := &types.Header{Number: big.NewInt(4), Root: common.HexToHash("0x01"), Time: parent.Time}
+	sign(header, 2)
+	if err := w.verifyBlockSig(params.TestChainConfig, header, parent); err != nil {
+		t.Errorf("raft leader's block rejected: %v", err)
+	}
+}
+
+// Tests that a node not allowed to sign on top of the parent doesn't
+// assemble a block, which the others would reject.
+func TestFinalizeAndAssembleSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	backend := &testBackend{key: key, coinbase: common.HexToAddress("0x1000")}
+	w := New(params.ConsensusETCD)
+	w.SetBackend(backend)
+
+	parent := &types.Header{Number: big.NewInt(0)}
+	chain := newTestChain(parent)
+	assemble := func() (*types.Block, error) {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		header := &types.Header{Number: big.NewInt(1), ParentHash: parent.Hash(), Fees: new(big.Int)}
+		return w.FinalizeAndAssemble(chain, header, statedb, nil, nil, nil)
+	}
+	block, err := assemble()
+	if err != nil {
+		t.Fatalf("signer failed to assemble: %v", err)
+	}
+	if err := w.verifyBlockSig(chain.Config(), block.Header(), parent); err != nil {
+		t.Fatalf("assembled block rejected: %v", err)
+	}
+	backend.nodeKey = other
+	if _, err := assemble(); err != consensus.ErrUnauthorized {
+		t.Fatalf("unregistered signer: expected %v, got %v", consensus.ErrUnauthorized, err)
+	}
+}
+
+func TestDistributeRewards(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	backend := &testBackend{key: key, coinbase: common.HexToAddress("0x1000")}
+	w := New(params.ConsensusETCD)
+	w.SetBackend(backend)
+
+	// build the header as a miner would
+	parent := &types.Header{Number: big.NewInt(0)}
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	header := &types.Header{Number: big.NewInt(1), Fees: big.NewInt(21000)}
+	if err := w.distributeRewards(header, parent, statedb, false); err != nil {
+		t.Fatalf("failed to distribute rewards: %v", err)
+	}
+	if header.Coinbase != backend.coinbase {
+		t.Fatalf("coinbase mismatch: have %v, want %v", header.Coinbase, backend.coinbase)
+	}
+	if have := statedb.GetBalance(backend.coinbase); have.Cmp(header.Fees) != 0 {
+		t.Fatalf("coinbase balance mismatch: have %v, want %v", have, header.Fees)
+	}
+	rewards := header.Rewards
+
+	tests := []struct {
+		name     string
+		coinbase common.Address
+		rewards  []byte
+		err      error
+	}{
+		{"valid", backend.coinbase, rewards, nil},
+		{"coinbase", common.HexToAddress("0xbad"), rewards, consensus.ErrInvalidRewards},
+		{"rewards", backend.coinbase, []byte("{}"), consensus.ErrInvalidRewards},
+	}
+	for _, tt := range tests {
+		header := &types.Header{Number: big.NewInt(1), Fees: big.NewInt(21000), Coinbase: tt.coinbase, Rewards: tt.rewards}
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		err := w.distributeRewards(header, parent, statedb, true)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.err, err)
+		}
+	}
+
+	// before governance, the rewards go to the coinbase and the header
+	// should carry none
+	backend.err = wemixminer.ErrNotInitialized
+	header = &types.Header{Number: big.NewInt(1), Fees: big.NewInt(21000), Coinbase: common.HexToAddress("0xb007")}
+	if err := w.distributeRewards(header, parent, statedb, true); err != nil {
+		t.Fatalf("block before governance rejected: %v", err)
+	}
+	header.Rewards = rewards
+	if err := w.distributeRewards(header, parent, statedb, true); !errors.Is(err, consensus.ErrInvalidRewards) {
+		t.Errorf("rewards before governance: expected %v, got %v", consensus.ErrInvalidRewards, err)
+	}
+	// the other failures to read the governance reject the block
+	backend.err = errors.New("state unavailable")
+	header.Rewards = nil
+	if err := w.distributeRewards(header, parent, statedb, true); err != backend.err {
+		t.Errorf("governance failure: expected %v, got %v", backend.err, err)
+	}
+	// the rewards can't be checked without the backend
+	header.Rewards = rewards
+	if err := New(params.ConsensusPoA).distributeRewards(header, parent, statedb, true); err != errNoBackend {
+		t.Errorf("rewards without backend: expected %v, got %v", errNoBackend, err)
+	}
+}
@@ -112,9 +112,7 @@ func (v *BlockValidator) ValidateState(block *types.Block, statedb *state.StateD
 // to keep the baseline gas close to the provided target, and increase it towards
 // the target if the baseline gas is lower.
 func CalcGasLimit(parentGasLimit, desiredLimit uint64) uint64 {
-	if params.FixedGasLimit != 0 {
-		return params.FixedGasLimit
-	} else if !wemixminer.IsPoW() {
+	if !wemixminer.IsPoW() {
 		if desiredLimit == 0 { // Wemix: governance is not initialized yet, inherit parent's gas limit
 			return parentGasLimit
 		}
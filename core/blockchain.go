@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/common/prque"
 	"github.com/ethereum/go-ethereum/consensus"
+	wemixengine "github.com/ethereum/go-ethereum/consensus/wemix"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/state/snapshot"
@@ -43,7 +44,6 @@ import (
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
-	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
 	lru "github.com/hashicorp/golang-lru"
 )
 
@@ -51,6 +51,7 @@ var (
 	headBlockGauge     = metrics.NewRegisteredGauge("chain/head/block", nil)
 	headHeaderGauge    = metrics.NewRegisteredGauge("chain/head/header", nil)
 	headFastBlockGauge = metrics.NewRegisteredGauge("chain/head/receipt", nil)
+	headFinalizedGauge = metrics.NewRegisteredGauge("chain/head/finalized", nil)
 
 	accountReadTimer   = metrics.NewRegisteredTimer("chain/account/reads", nil)
 	accountHashTimer   = metrics.NewRegisteredTimer("chain/account/hashes", nil)
@@ -81,6 +82,7 @@ var (
 
 	errInsertionInterrupted = errors.New("insertion is interrupted")
 	errChainStopped         = errors.New("blockchain is stopped")
+	errReorgFinalized       = errors.New("reorg reverts finalized block")
 )
 
 const (
@@ -190,6 +192,7 @@ type BlockChain struct {
 
 	currentBlock     atomic.Value // Current head of the block chain
 	currentFastBlock atomic.Value // Current head of the fast-sync chain (may be above the block chain!)
+	currentFinalized atomic.Value // Latest block finalized by a commit certificate (nil if none)
 
 	stateCache    state.Database // State database to reuse between imports (contains state cache)
 	bodyCache     *lru.Cache     // Cache for the most recent block bodies
@@ -265,6 +268,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	var nilBlock *types.Block
 	bc.currentBlock.Store(nilBlock)
 	bc.currentFastBlock.Store(nilBlock)
+	bc.currentFinalized.Store(nilBlock)
 
 	// Initialize the chain with ancient data if it isn't empty.
 	var txIndexBlock uint64
@@ -461,6 +465,13 @@ func (bc *BlockChain) loadLastState() error {
 			headFastBlockGauge.Update(int64(block.NumberU64()))
 		}
 	}
+	// Restore the last known finalized block
+	if head := rawdb.ReadFinalizedBlockHash(bc.db); head != (common.Hash{}) {
+		if block := bc.GetBlockByHash(head); block != nil {
+			bc.currentFinalized.Store(block)
+			headFinalizedGauge.Update(int64(block.NumberU64()))
+		}
+	}
 	// Issue a status log for the user
 	currentFastBlock := bc.CurrentFastBlock()
 
@@ -619,6 +630,11 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, root common.Hash, repair bo
 		log.Warn("Rewinding blockchain", "target", head)
 		bc.hc.SetHead(head, updateFn, delFn)
 	}
+	// Drop the finalized block if it's rewound
+	if finalized := bc.CurrentFinalizedBlock(); finalized != nil && bc.CurrentBlock().NumberU64() < finalized.NumberU64() {
+		log.Error("SetHead invalidated finalized block", "number", finalized.Number(), "hash", finalized.Hash())
+		bc.SetFinalized(nil)
+	}
 	// Clear out any stale content from the caches
 	bc.bodyCache.Purge()
 	bc.bodyRLPCache.Purge()
@@ -630,6 +646,41 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, root common.Hash, repair bo
 	return rootNumber, bc.loadLastState()
 }
 
+// SetFinalized sets the latest finalized block, or clears it if nil.
+func (bc *BlockChain) SetFinalized(block *types.Block) {
+	bc.currentFinalized.Store(block)
+	if block != nil {
+		rawdb.WriteFinalizedBlockHash(bc.db, block.Hash())
+		headFinalizedGauge.Update(int64(block.NumberU64()))
+	} else {
+		rawdb.WriteFinalizedBlockHash(bc.db, common.Hash{})
+		headFinalizedGauge.Update(0)
+	}
+}
+
+// WriteFinalityCert stores the commit certificate of a canonical block, and
+// finalizes the block if it's above the current finalized block. The
+// certificate is expected to be verified by the caller.
+func (bc *BlockChain) WriteFinalityCert(cert *types.FinalityCert) error {
+	if !bc.chainmu.TryLock() {
+		return errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+
+	block := bc.GetBlock(cert.Hash, cert.Number)
+	if block == nil {
+		return fmt.Errorf("unknown block #%d [%x..]", cert.Number, cert.Hash[:4])
+	}
+	if bc.GetCanonicalHash(cert.Number) != cert.Hash {
+		return fmt.Errorf("non canonical block #%d [%x..]", cert.Number, cert.Hash[:4])
+	}
+	rawdb.WriteFinalityCert(bc.db, cert)
+	if finalized := bc.CurrentFinalizedBlock(); finalized == nil || finalized.NumberU64() < cert.Number {
+		bc.SetFinalized(block)
+	}
+	return nil
+}
+
 // SnapSyncCommitHead sets the current head block to the one defined by the hash
 // irrelevant what the chain contents were prior.
 func (bc *BlockChain) SnapSyncCommitHead(hash common.Hash) error {
@@ -1384,8 +1435,9 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals, setHead bool)
 	senderCacher.recoverFromBlocks(types.MakeSigner(bc.chainConfig, chain[0].Number()), chain)
 
 	var (
-		stats     = insertStats{startTime: mclock.Now()}
-		lastCanon *types.Block
+		stats       = insertStats{startTime: mclock.Now()}
+		lastCanon   *types.Block
+		wemixEngine = wemixengine.FromEngine(bc.engine)
 	)
 	// Fire a single chain head event if we've progressed the chain
 	defer func() {
@@ -1621,10 +1673,10 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals, setHead bool)
 		// Validate the state using the default validator
 		substart = time.Now()
 		if err := bc.validator.ValidateState(block, statedb, receipts, usedGas, fees); err != nil {
-			if retryCount--; !wemixminer.IsPoW() && retryCount > 0 {
+			if retryCount--; wemixEngine != nil && retryCount > 0 {
 				// make sure the previous block exists in order to calculate rewards distribution
 				for try := 100; try > 0; try-- {
-					if _, _, err := wemixminer.CalculateRewards(block.Number(), big.NewInt(0), big.NewInt(100000000), nil); err == nil {
+					if wemixEngine.RewardsReady(parent) {
 						break
 					}
 					time.Sleep(100 * time.Millisecond)
@@ -2005,6 +2057,10 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 			return fmt.Errorf("invalid new chain")
 		}
 	}
+	// Never revert a block finalized by a commit certificate
+	if finalized := bc.CurrentFinalizedBlock(); finalized != nil && commonBlock.NumberU64() < finalized.NumberU64() {
+		return fmt.Errorf("%w: common ancestor #%d, finalized #%d", errReorgFinalized, commonBlock.NumberU64(), finalized.NumberU64())
+	}
 	// Ensure the user sees large reorgs
 	if len(oldChain) > 0 && len(newChain) > 0 {
 		logFn := log.Info
@@ -49,6 +49,18 @@ func (bc *BlockChain) CurrentFastBlock() *types.Block {
 	return bc.currentFastBlock.Load().(*types.Block)
 }
 
+// CurrentFinalizedBlock retrieves the latest block finalized by a commit
+// certificate, or nil if none.
+func (bc *BlockChain) CurrentFinalizedBlock() *types.Block {
+	return bc.currentFinalized.Load().(*types.Block)
+}
+
+// GetFinalityCert retrieves the commit certificate of a block, or nil if the
+// block has none.
+func (bc *BlockChain) GetFinalityCert(hash common.Hash, number uint64) *types.FinalityCert {
+	return rawdb.ReadFinalityCert(bc.db, hash, number)
+}
+
 // HasHeader checks if a block header is present in the database or not, caching
 // it if present.
 func (bc *BlockChain) HasHeader(hash common.Hash, number uint64) bool {
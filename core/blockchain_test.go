@@ -618,6 +618,66 @@ func testBadHashes(t *testing.T, full bool) {
 	}
 }
 
+// Tests that a block finalized by a commit certificate is never reverted by a
+// reorg, and that rewinding the chain below it clears it.
+func TestReorgFinalized(t *testing.T) {
+	db, blockchain, err := newCanonical(ethash.NewFaker(), 0, true)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	genesis := blockchain.CurrentBlock()
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 10, func(i int, b *BlockGen) {
+		b.OffsetTime(10)
+	})
+	if _, err := blockchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	if err := blockchain.WriteFinalityCert(&types.FinalityCert{Number: 5, Hash: common.Hash{0x01}}); err == nil {
+		t.Fatalf("certificate of unknown block accepted")
+	}
+	if err := blockchain.WriteFinalityCert(&types.FinalityCert{Number: 5, Hash: blocks[4].Hash()}); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if finalized := blockchain.CurrentFinalizedBlock(); finalized == nil || finalized.Hash() != blocks[4].Hash() {
+		t.Fatalf("finalized block mismatch: have %v, want %x", finalized, blocks[4].Hash())
+	}
+	if cert := blockchain.GetFinalityCert(blocks[4].Hash(), 5); cert == nil {
+		t.Fatalf("stored certificate not found")
+	}
+
+	// A heavier fork below the finalized block is rejected
+	fork, _ := GenerateChain(params.TestChainConfig, blocks[2], ethash.NewFaker(), db, 20, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+	if _, err := blockchain.InsertChain(fork); !errors.Is(err, errReorgFinalized) {
+		t.Fatalf("error mismatch: have %v, want %v", err, errReorgFinalized)
+	}
+	if head := blockchain.CurrentBlock(); head.Hash() != blocks[9].Hash() {
+		t.Fatalf("head reverted to %d [%x]", head.NumberU64(), head.Hash())
+	}
+
+	// A heavier fork above the finalized block is accepted
+	fork, _ = GenerateChain(params.TestChainConfig, blocks[6], ethash.NewFaker(), db, 20, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+	if _, err := blockchain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to insert fork above finalized block: %v", err)
+	}
+	if head := blockchain.CurrentBlock(); head.Hash() != fork[len(fork)-1].Hash() {
+		t.Fatalf("head mismatch: have %x, want %x", head.Hash(), fork[len(fork)-1].Hash())
+	}
+
+	// Rewinding below the finalized block clears it
+	if err := blockchain.SetHead(3); err != nil {
+		t.Fatalf("failed to rewind: %v", err)
+	}
+	if finalized := blockchain.CurrentFinalizedBlock(); finalized != nil {
+		t.Fatalf("finalized block not cleared: %d", finalized.NumberU64())
+	}
+}
+
 // Tests that bad hashes are detected on boot, and the chain rolled back to a
 // good state prior to the bad hash.
 func TestReorgBadHeaderHashes(t *testing.T) { testReorgBadHashes(t, false) }
@@ -216,6 +216,38 @@ func WriteHeadFastBlockHash(db ethdb.KeyValueWriter, hash common.Hash) {
 	}
 }
 
+// ReadFinalizedBlockHash retrieves the hash of the latest block finalized by a
+// commit certificate.
+func ReadFinalizedBlockHash(db ethdb.KeyValueReader) common.Hash {
+	data, _ := db.Get(headFinalizedBlockKey)
+	if len(data) == 0 {
+		return common.Hash{}
+	}
+	return common.BytesToHash(data)
+}
+
+// WriteFinalizedBlockHash stores the hash of the latest finalized block.
+func WriteFinalizedBlockHash(db ethdb.KeyValueWriter, hash common.Hash) {
+	if err := db.Put(headFinalizedBlockKey, hash.Bytes()); err != nil {
+		log.Crit("Failed to store last finalized block's hash", "err", err)
+	}
+}
+
+// ReadFinalityState retrieves the encoded votes of this node in the finality
+// gadget.
+func ReadFinalityState(db ethdb.KeyValueReader) []byte {
+	data, _ := db.Get(finalityStateKey)
+	return data
+}
+
+// WriteFinalityState stores the encoded votes of this node in the finality
+// gadget.
+func WriteFinalityState(db ethdb.KeyValueWriter, state []byte) {
+	if err := db.Put(finalityStateKey, state); err != nil {
+		log.Crit("Failed to store finality state", "err", err)
+	}
+}
+
 // ReadLastPivotNumber retrieves the number of the last pivot block. If the node
 // full synced, the last pivot will always be nil.
 func ReadLastPivotNumber(db ethdb.KeyValueReader) *uint64 {
@@ -821,12 +853,45 @@ func writeAncientBlock(op ethdb.AncientWriteOp, block *types.Block, header *type
 	return nil
 }
 
+// ReadFinalityCert retrieves the commit certificate of a block.
+func ReadFinalityCert(db ethdb.KeyValueReader, hash common.Hash, number uint64) *types.FinalityCert {
+	data, _ := db.Get(finalityCertKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+	cert := new(types.FinalityCert)
+	if err := rlp.DecodeBytes(data, cert); err != nil {
+		log.Error("Invalid finality certificate RLP", "hash", hash, "err", err)
+		return nil
+	}
+	return cert
+}
+
+// WriteFinalityCert stores the commit certificate of a block.
+func WriteFinalityCert(db ethdb.KeyValueWriter, cert *types.FinalityCert) {
+	data, err := rlp.EncodeToBytes(cert)
+	if err != nil {
+		log.Crit("Failed to RLP encode finality certificate", "err", err)
+	}
+	if err := db.Put(finalityCertKey(cert.Number, cert.Hash), data); err != nil {
+		log.Crit("Failed to store finality certificate", "err", err)
+	}
+}
+
+// DeleteFinalityCert removes the commit certificate of a block.
+func DeleteFinalityCert(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Delete(finalityCertKey(number, hash)); err != nil {
+		log.Crit("Failed to delete finality certificate", "err", err)
+	}
+}
+
 // DeleteBlock removes all block data associated with a hash.
 func DeleteBlock(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
 	DeleteReceipts(db, hash, number)
 	DeleteHeader(db, hash, number)
 	DeleteBody(db, hash, number)
 	DeleteTd(db, hash, number)
+	DeleteFinalityCert(db, hash, number)
 }
 
 // DeleteBlockWithoutNumber removes all block data associated with a hash, except
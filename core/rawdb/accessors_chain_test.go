@@ -282,6 +282,35 @@ func TestTdStorage(t *testing.T) {
 	}
 }
 
+// Tests commit certificate storage and retrieval operations.
+func TestFinalityCertStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+
+	cert := &types.FinalityCert{
+		Number: 314,
+		Hash:   common.Hash{0: 0xff},
+		Sigs: []*types.FinalitySig{
+			{NodeId: []byte{0x01}, Sig: []byte{0x02}},
+			{NodeId: []byte{0x03}, Sig: []byte{0x04}},
+		},
+	}
+	if entry := ReadFinalityCert(db, cert.Hash, cert.Number); entry != nil {
+		t.Fatalf("Non existent certificate returned: %v", entry)
+	}
+	// Write and verify the certificate in the database
+	WriteFinalityCert(db, cert)
+	if entry := ReadFinalityCert(db, cert.Hash, cert.Number); entry == nil {
+		t.Fatalf("Stored certificate not found")
+	} else if !reflect.DeepEqual(entry, cert) {
+		t.Fatalf("Retrieved certificate mismatch: have %v, want %v", entry, cert)
+	}
+	// Delete the block and verify the certificate is gone with it
+	DeleteBlock(db, cert.Hash, cert.Number)
+	if entry := ReadFinalityCert(db, cert.Hash, cert.Number); entry != nil {
+		t.Fatalf("Deleted certificate returned: %v", entry)
+	}
+}
+
 // Tests that canonical numbers can be mapped to hashes and retrieved.
 func TestCanonicalMappingStorage(t *testing.T) {
 	db := NewMemoryDatabase()
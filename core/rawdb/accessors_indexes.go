@@ -175,3 +175,39 @@ func DeleteBloombits(db ethdb.Database, bit uint, from uint64, to uint64) {
 		log.Crit("Failed to delete bloom bits", "err", it.Error())
 	}
 }
+
+// RewardEntry is the block reward an address received in a block, and the
+// transaction fees of the block if the address is its coinbase. The fees are
+// informational only, they are distributed in the rewards.
+type RewardEntry struct {
+	Number uint64
+	Reward *big.Int
+	Fees   *big.Int
+}
+
+// ReadRewardIndex retrieves the reward entries of the address in the given
+// section, in the order of the block numbers.
+func ReadRewardIndex(db ethdb.KeyValueReader, addr common.Address, section uint64, head common.Hash) []*RewardEntry {
+	data, _ := db.Get(rewardIndexKey(addr, section, head))
+	if len(data) == 0 {
+		return nil
+	}
+	var entries []*RewardEntry
+	if err := rlp.DecodeBytes(data, &entries); err != nil {
+		log.Error("Invalid reward index entries", "address", addr, "section", section, "err", err)
+		return nil
+	}
+	return entries
+}
+
+// WriteRewardIndex stores the reward entries of the address in the given
+// section.
+func WriteRewardIndex(db ethdb.KeyValueWriter, addr common.Address, section uint64, head common.Hash, entries []*RewardEntry) {
+	data, err := rlp.EncodeToBytes(entries)
+	if err != nil {
+		log.Crit("Failed to encode reward index entries", "err", err)
+	}
+	if err := db.Put(rewardIndexKey(addr, section, head), data); err != nil {
+		log.Crit("Failed to store reward index entries", "err", err)
+	}
+}
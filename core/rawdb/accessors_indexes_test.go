@@ -177,3 +177,32 @@ func TestDeleteBloomBits(t *testing.T) {
 	check(1, 1, params.MainnetGenesisHash, true)
 	check(1, 1, params.RinkebyGenesisHash, true)
 }
+
+func TestRewardIndexStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+	addr := common.HexToAddress("0x1000")
+	entries := []*RewardEntry{
+		{Number: 1, Reward: big.NewInt(100), Fees: big.NewInt(0)},
+		{Number: 5, Reward: big.NewInt(0), Fees: big.NewInt(21000)},
+	}
+	WriteRewardIndex(db, addr, 1, params.MainnetGenesisHash, entries)
+
+	have := ReadRewardIndex(db, addr, 1, params.MainnetGenesisHash)
+	if len(have) != len(entries) {
+		t.Fatalf("entry count mismatch: have %d, want %d", len(have), len(entries))
+	}
+	for i, e := range have {
+		if e.Number != entries[i].Number || e.Reward.Cmp(entries[i].Reward) != 0 || e.Fees.Cmp(entries[i].Fees) != 0 {
+			t.Fatalf("entry %d mismatch: have %+v, want %+v", i, e, entries[i])
+		}
+	}
+	if have := ReadRewardIndex(db, addr, 1, params.RinkebyGenesisHash); have != nil {
+		t.Fatalf("entries of another head: %v", have)
+	}
+	if have := ReadRewardIndex(db, addr, 0, params.MainnetGenesisHash); have != nil {
+		t.Fatalf("entries of another section: %v", have)
+	}
+	if have := ReadRewardIndex(db, common.HexToAddress("0x2000"), 1, params.MainnetGenesisHash); have != nil {
+		t.Fatalf("entries of another address: %v", have)
+	}
+}
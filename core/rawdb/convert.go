@@ -0,0 +1,169 @@
+// convert.go
+
+package rawdb
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
+	"github.com/ethereum/go-ethereum/ethdb/rocksdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Database engines of the key-value stores.
+const (
+	EngineLevelDB = "leveldb"
+	EngineRocksDB = "rocksdb"
+	EnginePebble  = "pebble"
+)
+
+// DatabaseEngine returns the engine of the existing database in the
+// directory, or "" if there's none.
+func DatabaseEngine(file string) string {
+	switch detectDb(file) {
+	case 1:
+		return EngineLevelDB
+	case 2:
+		return EngineRocksDB
+	case 3:
+		return EnginePebble
+	}
+	return ""
+}
+
+// NewKeyValueStore opens a key-value store of the engine, without a freezer.
+// The RocksDB profile is used if the engine is RocksDB.
+func NewKeyValueStore(engine string, file string, cache int, handles int, namespace string, readonly bool, rocksdbCfg rocksdb.Config) (ethdb.KeyValueStore, error) {
+	switch engine {
+	case EngineLevelDB:
+		return leveldb.New(file, cache, handles, namespace, readonly)
+	case EngineRocksDB:
+		if !rocksdb.Supported {
+			return nil, fmt.Errorf("built without %s support", engine)
+		}
+		return rocksdb.New(file, cache, handles, namespace, readonly, rocksdbConfig(rocksdbCfg))
+	case EnginePebble:
+		if !pebble.Supported {
+			return nil, fmt.Errorf("built without %s support", engine)
+		}
+		return pebble.New(file, cache, handles, namespace, readonly)
+	}
+	return nil, fmt.Errorf("unknown database engine %q", engine)
+}
+
+// ConvertDatabase copies all the key-value pairs of src into dst in batches.
+// The last copied key is committed with every batch, so an interrupted
+// conversion resumes from there when run again with the same dst. It returns
+// the number of the key-value pairs copied in this run.
+func ConvertDatabase(src ethdb.Iteratee, dst ethdb.KeyValueStore) (uint64, error) {
+	start, err := dst.Get(conversionProgressKey)
+	if err != nil || len(start) == 0 {
+		start = nil
+	} else {
+		log.Info("Resuming database conversion", "key", common.Bytes2Hex(start))
+	}
+	var (
+		it     = src.NewIterator(nil, start)
+		batch  = dst.NewBatch()
+		count  uint64
+		begun  = time.Now()
+		logged = time.Now()
+	)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if bytes.Equal(key, conversionProgressKey) {
+			continue
+		}
+		if err := batch.Put(key, it.Value()); err != nil {
+			return count, err
+		}
+		count++
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Put(conversionProgressKey, key); err != nil {
+				return count, err
+			}
+			if err := batch.Write(); err != nil {
+				return count, err
+			}
+			batch.Reset()
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Converting database", "keys", count, "key", common.Bytes2Hex(key), "elapsed", common.PrettyDuration(time.Since(begun)))
+			logged = time.Now()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return count, err
+	}
+	if err := batch.Delete(conversionProgressKey); err != nil {
+		return count, err
+	}
+	if err := batch.Write(); err != nil {
+		return count, err
+	}
+	log.Info("Converted database", "keys", count, "elapsed", common.PrettyDuration(time.Since(begun)))
+	return count, nil
+}
+
+// VerifyConversion compares the number of keys of src and dst, and the
+// hashes of the values of every sampleInterval'th key of src with dst.
+func VerifyConversion(src ethdb.KeyValueStore, dst ethdb.KeyValueStore, sampleInterval uint64) error {
+	if has, _ := dst.Has(conversionProgressKey); has {
+		return fmt.Errorf("conversion not finished")
+	}
+	if sampleInterval == 0 {
+		sampleInterval = 1
+	}
+	count := func(db ethdb.Iteratee, sample func(key, value []byte) error) (uint64, error) {
+		var (
+			it     = db.NewIterator(nil, nil)
+			n      uint64
+			begun  = time.Now()
+			logged = time.Now()
+		)
+		defer it.Release()
+		for it.Next() {
+			if sample != nil && n%sampleInterval == 0 {
+				if err := sample(it.Key(), it.Value()); err != nil {
+					return n, err
+				}
+			}
+			n++
+			if time.Since(logged) > 8*time.Second {
+				log.Info("Verifying database", "keys", n, "elapsed", common.PrettyDuration(time.Since(begun)))
+				logged = time.Now()
+			}
+		}
+		return n, it.Error()
+	}
+	have, err := count(src, func(key, value []byte) error {
+		v, err := dst.Get(key)
+		if err != nil {
+			return fmt.Errorf("key %x missing: %v", key, err)
+		}
+		if crypto.Keccak256Hash(v) != crypto.Keccak256Hash(value) {
+			return fmt.Errorf("key %x hash mismatch", key)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	want, err := count(dst, nil)
+	if err != nil {
+		return err
+	}
+	if have != want {
+		return fmt.Errorf("key count mismatch: source %d, converted %d", have, want)
+	}
+	log.Info("Verified database conversion", "keys", have)
+	return nil
+}
@@ -0,0 +1,110 @@
+// convert_test.go
+
+package rawdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
+	"github.com/ethereum/go-ethereum/ethdb/rocksdb"
+)
+
+// interruptedStore fails the writes after the given number of batches.
+type interruptedStore struct {
+	ethdb.KeyValueStore
+	batches int
+}
+
+type interruptedBatch struct {
+	ethdb.Batch
+	store *interruptedStore
+}
+
+func (s *interruptedStore) NewBatch() ethdb.Batch {
+	return &interruptedBatch{Batch: s.KeyValueStore.NewBatch(), store: s}
+}
+
+func (b *interruptedBatch) Write() error {
+	if b.store.batches == 0 {
+		return fmt.Errorf("interrupted")
+	}
+	b.store.batches--
+	return b.Batch.Write()
+}
+
+// Tests that the conversion copies all the data, resuming after interruption,
+// and that the verification detects differences.
+func TestConvertDatabase(t *testing.T) {
+	src, dst := memorydb.New(), memorydb.New()
+	value := make([]byte, 1024)
+	for i := 0; i < 1000; i++ {
+		value[0] = byte(i)
+		src.Put([]byte(fmt.Sprintf("key-%04d", i)), value)
+	}
+
+	// interrupt after 2 batches, which are kept
+	if _, err := ConvertDatabase(src, &interruptedStore{KeyValueStore: dst, batches: 2}); err == nil {
+		t.Fatalf("interrupted conversion didn't fail")
+	}
+	if err := VerifyConversion(src, dst, 1); err == nil {
+		t.Fatalf("unfinished conversion verified")
+	}
+	if dst.Len() <= 1 {
+		t.Fatalf("no batch written before interruption")
+	}
+	copied, err := ConvertDatabase(src, dst)
+	if err != nil {
+		t.Fatalf("failed to resume conversion: %v", err)
+	}
+	if copied >= 1000 {
+		t.Errorf("conversion not resumed: %d keys copied", copied)
+	}
+	if err := VerifyConversion(src, dst, 7); err != nil {
+		t.Fatalf("failed to verify conversion: %v", err)
+	}
+
+	// sampled value mismatch
+	dst.Put([]byte("key-0007"), []byte("bad"))
+	if err := VerifyConversion(src, dst, 7); err == nil {
+		t.Errorf("value mismatch not detected")
+	}
+	dst.Put([]byte("key-0007"), func() []byte { v, _ := src.Get([]byte("key-0007")); return v }())
+
+	// extra key
+	dst.Put([]byte("extra"), nil)
+	if err := VerifyConversion(src, dst, 7); err == nil {
+		t.Errorf("key count mismatch not detected")
+	}
+}
+
+func TestNewKeyValueStore(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewKeyValueStore(EngineLevelDB, dir, 16, 16, "", false, rocksdb.DefaultConfig)
+	if err != nil {
+		t.Fatalf("failed to open leveldb: %v", err)
+	}
+	db.Close()
+	if engine := DatabaseEngine(dir); engine != EngineLevelDB {
+		t.Errorf("engine mismatch: have %q, want %q", engine, EngineLevelDB)
+	}
+	if pebble.Supported {
+		dir := t.TempDir()
+		db, err := NewKeyValueStore(EnginePebble, dir, 16, 16, "", false, rocksdb.DefaultConfig)
+		if err != nil {
+			t.Fatalf("failed to open pebble: %v", err)
+		}
+		db.Close()
+		if engine := DatabaseEngine(dir); engine != EnginePebble {
+			t.Errorf("engine mismatch: have %q, want %q", engine, EnginePebble)
+		}
+	}
+	if engine := DatabaseEngine(t.TempDir()); engine != "" {
+		t.Errorf("engine of empty directory: have %q, want none", engine)
+	}
+	if _, err := NewKeyValueStore("badger", dir, 16, 16, "", false, rocksdb.DefaultConfig); err == nil {
+		t.Errorf("unknown engine didn't fail")
+	}
+}
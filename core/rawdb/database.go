@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethdb/leveldb"
 	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
 	"github.com/ethereum/go-ethereum/ethdb/rocksdb"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -271,10 +273,17 @@ func NewLevelDBDatabaseWithFreezer(file string, cache int, handles int, freezer
 	return frdb, nil
 }
 
+// rocksdbConfig returns the RocksDB profile with the keys routed to the column
+// families by their layouts in the schema.
+func rocksdbConfig(cfg rocksdb.Config) rocksdb.Config {
+	cfg.ColumnFamily = rocksdbColumnFamily
+	return cfg
+}
+
 // NewRocksDBDatabase creates a persistent key-value database without a freezer
 // moving immutable chain segments into cold storage.
-func NewRocksDBDatabase(file string, cache int, handles int, namespace string, readonly bool) (ethdb.Database, error) {
-	db, err := rocksdb.New(file, cache, handles, namespace, readonly)
+func NewRocksDBDatabase(file string, cache int, handles int, namespace string, readonly bool, cfg rocksdb.Config) (ethdb.Database, error) {
+	db, err := rocksdb.New(file, cache, handles, namespace, readonly, rocksdbConfig(cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -283,8 +292,8 @@ func NewRocksDBDatabase(file string, cache int, handles int, namespace string, r
 
 // NewRocksDBDatabaseWithFreezer creates a persistent key-value database with a
 // freezer moving immutable chain segments into cold storage.
-func NewRocksDBDatabaseWithFreezer(file string, cache int, handles int, freezer string, namespace string, readonly bool) (ethdb.Database, error) {
-	kvdb, err := rocksdb.New(file, cache, handles, namespace, readonly)
+func NewRocksDBDatabaseWithFreezer(file string, cache int, handles int, freezer string, namespace string, readonly bool, cfg rocksdb.Config) (ethdb.Database, error) {
+	kvdb, err := rocksdb.New(file, cache, handles, namespace, readonly, rocksdbConfig(cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -296,8 +305,41 @@ func NewRocksDBDatabaseWithFreezer(file string, cache int, handles int, freezer
 	return frdb, nil
 }
 
+// NewPebbleDBDatabase creates a persistent key-value database without a freezer
+// moving immutable chain segments into cold storage.
+func NewPebbleDBDatabase(file string, cache int, handles int, namespace string, readonly bool) (ethdb.Database, error) {
+	db, err := pebble.New(file, cache, handles, namespace, readonly)
+	if err != nil {
+		return nil, err
+	}
+	return NewDatabase(db), nil
+}
+
+// NewPebbleDBDatabaseWithFreezer creates a persistent key-value database with a
+// freezer moving immutable chain segments into cold storage.
+func NewPebbleDBDatabaseWithFreezer(file string, cache int, handles int, freezer string, namespace string, readonly bool) (ethdb.Database, error) {
+	kvdb, err := pebble.New(file, cache, handles, namespace, readonly)
+	if err != nil {
+		return nil, err
+	}
+	frdb, err := NewDatabaseWithFreezer(kvdb, freezer, namespace, readonly)
+	if err != nil {
+		kvdb.Close()
+		return nil, err
+	}
+	return frdb, nil
+}
+
+// detectDb returns the engine of the existing database, 0 if none, 1 for
+// LevelDB, 2 for RocksDB and 3 for Pebble, which writes no LOG but the
+// OPTIONS files.
 func detectDb(file string) int {
 	if f, err := os.Open(path.Join(file, "LOG")); err != nil {
+		if options, _ := filepath.Glob(path.Join(file, "OPTIONS-*")); len(options) > 0 {
+			if data, err := os.ReadFile(options[0]); err == nil && bytes.Contains(data, []byte("pebble_version")) {
+				return 3
+			}
+		}
 		return 0
 	} else if s, err := bufio.NewReader(f).ReadString('\n'); err != nil {
 		return 0
@@ -307,34 +349,99 @@ func detectDb(file string) int {
 	return 1
 }
 
-func NewDB(file string, cache int, handles int, namespace string, readonly bool) (ethdb.Database, error) {
+// NewDB opens the database of the engine in the directory, or creates one of
+// params.UseRocksDb. The RocksDB profile is used if it's RocksDB.
+func NewDB(file string, cache int, handles int, namespace string, readonly bool, rocksdbCfg rocksdb.Config) (ethdb.Database, error) {
 	switch detectDb(file) {
 	case 1:
 		return NewLevelDBDatabase(file, cache, handles, namespace, readonly)
 	case 2:
-		return NewRocksDBDatabase(file, cache, handles, namespace, readonly)
+		return NewRocksDBDatabase(file, cache, handles, namespace, readonly, rocksdbCfg)
+	case 3:
+		return NewPebbleDBDatabase(file, cache, handles, namespace, readonly)
 	}
-	if params.UseRocksDb != 0 {
-		return NewRocksDBDatabase(file, cache, handles, namespace, readonly)
-	} else {
+	switch params.UseRocksDb {
+	case 0:
 		return NewLevelDBDatabase(file, cache, handles, namespace, readonly)
+	case 2:
+		return NewPebbleDBDatabase(file, cache, handles, namespace, readonly)
+	default:
+		return NewRocksDBDatabase(file, cache, handles, namespace, readonly, rocksdbCfg)
 	}
 }
 
-func NewDBWithFreezer(file string, cache int, handles int, freezer string, namespace string, readonly bool) (ethdb.Database, error) {
+// NewDBWithFreezer is NewDB with a freezer moving immutable chain segments
+// into cold storage.
+func NewDBWithFreezer(file string, cache int, handles int, freezer string, namespace string, readonly bool, rocksdbCfg rocksdb.Config) (ethdb.Database, error) {
 	switch detectDb(file) {
 	case 1:
 		return NewLevelDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly)
 	case 2:
-		return NewRocksDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly)
+		return NewRocksDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly, rocksdbCfg)
+	case 3:
+		return NewPebbleDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly)
 	}
-	if params.UseRocksDb != 0 {
-		return NewRocksDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly)
-	} else {
+	switch params.UseRocksDb {
+	case 0:
 		return NewLevelDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly)
+	case 2:
+		return NewPebbleDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly)
+	default:
+		return NewRocksDBDatabaseWithFreezer(file, cache, handles, freezer, namespace, readonly, rocksdbCfg)
 	}
 }
 
+// errNoCheckpoint is returned if the key-value store doesn't support
+// checkpoints.
+var errNoCheckpoint = errors.New("database engine doesn't support checkpoints")
+
+// Checkpoint implements ethdb.Checkpointer, checkpointing the key-value store
+// and copying the ancient data into the "ancient" subdirectory up to the
+// matching number of items.
+func (frdb *freezerdb) Checkpoint(dir string) error {
+	cp, ok := frdb.KeyValueStore.(ethdb.Checkpointer)
+	if !ok {
+		return errNoCheckpoint
+	}
+	items, err := frdb.AncientStore.(*freezer).backup(filepath.Join(dir, "ancient"), func() error {
+		return cp.Checkpoint(dir)
+	})
+	if err == nil {
+		log.Info("Copied ancient database", "items", items)
+	}
+	return err
+}
+
+// Checkpoint implements ethdb.Checkpointer, checkpointing the key-value store.
+func (db *nofreezedb) Checkpoint(dir string) error {
+	cp, ok := db.KeyValueStore.(ethdb.Checkpointer)
+	if !ok {
+		return errNoCheckpoint
+	}
+	return cp.Checkpoint(dir)
+}
+
+// BackupDatabase creates a consistent copy of the database in the directory,
+// which must not exist, to be the chain database of a new datadir.
+func BackupDatabase(db ethdb.Database, dir string) error {
+	cp, ok := db.(ethdb.Checkpointer)
+	if !ok {
+		return errNoCheckpoint
+	}
+	if common.FileExist(dir) {
+		return fmt.Errorf("%s exists", dir)
+	}
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+	start := time.Now()
+	if err := cp.Checkpoint(dir); err != nil {
+		return err
+	}
+	log.Info("Backed up database", "path", dir, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
 type counter uint64
 
 func (c counter) String() string {
@@ -380,6 +487,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		headers         stat
 		bodies          stat
 		receipts        stat
+		finalityCerts   stat
 		tds             stat
 		numHashPairings stat
 		hashNumPairings stat
@@ -390,6 +498,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		storageSnaps    stat
 		preimages       stat
 		bloomBits       stat
+		rewardIndex     stat
 		cliqueSnaps     stat
 
 		// Ancient store statistics
@@ -424,6 +533,8 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			bodies.Add(size)
 		case bytes.HasPrefix(key, blockReceiptsPrefix) && len(key) == (len(blockReceiptsPrefix)+8+common.HashLength):
 			receipts.Add(size)
+		case bytes.HasPrefix(key, finalityCertPrefix) && len(key) == (len(finalityCertPrefix)+8+common.HashLength):
+			finalityCerts.Add(size)
 		case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerTDSuffix):
 			tds.Add(size)
 		case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerHashSuffix):
@@ -448,6 +559,10 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			bloomBits.Add(size)
 		case bytes.HasPrefix(key, BloomBitsIndexPrefix):
 			bloomBits.Add(size)
+		case bytes.HasPrefix(key, rewardIndexPrefix) && len(key) == (len(rewardIndexPrefix)+common.AddressLength+8+common.HashLength):
+			rewardIndex.Add(size)
+		case bytes.HasPrefix(key, RewardIndexTablePrefix):
+			rewardIndex.Add(size)
 		case bytes.HasPrefix(key, []byte("clique-")) && len(key) == 7+common.HashLength:
 			cliqueSnaps.Add(size)
 		case bytes.HasPrefix(key, []byte("cht-")) ||
@@ -464,7 +579,8 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 				databaseVersionKey, headHeaderKey, headBlockKey, headFastBlockKey, lastPivotKey,
 				fastTrieProgressKey, snapshotDisabledKey, SnapshotRootKey, snapshotJournalKey,
 				snapshotGeneratorKey, snapshotRecoveryKey, txIndexTailKey, fastTxLookupLimitKey,
-				uncleanShutdownKey, badBlockKey, transitionStatusKey,
+				uncleanShutdownKey, badBlockKey, transitionStatusKey, headFinalizedBlockKey,
+				finalityStateKey,
 			} {
 				if bytes.Equal(key, meta) {
 					metadata.Add(size)
@@ -500,11 +616,13 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		{"Key-Value store", "Headers", headers.Size(), headers.Count()},
 		{"Key-Value store", "Bodies", bodies.Size(), bodies.Count()},
 		{"Key-Value store", "Receipt lists", receipts.Size(), receipts.Count()},
+		{"Key-Value store", "Finality certificates", finalityCerts.Size(), finalityCerts.Count()},
 		{"Key-Value store", "Difficulties", tds.Size(), tds.Count()},
 		{"Key-Value store", "Block number->hash", numHashPairings.Size(), numHashPairings.Count()},
 		{"Key-Value store", "Block hash->number", hashNumPairings.Size(), hashNumPairings.Count()},
 		{"Key-Value store", "Transaction index", txLookups.Size(), txLookups.Count()},
 		{"Key-Value store", "Bloombit index", bloomBits.Size(), bloomBits.Count()},
+		{"Key-Value store", "Reward index", rewardIndex.Size(), rewardIndex.Count()},
 		{"Key-Value store", "Contract codes", codes.Size(), codes.Count()},
 		{"Key-Value store", "Trie nodes", tries.Size(), tries.Count()},
 		{"Key-Value store", "Trie preimages", preimages.Size(), preimages.Count()},
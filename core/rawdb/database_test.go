@@ -15,3 +15,108 @@
 // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
 
 package rawdb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/ethdb/rocksdb"
+)
+
+// checkpointStore is a key-value store "checkpointed" into a marker file.
+type checkpointStore struct {
+	*memorydb.Database
+}
+
+func (db *checkpointStore) Checkpoint(dir string) error {
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "CHECKPOINT"), nil, 0644)
+}
+
+// Tests that the backup checkpoints the key-value store and copies the
+// ancients into the new chain database directory.
+func TestBackupDatabase(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewDatabaseWithFreezer(&checkpointStore{memorydb.New()}, filepath.Join(dir, "ancient"), "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.ModifyAncients(func(op ethdb.AncientWriteOp) error {
+		for kind := range FreezerNoSnappy {
+			if err := op.AppendRaw(kind, 0, []byte{1}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	backup := filepath.Join(t.TempDir(), "gwemix", "chaindata")
+	if err := BackupDatabase(db, backup); err != nil {
+		t.Fatalf("failed to back up: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(backup, "CHECKPOINT")); err != nil {
+		t.Errorf("key-value store not checkpointed: %v", err)
+	}
+	frdb, err := newFreezer(filepath.Join(backup, "ancient"), "", true, freezerTableSize, FreezerNoSnappy)
+	if err != nil {
+		t.Fatalf("failed to open the ancients: %v", err)
+	}
+	defer frdb.Close()
+	if items, _ := frdb.Ancients(); items != 1 {
+		t.Errorf("ancients mismatch: have %d, want 1", items)
+	}
+	if err := BackupDatabase(db, backup); err == nil {
+		t.Errorf("backup overwrote the existing directory")
+	}
+	if err := BackupDatabase(NewMemoryDatabase(), filepath.Join(dir, "memory")); err != errNoCheckpoint {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoCheckpoint)
+	}
+}
+
+// Tests that the keys of the schema are routed to the RocksDB column families
+// by their layouts.
+func TestRocksDBColumnFamily(t *testing.T) {
+	var (
+		hash = common.Hash{0x01}
+		addr = common.Address{0x02}
+	)
+	for _, tt := range []struct {
+		key []byte
+		cf  int
+	}{
+		{hash.Bytes(), rocksdb.CFTrie},
+		{blockReceiptsKey(1, hash), rocksdb.CFReceipts},
+		{accountSnapshotKey(hash), rocksdb.CFSnapshot},
+		{storageSnapshotKey(hash, hash), rocksdb.CFSnapshot},
+		{storageSnapshotsKey(hash), rocksdb.CFDefault},
+		{headerKey(1, hash), rocksdb.CFDefault},
+		{headerTDKey(1, hash), rocksdb.CFDefault},
+		{headerHashKey(1), rocksdb.CFDefault},
+		{headerNumberKey(hash), rocksdb.CFDefault},
+		{blockBodyKey(1, hash), rocksdb.CFDefault},
+		{finalityCertKey(1, hash), rocksdb.CFDefault},
+		{txLookupKey(hash), rocksdb.CFDefault},
+		{bloomBitsKey(1, 1, hash), rocksdb.CFDefault},
+		{rewardIndexKey(addr, 1, hash), rocksdb.CFDefault},
+		{preimageKey(hash), rocksdb.CFDefault},
+		{codeKey(hash), rocksdb.CFDefault},
+		{configKey(hash), rocksdb.CFDefault},
+		{headBlockKey, rocksdb.CFDefault},
+		{SnapshotRootKey, rocksdb.CFDefault},
+		{conversionProgressKey, rocksdb.CFDefault},
+		{nil, rocksdb.CFDefault},
+	} {
+		if have := rocksdbColumnFamily(tt.key); have != tt.cf {
+			t.Errorf("%x: column family mismatch: have %d, want %d", tt.key, have, tt.cf)
+		}
+	}
+}
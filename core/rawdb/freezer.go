@@ -301,6 +301,30 @@ func (f *freezer) TruncateAncients(items uint64) error {
 	return nil
 }
 
+// backup runs the checkpoint of the key-value store, and copies the ancient
+// data into the directory up to the number of the items frozen meanwhile.
+// Freezing is blocked until done, so the ancient data and the key-value
+// store match, i.e. nothing is deleted from the latter not to be in the
+// former.
+func (f *freezer) backup(dir string, checkpoint func() error) (uint64, error) {
+	f.writeLock.RLock()
+	defer f.writeLock.RUnlock()
+
+	if err := checkpoint(); err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return 0, err
+	}
+	items := atomic.LoadUint64(&f.frozen)
+	for _, table := range f.tables {
+		if err := table.copyTo(dir, items); err != nil {
+			return 0, err
+		}
+	}
+	return items, nil
+}
+
 // Sync flushes all data tables to disk.
 func (f *freezer) Sync() error {
 	var errs []error
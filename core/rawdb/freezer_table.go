@@ -490,6 +490,72 @@ func (t *freezerTable) releaseFilesAfter(num uint32, remove bool) {
 	}
 }
 
+// copyTo copies the first 'items' items of the table into the directory,
+// i.e. the index file truncated to the items and the data files up to the
+// end of the last item.
+func (t *freezerTable) copyTo(dir string, items uint64) error {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if t.index == nil {
+		return errClosed
+	}
+	if items < uint64(t.itemOffset) || items > atomic.LoadUint64(&t.items) {
+		return errOutOfBounds
+	}
+	index := make([]byte, (items-uint64(t.itemOffset)+1)*indexEntrySize)
+	if _, err := t.index.ReadAt(index, 0); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, filepath.Base(t.index.Name())), index, 0644); err != nil {
+		return err
+	}
+	if items == uint64(t.itemOffset) {
+		// The first entry holds the tail, not a data offset
+		return nil
+	}
+	var last indexEntry
+	last.unmarshalBinary(index[len(index)-indexEntrySize:])
+
+	for num := t.tailId; num <= last.filenum; num++ {
+		name := fmt.Sprintf("%s.%04d.cdat", t.name, num)
+		if t.noCompression {
+			name = fmt.Sprintf("%s.%04d.rdat", t.name, num)
+		}
+		size := int64(-1)
+		if num == last.filenum {
+			size = int64(last.offset)
+		}
+		if err := copyFile(filepath.Join(t.path, name), filepath.Join(dir, name), size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyFile copies the first 'size' bytes of the file, or all if negative.
+func copyFile(src, dst string, size int64) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if size < 0 {
+		_, err = io.Copy(out, in)
+	} else {
+		_, err = io.CopyN(out, in, size)
+	}
+	if err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 // getIndices returns the index entries for the given from-item, covering 'count' items.
 // N.B: The actual number of returned indices for N items will always be N+1 (unless an
 // error is returned).
@@ -337,3 +337,71 @@ func checkAncientCount(t *testing.T, f *freezer, kind string, n uint64) {
 		t.Errorf("Ancient(%q, %d) returned unexpected error %q", kind, index, err)
 	}
 }
+
+// This checks that the backup copies the items frozen at the time, across
+// multiple data files, and is opened as a freezer.
+func TestFreezerBackup(t *testing.T) {
+	t.Parallel()
+
+	tables := map[string]bool{"raw": true, "snappy": false}
+	f, dir := newFreezerForTesting(t, tables)
+	defer os.RemoveAll(dir)
+	defer f.Close()
+
+	_, err := f.ModifyAncients(func(op ethdb.AncientWriteOp) error {
+		for i := 0; i < 30; i++ {
+			if err := op.AppendRaw("raw", uint64(i), getChunk(256, i)); err != nil {
+				return err
+			}
+			if err := op.AppendRaw("snappy", uint64(i), getChunk(256, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("ModifyAncients failed:", err)
+	}
+
+	backup := dir + ".backup"
+	defer os.RemoveAll(backup)
+	called := false
+	items, err := f.backup(backup, func() error { called = true; return nil })
+	if err != nil {
+		t.Fatal("backup failed:", err)
+	}
+	if !called || items != 30 {
+		t.Fatalf("backup mismatch: checkpoint %v, items %d", called, items)
+	}
+	b, err := newFreezer(backup, "", true, 2049, tables)
+	if err != nil {
+		t.Fatal("can't open backup", err)
+	}
+	defer b.Close()
+	checkAncientCount(t, b, "raw", 30)
+	checkAncientCount(t, b, "snappy", 30)
+	for i := 0; i < 30; i++ {
+		if v, _ := b.Ancient("raw", uint64(i)); !bytes.Equal(v, getChunk(256, i)) {
+			t.Fatalf("wrong value at %d: %x", i, v)
+		}
+	}
+
+	// a part of the table
+	part := dir + ".part"
+	defer os.RemoveAll(part)
+	os.MkdirAll(part, 0755)
+	if err := f.tables["raw"].copyTo(part, 13); err != nil {
+		t.Fatal("copy failed:", err)
+	}
+	table, err := NewFreezerTable(part, "raw", true, false)
+	if err != nil {
+		t.Fatal("can't open copied table", err)
+	}
+	defer table.Close()
+	if table.items != 13 {
+		t.Errorf("copied items mismatch: have %d, want 13", table.items)
+	}
+	if err := f.tables["raw"].copyTo(part, 31); err != errOutOfBounds {
+		t.Errorf("copying too many items: have %v, want %v", err, errOutOfBounds)
+	}
+}
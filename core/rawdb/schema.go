@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/rocksdb"
 	"github.com/ethereum/go-ethereum/metrics"
 )
 
@@ -78,6 +79,16 @@ var (
 	// transitionStatusKey tracks the eth2 transition status.
 	transitionStatusKey = []byte("eth2-transition")
 
+	// headFinalizedBlockKey tracks the latest block finalized by a commit certificate.
+	headFinalizedBlockKey = []byte("LastFinalized")
+
+	// finalityStateKey tracks the votes of this node in the finality gadget.
+	finalityStateKey = []byte("FinalityState")
+
+	// conversionProgressKey tracks the last key copied into a database being
+	// converted to another engine.
+	conversionProgressKey = []byte("ConversionProgress")
+
 	// Data item prefixes (use single byte to avoid mixing data types, avoid `i`, used for indexes).
 	headerPrefix       = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
 	headerTDSuffix     = []byte("t") // headerPrefix + num (uint64 big endian) + hash + headerTDSuffix -> td
@@ -86,9 +97,11 @@ var (
 
 	blockBodyPrefix     = []byte("b") // blockBodyPrefix + num (uint64 big endian) + hash -> block body
 	blockReceiptsPrefix = []byte("r") // blockReceiptsPrefix + num (uint64 big endian) + hash -> block receipts
+	finalityCertPrefix  = []byte("F") // finalityCertPrefix + num (uint64 big endian) + hash -> commit certificate
 
 	txLookupPrefix        = []byte("l") // txLookupPrefix + hash -> transaction/receipt lookup metadata
 	bloomBitsPrefix       = []byte("B") // bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash -> bloom bits
+	rewardIndexPrefix     = []byte("R") // rewardIndexPrefix + address + section (uint64 big endian) + hash -> reward entries
 	SnapshotAccountPrefix = []byte("a") // SnapshotAccountPrefix + account hash -> account trie value
 	SnapshotStoragePrefix = []byte("o") // SnapshotStoragePrefix + account hash + storage hash -> storage trie value
 	CodePrefix            = []byte("c") // CodePrefix + code hash -> account code
@@ -97,7 +110,8 @@ var (
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
 
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
-	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	BloomBitsIndexPrefix   = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	RewardIndexTablePrefix = []byte("iR") // RewardIndexTablePrefix is the data table of the reward indexer to track its progress
 
 	preimageCounter    = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
@@ -180,6 +194,11 @@ func blockReceiptsKey(number uint64, hash common.Hash) []byte {
 	return append(append(blockReceiptsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
 }
 
+// finalityCertKey = finalityCertPrefix + num (uint64 big endian) + hash
+func finalityCertKey(number uint64, hash common.Hash) []byte {
+	return append(append(finalityCertPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
 // txLookupKey = txLookupPrefix + hash
 func txLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
@@ -210,6 +229,13 @@ func bloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	return key
 }
 
+// rewardIndexKey = rewardIndexPrefix + address + section (uint64 big endian) + hash
+func rewardIndexKey(addr common.Address, section uint64, hash common.Hash) []byte {
+	key := append(append(rewardIndexPrefix, addr.Bytes()...), make([]byte, 8)...)
+	binary.BigEndian.PutUint64(key[len(rewardIndexPrefix)+common.AddressLength:], section)
+	return append(key, hash.Bytes()...)
+}
+
 // preimageKey = PreimagePrefix + hash
 func preimageKey(hash common.Hash) []byte {
 	return append(PreimagePrefix, hash.Bytes()...)
@@ -229,6 +255,24 @@ func IsCodeKey(key []byte) (bool, []byte) {
 	return false, nil
 }
 
+// rocksdbColumnFamily returns the RocksDB column family of the key by its
+// layout above. Trie nodes are the only keys of a bare hash, receipts and
+// snapshot data are told by their prefixes and lengths, and the rest goes to
+// the default column family.
+func rocksdbColumnFamily(key []byte) int {
+	switch {
+	case len(key) == common.HashLength:
+		return rocksdb.CFTrie
+	case len(key) == len(blockReceiptsPrefix)+8+common.HashLength && bytes.HasPrefix(key, blockReceiptsPrefix):
+		return rocksdb.CFReceipts
+	case len(key) == len(SnapshotAccountPrefix)+common.HashLength && bytes.HasPrefix(key, SnapshotAccountPrefix):
+		return rocksdb.CFSnapshot
+	case len(key) == len(SnapshotStoragePrefix)+2*common.HashLength && bytes.HasPrefix(key, SnapshotStoragePrefix):
+		return rocksdb.CFSnapshot
+	}
+	return rocksdb.CFDefault
+}
+
 // configKey = configPrefix + hash
 func configKey(hash common.Hash) []byte {
 	return append(configPrefix, hash.Bytes()...)
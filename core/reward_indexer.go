@@ -0,0 +1,110 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package core
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+const (
+	// rewardThrottling is the time to wait between processing two consecutive
+	// index sections, not to overload the disk while backfilling.
+	rewardThrottling = 100 * time.Millisecond
+)
+
+// RewardIndexer implements a core.ChainIndexer, indexing the block rewards in
+// the headers by address, so that the rewards of an address are found without
+// scanning every header.
+type RewardIndexer struct {
+	db      ethdb.Database                          // database instance to write index data into
+	section uint64                                  // section number being processed currently
+	head    common.Hash                             // hash of the last header processed
+	entries map[common.Address][]*rawdb.RewardEntry // entries of the current section by address
+}
+
+// NewRewardIndexer returns a chain indexer that generates the reward index
+// for the canonical chain, in sections of the given size.
+func NewRewardIndexer(db ethdb.Database, size, confirms uint64) *ChainIndexer {
+	backend := &RewardIndexer{
+		db: db,
+	}
+	table := rawdb.NewTable(db, string(rawdb.RewardIndexTablePrefix))
+
+	return NewChainIndexer(db, table, backend, size, confirms, rewardThrottling, "rewards")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new reward index
+// section.
+func (r *RewardIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	r.section, r.head = section, common.Hash{}
+	r.entries = make(map[common.Address][]*rawdb.RewardEntry)
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, adding the rewards of a new
+// header into the index.
+func (r *RewardIndexer) Process(ctx context.Context, header *types.Header) error {
+	entries, err := RewardEntries(header)
+	if err != nil {
+		return err
+	}
+	for addr, e := range entries {
+		r.entries[addr] = append(r.entries[addr], e)
+	}
+	r.head = header.Hash()
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, writing the reward entries of
+// the section out into the database.
+func (r *RewardIndexer) Commit() error {
+	batch := r.db.NewBatch()
+	for addr, entries := range r.entries {
+		rawdb.WriteRewardIndex(batch, addr, r.section, r.head, entries)
+	}
+	return batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (r *RewardIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// RewardEntries returns the block rewards in the header by address. The
+// transaction fees are not paid to the coinbase but distributed in the
+// rewards, so they are only recorded in the entry of the coinbase, if it is
+// rewarded, for information and not added to its reward.
+func RewardEntries(header *types.Header) (map[common.Address]*rawdb.RewardEntry, error) {
+	rewards, err := wemixapi.BlockRewards(header)
+	if err != nil {
+		return nil, err
+	}
+	number := header.Number.Uint64()
+	entries := make(map[common.Address]*rawdb.RewardEntry)
+	entry := func(addr common.Address) *rawdb.RewardEntry {
+		e, ok := entries[addr]
+		if !ok {
+			e = &rawdb.RewardEntry{Number: number, Reward: new(big.Int), Fees: new(big.Int)}
+			entries[addr] = e
+		}
+		return e
+	}
+	for _, rw := range rewards.Rewards {
+		if rw.Reward != nil {
+			e := entry(rw.Addr)
+			e.Reward.Add(e.Reward, rw.Reward)
+		}
+	}
+	if e, ok := entries[header.Coinbase]; ok && header.Fees != nil {
+		e.Fees.Set(header.Fees)
+	}
+	return entries, nil
+}
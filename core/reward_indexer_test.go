@@ -0,0 +1,82 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+// Tests that the rewards are indexed by address, with the fees recorded for
+// the rewarded coinbase only and not added to its reward.
+func TestRewardIndexer(t *testing.T) {
+	var (
+		db        = rawdb.NewMemoryDatabase()
+		member    = common.HexToAddress("0x0001")
+		ecosystem = common.HexToAddress("0x2000")
+		indexer   = &RewardIndexer{db: db}
+		head      *types.Header
+	)
+	header := func(number int64, coinbase common.Address, fees int64, rewards ...*wemixapi.WemixReward) *types.Header {
+		data, _ := json.Marshal(rewards)
+		return &types.Header{
+			Number:   big.NewInt(number),
+			Coinbase: coinbase,
+			Fees:     big.NewInt(fees),
+			Rewards:  data,
+		}
+	}
+	headers := []*types.Header{
+		header(8, member, 21000,
+			&wemixapi.WemixReward{Addr: member, Reward: big.NewInt(400)},
+			&wemixapi.WemixReward{Addr: ecosystem, Reward: big.NewInt(250)}),
+		header(9, ecosystem, 30000,
+			&wemixapi.WemixReward{Addr: member, Reward: big.NewInt(400)},
+			&wemixapi.WemixReward{Addr: member, Reward: big.NewInt(100)}),
+		{Number: big.NewInt(10), Coinbase: member},
+	}
+	indexer.Reset(context.Background(), 2, common.Hash{})
+	for _, h := range headers {
+		if err := indexer.Process(context.Background(), h); err != nil {
+			t.Fatalf("failed to process header %d: %v", h.Number, err)
+		}
+		head = h
+	}
+	if err := indexer.Commit(); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+
+	for _, tt := range []struct {
+		addr common.Address
+		want []*rawdb.RewardEntry
+	}{
+		{member, []*rawdb.RewardEntry{
+			{Number: 8, Reward: big.NewInt(400), Fees: big.NewInt(21000)},
+			{Number: 9, Reward: big.NewInt(500), Fees: big.NewInt(0)},
+		}},
+		{ecosystem, []*rawdb.RewardEntry{
+			{Number: 8, Reward: big.NewInt(250), Fees: big.NewInt(0)},
+		}},
+	} {
+		have := rawdb.ReadRewardIndex(db, tt.addr, 2, head.Hash())
+		if len(have) != len(tt.want) {
+			t.Fatalf("%x: entry count mismatch: have %d, want %d", tt.addr, len(have), len(tt.want))
+		}
+		for i, e := range have {
+			if e.Number != tt.want[i].Number || e.Reward.Cmp(tt.want[i].Reward) != 0 || e.Fees.Cmp(tt.want[i].Fees) != 0 {
+				t.Errorf("%x: entry %d mismatch: have %+v, want %+v", tt.addr, i, e, tt.want[i])
+			}
+		}
+	}
+
+	if _, err := RewardEntries(&types.Header{Number: big.NewInt(1), Rewards: []byte("{")}); err == nil {
+		t.Errorf("malformed rewards indexed")
+	}
+}
@@ -126,6 +126,10 @@ var (
 	// that this number is pretty low, since txpool reorgs happen very frequently.
 	dropBetweenReorgHistogram = metrics.NewRegisteredHistogram("txpool/dropbetweenreorg", nil, metrics.NewExpDecaySample(1028, 0.015))
 
+	// Sender hints of the partners checked, and found wrong or with invalid signatures
+	senderHintVerifiedCounter = metrics.NewRegisteredCounter("txpool/senderhint/verified", nil)
+	senderHintMismatchCounter = metrics.NewRegisteredCounter("txpool/senderhint/mismatch", nil)
+
 	pendingGauge = metrics.NewRegisteredGauge("txpool/pending", nil)
 	queuedGauge  = metrics.NewRegisteredGauge("txpool/queued", nil)
 	localGauge   = metrics.NewRegisteredGauge("txpool/local", nil)
@@ -170,6 +174,9 @@ type TxPoolConfig struct {
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
 
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
+
+	SenderHintSample uint64 // Percentage of the senders hinted by the partners to verify
+	NonceLimit       uint64 // Nonce limit for non-governing accounts overriding the chain config's if set
 }
 
 // DefaultTxPoolConfig contains the default configurations for the transaction
@@ -187,6 +194,8 @@ var DefaultTxPoolConfig = TxPoolConfig{
 	GlobalQueue:  500000,
 
 	Lifetime: 3 * time.Hour,
+
+	SenderHintSample: 10,
 }
 
 // sanitize checks the provided user configurations and changes anything that's
@@ -225,6 +234,10 @@ func (config *TxPoolConfig) sanitize() TxPoolConfig {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultTxPoolConfig.Lifetime)
 		conf.Lifetime = DefaultTxPoolConfig.Lifetime
 	}
+	if conf.SenderHintSample > 100 {
+		log.Warn("Sanitizing invalid txpool sender hint sample", "provided", conf.SenderHintSample, "updated", 100)
+		conf.SenderHintSample = 100
+	}
 	return conf
 }
 
@@ -249,6 +262,8 @@ type TxPool struct {
 	eip2718  bool // Fork indicator whether we are using EIP-2718 type transactions.
 	eip1559  bool // Fork indicator whether we are using EIP-1559 type transactions.
 
+	nonceLimit uint64 // Wemix nonce limit for non-governing accounts, 0 means no limit
+
 	currentState  *state.StateDB // Current state in the blockchain head
 	pendingNonces *txNoncer      // Pending state tracking virtual nonces
 	currentMaxGas uint64         // Current gas limit for transaction caps
@@ -628,8 +643,8 @@ func (pool *TxPool) local() map[common.Address]types.Transactions {
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	// Check nonce limit
-	if params.NonceLimit != 0 && tx.Nonce() > params.NonceLimit {
-		return fmt.Errorf("Too many transactions (%d) for an account", params.NonceLimit)
+	if pool.nonceLimit != 0 && tx.Nonce() > pool.nonceLimit {
+		return fmt.Errorf("Too many transactions (%d) for an account", pool.nonceLimit)
 	}
 	// Accept only legacy transactions until EIP-2718/2930 activates.
 	if !pool.eip2718 && tx.Type() != types.LegacyTxType {
@@ -1358,6 +1373,10 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.istanbul = pool.chainconfig.IsIstanbul(next)
 	pool.eip2718 = pool.chainconfig.IsBerlin(next)
 	pool.eip1559 = pool.chainconfig.IsLondon(next)
+	pool.nonceLimit = pool.chainconfig.Wemix.Params(next).NonceLimit
+	if pool.config.NonceLimit != 0 {
+		pool.nonceLimit = pool.config.NonceLimit
+	}
 }
 
 // promoteExecutables moves transactions that have become processable from the
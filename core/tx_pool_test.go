@@ -2428,6 +2428,74 @@ func TestTransactionSlotCount(t *testing.T) {
 	}
 }
 
+// Tests that the senders hinted by partners are verified as sampled, the wrong
+// ones are corrected, and the ones with invalid signatures are dropped.
+func TestTransactionSenderHints(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	wrong := common.HexToAddress("0xdeadbeef")
+	hinted := func(hint common.Address, txs ...*types.Transaction) []*types.Transaction {
+		var txexs []*types.TransactionEx
+		for _, tx := range txs {
+			txexs = append(txexs, &types.TransactionEx{Tx: tx, From: hint})
+		}
+		return types.TxExs2Txs(pool.signer, txexs, true)
+	}
+	unsigned := types.NewTransaction(0, common.Address{}, big.NewInt(100), 100000, big.NewInt(1), nil)
+	invalid, _ := unsigned.WithSignature(pool.signer, make([]byte, 65))
+	verify := func(txs []*types.Transaction) ([]*types.Transaction, int) {
+		type result struct {
+			txs        []*types.Transaction
+			mismatches int
+		}
+		ch := make(chan result, 1)
+		pool.VerifySenderHints(txs, func(out []*types.Transaction, mismatches int) {
+			ch <- result{out, mismatches}
+		})
+		select {
+		case res := <-ch:
+			return res.txs, res.mismatches
+		case <-time.After(5 * time.Second):
+			t.Fatal("sender hints not verified in time")
+			return nil, 0
+		}
+	}
+
+	// nothing is checked if not sampled
+	pool.config.SenderHintSample = 0
+	txs := hinted(wrong, transaction(0, 100000, key))
+	if out, mismatches := verify(txs); len(out) != 1 || mismatches != 0 {
+		t.Fatalf("unsampled: got %d txs, %d mismatches, want 1, 0", len(out), mismatches)
+	}
+	if sender, _ := types.Sender(pool.signer, txs[0]); sender != wrong {
+		t.Fatalf("unsampled: sender %x, want the hint %x", sender, wrong)
+	}
+
+	// all are checked if fully sampled
+	pool.config.SenderHintSample = 100
+	txs = append(hinted(from, transaction(1, 100000, key)), hinted(wrong, transaction(2, 100000, key), invalid)...)
+	txs = append(txs, transaction(3, 100000, key))
+	out, mismatches := verify(txs)
+	if len(out) != 3 || mismatches != 2 {
+		t.Fatalf("sampled: got %d txs, %d mismatches, want 3, 2", len(out), mismatches)
+	}
+	for i, tx := range out {
+		if tx == invalid {
+			t.Fatalf("tx %d: invalid signature not dropped", i)
+		}
+		if types.HasSenderHint(pool.signer, tx) {
+			t.Fatalf("tx %d: hint left unverified", i)
+		}
+		if sender, _ := types.Sender(pool.signer, tx); sender != from {
+			t.Fatalf("tx %d: sender %x, want %x", i, sender, from)
+		}
+	}
+}
+
 // Benchmarks the speed of validating the contents of the pending queue of the
 // transaction pool.
 func BenchmarkPendingDemotion100(b *testing.B)   { benchmarkPendingDemotion(b, 100) }
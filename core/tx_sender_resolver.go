@@ -3,12 +3,17 @@
 package core
 
 import (
+	crand "crypto/rand"
+	"math"
+	"math/big"
+	mrand "math/rand"
 	"sync"
 	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
 )
 
 // job structure is needed because param is necessary due to evaluation order
@@ -24,17 +29,34 @@ type SenderResolver struct {
 	tx2addr *lru.LruCache
 	jobs    chan *job
 	busy    chan interface{}
+
+	rand     *mrand.Rand // sampler of the sender hints to verify
+	randLock sync.Mutex
 }
 
 // NewSenderResolver creates a new sender resolver worker pool
 func NewSenderResolver(concurrency, cacheSize int) *SenderResolver {
+	// Seed a fast but crypto originating random generator, so the peers
+	// can't predict which of their hints are checked
+	seed, err := crand.Int(crand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		log.Crit("Failed to initialize random seed", "err", err)
+	}
 	return &SenderResolver{
 		tx2addr: lru.NewLruCache(cacheSize, true),
 		jobs:    make(chan *job, concurrency),
 		busy:    make(chan interface{}, concurrency),
+		rand:    mrand.New(mrand.NewSource(seed.Int64())),
 	}
 }
 
+// sample returns true with the given percent chance
+func (s *SenderResolver) sample(percent uint64) bool {
+	s.randLock.Lock()
+	defer s.randLock.Unlock()
+	return uint64(s.rand.Intn(100)) < percent
+}
+
 // sender resolver main loop
 func (s *SenderResolver) Run() {
 	for {
@@ -98,6 +120,62 @@ func (pool *TxPool) ResolveSenders(signer types.Signer, txs []*types.Transaction
 	wg.Wait()
 }
 
+// VerifySenderHints verifies the senders hinted by a partner, a sample of
+// SenderHintSample percent of them, with the sender resolver workers without
+// waiting for them. The hints found wrong are replaced with the recovered
+// senders. Once all are checked, done is called with the transactions less
+// the ones with invalid signatures, and the # of the hints found wrong or
+// with invalid signatures. done is called in place if nothing is sampled.
+func (pool *TxPool) VerifySenderHints(txs []*types.Transaction, done func([]*types.Transaction, int)) {
+	s := pool.senderResolver
+
+	var sampled []int
+	for i, tx := range txs {
+		if types.HasSenderHint(pool.signer, tx) && s.sample(pool.config.SenderHintSample) {
+			sampled = append(sampled, i)
+		}
+	}
+	if len(sampled) == 0 {
+		done(txs, 0)
+		return
+	}
+
+	var mismatches int64
+	pending := int64(len(sampled))
+	invalid := make([]bool, len(txs))
+	finish := func() {
+		if atomic.AddInt64(&pending, -1) != 0 {
+			return
+		}
+		if mismatches == 0 {
+			done(txs, 0)
+			return
+		}
+		var out []*types.Transaction
+		for i, tx := range txs {
+			if !invalid[i] {
+				out = append(out, tx)
+			}
+		}
+		done(out, int(mismatches))
+	}
+	for _, i := range sampled {
+		i := i
+		s.Post(func(param interface{}) {
+			defer finish()
+			ok, err := types.VerifySenderHint(pool.signer, param.(*types.Transaction))
+			senderHintVerifiedCounter.Inc(1)
+			if err != nil {
+				invalid[i] = true
+			}
+			if !ok {
+				atomic.AddInt64(&mismatches, 1)
+				senderHintMismatchCounter.Inc(1)
+			}
+		}, txs[i])
+	}
+}
+
 // ResolveSender resolves sender address from a transaction
 func (pool *TxPool) ResolveSender(signer types.Signer, tx *types.Transaction) {
 	var txs []*types.Transaction
@@ -0,0 +1,78 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Finality vote types. The governance nodes prevote for a block on their
+// canonical chain, and precommit it once more than 2/3 of them prevoted for
+// it. The precommits of more than 2/3 of them make its commit certificate.
+const (
+	Prevote uint8 = iota
+	Precommit
+)
+
+// ErrInvalidVoteSig is returned if a finality vote is not signed by its voter.
+var ErrInvalidVoteSig = errors.New("invalid finality vote signature")
+
+// FinalityVote is a governance node's vote for a block.
+type FinalityVote struct {
+	Type   uint8
+	Number uint64
+	Hash   common.Hash
+	NodeId []byte // public key of the voter without the 0x04 prefix
+	Sig    []byte // signature of SigHash by the node key of the voter
+}
+
+// SigHash returns the hash the voter signs.
+func (v *FinalityVote) SigHash() common.Hash {
+	return rlpHash([]interface{}{"wemix-finality", v.Type, v.Number, v.Hash})
+}
+
+// Verify checks if the vote is signed by the node key of NodeId.
+func (v *FinalityVote) Verify() error {
+	pub, err := crypto.Ecrecover(v.SigHash().Bytes(), v.Sig)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pub[1:], v.NodeId) {
+		return ErrInvalidVoteSig
+	}
+	return nil
+}
+
+// FinalitySig is a voter's signature in a commit certificate.
+type FinalitySig struct {
+	NodeId []byte
+	Sig    []byte
+}
+
+// FinalityCert is the commit certificate of a block, i.e. the precommits of
+// more than 2/3 of the nodes allowed to sign the block. The ancestors of the
+// block are final as well.
+type FinalityCert struct {
+	Number uint64
+	Hash   common.Hash
+	Sigs   []*FinalitySig
+}
+
+// Precommits returns the precommit votes in the certificate.
+func (c *FinalityCert) Precommits() []*FinalityVote {
+	votes := make([]*FinalityVote, len(c.Sigs))
+	for i, s := range c.Sigs {
+		votes[i] = &FinalityVote{
+			Type:   Precommit,
+			Number: c.Number,
+			Hash:   c.Hash,
+			NodeId: s.NodeId,
+			Sig:    s.Sig,
+		}
+	}
+	return votes
+}
@@ -413,7 +413,7 @@ func TxExs2Txs(signer Signer, txs []*TransactionEx, trustIt bool) []*Transaction
 	var out []*Transaction
 	for _, i := range txs {
 		if trustIt {
-			i.Tx.from.Store(sigCache{signer: signer, from: i.From})
+			i.Tx.from.Store(sigCache{signer: signer, from: i.From, hint: true})
 		}
 		out = append(out, i.Tx)
 	}
@@ -34,6 +34,7 @@ var ErrInvalidChainId = errors.New("invalid chain id for signer")
 type sigCache struct {
 	signer Signer
 	from   common.Address
+	hint   bool // supplied by a trusted peer, not recovered yet
 }
 
 // MakeSigner returns a Signer based on the given chain config and block number.
@@ -172,6 +173,30 @@ func GetSender(signer Signer, tx *Transaction) *common.Address {
 	return nil
 }
 
+// HasSenderHint returns true if the cached sender of the transaction was
+// supplied by a trusted peer rather than recovered from the signature.
+func HasSenderHint(signer Signer, tx *Transaction) bool {
+	if sc := tx.from.Load(); sc != nil {
+		sigCache := sc.(sigCache)
+		return sigCache.hint && sigCache.signer.Equal(signer)
+	}
+	return false
+}
+
+// VerifySenderHint recovers the sender of the transaction and checks it
+// against the one supplied by a trusted peer, returning false if they
+// differ. The recovered sender replaces the hint. The hint is left intact if
+// the signature is invalid, so the transaction is to be dropped then.
+func VerifySenderHint(signer Signer, tx *Transaction) (bool, error) {
+	hint := GetSender(signer, tx)
+	addr, err := signer.Sender(tx)
+	if err != nil {
+		return false, err
+	}
+	tx.from.Store(sigCache{signer: signer, from: addr})
+	return hint == nil || *hint == addr, nil
+}
+
 // Signer encapsulates transaction signature handling. The name of this type is slightly
 // misleading because Signers don't actually sign, they're just for validating and
 // processing of signatures.
@@ -24,6 +24,7 @@ import (
 	"io"
 	"math/big"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -42,6 +43,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
 	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
 )
 
 // PublicEthereumAPI provides an API to access Ethereum full node-related
@@ -212,6 +214,22 @@ func (api *PrivateAdminAPI) ExportChain(file string, first *uint64, last *uint64
 	return true, nil
 }
 
+// BackupChainData creates a consistent copy of the chain database, including
+// the ancient data, in the new datadir 'dir' while the node is running.
+func (api *PrivateAdminAPI) BackupChainData(dir string) (bool, error) {
+	if api.eth.chainDir == "" {
+		return false, errors.New("chain database is not on disk")
+	}
+	if _, err := os.Stat(dir); err == nil {
+		// Same as ExportChain, don't overwrite anything
+		return false, errors.New("location would overwrite an existing directory")
+	}
+	if err := rawdb.BackupDatabase(api.eth.ChainDb(), filepath.Join(dir, api.eth.chainDir)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func hasAllBlocks(chain *core.BlockChain, bs []*types.Block) bool {
 	for _, b := range bs {
 		if !chain.HasBlock(b.Hash(), b.NumberU64()) {
@@ -271,52 +289,34 @@ func (api *PrivateAdminAPI) ImportChain(file string) (bool, error) {
 	return true, nil
 }
 
-// RequestMinerStatus asks the given peer to send extended status
-func (api *PrivateAdminAPI) RequestMinerStatus(id enode.ID) error {
-	return api.eth.handler.RequestMinerStatus(id)
-}
-
-// RequestEtcdAddMember asks the given peer to add this node to the etcd cluster
-func (api *PrivateAdminAPI) RequestEtcdAddMember(id enode.ID) error {
-	return api.eth.handler.RequestEtcdAddMember(id)
-}
-
-// Initializes an etcd cluster
-func (api *PrivateAdminAPI) EtcdInit() error {
-	return wemixapi.EtcdInit()
-}
-
-// Manually adds a node to an etcd cluster.
-// TODO: to be removed
-func (api *PrivateAdminAPI) EtcdAddMember(name string) (string, error) {
-	return wemixapi.EtcdAddMember(name)
-}
-
-// Manually removes a node from an etcd cluster
-// TODO: to be removed
-func (api *PrivateAdminAPI) EtcdRemoveMember(name string) (string, error) {
-	return wemixapi.EtcdRemoveMember(name)
+// RequestMinerStatus fetches the extended status of the given governance node
+func (api *PrivateAdminAPI) RequestMinerStatus(ctx context.Context, id enode.ID) (*wemixapi.WemixMinerStatus, error) {
+	return api.eth.handler.RequestMinerStatus(ctx, id.String())
 }
 
-// Manually join an etcd network
-// TODO: to be removed
-func (api *PrivateAdminAPI) EtcdJoin(cluster string) error {
-	return wemixapi.EtcdJoin(cluster)
-}
-
-// Manually move leader in case the leader is misbehaving
-func (api *PrivateAdminAPI) EtcdMoveLeader(name string) error {
-	return wemixapi.EtcdMoveLeader(name)
+// Manually move the raft leader in case the leader is misbehaving
+func (api *PrivateAdminAPI) RaftMoveLeader(name string) error {
+	return wemixapi.RaftMoveLeader(name)
 }
 
 // Get the latest logged work
-func (api *PrivateAdminAPI) EtcdGetWork() (string, error) {
-	return wemixapi.EtcdGetWork()
+func (api *PrivateAdminAPI) RaftGetWork() (string, error) {
+	return wemixapi.RaftGetWork()
 }
 
 // Remove the latest logged work
-func (api *PrivateAdminAPI) EtcdDeleteWork() error {
-	return wemixapi.EtcdDeleteWork()
+func (api *PrivateAdminAPI) RaftDeleteWork() error {
+	return wemixapi.RaftDeleteWork()
+}
+
+// VerifyBlockRewards verifies the fees and rewards of the given block against
+// the governance state of its parent block
+func (api *PrivateAdminAPI) VerifyBlockRewards(blockNr rpc.BlockNumber) interface{} {
+	var height *big.Int
+	if blockNr >= 0 {
+		height = big.NewInt(blockNr.Int64())
+	}
+	return wemixminer.VerifyBlockRewards(height)
 }
 
 // Synchronize with the given peer
@@ -674,3 +674,222 @@ func (api *PrivateDebugAPI) GetAccessibleState(from, to rpc.BlockNumber) (uint64
 	}
 	return 0, fmt.Errorf("No state found")
 }
+
+// PublicWemixAPI provides an API to access wemix specific information.
+type PublicWemixAPI struct {
+	e *Ethereum
+}
+
+// NewPublicWemixAPI creates a new wemix API.
+func NewPublicWemixAPI(e *Ethereum) *PublicWemixAPI {
+	return &PublicWemixAPI{e}
+}
+
+// GetFinalityProof returns the commit certificate proving the block final,
+// i.e. its own, or the one of the last finalized block if it's an ancestor
+// of it. nil is returned if the block is not final.
+func (api *PublicWemixAPI) GetFinalityProof(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (map[string]interface{}, error) {
+	header, err := api.e.APIBackend.HeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	} else if header == nil {
+		return nil, errors.New("block not found")
+	}
+	var (
+		chain     = api.e.blockchain
+		number    = header.Number.Uint64()
+		hash      = header.Hash()
+		finalized = chain.CurrentFinalizedBlock()
+	)
+	if finalized == nil || number > finalized.NumberU64() || chain.GetCanonicalHash(number) != hash {
+		return nil, nil
+	}
+	cert := chain.GetFinalityCert(hash, number)
+	if cert == nil {
+		cert = chain.GetFinalityCert(finalized.Hash(), finalized.NumberU64())
+	}
+	if cert == nil {
+		return nil, nil
+	}
+	sigs := make([]map[string]interface{}, len(cert.Sigs))
+	for i, sig := range cert.Sigs {
+		sigs[i] = map[string]interface{}{
+			"nodeId":    hexutil.Bytes(sig.NodeId),
+			"signature": hexutil.Bytes(sig.Sig),
+		}
+	}
+	return map[string]interface{}{
+		"number": hexutil.Uint64(number),
+		"hash":   hash,
+		"certificate": map[string]interface{}{
+			"number":     hexutil.Uint64(cert.Number),
+			"hash":       cert.Hash,
+			"signatures": sigs,
+		},
+	}, nil
+}
+
+// header returns the header of the given block.
+func (api *PublicWemixAPI) header(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Header, error) {
+	header, err := api.e.APIBackend.HeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	} else if header == nil {
+		return nil, errors.New("block not found")
+	}
+	return header, nil
+}
+
+// GetMembers returns the governance members at the given block.
+func (api *PublicWemixAPI) GetMembers(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*wemixapi.WemixGovMember, error) {
+	if wemixapi.GetMembers == nil {
+		return nil, wemixminer.ErrNotInitialized
+	}
+	header, err := api.header(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return wemixapi.GetMembers(ctx, header)
+}
+
+// GetNodes returns the governance nodes at the given block.
+func (api *PublicWemixAPI) GetNodes(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*wemixapi.WemixGovNode, error) {
+	if wemixapi.GetNodes == nil {
+		return nil, wemixminer.ErrNotInitialized
+	}
+	header, err := api.header(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return wemixapi.GetNodes(ctx, header)
+}
+
+// GetEnvValues returns the governance environment variables at the given
+// block by name.
+func (api *PublicWemixAPI) GetEnvValues(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (map[string]*hexutil.Big, error) {
+	if wemixapi.GetEnvValues == nil {
+		return nil, wemixminer.ErrNotInitialized
+	}
+	header, err := api.header(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	values, err := wemixapi.GetEnvValues(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*hexutil.Big, len(values))
+	for name, v := range values {
+		result[name] = (*hexutil.Big)(v)
+	}
+	return result, nil
+}
+
+// GetRewardParameters returns the parameters the rewards of the block
+// following the given one are distributed by.
+func (api *PublicWemixAPI) GetRewardParameters(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*wemixapi.WemixRewardParameters, error) {
+	if wemixapi.GetRewardParameters == nil {
+		return nil, wemixminer.ErrNotInitialized
+	}
+	header, err := api.header(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return wemixapi.GetRewardParameters(ctx, header)
+}
+
+// GetMinerForBlock returns the governance node that mined the given block.
+func (api *PublicWemixAPI) GetMinerForBlock(ctx context.Context, number rpc.BlockNumber) (*wemixapi.WemixBlockMiner, error) {
+	if wemixapi.GetBlockMiner == nil {
+		return nil, wemixminer.ErrNotInitialized
+	}
+	header, err := api.header(ctx, rpc.BlockNumberOrHashWithNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	return wemixapi.GetBlockMiner(ctx, header)
+}
+
+// GetRewardsByBlock returns the fees and the rewards of the given block.
+func (api *PublicWemixAPI) GetRewardsByBlock(ctx context.Context, number rpc.BlockNumber) (*wemixapi.WemixBlockRewards, error) {
+	header, err := api.header(ctx, rpc.BlockNumberOrHashWithNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	return wemixapi.BlockRewards(header)
+}
+
+// rewardRange resolves the block range of the reward queries, up to the
+// current block.
+func (api *PublicWemixAPI) rewardRange(ctx context.Context, from, to rpc.BlockNumber) (uint64, uint64, error) {
+	resolve := func(number rpc.BlockNumber) (uint64, error) {
+		if number >= 0 {
+			return uint64(number), nil
+		}
+		header, err := api.header(ctx, rpc.BlockNumberOrHashWithNumber(number))
+		if err != nil {
+			return 0, err
+		}
+		return header.Number.Uint64(), nil
+	}
+	first, err := resolve(from)
+	if err != nil {
+		return 0, 0, err
+	}
+	last, err := resolve(to)
+	if err != nil {
+		return 0, 0, err
+	}
+	if head := api.e.blockchain.CurrentHeader().Number.Uint64(); last > head {
+		last = head
+	}
+	if first > last {
+		return 0, 0, errors.New("invalid block range")
+	}
+	return first, last, nil
+}
+
+// GetRewardHistory returns the block rewards the address received in the
+// blocks from from to to, inclusive, with the fees of the blocks it mined.
+func (api *PublicWemixAPI) GetRewardHistory(ctx context.Context, addr common.Address, from, to rpc.BlockNumber) ([]*wemixapi.WemixRewardEntry, error) {
+	first, last, err := api.rewardRange(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := api.e.rewardHistory(ctx, addr, first, last)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]*wemixapi.WemixRewardEntry, len(entries))
+	for i, e := range entries {
+		history[i] = &wemixapi.WemixRewardEntry{Number: e.Number, Reward: e.Reward, Fees: e.Fees}
+	}
+	return history, nil
+}
+
+// GetRewardTotal returns the sum of the block rewards the address received in
+// the blocks from from to to, inclusive, with the fees of the blocks it mined
+// summed up separately.
+func (api *PublicWemixAPI) GetRewardTotal(ctx context.Context, addr common.Address, from, to rpc.BlockNumber) (*wemixapi.WemixRewardTotal, error) {
+	first, last, err := api.rewardRange(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := api.e.rewardHistory(ctx, addr, first, last)
+	if err != nil {
+		return nil, err
+	}
+	total := &wemixapi.WemixRewardTotal{
+		Address: addr,
+		From:    first,
+		To:      last,
+		Blocks:  len(entries),
+		Rewards: new(big.Int),
+		Fees:    new(big.Int),
+	}
+	for _, e := range entries {
+		total.Rewards.Add(total.Rewards, e.Reward)
+		total.Fees.Add(total.Fees, e.Fees)
+	}
+	return total, nil
+}
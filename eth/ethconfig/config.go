@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/beacon"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/wemix"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
@@ -169,6 +170,13 @@ type Config struct {
 	SnapshotCache           int
 	Preimages               bool
 
+	// RewardIndex enables indexing the block rewards and fees by address
+	RewardIndex bool `toml:",omitempty"`
+
+	// Hubs are the node ids of the transaction relay hubs among the partners
+	// by preference, or "governance" for the governance nodes
+	Hubs []string `toml:",omitempty"`
+
 	// Mining options
 	Miner miner.Config
 
@@ -216,6 +224,19 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, co
 	var engine consensus.Engine
 	if chainConfig.Clique != nil {
 		engine = clique.New(chainConfig.Clique, db)
+	} else if chainConfig.Wemix != nil || params.ConsensusMethod != params.ConsensusPoW {
+		// chains created before the wemix engine config are identified by
+		// the consensus method flag, otherwise the chain config decides.
+		method := params.ConsensusMethod
+		if chainConfig.Wemix != nil {
+			if method == params.ConsensusPoW {
+				log.Crit("Proof-of-work consensus method requested for a wemix chain")
+			}
+			if method = chainConfig.Wemix.Consensus(); method != params.ConsensusMethod {
+				log.Warn("Consensus method flag overridden by the chain config", "flag", params.ConsensusMethod, "config", method)
+			}
+		}
+		engine = wemix.New(method)
 	} else {
 		switch config.PowMode {
 		case ethash.ModeFake:
@@ -48,6 +48,8 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		TrieTimeout                     time.Duration
 		SnapshotCache                   int
 		Preimages                       bool
+		RewardIndex                     bool     `toml:",omitempty"`
+		Hubs                            []string `toml:",omitempty"`
 		Miner                           miner.Config
 		Ethash                          ethash.Config
 		TxPool                          core.TxPoolConfig
@@ -93,6 +95,8 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.TrieTimeout = c.TrieTimeout
 	enc.SnapshotCache = c.SnapshotCache
 	enc.Preimages = c.Preimages
+	enc.RewardIndex = c.RewardIndex
+	enc.Hubs = c.Hubs
 	enc.Miner = c.Miner
 	enc.Ethash = c.Ethash
 	enc.TxPool = c.TxPool
@@ -142,6 +146,8 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		TrieTimeout                     *time.Duration
 		SnapshotCache                   *int
 		Preimages                       *bool
+		RewardIndex                     *bool    `toml:",omitempty"`
+		Hubs                            []string `toml:",omitempty"`
 		Miner                           *miner.Config
 		Ethash                          *ethash.Config
 		TxPool                          *core.TxPoolConfig
@@ -250,6 +256,12 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.Preimages != nil {
 		c.Preimages = *dec.Preimages
 	}
+	if dec.RewardIndex != nil {
+		c.RewardIndex = *dec.RewardIndex
+	}
+	if dec.Hubs != nil {
+		c.Hubs = dec.Hubs
+	}
 	if dec.Miner != nil {
 		c.Miner = *dec.Miner
 	}
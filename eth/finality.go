@@ -0,0 +1,430 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package eth
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	wemixengine "github.com/ethereum/go-ethereum/consensus/wemix"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// finalityVoteWindow is the # of blocks ahead of the head to accept
+	// finality votes for, and to keep unresolved votes for.
+	finalityVoteWindow = 1024
+
+	// maxPendingVotes is the max # of votes kept for a block not imported yet.
+	maxPendingVotes = 256
+
+	// maxHeightBlocks is the max # of blocks voted for at a height.
+	maxHeightBlocks = 8
+
+	// maxUnknownVotes is the max # of votes for blocks not imported yet kept
+	// from a peer.
+	maxUnknownVotes = 64
+
+	finalityChanSize = 256
+)
+
+// finalityState is the voting state of the local node, persisted so as not to
+// cast conflicting votes across restarts.
+type finalityState struct {
+	Prevoted     uint64 // last block number prevoted
+	Precommitted uint64 // last block number precommitted
+	LockNumber   uint64 // block locked on, i.e. with quorum prevotes
+	LockHash     common.Hash
+}
+
+// peerVote is a finality vote with the peer it came from, empty for the
+// local node.
+type peerVote struct {
+	peer string
+	vote *types.FinalityVote
+}
+
+// blockVotes are the votes for a block.
+type blockVotes struct {
+	number  uint64
+	votes   [2]map[string]*types.FinalityVote // verified votes by type and voter
+	pending []*peerVote                       // votes to verify once the block is imported
+}
+
+// heightVotes are the votes for the blocks at a height.
+type heightVotes struct {
+	blocks map[common.Hash]*blockVotes
+	voted  [2]map[string]common.Hash // block voted for by type and voter
+}
+
+// finalityGadget finalizes blocks with commit certificates in two rounds of
+// voting among the governance nodes. A node prevotes for the block on its
+// canonical chain at every FinalityInterval blocks unless it conflicts with
+// the block it's locked on. When more than 2/3 of the nodes prevoted for a
+// block, a node locks on it and precommits it. The precommits of more than
+// 2/3 of the nodes make the commit certificate, finalizing the block and its
+// ancestors.
+//
+// A lock is released only by quorum prevotes for a later block, even a
+// conflicting one. Those can't be had against a block with a certificate, as
+// more than 2/3 of the nodes are locked on it and the honest ones don't
+// prevote conflicting blocks, so two conflicting certificates take more than
+// 1/3 of faulty nodes.
+//
+// A voter's first vote of each type at a height is kept, up to
+// maxHeightBlocks blocks at a height, and the votes for the blocks not
+// imported yet are kept up to maxUnknownVotes from each peer.
+type finalityGadget struct {
+	db     ethdb.Database
+	chain  *core.BlockChain
+	engine *wemixengine.Wemix
+
+	broadcastVote func(*types.FinalityVote)
+	broadcastCert func(*types.FinalityCert)
+
+	state   finalityState
+	votes   map[uint64]*heightVotes
+	unknown map[string]int      // # of votes for the blocks not imported yet by peer
+	pending *types.FinalityCert // verified certificate not written yet
+
+	headCh  chan core.ChainHeadEvent
+	headSub event.Subscription
+	voteCh  chan *peerVote
+	certCh  chan *types.FinalityCert
+	quit    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newFinalityGadget(db ethdb.Database, chain *core.BlockChain, engine *wemixengine.Wemix,
+	broadcastVote func(*types.FinalityVote), broadcastCert func(*types.FinalityCert)) *finalityGadget {
+	g := &finalityGadget{
+		db:            db,
+		chain:         chain,
+		engine:        engine,
+		broadcastVote: broadcastVote,
+		broadcastCert: broadcastCert,
+		votes:         make(map[uint64]*heightVotes),
+		unknown:       make(map[string]int),
+		voteCh:        make(chan *peerVote, finalityChanSize),
+		certCh:        make(chan *types.FinalityCert, finalityChanSize),
+		quit:          make(chan struct{}),
+	}
+	if data := rawdb.ReadFinalityState(db); len(data) > 0 {
+		if err := rlp.DecodeBytes(data, &g.state); err != nil {
+			log.Error("Invalid finality state", "err", err)
+		}
+	}
+	return g
+}
+
+func (g *finalityGadget) start() {
+	g.headCh = make(chan core.ChainHeadEvent, finalityChanSize)
+	g.headSub = g.chain.SubscribeChainHeadEvent(g.headCh)
+	g.wg.Add(1)
+	go g.loop()
+}
+
+func (g *finalityGadget) stop() {
+	g.headSub.Unsubscribe()
+	close(g.quit)
+	g.wg.Wait()
+}
+
+// addVote queues a finality vote from a peer.
+func (g *finalityGadget) addVote(peer string, vote *types.FinalityVote) {
+	select {
+	case g.voteCh <- &peerVote{peer: peer, vote: vote}:
+	case <-g.quit:
+	}
+}
+
+// addCert queues a commit certificate from a peer.
+func (g *finalityGadget) addCert(cert *types.FinalityCert) {
+	select {
+	case g.certCh <- cert:
+	case <-g.quit:
+	}
+}
+
+func (g *finalityGadget) loop() {
+	defer g.wg.Done()
+
+	g.onHead(g.chain.CurrentHeader())
+	for {
+		select {
+		case ev := <-g.headCh:
+			g.onHead(ev.Block.Header())
+		case pv := <-g.voteCh:
+			g.onVote(pv.peer, pv.vote)
+		case cert := <-g.certCh:
+			g.onCert(cert)
+		case <-g.headSub.Err():
+			return
+		case <-g.quit:
+			return
+		}
+	}
+}
+
+// finalizedNumber returns the number of the last finalized block.
+func (g *finalityGadget) finalizedNumber() uint64 {
+	if block := g.chain.CurrentFinalizedBlock(); block != nil {
+		return block.NumberU64()
+	}
+	return 0
+}
+
+// onHead prevotes for the latest block to vote for, and resolves the votes
+// and the certificate waiting for blocks just imported.
+func (g *finalityGadget) onHead(head *types.Header) {
+	if g.pending != nil {
+		g.finalize(g.pending)
+	}
+	number := head.Number.Uint64()
+	for n, hv := range g.votes {
+		if n+finalityVoteWindow < number {
+			g.dropVotes(n)
+			continue
+		}
+		for hash, bv := range hv.blocks {
+			if len(bv.pending) > 0 && g.chain.GetHeader(hash, bv.number) != nil {
+				votes := bv.pending
+				bv.pending = nil
+				for _, pv := range votes {
+					g.releaseUnknown(pv.peer)
+					g.verifyVote(hv, bv, pv.vote)
+				}
+				g.tally(hash, bv)
+			}
+		}
+	}
+
+	interval := g.chain.Config().Wemix.Params(head.Number).FinalityInterval
+	target := number / interval * interval
+	if target == 0 || target <= g.state.Prevoted || target <= g.finalizedNumber() || !g.isVoteTarget(target) {
+		return
+	}
+	hash := g.chain.GetCanonicalHash(target)
+	if g.state.LockNumber > 0 && g.chain.GetCanonicalHash(g.state.LockNumber) != g.state.LockHash {
+		log.Debug("Not prevoting block conflicting with the lock", "number", target, "hash", hash,
+			"lock", g.state.LockNumber, "lockhash", g.state.LockHash)
+		return
+	}
+	vote := g.sign(types.Prevote, target, hash)
+	if vote == nil {
+		return
+	}
+	g.state.Prevoted = target
+	g.saveState()
+	g.broadcastVote(vote)
+	g.onVote("", vote)
+}
+
+// onVote adds a vote from a peer, and precommits or finalizes the block if it
+// makes a quorum.
+func (g *finalityGadget) onVote(peer string, vote *types.FinalityVote) {
+	if vote.Type > types.Precommit || vote.Number <= g.finalizedNumber() ||
+		vote.Number > g.chain.CurrentHeader().Number.Uint64()+finalityVoteWindow {
+		return
+	}
+	if !g.isVoteTarget(vote.Number) {
+		return
+	}
+	if hv := g.votes[vote.Number]; hv != nil {
+		// the first vote of a voter at a height only
+		if _, ok := hv.voted[vote.Type][string(vote.NodeId)]; ok {
+			return
+		}
+	}
+	if g.chain.GetHeader(vote.Hash, vote.Number) == nil {
+		// the governance at the block is unknown yet, check the signature only
+		if g.unknown[peer] >= maxUnknownVotes || vote.Verify() != nil {
+			return
+		}
+		hv, bv := g.blockVotes(vote.Number, vote.Hash)
+		if bv == nil || len(bv.pending) >= maxPendingVotes {
+			return
+		}
+		bv.pending = append(bv.pending, &peerVote{peer: peer, vote: vote})
+		hv.voted[vote.Type][string(vote.NodeId)] = vote.Hash
+		g.unknown[peer]++
+		return
+	}
+	if err := g.engine.VerifyFinalityVote(g.chain, vote); err != nil {
+		log.Trace("Invalid finality vote", "number", vote.Number, "hash", vote.Hash, "err", err)
+		return
+	}
+	if hv, bv := g.blockVotes(vote.Number, vote.Hash); bv != nil {
+		bv.votes[vote.Type][string(vote.NodeId)] = vote
+		hv.voted[vote.Type][string(vote.NodeId)] = vote.Hash
+		g.tally(vote.Hash, bv)
+	}
+}
+
+// isVoteTarget returns whether the blocks at the height are voted for, i.e.
+// it's at the finality interval.
+func (g *finalityGadget) isVoteTarget(number uint64) bool {
+	interval := g.chain.Config().Wemix.Params(new(big.Int).SetUint64(number)).FinalityInterval
+	return interval != 0 && number%interval == 0
+}
+
+// blockVotes returns the votes for a block, adding them if there are less
+// than maxHeightBlocks blocks voted for at the height, or nil.
+func (g *finalityGadget) blockVotes(number uint64, hash common.Hash) (*heightVotes, *blockVotes) {
+	hv := g.votes[number]
+	if hv == nil {
+		hv = &heightVotes{
+			blocks: make(map[common.Hash]*blockVotes),
+			voted:  [2]map[string]common.Hash{make(map[string]common.Hash), make(map[string]common.Hash)},
+		}
+		g.votes[number] = hv
+	}
+	bv := hv.blocks[hash]
+	if bv == nil {
+		if len(hv.blocks) >= maxHeightBlocks {
+			return hv, nil
+		}
+		bv = &blockVotes{
+			number: number,
+			votes: [2]map[string]*types.FinalityVote{
+				make(map[string]*types.FinalityVote),
+				make(map[string]*types.FinalityVote),
+			},
+		}
+		hv.blocks[hash] = bv
+	}
+	return hv, bv
+}
+
+// verifyVote adds the vote to the verified ones if it's from a governance node.
+func (g *finalityGadget) verifyVote(hv *heightVotes, bv *blockVotes, vote *types.FinalityVote) bool {
+	if err := g.engine.VerifyFinalityVote(g.chain, vote); err != nil {
+		log.Trace("Invalid finality vote", "number", vote.Number, "hash", vote.Hash, "err", err)
+		return false
+	}
+	bv.votes[vote.Type][string(vote.NodeId)] = vote
+	hv.voted[vote.Type][string(vote.NodeId)] = vote.Hash
+	return true
+}
+
+// dropVotes drops the votes at a height.
+func (g *finalityGadget) dropVotes(number uint64) {
+	for _, bv := range g.votes[number].blocks {
+		for _, pv := range bv.pending {
+			g.releaseUnknown(pv.peer)
+		}
+	}
+	delete(g.votes, number)
+}
+
+// releaseUnknown gives back a vote for a block not imported yet to the
+// budget of the peer.
+func (g *finalityGadget) releaseUnknown(peer string) {
+	if g.unknown[peer]--; g.unknown[peer] <= 0 {
+		delete(g.unknown, peer)
+	}
+}
+
+// tally locks on and precommits the block with quorum prevotes, releasing
+// any earlier lock, and writes the certificate of the block with quorum
+// precommits.
+func (g *finalityGadget) tally(hash common.Hash, bv *blockVotes) {
+	quorum, err := g.engine.FinalityQuorum(g.chain, bv.number, hash)
+	if err != nil {
+		return
+	}
+	if len(bv.votes[types.Prevote]) >= quorum && bv.number > g.state.LockNumber {
+		g.state.LockNumber, g.state.LockHash = bv.number, hash
+		var vote *types.FinalityVote
+		if bv.number > g.state.Precommitted {
+			if vote = g.sign(types.Precommit, bv.number, hash); vote != nil {
+				g.state.Precommitted = bv.number
+			}
+		}
+		g.saveState()
+		if vote != nil {
+			g.broadcastVote(vote)
+			bv.votes[types.Precommit][string(vote.NodeId)] = vote
+			g.votes[bv.number].voted[types.Precommit][string(vote.NodeId)] = hash
+		}
+	}
+	if len(bv.votes[types.Precommit]) >= quorum {
+		cert := &types.FinalityCert{Number: bv.number, Hash: hash}
+		for _, vote := range bv.votes[types.Precommit] {
+			cert.Sigs = append(cert.Sigs, &types.FinalitySig{NodeId: vote.NodeId, Sig: vote.Sig})
+		}
+		sort.Slice(cert.Sigs, func(i, j int) bool {
+			return bytes.Compare(cert.Sigs[i].NodeId, cert.Sigs[j].NodeId) < 0
+		})
+		g.finalize(cert)
+	}
+}
+
+// onCert verifies and writes a certificate from a peer. The certificates of
+// unknown blocks are dropped, later ones finalize their ancestors anyway.
+func (g *finalityGadget) onCert(cert *types.FinalityCert) {
+	if cert.Number <= g.finalizedNumber() || g.chain.GetHeader(cert.Hash, cert.Number) == nil {
+		return
+	}
+	if err := g.engine.VerifyFinalityCert(g.chain, cert); err != nil {
+		log.Debug("Invalid commit certificate", "number", cert.Number, "hash", cert.Hash, "err", err)
+		return
+	}
+	g.finalize(cert)
+}
+
+// finalize writes a verified certificate, and relays it to the peers. The
+// certificates of non-canonical blocks are retried on new heads.
+func (g *finalityGadget) finalize(cert *types.FinalityCert) {
+	if cert.Number <= g.finalizedNumber() {
+		if g.pending == cert {
+			g.pending = nil
+		}
+		return
+	}
+	if err := g.chain.WriteFinalityCert(cert); err != nil {
+		if g.pending == nil || g.pending.Number < cert.Number {
+			log.Warn("Failed to write commit certificate", "number", cert.Number, "hash", cert.Hash, "err", err)
+			g.pending = cert
+		}
+		return
+	}
+	g.pending = nil
+	log.Info("Finalized block", "number", cert.Number, "hash", cert.Hash, "votes", len(cert.Sigs))
+	for n := range g.votes {
+		if n <= cert.Number {
+			g.dropVotes(n)
+		}
+	}
+	g.broadcastCert(cert)
+}
+
+// sign signs a vote if the local node is a governance node at the block.
+func (g *finalityGadget) sign(typ uint8, number uint64, hash common.Hash) *types.FinalityVote {
+	vote, err := g.engine.SignFinalityVote(typ, number, hash)
+	if err != nil {
+		log.Trace("Failed to sign finality vote", "number", number, "hash", hash, "err", err)
+		return nil
+	}
+	if err = g.engine.VerifyFinalityVote(g.chain, vote); err != nil {
+		return nil
+	}
+	return vote
+}
+
+func (g *finalityGadget) saveState() {
+	data, err := rlp.EncodeToBytes(&g.state)
+	if err != nil {
+		log.Crit("Failed to encode finality state", "err", err)
+	}
+	rawdb.WriteFinalityState(g.db, data)
+}
@@ -0,0 +1,315 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package eth
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	wemixengine "github.com/ethereum/go-ethereum/consensus/wemix"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+// testGovernance is a governance of the given nodes, signing with key.
+type testGovernance struct {
+	key   *ecdsa.PrivateKey
+	nodes []*ecdsa.PrivateKey
+}
+
+func (b *testGovernance) IsBlockSigner(parent *types.Header, nodeId []byte) (bool, error) {
+	for _, node := range b.nodes {
+		if bytes.Equal(nodeId, crypto.FromECDSAPub(&node.PublicKey)[1:]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (b *testGovernance) NumBlockSigners(parent *types.Header) (int, error) {
+	return len(b.nodes), nil
+}
+
+func (b *testGovernance) MinerSchedule(parent *types.Header) ([][]byte, uint64, error) {
+	var ids [][]byte
+	for _, node := range b.nodes {
+		ids = append(ids, crypto.FromECDSAPub(&node.PublicKey)[1:])
+	}
+	return ids, 1, nil
+}
+
+func (b *testGovernance) CalculateRewards(parent *types.Header, blockReward, fees *big.Int, addBalance func(common.Address, *big.Int)) (*common.Address, []byte, error) {
+	return nil, nil, wemixminer.ErrNotInitialized
+}
+
+func (b *testGovernance) VerifyRewards(num *big.Int, expected, actual []byte) error {
+	return nil
+}
+
+func (b *testGovernance) SignBlock(hash common.Hash) ([]byte, []byte, error) {
+	sig, err := crypto.Sign(hash.Bytes(), b.key)
+	return crypto.FromECDSAPub(&b.key.PublicKey)[1:], sig, err
+}
+
+// Tests that the governance nodes finalize the latest block to vote for, and
+// that the other nodes learn it from the commit certificate.
+func TestFinalityGadget(t *testing.T) {
+	var (
+		voters = 4
+		keys   = make([]*ecdsa.PrivateKey, voters+1)
+		gspec  = &core.Genesis{Config: params.TestChainConfig}
+		blocks []*types.Block
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	gadgets := make([]*finalityGadget, len(keys))
+	for i, key := range keys {
+		db := rawdb.NewMemoryDatabase()
+		genesis := gspec.MustCommit(db)
+		if blocks == nil {
+			blocks, _ = core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 25, nil)
+		}
+		chain, _ := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+		defer chain.Stop()
+		if _, err := chain.InsertChain(blocks); err != nil {
+			t.Fatalf("node %d: failed to insert chain: %v", i, err)
+		}
+		engine := wemixengine.New(params.ConsensusPBFT)
+		engine.SetBackend(&testGovernance{key: key, nodes: keys[:voters]})
+
+		self := i
+		gadgets[i] = newFinalityGadget(db, chain, engine, func(vote *types.FinalityVote) {
+			for j, g := range gadgets[:voters] {
+				if j != self {
+					g.addVote(fmt.Sprint(self), vote)
+				}
+			}
+		}, func(cert *types.FinalityCert) {
+			for j, g := range gadgets {
+				if j != self {
+					g.addCert(cert)
+				}
+			}
+		})
+	}
+	for _, g := range gadgets {
+		g.start()
+	}
+
+	want := blocks[19]
+	for i, g := range gadgets {
+		deadline := time.Now().Add(5 * time.Second)
+		for g.chain.CurrentFinalizedBlock() == nil && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		finalized := g.chain.CurrentFinalizedBlock()
+		if finalized == nil || finalized.Hash() != want.Hash() {
+			t.Fatalf("node %d: finalized block mismatch: have %v, want %d", i, finalized, want.NumberU64())
+		}
+		cert := g.chain.GetFinalityCert(want.Hash(), want.NumberU64())
+		if cert == nil {
+			t.Fatalf("node %d: commit certificate missing", i)
+		}
+		if err := gadgets[0].engine.VerifyFinalityCert(gadgets[0].chain, cert); err != nil {
+			t.Fatalf("node %d: invalid commit certificate: %v", i, err)
+		}
+	}
+	for _, g := range gadgets {
+		g.stop()
+	}
+	// the voting state survives restarts
+	if state := gadgets[0].state; state.Prevoted != 20 || state.Precommitted != 20 || state.LockHash != want.Hash() {
+		t.Errorf("voting state mismatch: have %+v", state)
+	}
+	if restarted := newFinalityGadget(gadgets[0].db, gadgets[0].chain, gadgets[0].engine, nil, nil); restarted.state != gadgets[0].state {
+		t.Errorf("restored voting state mismatch: have %+v, want %+v", restarted.state, gadgets[0].state)
+	}
+}
+
+// Tests that a lock on a block reorged out is released by quorum prevotes for
+// a later block only, even once it's out of the vote window.
+func TestFinalityLockRelease(t *testing.T) {
+	var (
+		voters = 4
+		keys   = make([]*ecdsa.PrivateKey, voters)
+		gspec  = &core.Genesis{Config: params.TestChainConfig}
+		db     = rawdb.NewMemoryDatabase()
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	genesis := gspec.MustCommit(db)
+	lost, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 25, nil)
+	fork, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, finalityVoteWindow+40, func(i int, gen *core.BlockGen) {
+		gen.SetCoinbase(common.Address{1})
+	})
+
+	// newNode returns the gadget of the first voter, locked on the block 20
+	// of the lost fork, and the votes it broadcasts
+	newNode := func() (*finalityGadget, *[]*types.FinalityVote) {
+		db := rawdb.NewMemoryDatabase()
+		gspec.MustCommit(db)
+		chain, _ := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+		t.Cleanup(chain.Stop)
+		if _, err := chain.InsertChain(lost); err != nil {
+			t.Fatalf("failed to insert chain: %v", err)
+		}
+		engine := wemixengine.New(params.ConsensusPBFT)
+		engine.SetBackend(&testGovernance{key: keys[0], nodes: keys})
+		sent := new([]*types.FinalityVote)
+		g := newFinalityGadget(db, chain, engine, func(vote *types.FinalityVote) {
+			*sent = append(*sent, vote)
+		}, func(*types.FinalityCert) {})
+
+		g.onHead(chain.CurrentHeader())
+		for _, key := range keys[1:3] {
+			g.onVote("peer", signFinalityVote(t, key, types.Prevote, lost[19]))
+		}
+		if g.state.LockHash != lost[19].Hash() || g.state.Precommitted != 20 {
+			t.Fatalf("not locked on the block 20: %+v", g.state)
+		}
+		*sent = nil
+		return g, sent
+	}
+
+	// the lock holds across a reorg, until the quorum prevotes for a later
+	// block on the new fork
+	g, sent := newNode()
+	if _, err := g.chain.InsertChain(fork[:35]); err != nil {
+		t.Fatalf("failed to insert fork: %v", err)
+	}
+	g.onHead(g.chain.CurrentHeader())
+	if len(*sent) != 0 || g.state.Prevoted != 20 {
+		t.Fatalf("prevoted against the lock: %+v", g.state)
+	}
+	for _, key := range keys[1:] {
+		g.onVote("peer", signFinalityVote(t, key, types.Prevote, fork[29]))
+	}
+	if g.state.LockHash != fork[29].Hash() || g.state.Precommitted != 30 {
+		t.Fatalf("lock not moved to the block 30: %+v", g.state)
+	}
+	if len(*sent) != 1 || (*sent)[0].Type != types.Precommit || (*sent)[0].Hash != fork[29].Hash() {
+		t.Fatalf("block 30 not precommitted: %v", *sent)
+	}
+
+	// the lock holds out of the vote window too, until the quorum prevotes
+	g, sent = newNode()
+	if _, err := g.chain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to insert fork: %v", err)
+	}
+	g.onHead(g.chain.CurrentHeader())
+	if len(*sent) != 0 || g.state.LockHash != lost[19].Hash() {
+		t.Fatalf("lock released out of the vote window: %+v", g.state)
+	}
+	want := fork[len(fork)/10*10-1] // at the finality interval
+	for _, key := range keys[1:] {
+		g.onVote("peer", signFinalityVote(t, key, types.Prevote, want))
+	}
+	if g.state.LockHash != want.Hash() || g.state.Precommitted != want.NumberU64() {
+		t.Fatalf("lock not moved to the block %d: %+v", want.NumberU64(), g.state)
+	}
+}
+
+// Tests that the votes kept are limited by voter and height, and the votes
+// for unknown blocks by peer.
+func TestFinalityVoteLimits(t *testing.T) {
+	var (
+		voters = 4
+		keys   = make([]*ecdsa.PrivateKey, voters)
+		gspec  = &core.Genesis{Config: params.TestChainConfig}
+		db     = rawdb.NewMemoryDatabase()
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	genesis := gspec.MustCommit(db)
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 25, nil)
+	chain, _ := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	defer chain.Stop()
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	engine := wemixengine.New(params.ConsensusPBFT)
+	engine.SetBackend(&testGovernance{key: keys[0], nodes: keys})
+	g := newFinalityGadget(db, chain, engine, func(*types.FinalityVote) {}, func(*types.FinalityCert) {})
+
+	// unknownVote returns a vote of a new voter for an unknown block
+	unknownVote := func(number uint64) *types.FinalityVote {
+		key, _ := crypto.GenerateKey()
+		header := &types.Header{Number: new(big.Int).SetUint64(number), Extra: crypto.FromECDSAPub(&key.PublicKey)}
+		return signFinalityVote(t, key, types.Prevote, types.NewBlockWithHeader(header))
+	}
+	pending := func(number uint64) (n int) {
+		if hv := g.votes[number]; hv != nil {
+			for _, bv := range hv.blocks {
+				n += len(bv.pending)
+			}
+		}
+		return n
+	}
+
+	// the votes off the finality interval are dropped
+	g.onVote("peer", unknownVote(35))
+	if len(g.votes) != 0 {
+		t.Fatalf("vote off the finality interval kept")
+	}
+
+	// a voter's later votes at a height are dropped
+	g.onVote("peer", signFinalityVote(t, keys[1], types.Prevote, blocks[19]))
+	g.onVote("peer", signFinalityVote(t, keys[1], types.Prevote, types.NewBlockWithHeader(&types.Header{Number: big.NewInt(20)})))
+	if hv := g.votes[20]; len(hv.blocks) != 1 || len(hv.blocks[blocks[19].Hash()].votes[types.Prevote]) != 1 {
+		t.Fatalf("conflicting vote kept")
+	}
+
+	// the blocks voted for at a height are limited
+	for i := 0; i < 2*maxHeightBlocks; i++ {
+		g.onVote(fmt.Sprint("peer", i), unknownVote(30))
+	}
+	if len(g.votes[30].blocks) != maxHeightBlocks || pending(30) != maxHeightBlocks {
+		t.Fatalf("blocks at a height mismatch: have %d, want %d", len(g.votes[30].blocks), maxHeightBlocks)
+	}
+
+	// the votes for unknown blocks are limited by peer
+	for n := uint64(40); n <= 40+10*(maxUnknownVotes/maxHeightBlocks+1); n += 10 {
+		for i := 0; i < maxHeightBlocks; i++ {
+			g.onVote("flooder", unknownVote(n))
+		}
+	}
+	if g.unknown["flooder"] != maxUnknownVotes {
+		t.Fatalf("unknown votes of a peer mismatch: have %d, want %d", g.unknown["flooder"], maxUnknownVotes)
+	}
+	if g.onVote("other", unknownVote(500)); pending(500) != 1 {
+		t.Fatalf("vote of another peer dropped")
+	}
+
+	// the budget is given back when the votes are dropped
+	for n := range g.votes {
+		g.dropVotes(n)
+	}
+	if len(g.unknown) != 0 {
+		t.Fatalf("unknown votes left after dropping: %v", g.unknown)
+	}
+}
+
+// signFinalityVote signs a vote for the block with the node key.
+func signFinalityVote(t *testing.T, key *ecdsa.PrivateKey, typ uint8, block *types.Block) *types.FinalityVote {
+	vote := &types.FinalityVote{Type: typ, Number: block.NumberU64(), Hash: block.Hash()}
+	sig, err := crypto.Sign(vote.SigHash().Bytes(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vote.NodeId, vote.Sig = crypto.FromECDSAPub(&key.PublicKey)[1:], sig
+	return vote
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/beacon"
+	wemixengine "github.com/ethereum/go-ethereum/consensus/wemix"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -41,6 +42,8 @@ import (
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
 )
 
 const (
@@ -67,6 +70,11 @@ type txPool interface {
 	// AddRemotes should add the given transactions to the pool.
 	AddRemotes([]*types.Transaction) []error
 
+	// VerifySenderHints should verify the senders hinted by a partner in the
+	// background, calling back with the transactions to keep and the # of
+	// wrong hints.
+	VerifySenderHints([]*types.Transaction, func([]*types.Transaction, int))
+
 	// Pending should return pending transactions.
 	// The slice should be modifiable by the caller.
 	Pending(enforceTips bool) map[common.Address]types.Transactions
@@ -89,6 +97,7 @@ type handlerConfig struct {
 	EventMux   *event.TypeMux            // Legacy event mux, deprecate for `feed`
 	Checkpoint *params.TrustedCheckpoint // Hard coded checkpoint for sync challenges
 	Whitelist  map[uint64]common.Hash    // Hard coded whitelist for sync challenged
+	Hubs       []string                  // Transaction relay hubs among the partners by preference
 }
 
 type handler struct {
@@ -110,6 +119,9 @@ type handler struct {
 	blockFetcher *fetcher.BlockFetcher
 	txFetcher    *fetcher.TxFetcher
 	peers        *peerSet
+	wemixPeers   *wemixPeerSet
+	relay        *txRelay
+	hubs         []string
 	merger       *consensus.Merger
 
 	eventMux      *event.TypeMux
@@ -124,6 +136,7 @@ type handler struct {
 	quitSync chan struct{}
 
 	chainSync *chainSyncer
+	finality  *finalityGadget // commit certificates with ConsensusPBFT, nil otherwise
 	wg        sync.WaitGroup
 	peerWG    sync.WaitGroup
 }
@@ -142,6 +155,9 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		txpool:     config.TxPool,
 		chain:      config.Chain,
 		peers:      newPeerSet(),
+		wemixPeers: newWemixPeerSet(),
+		relay:      newTxRelay(),
+		hubs:       config.Hubs,
 		merger:     config.Merger,
 		whitelist:  config.Whitelist,
 		txsyncCh:   make(chan *txsync),
@@ -276,6 +292,12 @@ func newHandler(config *handlerConfig) (*handler, error) {
 	}
 	h.txFetcher = fetcher.NewTxFetcher(h.txpool.Has, h.txpool.AddRemotes, fetchTx)
 	h.chainSync = newChainSyncer(h)
+
+	if engine := wemixengine.FromEngine(h.chain.Engine()); engine != nil && engine.ConsensusMethod() == params.ConsensusPBFT {
+		h.finality = newFinalityGadget(h.database, h.chain, engine, h.BroadcastFinalityVote, h.BroadcastFinalityCert)
+	}
+	wemixapi.SendRaftMessage = h.SendRaftMessage
+	wemixapi.RequestMinerStatus = h.RequestMinerStatus
 	return h, nil
 }
 
@@ -334,6 +356,7 @@ func (h *handler) runEthPeer(peer *eth.Peer, handler eth.Handler) error {
 		return err
 	}
 	defer h.unregisterPeer(peer.ID())
+	h.relay.peerUp(peer.ID())
 
 	p := h.peers.peer(peer.ID())
 	if p == nil {
@@ -496,6 +519,7 @@ func (h *handler) unregisterPeer(id string) {
 	}
 	h.downloader.UnregisterPeer(id)
 	h.txFetcher.Drop(id)
+	h.relay.peerDown(id)
 
 	if err := h.peers.unregisterPeer(id); err != nil {
 		logger.Error("Ethereum peer removal failed", "err", err)
@@ -520,11 +544,19 @@ func (h *handler) Start(maxPeers int) {
 	h.wg.Add(2)
 	go h.chainSync.loop()
 	go h.txsyncLoop64() // TODO(karalabe): Legacy initial tx echange, drop with eth/64.
+
+	// start finality voting
+	if h.finality != nil {
+		h.finality.start()
+	}
 }
 
 func (h *handler) Stop() {
 	h.txsSub.Unsubscribe()        // quits txBroadcastLoop
 	h.minedBlockSub.Unsubscribe() // quits blockBroadcastLoop
+	if h.finality != nil {
+		h.finality.stop()
+	}
 
 	// Quit chainSync and txsync64.
 	// After this is done, no new peers will be accepted.
@@ -598,11 +630,19 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 
 		txset = make(map[*ethPeer][]common.Hash) // Set peer->hash to transfer directly
 		annos = make(map[*ethPeer][]common.Hash) // Set peer->hash to announce
-
 	)
+	// Among the partners, relay through the hub if any
+	hub, path := h.relay.pick(h.peers, h.hubs)
+	h.relay.mark(path, len(txs))
+
 	// Broadcast transactions to a batch of peers not knowing about it
 	for _, tx := range txs {
-		peers := h.peers.peersWithoutTransaction2(tx.Hash())
+		var peers []*ethPeer
+		if hub == nil {
+			peers = h.peers.peersWithoutTransaction(tx.Hash())
+		} else if !hub.KnownTransaction(tx.Hash()) {
+			peers = []*ethPeer{hub}
+		}
 		// Send the tx unconditionally to a subset of our peers
 		// numDirect := int(math.Sqrt(float64(len(peers))))
 		// TODO: for now send txs to all the peers
@@ -655,21 +695,29 @@ func (h *handler) txBroadcastLoop() {
 	}
 }
 
-// RequestMinerStatus sends GetStatusExMsg to the given peer
-func (h *handler) RequestMinerStatus(id enode.ID) error {
-	if p := h.peers.peer(id.String()); p != nil {
-		return p.RequestStatusEx()
+// SendRaftMessage sends a raft message to the governance node with the given id
+func (h *handler) SendRaftMessage(id string, data []byte) error {
+	if p := h.wemixPeers.peer(id); p != nil {
+		go p.SendRaftMessage(data)
+		return nil
 	} else {
 		return ethereum.NotFound
 	}
 }
 
-// RequestEtcdAddMember is an internal protocol level command to add a node to the etcd cluster
-func (h *handler) RequestEtcdAddMember(id enode.ID) error {
-	if p := h.peers.peer(id.String()); p != nil {
-		return p.RequestEtcdAddMember()
-	} else {
-		return ethereum.NotFound
+// BroadcastFinalityVote sends a finality vote to the governance nodes
+func (h *handler) BroadcastFinalityVote(vote *types.FinalityVote) {
+	for _, p := range h.wemixPeers.peerList() {
+		if wemixminer.IsPartner(p.ID()) {
+			go p.SendFinalityVote(vote)
+		}
+	}
+}
+
+// BroadcastFinalityCert sends a commit certificate to all the peers
+func (h *handler) BroadcastFinalityCert(cert *types.FinalityCert) {
+	for _, p := range h.wemixPeers.peerList() {
+		go p.SendFinalityCert(cert)
 	}
 }
 
@@ -71,7 +71,19 @@ func (h *ethHandler) Handle(peer *eth.Peer, packet eth.Packet) error {
 		return h.txFetcher.Notify(peer.ID(), *packet)
 
 	case *eth.TransactionsPacket:
-		return h.txFetcher.Enqueue(peer.ID(), *packet, false)
+		// the senders hinted by a partner are checked before trusted, in
+		// the background not to hold up the peer, which is dropped later if
+		// found lying
+		all := len(*packet)
+		h.txpool.VerifySenderHints(*packet, func(txs []*types.Transaction, mismatches int) {
+			if mismatches > 0 {
+				peer.Log().Warn("Partner hinted wrong transaction senders", "txs", all, "mismatches", mismatches)
+				(*handler)(h).removePeer(peer.ID())
+				return
+			}
+			h.txFetcher.Enqueue(peer.ID(), txs, false)
+		})
+		return nil
 
 	case *eth.PooledTransactionsPacket:
 		return h.txFetcher.Enqueue(peer.ID(), *packet, true)
@@ -91,6 +91,29 @@ func (p *testTxPool) AddRemotes(txs []*types.Transaction) []error {
 	return make([]error, len(txs))
 }
 
+// VerifySenderHints checks all the senders hinted by a partner
+func (p *testTxPool) VerifySenderHints(txs []*types.Transaction, done func([]*types.Transaction, int)) {
+	var (
+		out        []*types.Transaction
+		mismatches int
+	)
+	for _, tx := range txs {
+		signer := types.LatestSignerForChainID(tx.ChainId())
+		if !types.HasSenderHint(signer, tx) {
+			out = append(out, tx)
+			continue
+		}
+		ok, err := types.VerifySenderHint(signer, tx)
+		if !ok {
+			mismatches++
+		}
+		if err == nil {
+			out = append(out, tx)
+		}
+	}
+	done(out, mismatches)
+}
+
 // Pending returns all the transactions known to the pool
 func (p *testTxPool) Pending(enforceTips bool) map[common.Address]types.Transactions {
 	p.lock.RLock()
@@ -0,0 +1,160 @@
+package eth
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	wemixproto "github.com/ethereum/go-ethereum/eth/protocols/wemix"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+// wemixPeerSet is the set of the peers on the `wemix` protocol. Unlike
+// `snap`, it's not joined with the `eth` peers, as the requests on it don't
+// depend on the chain sync.
+type wemixPeerSet struct {
+	peers map[string]*wemixproto.Peer
+	lock  sync.RWMutex
+}
+
+func newWemixPeerSet() *wemixPeerSet {
+	return &wemixPeerSet{peers: make(map[string]*wemixproto.Peer)}
+}
+
+func (ps *wemixPeerSet) register(peer *wemixproto.Peer) error {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	if _, ok := ps.peers[peer.ID()]; ok {
+		return errPeerAlreadyRegistered
+	}
+	ps.peers[peer.ID()] = peer
+	return nil
+}
+
+func (ps *wemixPeerSet) unregister(id string) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	delete(ps.peers, id)
+}
+
+func (ps *wemixPeerSet) peer(id string) *wemixproto.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	return ps.peers[id]
+}
+
+func (ps *wemixPeerSet) peerList() []*wemixproto.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	list := make([]*wemixproto.Peer, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		list = append(list, p)
+	}
+	return list
+}
+
+// wemixHandler implements the wemix.Backend interface to handle the requests
+// among the governance nodes.
+type wemixHandler handler
+
+// RunPeer is invoked when a peer joins on the `wemix` protocol.
+func (h *wemixHandler) RunPeer(peer *wemixproto.Peer, hand wemixproto.Handler) error {
+	h.peerWG.Add(1)
+	defer h.peerWG.Done()
+
+	if err := h.wemixPeers.register(peer); err != nil {
+		peer.Log().Error("Wemix peer registration failed", "err", err)
+		return err
+	}
+	defer h.wemixPeers.unregister(peer.ID())
+	return hand(peer)
+}
+
+// PeerInfo retrieves all known `wemix` information about a peer.
+func (h *wemixHandler) PeerInfo(id enode.ID) interface{} {
+	if p := h.wemixPeers.peer(id.String()); p != nil {
+		return map[string]interface{}{
+			"version": p.Version(),
+			"pending": p.Pending(),
+		}
+	}
+	return nil
+}
+
+// Serve returns true if both this node and the peer are governance nodes.
+func (h *wemixHandler) Serve(peer *wemixproto.Peer) bool {
+	return wemixminer.AmPartner() && wemixminer.IsPartner(peer.ID())
+}
+
+// MinerStatus returns the extended status of this node with the total
+// difficulty of its head.
+func (h *wemixHandler) MinerStatus() *wemixapi.WemixMinerStatus {
+	if wemixapi.GetMinerStatus == nil {
+		return nil
+	}
+	status := wemixapi.GetMinerStatus()
+	if status == nil || status.LatestBlockHeight == nil {
+		return status
+	}
+	status.LatestBlockTd = h.chain.GetTd(status.LatestBlockHash,
+		status.LatestBlockHeight.Uint64())
+	return status
+}
+
+// Handle is invoked when a response arrives, after it's delivered to the
+// request waiting for it, or a broadcast.
+func (h *wemixHandler) Handle(peer *wemixproto.Peer, packet wemixproto.Packet) error {
+	switch packet := packet.(type) {
+	case *wemixproto.StatusExPacket:
+		// the status tells the head of the peer too
+		status := &packet.Status
+		if p := h.peers.peer(peer.ID()); p != nil && status.LatestBlockTd != nil {
+			if _, td := p.Head(); status.LatestBlockTd.Cmp(td) > 0 {
+				p.SetHead(status.LatestBlockHash, status.LatestBlockTd)
+			}
+		}
+
+	case *wemixproto.TransactionsExPacket:
+		// the transactions are tracked on `eth`, where they're handed over
+		p := h.peers.peer(peer.ID())
+		if p == nil || !(*ethHandler)(h).AcceptTxs() {
+			return nil
+		}
+		signer := types.MakeSigner(h.chain.Config(), h.chain.CurrentBlock().Number())
+		txs := eth.TransactionsPacket(types.TxExs2Txs(signer, *packet, wemixminer.IsPartner(peer.ID())))
+		p.MarkTransactions(txs)
+		return (*ethHandler)(h).Handle(p.Peer, &txs)
+
+	case *wemixproto.FinalityVotePacket:
+		if h.finality != nil {
+			h.finality.addVote(peer.ID(), (*types.FinalityVote)(packet))
+		}
+
+	case *wemixproto.FinalityCertPacket:
+		if h.finality != nil {
+			h.finality.addCert((*types.FinalityCert)(packet))
+		}
+
+	case *wemixproto.RaftPacket:
+		if wemixapi.RaftStep != nil {
+			if err := wemixapi.RaftStep(peer.ID(), *packet); err != nil {
+				peer.Log().Debug("Failed to step raft", "err", err)
+			}
+		}
+	}
+	return nil
+}
+
+// RequestMinerStatus fetches the extended status of the governance node with
+// the given id on the `wemix` protocol.
+func (h *handler) RequestMinerStatus(ctx context.Context, id string) (*wemixapi.WemixMinerStatus, error) {
+	if p := h.wemixPeers.peer(id); p != nil {
+		return p.RequestStatusEx(ctx)
+	} else {
+		return nil, ethereum.NotFound
+	}
+}
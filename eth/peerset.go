@@ -19,14 +19,13 @@ package eth
 import (
 	"errors"
 	"math/big"
+	"strings"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/eth/protocols/snap"
 	"github.com/ethereum/go-ethereum/p2p"
-	"github.com/ethereum/go-ethereum/params"
-	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
 )
 
 var (
@@ -271,38 +270,20 @@ func (ps *peerSet) peerList() []*ethPeer {
 	return list
 }
 
-// hub subsystem
-var (
-	isHub int = -1 //  -1: unset, 1: hub, 0: not hub
-)
-
-// peersWithoutTransaction2 retrieves a list of peers that do not have a given
-// transaction in their set of known hashes.
-func (ps *peerSet) peersWithoutTransaction2(hash common.Hash) []*ethPeer {
-	if !wemixminer.AmPartner() {
-		return ps.peersWithoutTransaction(hash)
-	}
+// peerWithPrefix retrieves the registered peer whose id starts with prefix,
+// ignoring case.
+func (ps *peerSet) peerWithPrefix(prefix string) *ethPeer {
+	prefix = strings.ToLower(prefix)
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
 
-	if isHub == -1 {
-		isHub = wemixminer.AmHub(params.Hub)
+	if p, ok := ps.peers[prefix]; ok {
+		return p
 	}
-
-	if isHub == 0 {
-		// send it to the hub if it did not come from there
-		ps.lock.RLock()
-		for _, p := range ps.peers {
-			if p.ID() == params.Hub {
-				var list []*ethPeer
-				if !p.KnownTransaction(hash) {
-					list = append(list, p)
-				}
-				ps.lock.RUnlock()
-				return list
-			}
+	for id, p := range ps.peers {
+		if strings.HasPrefix(id, prefix) {
+			return p
 		}
-		ps.lock.RUnlock()
 	}
-
-	// fall back
-	return ps.peersWithoutTransaction(hash)
+	return nil
 }
@@ -186,8 +186,6 @@ var eth65 = map[uint64]msgHandler{
 	GetPendingTxsMsg:  handleGetPendingTxs,
 	GetStatusExMsg:    handleGetStatusEx,
 	StatusExMsg:       handleStatusEx,
-	EtcdAddMemberMsg:  handleEtcdAddMember,
-	EtcdClusterMsg:    handleEtcdCluster,
 	TransactionsExMsg: handleTransactionsEx,
 }
 
@@ -211,8 +209,6 @@ var eth66 = map[uint64]msgHandler{
 	GetPendingTxsMsg:  handleGetPendingTxs,
 	GetStatusExMsg:    handleGetStatusEx,
 	StatusExMsg:       handleStatusEx,
-	EtcdAddMemberMsg:  handleEtcdAddMember,
-	EtcdClusterMsg:    handleEtcdCluster,
 	TransactionsExMsg: handleTransactionsEx,
 }
 
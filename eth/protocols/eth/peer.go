@@ -185,6 +185,14 @@ func (p *Peer) markTransaction(hash common.Hash) {
 	p.knownTxs.Add(hash)
 }
 
+// MarkTransactions marks the transactions received on another protocol, i.e.
+// `wemix`, as known for the peer.
+func (p *Peer) MarkTransactions(txs []*types.Transaction) {
+	for _, tx := range txs {
+		p.markTransaction(tx.Hash())
+	}
+}
+
 // SendTransactions sends transactions to the peer and includes the hashes
 // in its transaction hash set for future reference.
 //
@@ -378,32 +386,12 @@ func (p *Peer) ReplyReceiptsRLP(id uint64, receipts []rlp.RawValue) error {
 	})
 }
 
-// SendStatusEx sends this node's miner status
+// SendStatusEx sends this node's miner status to the older nodes requesting
+// it on `eth`, instead of `wemix`
 func (p *Peer) SendStatusEx(status *wemixapi.WemixMinerStatus) error {
 	return p2p.Send(p.rw, StatusExMsg, status)
 }
 
-// ReplyStatusEx is the eth/66 response to GetStatusEx
-func (p *Peer) ReplyStatusEx(id uint64, status *wemixapi.WemixMinerStatus) error {
-	return p2p.Send(p.rw, StatusExMsg, StatusExPacket66{
-		RequestId:      id,
-		StatusExPacket: StatusExPacket(*status),
-	})
-}
-
-// SendEtcdCluster sends this node's etcd cluster
-func (p *Peer) SendEtcdCluster(cluster string) error {
-	return p2p.Send(p.rw, EtcdClusterMsg, cluster)
-}
-
-// ReplyEtcdCluster is the eth/66 response to EtcdAddMember
-func (p *Peer) ReplyEtcdCluster(id uint64, cluster string) error {
-	return p2p.Send(p.rw, EtcdClusterMsg, EtcdClusterPacket66{
-		RequestId:         id,
-		EtcdClusterPacket: EtcdClusterPacket(cluster),
-	})
-}
-
 // RequestOneHeader is a wrapper around the header query functions to fetch a
 // single header. It is used solely by the fetcher.
 func (p *Peer) RequestOneHeader(hash common.Hash, sink chan *Response) (*Request, error) {
@@ -624,24 +612,6 @@ func (k *knownCache) Cardinality() int {
 	return k.hashes.Cardinality()
 }
 
-// RequestStatusEx fetches extended status of the peer
-func (p *Peer) RequestStatusEx() error {
-	p.Log().Debug("Fetching extended status")
-	id := rand.Uint64()
-
-	requestTracker.Track(p.id, p.version, GetStatusExMsg, StatusExMsg, id)
-	return p2p.Send(p.rw, GetStatusExMsg, common.Big1)
-}
-
-// RequestEtcdAddMember requests the peer to add this node to the cluster
-func (p *Peer) RequestEtcdAddMember() error {
-	p.Log().Debug("Trying to join etcd network")
-	id := rand.Uint64()
-
-	requestTracker.Track(p.id, p.version, EtcdAddMemberMsg, EtcdClusterMsg, id)
-	return p2p.Send(p.rw, EtcdAddMemberMsg, common.Big1)
-}
-
 // request id for ETH/65
 func (p *Peer) genRequestId(code uint64) uint64 {
 	return crypto.Keccak256Hash([]byte(p.id), []byte(fmt.Sprintf("%d", code))).Big().Uint64()
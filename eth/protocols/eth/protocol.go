@@ -72,11 +72,9 @@ const (
 
 	// Added by Wemix, wemix/64
 	GetPendingTxsMsg  = 0x11
-	GetStatusExMsg    = 0x12
+	GetStatusExMsg    = 0x12 // superseded by `wemix`, served for older nodes
 	StatusExMsg       = 0x13
-	EtcdAddMemberMsg  = 0x14
-	EtcdClusterMsg    = 0x15
-	TransactionsExMsg = 0x16
+	TransactionsExMsg = 0x16 // superseded by `wemix`, served for older nodes
 )
 
 var (
@@ -361,24 +359,6 @@ type StatusExPacket66 struct {
 	StatusExPacket
 }
 
-// EtcdAddMemberPacket is the netowkr packet for EtcdAddMember
-type EtcdAddMemberPacket int
-
-// EtcdAddMemberPacket66 is the eth/66 form of EtcdAddMember
-type EtcdAddMemberPacket66 struct {
-	RequestId uint64
-	EtcdAddMemberPacket
-}
-
-// EtcdClusterPacket is the network packet for EtcdAddMember / EtcdCluster exchange
-type EtcdClusterPacket string
-
-// EtcdClusterPacket66 is the eth/66 form of EtcdClusterPacket
-type EtcdClusterPacket66 struct {
-	RequestId uint64
-	EtcdClusterPacket
-}
-
 func (*StatusPacket) Name() string { return "Status" }
 func (*StatusPacket) Kind() byte   { return StatusMsg }
 
@@ -432,9 +412,3 @@ func (*GetStatusExPacket) Kind() byte   { return GetStatusExMsg }
 
 func (*StatusExPacket) Name() string { return "StatusEx" }
 func (*StatusExPacket) Kind() byte   { return StatusExMsg }
-
-func (*EtcdAddMemberPacket) Name() string { return "EtcdAddMember" }
-func (*EtcdAddMemberPacket) Kind() byte   { return EtcdAddMemberMsg }
-
-func (*EtcdClusterPacket) Name() string { return "EtcdCluster" }
-func (*EtcdClusterPacket) Kind() byte   { return EtcdClusterMsg }
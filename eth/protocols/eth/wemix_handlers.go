@@ -17,14 +17,16 @@ func handleGetStatusEx(backend Backend, msg Decoder, peer *Peer) error {
 		return nil
 	}
 
-	go func() {
-		statusEx := wemixapi.GetMinerStatus()
-		statusEx.LatestBlockTd = backend.Chain().GetTd(statusEx.LatestBlockHash,
-			statusEx.LatestBlockHeight.Uint64())
-		if err := peer.SendStatusEx(statusEx); err != nil {
-			// ignore the error
-		}
-	}()
+	// answered in place, so a peer can't pile up the requests
+	statusEx := wemixapi.GetMinerStatus()
+	if statusEx == nil || statusEx.LatestBlockHeight == nil {
+		return nil
+	}
+	statusEx.LatestBlockTd = backend.Chain().GetTd(statusEx.LatestBlockHash,
+		statusEx.LatestBlockHeight.Uint64())
+	if err := peer.SendStatusEx(statusEx); err != nil {
+		// ignore the error
+	}
 
 	return nil
 }
@@ -38,41 +40,13 @@ func handleStatusEx(backend Backend, msg Decoder, peer *Peer) error {
 		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
 	}
 
-	go func() {
+	// the requests are made on `wemix` now, so this can only be a late
+	// response from an older node. It still tells the head of the peer.
+	if status.LatestBlockTd != nil {
 		if _, td := peer.Head(); status.LatestBlockTd.Cmp(td) > 0 {
 			peer.SetHead(status.LatestBlockHash, status.LatestBlockTd)
 		}
-		wemixapi.GotStatusEx(&status)
-	}()
-
-	return nil
-}
-
-func handleEtcdAddMember(backend Backend, msg Decoder, peer *Peer) error {
-	if !wemixminer.AmPartner() || !wemixminer.IsPartner(peer.ID()) {
-		return nil
-	}
-
-	go func() {
-		cluster, _ := wemixapi.EtcdAddMember(peer.ID())
-		if err := peer.SendEtcdCluster(cluster); err != nil {
-			// ignore the error
-		}
-	}()
-
-	return nil
-}
-
-func handleEtcdCluster(backend Backend, msg Decoder, peer *Peer) error {
-	if !wemixminer.AmPartner() || !wemixminer.IsPartner(peer.ID()) {
-		return nil
 	}
-	var cluster string
-	if err := msg.Decode(&cluster); err != nil {
-		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
-	}
-
-	go wemixapi.GotEtcdCluster(cluster)
 
 	return nil
 }
@@ -0,0 +1,198 @@
+package wemix
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+var (
+	requestTimer     = metrics.NewRegisteredTimer("wemix/p2p/req", nil)
+	timeoutMeter     = metrics.NewRegisteredMeter("wemix/p2p/timeout", nil)
+	unsolicitedMeter = metrics.NewRegisteredMeter("wemix/p2p/unsolicited", nil)
+)
+
+// Handler is a callback to invoke from an outside runner after the boilerplate
+// exchanges have passed.
+type Handler func(peer *Peer) error
+
+// Backend defines the data retrieval methods to serve remote requests and the
+// callback methods to invoke on remote deliveries.
+type Backend interface {
+	// RunPeer is invoked when a peer joins on the `wemix` protocol. The
+	// handler should register the peer and give the control back to the
+	// `handler` to process the inbound messages going forward.
+	RunPeer(peer *Peer, handler Handler) error
+
+	// PeerInfo retrieves all known `wemix` information about a peer.
+	PeerInfo(id enode.ID) interface{}
+
+	// Serve returns true if the requests from the peer are to be served, i.e.
+	// both are governance nodes. Requests from others are ignored.
+	Serve(peer *Peer) bool
+
+	// MinerStatus returns the extended status of this node.
+	MinerStatus() *wemixapi.WemixMinerStatus
+
+	// Handle is a callback to be invoked when a response is received from
+	// the remote peer, after it's delivered to the request, or a broadcast.
+	Handle(peer *Peer, packet Packet) error
+}
+
+// MakeProtocols constructs the P2P protocol definitions for `wemix`.
+func MakeProtocols(backend Backend) []p2p.Protocol {
+	protocols := make([]p2p.Protocol, len(ProtocolVersions))
+	for i, version := range ProtocolVersions {
+		version := version // Closure
+
+		protocols[i] = p2p.Protocol{
+			Name:    ProtocolName,
+			Version: version,
+			Length:  protocolLengths[version],
+			Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+				return backend.RunPeer(NewPeer(version, p, rw), func(peer *Peer) error {
+					return Handle(backend, peer)
+				})
+			},
+			PeerInfo: func(id enode.ID) interface{} {
+				return backend.PeerInfo(id)
+			},
+		}
+	}
+	return protocols
+}
+
+// Handle is the callback invoked to manage the life cycle of a `wemix` peer.
+// When this function terminates, the peer is disconnected.
+func Handle(backend Backend, peer *Peer) error {
+	defer peer.Close()
+	go serveStatusEx(backend, peer)
+	for {
+		if err := HandleMessage(backend, peer); err != nil {
+			peer.Log().Debug("Message handling failed in `wemix`", "err", err)
+			return err
+		}
+	}
+}
+
+// HandleMessage is invoked whenever an inbound message is received from a
+// remote peer on the `wemix` protocol. The remote connection is torn down
+// upon returning any error.
+func HandleMessage(backend Backend, peer *Peer) error {
+	// Read the next message from the remote peer, and ensure it's fully consumed
+	msg, err := peer.rw.ReadMsg()
+	if err != nil {
+		return err
+	}
+	if msg.Size > maxMessageSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, maxMessageSize)
+	}
+	defer msg.Discard()
+
+	// Track the amount of time it takes to serve the request and run the handler
+	if metrics.Enabled {
+		h := fmt.Sprintf("%s/%s/%d/%#02x", p2p.HandleHistName, ProtocolName, peer.Version(), msg.Code)
+		defer func(start time.Time) {
+			sampler := func() metrics.Sample {
+				return metrics.ResettingSample(
+					metrics.NewExpDecaySample(1028, 0.015),
+				)
+			}
+			metrics.GetOrRegisterHistogramLazy(h, nil, sampler).Update(time.Since(start).Microseconds())
+		}(time.Now())
+	}
+	// Handle the message depending on its contents
+	switch msg.Code {
+	case GetStatusExMsg:
+		var req GetStatusExPacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if !backend.Serve(peer) {
+			return nil
+		}
+		// the status is collected off the message loop, not to hold up the
+		// responses to our own requests, and a few requests at most wait
+		select {
+		case peer.statusReqs <- req.RequestId:
+		default:
+			peer.Log().Debug("Dropped extended status request", "reqid", req.RequestId, "err", errBusy)
+		}
+		return nil
+
+	case StatusExMsg:
+		res := new(StatusExPacket)
+		if err := msg.Decode(res); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if err := peer.deliver(res.RequestId, msg.Code, res); err != nil {
+			peer.Log().Debug("Dropped extended status", "reqid", res.RequestId, "err", err)
+			return nil
+		}
+		return backend.Handle(peer, res)
+
+	case TransactionsExMsg:
+		txs := new(TransactionsExPacket)
+		if err := msg.Decode(txs); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		for i, tx := range *txs {
+			if tx == nil || tx.Tx == nil {
+				return fmt.Errorf("%w: transaction %d is nil", errDecode, i)
+			}
+		}
+		return backend.Handle(peer, txs)
+
+	case FinalityVoteMsg:
+		vote := new(FinalityVotePacket)
+		if err := msg.Decode(vote); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if !backend.Serve(peer) {
+			return nil
+		}
+		return backend.Handle(peer, vote)
+
+	case FinalityCertMsg:
+		// commit certificates are self-verifying, accepted from any peer
+		cert := new(FinalityCertPacket)
+		if err := msg.Decode(cert); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		return backend.Handle(peer, cert)
+
+	case RaftMsg:
+		data := new(RaftPacket)
+		if err := msg.Decode(data); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if !backend.Serve(peer) {
+			return nil
+		}
+		return backend.Handle(peer, data)
+
+	default:
+		return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
+	}
+}
+
+// serveStatusEx answers the queued GetStatusEx requests of the peer one at a
+// time until the peer is closed.
+func serveStatusEx(backend Backend, peer *Peer) {
+	for {
+		select {
+		case id := <-peer.statusReqs:
+			if status := backend.MinerStatus(); status != nil {
+				if err := peer.ReplyStatusEx(id, status); err != nil {
+					peer.Log().Debug("Failed to reply extended status", "err", err)
+				}
+			}
+		case <-peer.term:
+			return
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package wemix
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+// RequestTimeout is the time to wait for a response unless the context of
+// the request has a deadline of its own.
+const RequestTimeout = 5 * time.Second
+
+// maxQueuedStatusEx is the maximum number of the GetStatusEx requests of a peer
+// waiting to be answered. More are dropped, timing out on the peer.
+const maxQueuedStatusEx = 4
+
+// Peer is a collection of relevant information we have about a `wemix` peer.
+type Peer struct {
+	id string // Unique ID for the peer, cached
+
+	*p2p.Peer                   // The embedded P2P package peer
+	rw        p2p.MsgReadWriter // Input/output streams for wemix
+	version   uint              // Protocol version negotiated
+
+	lock    sync.Mutex
+	pending map[uint64]*request // requests waiting for responses by id
+
+	statusReqs chan uint64 // ids of the GetStatusEx requests to answer
+
+	term     chan struct{} // Termination channel to fail the pending requests
+	termOnce sync.Once
+	logger   log.Logger // Contextual logger with the peer id injected
+}
+
+// request is an in-flight request waiting for the response of code want.
+type request struct {
+	want uint64
+	sink chan Packet
+}
+
+// NewPeer create a wrapper for a network connection and negotiated  protocol
+// version.
+func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+	id := p.ID().String()
+	return &Peer{
+		id:      id,
+		Peer:    p,
+		rw:      rw,
+		version: version,
+		pending: make(map[uint64]*request),
+		term:    make(chan struct{}),
+		logger:  log.New("peer", id[:8]),
+
+		statusReqs: make(chan uint64, maxQueuedStatusEx),
+	}
+}
+
+// NewFakePeer create a fake wemix peer without a backing p2p peer, for testing purposes.
+func NewFakePeer(version uint, id string, rw p2p.MsgReadWriter) *Peer {
+	return &Peer{
+		id:      id,
+		rw:      rw,
+		version: version,
+		pending: make(map[uint64]*request),
+		term:    make(chan struct{}),
+		logger:  log.New("peer", id[:8]),
+
+		statusReqs: make(chan uint64, maxQueuedStatusEx),
+	}
+}
+
+// Close signals the pending requests that the peer is gone.
+func (p *Peer) Close() {
+	p.termOnce.Do(func() { close(p.term) })
+}
+
+// ID retrieves the peer's unique identifier.
+func (p *Peer) ID() string {
+	return p.id
+}
+
+// Version retrieves the peer's negoatiated `wemix` protocol version.
+func (p *Peer) Version() uint {
+	return p.version
+}
+
+// Log overrides the P2P logget with the higher level one containing only the id.
+func (p *Peer) Log() log.Logger {
+	return p.logger
+}
+
+// Pending returns the number of the requests waiting for responses.
+func (p *Peer) Pending() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.pending)
+}
+
+// RequestStatusEx fetches the extended status of the peer, waiting for the
+// response until the context is done or RequestTimeout if it has no
+// deadline.
+func (p *Peer) RequestStatusEx(ctx context.Context) (*wemixapi.WemixMinerStatus, error) {
+	id := rand.Uint64()
+	p.logger.Trace("Fetching extended status", "reqid", id)
+
+	res, err := p.request(ctx, id, GetStatusExMsg, StatusExMsg, &GetStatusExPacket{RequestId: id})
+	if err != nil {
+		return nil, err
+	}
+	return &res.(*StatusExPacket).Status, nil
+}
+
+// ReplyStatusEx is the response to GetStatusEx.
+func (p *Peer) ReplyStatusEx(id uint64, status *wemixapi.WemixMinerStatus) error {
+	return p2p.Send(p.rw, StatusExMsg, &StatusExPacket{
+		RequestId: id,
+		Status:    *status,
+	})
+}
+
+// SendTransactionsEx relays the transactions with their senders.
+func (p *Peer) SendTransactionsEx(txs []*types.Transaction) error {
+	return p2p.Send(p.rw, TransactionsExMsg, types.Txs2TxExs(txs))
+}
+
+// SendFinalityVote sends a finality vote to the governance node.
+func (p *Peer) SendFinalityVote(vote *types.FinalityVote) error {
+	return p2p.Send(p.rw, FinalityVoteMsg, vote)
+}
+
+// SendFinalityCert sends a commit certificate.
+func (p *Peer) SendFinalityCert(cert *types.FinalityCert) error {
+	return p2p.Send(p.rw, FinalityCertMsg, cert)
+}
+
+// SendRaftMessage sends a raft message to the governance node.
+func (p *Peer) SendRaftMessage(data []byte) error {
+	return p2p.Send(p.rw, RaftMsg, data)
+}
+
+// request sends the request with the given id and waits for its response.
+func (p *Peer) request(ctx context.Context, id, code, want uint64, data interface{}) (Packet, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, RequestTimeout)
+		defer cancel()
+	}
+
+	req := &request{want: want, sink: make(chan Packet, 1)}
+	p.lock.Lock()
+	if _, ok := p.pending[id]; ok {
+		p.lock.Unlock()
+		return nil, errDuplicateReqId
+	}
+	p.pending[id] = req
+	p.lock.Unlock()
+	defer func() {
+		p.lock.Lock()
+		delete(p.pending, id)
+		p.lock.Unlock()
+	}()
+
+	start := time.Now()
+	if err := p2p.Send(p.rw, code, data); err != nil {
+		return nil, err
+	}
+	select {
+	case res := <-req.sink:
+		requestTimer.UpdateSince(start)
+		return res, nil
+	case <-ctx.Done():
+		timeoutMeter.Mark(1)
+		return nil, ctx.Err()
+	case <-p.term:
+		return nil, errPeerClosed
+	}
+}
+
+// deliver hands the response over to the request waiting for it.
+func (p *Peer) deliver(id, code uint64, res Packet) error {
+	p.lock.Lock()
+	req, ok := p.pending[id]
+	if ok && req.want == code {
+		delete(p.pending, id)
+	}
+	p.lock.Unlock()
+
+	if !ok || req.want != code {
+		// late responses to the requests timed out end up here too
+		unsolicitedMeter.Mark(1)
+		return errUnknownResponse
+	}
+	req.sink <- res
+	return nil
+}
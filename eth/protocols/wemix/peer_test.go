@@ -0,0 +1,212 @@
+package wemix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+// testBackend serves its status after delay, if serve is set, and passes the
+// broadcasts handled on to handled if set
+type testBackend struct {
+	name    string
+	serve   bool
+	delay   chan time.Duration
+	calls   int32
+	handled chan Packet
+}
+
+func (b *testBackend) RunPeer(peer *Peer, handler Handler) error { return handler(peer) }
+func (b *testBackend) PeerInfo(id enode.ID) interface{}          { return nil }
+func (b *testBackend) Serve(peer *Peer) bool                     { return b.serve }
+
+func (b *testBackend) Handle(peer *Peer, packet Packet) error {
+	if b.handled != nil {
+		b.handled <- packet
+	}
+	return nil
+}
+
+func (b *testBackend) MinerStatus() *wemixapi.WemixMinerStatus {
+	atomic.AddInt32(&b.calls, 1)
+	select {
+	case d := <-b.delay:
+		time.Sleep(d)
+	default:
+	}
+	return &wemixapi.WemixMinerStatus{
+		NodeName:          b.name,
+		LatestBlockHeight: big.NewInt(100),
+		LatestBlockTd:     big.NewInt(200),
+		RttMs:             new(big.Int),
+	}
+}
+
+// newTestPeers returns a pair of peers connected with each other, running
+// the handlers with the given backends
+func newTestPeers(t *testing.T, b1, b2 Backend) (*Peer, *Peer) {
+	app, net := p2p.MsgPipe()
+	p1 := NewFakePeer(WEMIX1, fmt.Sprintf("%064x", 1), app)
+	p2 := NewFakePeer(WEMIX1, fmt.Sprintf("%064x", 2), net)
+	go Handle(b1, p1)
+	go Handle(b2, p2)
+	t.Cleanup(func() {
+		app.Close()
+		net.Close()
+	})
+	return p1, p2
+}
+
+func TestRequestStatusEx(t *testing.T) {
+	b1 := &testBackend{name: "one", serve: true}
+	b2 := &testBackend{name: "two", serve: true, delay: make(chan time.Duration, 4)}
+	p1, p2 := newTestPeers(t, b1, b2)
+
+	// the slow response to the first request doesn't get in the way of the
+	// others, nor is mixed up with them
+	b2.delay <- 300 * time.Millisecond
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s, err := p1.RequestStatusEx(context.Background())
+			if err == nil && s.NodeName != "two" {
+				err = fmt.Errorf("got status of %q", s.NodeName)
+			}
+			errs <- err
+		}()
+		time.Sleep(10 * time.Millisecond)
+	}
+	s, err := p2.RequestStatusEx(context.Background())
+	if err != nil || s.NodeName != "one" || s.LatestBlockTd.Int64() != 200 {
+		t.Fatalf("unexpected status %+v, error %v", s, err)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := p1.Pending(); n != 0 {
+		t.Fatalf("%d requests left pending", n)
+	}
+
+	// the request times out, and the late response is dropped
+	b2.delay <- 300 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := p1.RequestStatusEx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if n := p1.Pending(); n != 0 {
+		t.Fatalf("%d requests left pending after timeout", n)
+	}
+	time.Sleep(400 * time.Millisecond)
+	if s, err := p1.RequestStatusEx(context.Background()); err != nil || s.NodeName != "two" {
+		t.Fatalf("unexpected status %+v, error %v", s, err)
+	}
+}
+
+func TestRequestStatusExNotServed(t *testing.T) {
+	b1 := &testBackend{name: "one", serve: true}
+	b2 := &testBackend{name: "two"}
+	p1, _ := newTestPeers(t, b1, b2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := p1.RequestStatusEx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRequestStatusExPeerClosed(t *testing.T) {
+	b1 := &testBackend{name: "one", serve: true}
+	b2 := &testBackend{name: "two"}
+	p1, _ := newTestPeers(t, b1, b2)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		p1.Close()
+	}()
+	if _, err := p1.RequestStatusEx(context.Background()); err != errPeerClosed {
+		t.Fatalf("got %v, want %v", err, errPeerClosed)
+	}
+}
+
+func TestRequestStatusExBounded(t *testing.T) {
+	b1 := &testBackend{name: "one", serve: true}
+	b2 := &testBackend{name: "two", serve: true, delay: make(chan time.Duration, 1)}
+	p1, _ := newTestPeers(t, b1, b2)
+
+	// the requests flooding in while the status is being collected are
+	// dropped but a few, without holding up the peer
+	b2.delay <- 300 * time.Millisecond
+	for i := 0; i < 4*maxQueuedStatusEx; i++ {
+		if err := p2p.Send(p1.rw, GetStatusExMsg, &GetStatusExPacket{RequestId: uint64(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(500 * time.Millisecond)
+	if n := atomic.LoadInt32(&b2.calls); n > maxQueuedStatusEx+1 {
+		t.Fatalf("status collected %d times, want at most %d", n, maxQueuedStatusEx+1)
+	}
+	if s, err := p1.RequestStatusEx(context.Background()); err != nil || s.NodeName != "two" {
+		t.Fatalf("unexpected status %+v, error %v", s, err)
+	}
+}
+
+func TestBroadcasts(t *testing.T) {
+	vote := &types.FinalityVote{Number: 1, Hash: common.Hash{1}}
+	cert := &types.FinalityCert{Number: 1, Hash: common.Hash{1}}
+	tx := types.NewTransaction(0, common.Address{1}, big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	for _, serve := range []bool{false, true} {
+		b1 := &testBackend{name: "one"}
+		b2 := &testBackend{name: "two", serve: serve, handled: make(chan Packet, 4)}
+		p1, _ := newTestPeers(t, b1, b2)
+
+		if err := p1.SendFinalityVote(vote); err != nil {
+			t.Fatal(err)
+		}
+		if err := p1.SendRaftMessage([]byte{1}); err != nil {
+			t.Fatal(err)
+		}
+		if err := p1.SendFinalityCert(cert); err != nil {
+			t.Fatal(err)
+		}
+		if err := p1.SendTransactionsEx([]*types.Transaction{tx}); err != nil {
+			t.Fatal(err)
+		}
+
+		// only the certificates and the transactions are taken from the
+		// nodes not served
+		want := []byte{FinalityCertMsg, TransactionsExMsg}
+		if serve {
+			want = []byte{FinalityVoteMsg, RaftMsg, FinalityCertMsg, TransactionsExMsg}
+		}
+		for _, kind := range want {
+			select {
+			case packet := <-b2.handled:
+				if packet.Kind() != kind {
+					t.Fatalf("serve %v: handled %s, want %#02x", serve, packet.Name(), kind)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("serve %v: %#02x not handled", serve, kind)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Package wemix implements the `wemix` devp2p protocol, a satellite protocol
+// to `eth` for the requests among the governance nodes.
+package wemix
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+// Constants to match up protocol versions and messages
+const (
+	WEMIX1 = 1
+)
+
+// ProtocolName is the official short name of the `wemix` protocol used during
+// devp2p capability negotiation.
+const ProtocolName = "wemix"
+
+// ProtocolVersions are the supported versions of the `wemix` protocol (first
+// is primary).
+var ProtocolVersions = []uint{WEMIX1}
+
+// protocolLengths are the number of implemented message corresponding to
+// different protocol versions.
+var protocolLengths = map[uint]uint64{WEMIX1: 6}
+
+// maxMessageSize is the maximum cap on the size of a protocol message.
+const maxMessageSize = 10 * 1024 * 1024
+
+const (
+	GetStatusExMsg    = 0x00
+	StatusExMsg       = 0x01
+	FinalityVoteMsg   = 0x02
+	FinalityCertMsg   = 0x03
+	RaftMsg           = 0x04
+	TransactionsExMsg = 0x05
+)
+
+var (
+	errMsgTooLarge     = errors.New("message too long")
+	errDecode          = errors.New("invalid message")
+	errInvalidMsgCode  = errors.New("invalid message code")
+	errPeerClosed      = errors.New("peer closed")
+	errDuplicateReqId  = errors.New("duplicate request id")
+	errUnknownResponse = errors.New("unsolicited response")
+	errBusy            = errors.New("too many pending requests")
+)
+
+// Packet represents a p2p message in the `wemix` protocol.
+type Packet interface {
+	Name() string // Name returns a string corresponding to the message type.
+	Kind() byte   // Kind returns the message type.
+}
+
+// GetStatusExPacket requests the extended status of a governance node.
+type GetStatusExPacket struct {
+	RequestId uint64 // Request ID to match up responses with
+}
+
+// StatusExPacket is the response to GetStatusExPacket.
+type StatusExPacket struct {
+	RequestId uint64 // ID of the request this is a response for
+	Status    wemixapi.WemixMinerStatus
+}
+
+// TransactionsExPacket is the network packet for relaying transactions with
+// their senders.
+type TransactionsExPacket []*types.TransactionEx
+
+// FinalityVotePacket is the network packet for a finality vote among the
+// governance nodes.
+type FinalityVotePacket types.FinalityVote
+
+// FinalityCertPacket is the network packet for a commit certificate.
+type FinalityCertPacket types.FinalityCert
+
+// RaftPacket is the network packet for a raft message among the governance
+// nodes.
+type RaftPacket []byte
+
+func (*GetStatusExPacket) Name() string { return "GetStatusEx" }
+func (*GetStatusExPacket) Kind() byte   { return GetStatusExMsg }
+
+func (*StatusExPacket) Name() string { return "StatusEx" }
+func (*StatusExPacket) Kind() byte   { return StatusExMsg }
+
+func (*TransactionsExPacket) Name() string { return "TransactionsEx" }
+func (*TransactionsExPacket) Kind() byte   { return TransactionsExMsg }
+
+func (*FinalityVotePacket) Name() string { return "FinalityVote" }
+func (*FinalityVotePacket) Kind() byte   { return FinalityVoteMsg }
+
+func (*FinalityCertPacket) Name() string { return "FinalityCert" }
+func (*FinalityCertPacket) Kind() byte   { return FinalityCertMsg }
+
+func (*RaftPacket) Name() string { return "Raft" }
+func (*RaftPacket) Kind() byte   { return RaftMsg }
@@ -0,0 +1,158 @@
+package eth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+// hubHoldDown is how long a hub that dropped has to stay connected again
+// before the transactions are relayed through it, not to flap the route.
+const hubHoldDown = 10 * time.Second
+
+// governanceHubs among the hubs configured stands for the governance nodes in
+// the order of their names.
+const governanceHubs = "governance"
+
+// # of the transactions by how they're propagated
+var (
+	relayDirectMeter   = metrics.NewRegisteredMeter("eth/txrelay/direct", nil)   // not a partner or no hubs
+	relayHubMeter      = metrics.NewRegisteredMeter("eth/txrelay/hub", nil)      // as the hub, to all peers
+	relayViaHubMeter   = metrics.NewRegisteredMeter("eth/txrelay/viahub", nil)   // to the hub only
+	relayFallbackMeter = metrics.NewRegisteredMeter("eth/txrelay/fallback", nil) // to all peers, no hub reachable
+)
+
+// relay paths
+const (
+	relayDirect   = "direct"
+	relayHub      = "hub"
+	relayViaHub   = "viahub"
+	relayFallback = "fallback"
+)
+
+// hubHealth is the connection state of a partner that can be a hub.
+type hubHealth struct {
+	up      bool
+	dropped bool      // dropped at least once
+	since   time.Time // when it got connected or dropped
+}
+
+// txRelay routes the transactions among the partners through the hubs: the
+// partners send them only to the first of the hubs in the order of
+// preference that's healthy, and that hub sends them to all. If none of the
+// hubs is reachable, the partners fall back to sending them to all.
+type txRelay struct {
+	lock   sync.Mutex
+	health map[string]*hubHealth // peers by id
+	route  string                // the last route taken, for logging
+}
+
+func newTxRelay() *txRelay {
+	return &txRelay{health: make(map[string]*hubHealth)}
+}
+
+// peerUp records a peer getting connected.
+func (r *txRelay) peerUp(id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	h, ok := r.health[id]
+	if !ok {
+		h = &hubHealth{}
+		r.health[id] = h
+	}
+	h.up, h.since = true, time.Now()
+}
+
+// peerDown records a peer getting dropped, remembered only for the
+// partners.
+func (r *txRelay) peerDown(id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !wemixminer.IsPartner(id) {
+		delete(r.health, id)
+	} else if h, ok := r.health[id]; ok {
+		h.up, h.dropped, h.since = false, true, time.Now()
+	}
+}
+
+// healthy returns true if the partner is connected, and has been long enough
+// if it dropped before.
+func (r *txRelay) healthy(id string, now time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	h, ok := r.health[id]
+	return ok && h.up && (!h.dropped || now.Sub(h.since) >= hubHoldDown)
+}
+
+// hubs expands the hubs configured, governanceHubs to the governance nodes.
+func (r *txRelay) hubs(config []string) []string {
+	var hubs []string
+	for _, hub := range config {
+		if hub == governanceHubs {
+			hubs = append(hubs, wemixminer.GovernanceNodes()...)
+		} else {
+			hubs = append(hubs, hub)
+		}
+	}
+	return hubs
+}
+
+// pick returns the hub peer to relay the transactions through, nil to send
+// them to all, and the relay path.
+func (r *txRelay) pick(ps *peerSet, config []string) (*ethPeer, string) {
+	if !wemixminer.AmPartner() || len(config) == 0 {
+		return nil, relayDirect
+	}
+
+	var (
+		hub  *ethPeer
+		path = relayFallback
+		now  = time.Now()
+	)
+	for _, id := range r.hubs(config) {
+		if wemixminer.AmHub(id) == 1 {
+			path = relayHub
+			break
+		}
+		if p := ps.peerWithPrefix(id); p != nil && r.healthy(p.ID(), now) {
+			hub, path = p, relayViaHub
+			break
+		}
+	}
+
+	r.lock.Lock()
+	if route := path + ":" + peerIdOf(hub); route != r.route {
+		if path == relayFallback {
+			log.Warn("No transaction relay hub reachable, sending to all", "hubs", len(config))
+		} else {
+			log.Info("Transaction relay route changed", "path", path, "hub", peerIdOf(hub))
+		}
+		r.route = route
+	}
+	r.lock.Unlock()
+	return hub, path
+}
+
+// mark counts the transactions propagated by path.
+func (r *txRelay) mark(path string, n int) {
+	switch path {
+	case relayDirect:
+		relayDirectMeter.Mark(int64(n))
+	case relayHub:
+		relayHubMeter.Mark(int64(n))
+	case relayViaHub:
+		relayViaHubMeter.Mark(int64(n))
+	case relayFallback:
+		relayFallbackMeter.Mark(int64(n))
+	}
+}
+
+func peerIdOf(p *ethPeer) string {
+	if p == nil {
+		return ""
+	}
+	return p.ID()
+}
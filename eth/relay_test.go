@@ -0,0 +1,96 @@
+package eth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+func TestTxRelayPick(t *testing.T) {
+	var (
+		self       = enode.ID{0}.String()
+		amPartner  = true
+		governance []string
+	)
+	defer func(amPartnerFunc func() bool, isPartnerFunc func(string) bool, amHubFunc func(string) int, governanceNodesFunc func() []string) {
+		wemixminer.AmPartnerFunc = amPartnerFunc
+		wemixminer.IsPartnerFunc = isPartnerFunc
+		wemixminer.AmHubFunc = amHubFunc
+		wemixminer.GovernanceNodesFunc = governanceNodesFunc
+	}(wemixminer.AmPartnerFunc, wemixminer.IsPartnerFunc, wemixminer.AmHubFunc, wemixminer.GovernanceNodesFunc)
+	wemixminer.AmPartnerFunc = func() bool { return amPartner }
+	wemixminer.IsPartnerFunc = func(string) bool { return true }
+	wemixminer.AmHubFunc = func(id string) int {
+		if id == self {
+			return 1
+		}
+		return 0
+	}
+	wemixminer.GovernanceNodesFunc = func() []string { return governance }
+
+	ps := newPeerSet()
+	r := newTxRelay()
+	connect := func(n byte) string {
+		app, net := p2p.MsgPipe()
+		t.Cleanup(func() {
+			app.Close()
+			net.Close()
+		})
+		peer := eth.NewPeer(eth.ETH66, p2p.NewPeer(enode.ID{n}, "", nil), app, nil)
+		t.Cleanup(peer.Close)
+		if err := ps.registerPeer(peer, nil); err != nil {
+			t.Fatal(err)
+		}
+		r.peerUp(peer.ID())
+		return peer.ID()
+	}
+	disconnect := func(id string) {
+		ps.unregisterPeer(id)
+		r.peerDown(id)
+	}
+	check := func(config []string, wantHub, wantPath string) {
+		t.Helper()
+		hub, path := r.pick(ps, config)
+		if peerIdOf(hub) != wantHub || path != wantPath {
+			t.Fatalf("got (%s, %s), want (%s, %s)", peerIdOf(hub), path, wantHub, wantPath)
+		}
+	}
+
+	id1, id2 := connect(1), connect(2)
+	hubs := []string{id1, id2}
+
+	// to the first hub that's up, by a prefix of its id too
+	check(hubs, id1, relayViaHub)
+	check([]string{id2[:8], id1}, id2, relayViaHub)
+	check(nil, "", relayDirect)
+
+	// not among the partners
+	amPartner = false
+	check(hubs, "", relayDirect)
+	amPartner = true
+
+	// fail over to the next hub, and back only after the hold down
+	disconnect(id1)
+	check(hubs, id2, relayViaHub)
+	connect(1)
+	check(hubs, id2, relayViaHub)
+	r.health[id1].since = time.Now().Add(-hubHoldDown)
+	check(hubs, id1, relayViaHub)
+
+	// none reachable
+	disconnect(id1)
+	disconnect(id2)
+	check(hubs, "", relayFallback)
+
+	// this node is the hub, ahead of the ones down
+	check([]string{id1, self, id2}, "", relayHub)
+
+	// the governance nodes as the hubs
+	connect(3)
+	governance = []string{id2, enode.ID{3}.String(), self}
+	check([]string{governanceHubs}, enode.ID{3}.String(), relayViaHub)
+}
@@ -0,0 +1,66 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// maxRewardScanBlocks is the maximum # of the headers scanned for the
+// rewards of the blocks not in the reward index, enough for the sections
+// not confirmed yet.
+const maxRewardScanBlocks = 2 * params.RewardIndexBlocks
+
+var errRewardScanLimit = fmt.Errorf("more than %d blocks not in the reward index, narrow the range or enable --rewardindex", maxRewardScanBlocks)
+
+// rewardHistory returns the rewards the address received in the blocks from
+// first to last, inclusive, from the reward index and the headers of the
+// blocks not indexed yet.
+func (eth *Ethereum) rewardHistory(ctx context.Context, addr common.Address, first, last uint64) ([]*rawdb.RewardEntry, error) {
+	var (
+		size     = params.RewardIndexBlocks
+		sections uint64
+		scanned  uint64
+		entries  []*rawdb.RewardEntry
+	)
+	if eth.rewardIndexer != nil {
+		sections, _, _ = eth.rewardIndexer.Sections()
+	}
+	for number := first; number <= last; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if section := number / size; section < sections {
+			head := rawdb.ReadCanonicalHash(eth.chainDb, (section+1)*size-1)
+			for _, e := range rawdb.ReadRewardIndex(eth.chainDb, addr, section, head) {
+				if e.Number >= first && e.Number <= last {
+					entries = append(entries, e)
+				}
+			}
+			number = (section + 1) * size
+			continue
+		}
+
+		if scanned++; scanned > maxRewardScanBlocks {
+			return nil, errRewardScanLimit
+		}
+		header := rawdb.ReadHeader(eth.chainDb, rawdb.ReadCanonicalHash(eth.chainDb, number), number)
+		if header == nil {
+			return nil, errors.New("block not found")
+		}
+		rewards, err := core.RewardEntries(header)
+		if err != nil {
+			return nil, err
+		}
+		if e, ok := rewards[addr]; ok {
+			entries = append(entries, e)
+		}
+		number++
+	}
+	return entries, nil
+}
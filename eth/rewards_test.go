@@ -0,0 +1,135 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+// rewardTestChain is the chain the reward indexer follows in the tests.
+type rewardTestChain struct {
+	head *types.Header
+	feed event.Feed
+}
+
+func (c *rewardTestChain) CurrentHeader() *types.Header { return c.head }
+
+func (c *rewardTestChain) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return c.feed.Subscribe(ch)
+}
+
+// Tests that the rewards are found the same from the reward index and from
+// the headers not indexed yet.
+func TestRewardHistory(t *testing.T) {
+	var (
+		db     = rawdb.NewMemoryDatabase()
+		member = common.HexToAddress("0x0001")
+		other  = common.HexToAddress("0x0002")
+		miner  = common.HexToAddress("0x0003") // mines without rewards
+		size   = params.RewardIndexBlocks
+		last   = size + params.RewardIndexConfirms + 10
+		chain  = &rewardTestChain{}
+	)
+	for n := uint64(0); n <= last; n++ {
+		header := &types.Header{
+			Number:     new(big.Int).SetUint64(n),
+			Difficulty: big.NewInt(1),
+			BaseFee:    big.NewInt(0), // to encode the optional fields after it
+			Coinbase:   miner,
+		}
+		if n > 0 {
+			header.ParentHash = chain.head.Hash()
+			header.Fees = new(big.Int).SetUint64(n)
+			header.Rewards, _ = json.Marshal([]*wemixapi.WemixReward{
+				{Addr: member, Reward: big.NewInt(100)},
+				{Addr: other, Reward: big.NewInt(10)},
+			})
+			if n%2 == 1 {
+				header.Coinbase = member
+			}
+		}
+		rawdb.WriteHeader(db, header)
+		rawdb.WriteCanonicalHash(db, header.Hash(), n)
+		chain.head = header
+	}
+
+	// the headers only
+	eth := &Ethereum{chainDb: db}
+	scanned, err := eth.rewardHistory(context.Background(), member, 0, last)
+	if err != nil {
+		t.Fatalf("failed to scan rewards: %v", err)
+	}
+	if uint64(len(scanned)) != last {
+		t.Fatalf("scanned entry count mismatch: have %d, want %d", len(scanned), last)
+	}
+
+	eth.rewardIndexer = core.NewRewardIndexer(db, size, params.RewardIndexConfirms)
+	defer eth.rewardIndexer.Close()
+	eth.rewardIndexer.Start(chain)
+	for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+		if sections, _, _ := eth.rewardIndexer.Sections(); sections == 1 {
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("reward index section not processed")
+		}
+	}
+	if entries := rawdb.ReadRewardIndex(db, member, 0, rawdb.ReadCanonicalHash(db, size-1)); uint64(len(entries)) != size-1 {
+		t.Fatalf("indexed entry count mismatch: have %d, want %d", len(entries), size-1)
+	}
+
+	indexed, err := eth.rewardHistory(context.Background(), member, 0, last)
+	if err != nil {
+		t.Fatalf("failed to read rewards: %v", err)
+	}
+	if len(indexed) != len(scanned) {
+		t.Fatalf("entry count mismatch: indexed %d, scanned %d", len(indexed), len(scanned))
+	}
+	for i, e := range indexed {
+		s := scanned[i]
+		if e.Number != s.Number || e.Reward.Cmp(s.Reward) != 0 || e.Fees.Cmp(s.Fees) != 0 {
+			t.Fatalf("entry %d mismatch: indexed %+v, scanned %+v", i, e, s)
+		}
+	}
+
+	// a range across the end of the indexed section
+	entries, err := eth.rewardHistory(context.Background(), member, size-5, size+4)
+	if err != nil {
+		t.Fatalf("failed to read rewards: %v", err)
+	}
+	if len(entries) != 10 || entries[0].Number != size-5 || entries[9].Number != size+4 {
+		t.Fatalf("unexpected entries %v", entries)
+	}
+	rewards, fees := new(big.Int), new(big.Int)
+	for _, e := range entries {
+		rewards.Add(rewards, e.Reward)
+		fees.Add(fees, e.Fees)
+	}
+	// the fees are not part of the rewards
+	if want := big.NewInt(1000); rewards.Cmp(want) != 0 {
+		t.Fatalf("rewards mismatch: have %v, want %v", rewards, want)
+	}
+	// the odd blocks of size-5, ..., size+4
+	if want := big.NewInt(int64(5*size - 5)); fees.Cmp(want) != 0 {
+		t.Fatalf("fees mismatch: have %v, want %v", fees, want)
+	}
+
+	// the coinbase without rewards has none
+	entries, err = eth.rewardHistory(context.Background(), miner, 0, last)
+	if err != nil {
+		t.Fatalf("failed to read rewards: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("coinbase without rewards has entries %v", entries)
+	}
+}
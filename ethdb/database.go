@@ -57,6 +57,13 @@ type Compacter interface {
 	Compact(start []byte, limit []byte) error
 }
 
+// Checkpointer wraps the Checkpoint method of a backing data store.
+type Checkpointer interface {
+	// Checkpoint creates a consistent copy of the data store in the directory,
+	// which must not exist, hard linking the immutable files if possible.
+	Checkpoint(dir string) error
+}
+
 // KeyValueStore contains all the methods required to allow handling different
 // key-value data stores backing the high level database.
 type KeyValueStore interface {
@@ -0,0 +1,18 @@
+// nopebble.go
+//go:build !(arm64 || amd64)
+// +build !arm64,!amd64
+
+package pebble
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// Supported tells if Pebble is built in, which is on 64-bit platforms only.
+const Supported = false
+
+func New(file string, cache int, handles int, namespace string, readonly bool) (ethdb.KeyValueStore, error) {
+	return nil, errors.New("pebble is not supported on this platform")
+}
@@ -0,0 +1,53 @@
+// pebble_test.go
+//go:build arm64 || amd64
+// +build arm64 amd64
+
+package pebble
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/dbtest"
+)
+
+func TestPebbleDB(t *testing.T) {
+	t.Run("DatabaseSuite", func(t *testing.T) {
+		dbtest.TestDatabaseSuite(t, func() ethdb.KeyValueStore {
+			db, err := New(t.TempDir(), 16, 16, "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return db
+		})
+	})
+}
+
+func TestPebbleDBCheckpoint(t *testing.T) {
+	db, err := New(t.TempDir(), 16, 16, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := db.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if stats, err := db.Stat("leveldb.stats"); err != nil || stats == "" {
+		t.Errorf("failed to get stats: %q %v", stats, err)
+	}
+	dir := t.TempDir() + "/checkpoint"
+	if err := db.Checkpoint(dir); err != nil {
+		t.Fatalf("failed to create checkpoint: %v", err)
+	}
+	if err := db.Put([]byte("key"), []byte("changed")); err != nil {
+		t.Fatal(err)
+	}
+	cp, err := New(dir, 16, 16, "", true)
+	if err != nil {
+		t.Fatalf("failed to open checkpoint: %v", err)
+	}
+	defer cp.Close()
+	if v, err := cp.Get([]byte("key")); err != nil || string(v) != "value" {
+		t.Errorf("checkpoint value mismatch: have %q %v, want %q", v, err, "value")
+	}
+}
@@ -0,0 +1,156 @@
+// config.go
+
+package rocksdb
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// Config is the tunable profile of RocksDB databases, i.e. the [Node.RocksDB]
+// section of the config file.
+type Config struct {
+	Compression       []string `toml:",omitempty"` // Compression per level: none, snappy, lz4, lz4hc or zstd, the last one for the deeper levels
+	WriteBufferSize   int      `toml:",omitempty"` // Memtable size in megabytes, a quarter of the cache if 0
+	MaxBackgroundJobs int      `toml:",omitempty"` // Maximum number of concurrent flushes and compactions, RocksDB default if 0
+	RateLimit         int      `toml:",omitempty"` // Flush and compaction write rate limit in megabytes per second, unlimited if 0
+	ColumnFamilies    bool     `toml:",omitempty"` // Keep trie nodes, receipts and snapshot data in their own column families, for new databases only
+
+	// ColumnFamily returns the column family of a key, set by the owner of
+	// the key layouts, i.e. core/rawdb. Required if column families are used.
+	ColumnFamily func(key []byte) int `toml:"-" json:"-"`
+}
+
+// DefaultConfig is the RocksDB profile used unless configured otherwise,
+// i.e. RocksDB's own defaults.
+var DefaultConfig = Config{}
+
+// compressionTypes maps the compression names to the RocksDB constants.
+var compressionTypes = map[string]int{
+	"none":   0, // rocksdb_no_compression
+	"snappy": 1, // rocksdb_snappy_compression
+	"lz4":    4, // rocksdb_lz4_compression
+	"lz4hc":  5, // rocksdb_lz4hc_compression
+	"zstd":   7, // rocksdb_zstd_compression
+}
+
+// Validate checks the compression names and the sizes of the profile.
+func (c *Config) Validate() error {
+	if _, err := c.compressionLevels(); err != nil {
+		return err
+	}
+	if c.WriteBufferSize < 0 || c.MaxBackgroundJobs < 0 || c.RateLimit < 0 {
+		return fmt.Errorf("negative rocksdb write buffer size, background jobs or rate limit")
+	}
+	return nil
+}
+
+// compressionLevels returns the RocksDB compression types per level.
+func (c *Config) compressionLevels() ([]int, error) {
+	var levels []int
+	for _, name := range c.Compression {
+		t, ok := compressionTypes[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown rocksdb compression %q", name)
+		}
+		levels = append(levels, t)
+	}
+	return levels, nil
+}
+
+// Column families, the data other than trie nodes, receipts and snapshot
+// goes to the default one.
+const (
+	CFDefault = iota
+	CFTrie
+	CFReceipts
+	CFSnapshot
+)
+
+var columnFamilyNames = []string{"default", "trie", "receipts", "snapshot"}
+
+// mergedIterator iterates over the union of iterators in the key order, used
+// for iterating over all the column families. The key spaces of the
+// iterators are expected to be disjoint. The current keys of the iterators
+// are kept, as the ones of RocksDB are copied out on every Key call.
+type mergedIterator struct {
+	its   []ethdb.Iterator
+	valid []bool
+	keys  [][]byte
+	cur   int
+	first bool
+}
+
+func newMergedIterator(its []ethdb.Iterator) *mergedIterator {
+	it := &mergedIterator{
+		its:   its,
+		valid: make([]bool, len(its)),
+		keys:  make([][]byte, len(its)),
+		cur:   -1,
+		first: true,
+	}
+	return it
+}
+
+// advance moves the i-th iterator to its next key
+func (it *mergedIterator) advance(i int) {
+	if it.valid[i] = it.its[i].Next(); it.valid[i] {
+		it.keys[i] = it.its[i].Key()
+	} else {
+		it.keys[i] = nil
+	}
+}
+
+func (it *mergedIterator) Next() bool {
+	if it.first {
+		for i := range it.its {
+			it.advance(i)
+		}
+		it.first = false
+	} else if it.cur >= 0 {
+		it.advance(it.cur)
+	}
+	it.cur = -1
+	for i, key := range it.keys {
+		if !it.valid[i] {
+			continue
+		}
+		if it.cur < 0 || bytes.Compare(key, it.keys[it.cur]) < 0 {
+			it.cur = i
+		}
+	}
+	return it.cur >= 0
+}
+
+func (it *mergedIterator) Error() error {
+	for _, sub := range it.its {
+		if err := sub.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (it *mergedIterator) Key() []byte {
+	if it.cur < 0 {
+		return nil
+	}
+	return it.keys[it.cur]
+}
+
+func (it *mergedIterator) Value() []byte {
+	if it.cur < 0 {
+		return nil
+	}
+	return it.its[it.cur].Value()
+}
+
+func (it *mergedIterator) Release() {
+	for _, sub := range it.its {
+		sub.Release()
+	}
+	it.cur = -1
+}
@@ -0,0 +1,58 @@
+// config_test.go
+
+package rocksdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+func TestConfig(t *testing.T) {
+	cfg := Config{Compression: []string{"none", "LZ4", " zstd"}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("failed to validate config: %v", err)
+	}
+	levels, _ := cfg.compressionLevels()
+	if want := []int{0, 4, 7}; len(levels) != len(want) || levels[0] != want[0] || levels[1] != want[1] || levels[2] != want[2] {
+		t.Errorf("compression mismatch: have %v, want %v", levels, want)
+	}
+	if err := (&Config{Compression: []string{"gzip"}}).Validate(); err == nil {
+		t.Errorf("unknown compression didn't fail")
+	}
+	if err := (&Config{RateLimit: -1}).Validate(); err == nil {
+		t.Errorf("negative rate limit didn't fail")
+	}
+}
+
+func TestMergedIterator(t *testing.T) {
+	var (
+		dbs  = []*memorydb.Database{memorydb.New(), memorydb.New(), memorydb.New()}
+		keys = [][]byte{[]byte("a1"), []byte("a2"), []byte("b1"), []byte("b2"), []byte("c1")}
+	)
+	for i, key := range keys {
+		dbs[i%len(dbs)].Put(key, key)
+	}
+	its := make([]ethdb.Iterator, len(dbs))
+	for i, db := range dbs {
+		its[i] = db.NewIterator([]byte("a"), nil)
+	}
+	it := newMergedIterator(its)
+	defer it.Release()
+
+	var have [][]byte
+	for it.Next() {
+		if !bytes.Equal(it.Key(), it.Value()) {
+			t.Errorf("value mismatch: have %s, want %s", it.Value(), it.Key())
+		}
+		have = append(have, it.Key())
+	}
+	if err := it.Error(); err != nil {
+		t.Fatal(err)
+	}
+	if len(have) != 2 || !bytes.Equal(have[0], keys[0]) || !bytes.Equal(have[1], keys[1]) {
+		t.Errorf("keys mismatch: have %s, want %s", have, keys[:2])
+	}
+}
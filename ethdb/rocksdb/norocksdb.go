@@ -5,6 +5,9 @@ package rocksdb
 
 import "github.com/ethereum/go-ethereum/ethdb/leveldb"
 
-func New(file string, cache int, handles int, namespace string, readonly bool) (*leveldb.Database, error) {
+// Supported tells if RocksDB is built in, New opens LevelDB otherwise.
+const Supported = false
+
+func New(file string, cache int, handles int, namespace string, readonly bool, cfg Config) (*leveldb.Database, error) {
 	return leveldb.New(file, cache, handles, namespace, readonly)
 }
@@ -7,19 +7,58 @@ package rocksdb
 // #include <stdlib.h>
 // #include <string.h>
 // #include "rocksdb/c.h"
+//
+// // bits_per_key is int or double depending on the version
+// static rocksdb_filterpolicy_t* wemix_filterpolicy_create_bloom(int bits_per_key) {
+//   return rocksdb_filterpolicy_create_bloom(bits_per_key);
+// }
 import "C"
 
 import (
 	"errors"
 	"runtime"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
 )
 
+const (
+	// degradationWarnInterval specifies how often warning should be printed if the
+	// write stalls due to compaction.
+	degradationWarnInterval = time.Minute
+
+	// minCache is the minimum amount of memory in megabytes to allocate to rocksdb
+	// read and write caching, split half and half.
+	minCache = 16
+
+	// minHandles is the minimum number of files handles to allocate to the open
+	// database files.
+	minHandles = 16
+
+	// metricsGatheringInterval specifies the interval to retrieve rocksdb database
+	// compaction, io and cache stats to report to the user.
+	metricsGatheringInterval = 3 * time.Second
+
+	// bloomBitsPerKey is the bits per key of the bloom filters.
+	bloomBitsPerKey = 10
+
+	// rateLimiterRefillPeriod and rateLimiterFairness are the RocksDB
+	// defaults of the rate limiter, in microseconds and the 1/n chance of low
+	// priority requests going first.
+	rateLimiterRefillPeriod = 100 * 1000
+	rateLimiterFairness     = 10
+)
+
+// Supported tells if RocksDB is built in, New opens LevelDB otherwise.
+const Supported = true
+
 // Wemix: db stats
 // (reads, read bytes, writes, written bytes, lookups, deletes)
 var (
@@ -33,6 +72,32 @@ type RDBDatabase struct {
 	opts  *C.rocksdb_options_t
 	wopts *C.rocksdb_writeoptions_t
 	ropts *C.rocksdb_readoptions_t
+	cache *C.rocksdb_cache_t
+	cfs   []*C.rocksdb_column_family_handle_t // column families, nil if not used
+	route func(key []byte) int                // column family of a key
+
+	compTimeMeter     metrics.Meter        // Meter for measuring the total time spent in database compaction
+	compReadMeter     metrics.Meter        // Meter for measuring the data read during compaction
+	compWriteMeter    metrics.Meter        // Meter for measuring the data written during compaction
+	compPendingGauge  metrics.Gauge        // Gauge for tracking the estimated amount of data to be compacted
+	compRunningGauge  metrics.Gauge        // Gauge for tracking the number of running compactions
+	level0FilesGauge  metrics.Gauge        // Gauge for tracking the number of files in level0
+	writeDelayNMeter  metrics.Meter        // Meter for measuring the write stall number due to database compaction
+	writeDelayMeter   metrics.Meter        // Meter for measuring the write stall duration due to database compaction
+	diskSizeGauge     metrics.Gauge        // Gauge for tracking the size of all the levels in the database
+	diskReadMeter     metrics.Meter        // Meter for measuring the effective amount of data read
+	diskWriteMeter    metrics.Meter        // Meter for measuring the effective amount of data written
+	readAmpGauge      metrics.GaugeFloat64 // Gauge for tracking the data read from the storage per data read
+	writeAmpGauge     metrics.GaugeFloat64 // Gauge for tracking the data written to the storage per data written
+	cacheHitMeter     metrics.Meter        // Meter for measuring the block cache hits
+	cacheMissMeter    metrics.Meter        // Meter for measuring the block cache misses
+	cacheUsageGauge   metrics.Gauge        // Gauge for tracking the memory size of the block cache entries
+	memTableSizeGauge metrics.Gauge        // Gauge for tracking the memory size of the memtables
+
+	quitLock sync.Mutex         // Mutex protecting the quit channel access
+	quitChan chan chan struct{} // Quit channel to stop the metrics collection before closing the database
+
+	log log.Logger // Contextual logger tracking the database path
 }
 
 type RDBIterator struct {
@@ -60,36 +125,184 @@ func b2c(b []byte) *C.char {
 	}
 }
 
-func New(file string, cache int, handles int, namespace string, readonly bool) (*RDBDatabase, error) {
+// New returns a wrapped RocksDB object with the given profile. The namespace
+// is the prefix that the metrics reporting should use for surfacing internal
+// stats.
+func New(file string, cache int, handles int, namespace string, readonly bool, cfg Config) (*RDBDatabase, error) {
 	var cerr *C.char
 
 	// null terminated c string
 	file0 := file + string(rune(0))
 
+	// Ensure we have some minimal caching and file guarantees
+	if cache < minCache {
+		cache = minCache
+	}
+	if handles < minHandles {
+		handles = minHandles
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	compression, _ := cfg.compressionLevels()
+	blockCache, writeBuffer := cache/2*1024*1024, cache/4*1024*1024
+	if cfg.WriteBufferSize > 0 {
+		writeBuffer = cfg.WriteBufferSize * 1024 * 1024
+	}
+	logger := log.New("database", file)
+	logCtx := []interface{}{"cache", common.StorageSize(blockCache + writeBuffer*2), "handles", handles}
+	if readonly {
+		logCtx = append(logCtx, "readonly", "true")
+	}
+	logger.Info("Allocated cache and file handles", logCtx...)
+
+	// block cache and bloom filters, the filter policy is owned by the
+	// table options, which are copied into opts
+	lru := C.rocksdb_cache_create_lru(C.size_t(blockCache))
+	topts := C.rocksdb_block_based_options_create()
+	C.rocksdb_block_based_options_set_block_cache(topts, lru)
+	C.rocksdb_block_based_options_set_filter_policy(topts, C.wemix_filterpolicy_create_bloom(bloomBitsPerKey))
+
 	opts := C.rocksdb_options_create()
 	C.rocksdb_options_set_create_if_missing(opts, 1)
 	C.rocksdb_options_set_max_open_files(opts, C.int(handles))
+	C.rocksdb_options_set_write_buffer_size(opts, C.size_t(writeBuffer))
+	C.rocksdb_options_set_block_based_table_factory(opts, topts)
+	C.rocksdb_block_based_options_destroy(topts)
+	C.rocksdb_options_enable_statistics(opts)
+	if len(compression) > 0 {
+		levels := make([]C.int, len(compression))
+		for i, t := range compression {
+			levels[i] = C.int(t)
+		}
+		C.rocksdb_options_set_compression_per_level(opts, &levels[0], C.size_t(len(levels)))
+	}
+	if cfg.MaxBackgroundJobs > 0 {
+		C.rocksdb_options_set_max_background_jobs(opts, C.int(cfg.MaxBackgroundJobs))
+	}
+	if cfg.RateLimit > 0 {
+		// opts shares the limiter
+		limiter := C.rocksdb_ratelimiter_create(C.int64_t(cfg.RateLimit)*1024*1024, rateLimiterRefillPeriod, rateLimiterFairness)
+		C.rocksdb_options_set_ratelimiter(opts, limiter)
+		C.rocksdb_ratelimiter_destroy(limiter)
+	}
 
 	wopts := C.rocksdb_writeoptions_create()
 	ropts := C.rocksdb_readoptions_create()
 
-	var db *C.rocksdb_t
-	if readonly {
+	// The column families are laid out when the database is created, the
+	// existing ones are opened as they are.
+	useCFs := cfg.ColumnFamilies
+	if existing := listColumnFamilies(opts, file0); existing != nil {
+		if useCFs && len(existing) == 1 {
+			logger.Warn("Column families are configured for new databases only, ignoring")
+		}
+		useCFs = len(existing) > 1
+	}
+	if useCFs && cfg.ColumnFamily == nil {
+		C.rocksdb_options_destroy(opts)
+		C.rocksdb_writeoptions_destroy(wopts)
+		C.rocksdb_readoptions_destroy(ropts)
+		C.rocksdb_cache_destroy(lru)
+		return nil, errors.New("no column family of keys given for a database with column families")
+	}
+
+	var (
+		db  *C.rocksdb_t
+		cfs []*C.rocksdb_column_family_handle_t
+		err error
+	)
+	if useCFs {
+		db, cfs, err = openColumnFamilies(opts, file0, readonly)
+		if err != nil {
+			C.rocksdb_options_destroy(opts)
+			C.rocksdb_writeoptions_destroy(wopts)
+			C.rocksdb_readoptions_destroy(ropts)
+			C.rocksdb_cache_destroy(lru)
+			return nil, err
+		}
+	} else if readonly {
 		db = C.rocksdb_open_for_read_only(opts, b2c([]byte(file0)), 0, &cerr)
 	} else {
 		db = C.rocksdb_open(opts, b2c([]byte(file0)), &cerr)
 	}
 	if cerr != nil {
 		C.rocksdb_options_destroy(opts)
+		C.rocksdb_writeoptions_destroy(wopts)
+		C.rocksdb_readoptions_destroy(ropts)
+		C.rocksdb_cache_destroy(lru)
 		return nil, cerror(cerr)
 	}
-	return &RDBDatabase{
+	rdb := &RDBDatabase{
 		fn:    file,
 		db:    db,
 		opts:  opts,
 		wopts: wopts,
 		ropts: ropts,
-	}, nil
+		cache: lru,
+		cfs:   cfs,
+		route: cfg.ColumnFamily,
+		log:   logger,
+	}
+	rdb.Meter(namespace)
+	return rdb, nil
+}
+
+// listColumnFamilies returns the column families of the database, or nil if
+// it doesn't exist yet.
+func listColumnFamilies(opts *C.rocksdb_options_t, file0 string) []string {
+	var (
+		cerr *C.char
+		n    C.size_t
+	)
+	cnames := C.rocksdb_list_column_families(opts, b2c([]byte(file0)), &n, &cerr)
+	if cerr != nil {
+		C.rocksdb_free(unsafe.Pointer(cerr))
+		return nil
+	}
+	defer C.rocksdb_list_column_families_destroy(cnames, n)
+	names := make([]string, int(n))
+	for i, cname := range (*[1 << 20]*C.char)(unsafe.Pointer(cnames))[:int(n):int(n)] {
+		names[i] = C.GoString(cname)
+	}
+	return names
+}
+
+// openColumnFamilies opens the database with the column families of
+// columnFamilyNames, creating the missing ones.
+func openColumnFamilies(opts *C.rocksdb_options_t, file0 string, readonly bool) (*C.rocksdb_t, []*C.rocksdb_column_family_handle_t, error) {
+	var (
+		cerr    *C.char
+		n       = len(columnFamilyNames)
+		names   = make([]*C.char, n)
+		cfopts  = make([]*C.rocksdb_options_t, n)
+		handles = make([]*C.rocksdb_column_family_handle_t, n)
+		db      *C.rocksdb_t
+	)
+	for i, name := range columnFamilyNames {
+		names[i] = C.CString(name)
+		defer C.free(unsafe.Pointer(names[i]))
+		cfopts[i] = opts
+	}
+	C.rocksdb_options_set_create_missing_column_families(opts, 1)
+	if readonly {
+		db = C.rocksdb_open_for_read_only_column_families(opts, b2c([]byte(file0)), C.int(n), &names[0], &cfopts[0], &handles[0], 0, &cerr)
+	} else {
+		db = C.rocksdb_open_column_families(opts, b2c([]byte(file0)), C.int(n), &names[0], &cfopts[0], &handles[0], &cerr)
+	}
+	if cerr != nil {
+		return nil, nil, cerror(cerr)
+	}
+	return db, handles, nil
+}
+
+// handle returns the column family of the key, or nil if column families are
+// not used.
+func (db *RDBDatabase) handle(key []byte) *C.rocksdb_column_family_handle_t {
+	if db.cfs == nil {
+		return nil
+	}
+	return db.cfs[db.route(key)]
 }
 
 func (db *RDBDatabase) Path() string {
@@ -103,8 +316,12 @@ func (db *RDBDatabase) Put(key []byte, value []byte) error {
 	}
 	var cerr *C.char
 	ck, cv := b2c(key), b2c(value)
-	C.rocksdb_put(db.db, db.wopts, ck, C.size_t(len(key)), cv, C.size_t(len(value)),
-		&cerr)
+	if cf := db.handle(key); cf != nil {
+		C.rocksdb_put_cf(db.db, db.wopts, cf, ck, C.size_t(len(key)), cv, C.size_t(len(value)), &cerr)
+	} else {
+		C.rocksdb_put(db.db, db.wopts, ck, C.size_t(len(key)), cv, C.size_t(len(value)),
+			&cerr)
+	}
 	if cerr != nil {
 		return cerror(cerr)
 	}
@@ -117,8 +334,7 @@ func (db *RDBDatabase) Has(key []byte) (bool, error) {
 	}
 	var cerr *C.char
 	var cvl C.size_t
-	ck := b2c(key)
-	cv := C.rocksdb_get(db.db, db.ropts, ck, C.size_t(len(key)), &cvl, &cerr)
+	cv := db.get(key, &cvl, &cerr)
 	if cerr != nil {
 		return false, cerror(cerr)
 	}
@@ -135,8 +351,7 @@ func (db *RDBDatabase) Get(key []byte) ([]byte, error) {
 	}
 	var cerr *C.char
 	var cvl C.size_t
-	ck := b2c(key)
-	cv := C.rocksdb_get(db.db, db.ropts, ck, C.size_t(len(key)), &cvl, &cerr)
+	cv := db.get(key, &cvl, &cerr)
 	if cerr != nil {
 		if _stats_enabled {
 			atomic.AddUint64(&_r_bytes, uint64(len(key)))
@@ -156,13 +371,26 @@ func (db *RDBDatabase) Get(key []byte) ([]byte, error) {
 	return C.GoBytes(unsafe.Pointer(cv), C.int(cvl)), nil
 }
 
+// get reads the key from its column family.
+func (db *RDBDatabase) get(key []byte, cvl *C.size_t, cerr **C.char) *C.char {
+	ck := b2c(key)
+	if cf := db.handle(key); cf != nil {
+		return C.rocksdb_get_cf(db.db, db.ropts, cf, ck, C.size_t(len(key)), cvl, cerr)
+	}
+	return C.rocksdb_get(db.db, db.ropts, ck, C.size_t(len(key)), cvl, cerr)
+}
+
 func (db *RDBDatabase) Delete(key []byte) error {
 	if _stats_enabled {
 		atomic.AddUint64(&_d_count, 1)
 	}
 	var cerr *C.char
 	ck := b2c(key)
-	C.rocksdb_delete(db.db, db.wopts, ck, C.size_t(len(key)), &cerr)
+	if cf := db.handle(key); cf != nil {
+		C.rocksdb_delete_cf(db.db, db.wopts, cf, ck, C.size_t(len(key)), &cerr)
+	} else {
+		C.rocksdb_delete(db.db, db.wopts, ck, C.size_t(len(key)), &cerr)
+	}
 	if cerr != nil {
 		return cerror(cerr)
 	}
@@ -194,32 +422,40 @@ func (db *RDBDatabase) NewIterator(prefix, start []byte) ethdb.Iterator {
 		upperBound = memdup(end)
 		C.rocksdb_readoptions_set_iterate_upper_bound(opts, upperBound, C.size_t(len(end)))
 	}
-	it := C.rocksdb_create_iterator(db.db, opts)
-	C.rocksdb_iter_seek_to_first(it)
-	rit := &RDBIterator{
-		it:    it,
-		opts:  opts,
-		first: true,
+	return db.newIterator(opts, lowerBound, upperBound)
+}
+
+// newIterator creates an iterator over all the column families with the read
+// options and the bounds, which are owned by the iterator.
+func (db *RDBDatabase) newIterator(opts *C.rocksdb_readoptions_t, lowerBound, upperBound *C.char) ethdb.Iterator {
+	if db.cfs == nil {
+		it := C.rocksdb_create_iterator(db.db, opts)
+		C.rocksdb_iter_seek_to_first(it)
+		return &RDBIterator{
+			it:         it,
+			opts:       opts,
+			first:      true,
+			lowerBound: lowerBound,
+			upperBound: upperBound,
+		}
 	}
-	if len(begin) > 0 {
-		rit.lowerBound = lowerBound
-		rit.upperBound = upperBound
+	its := make([]ethdb.Iterator, len(db.cfs))
+	for i, cf := range db.cfs {
+		it := C.rocksdb_create_iterator_cf(db.db, opts, cf)
+		C.rocksdb_iter_seek_to_first(it)
+		its[i] = &RDBIterator{it: it, first: true}
 	}
-	return rit
+	// the last one to be released frees the shared options and bounds
+	rit := its[len(its)-1].(*RDBIterator)
+	rit.opts, rit.lowerBound, rit.upperBound = opts, lowerBound, upperBound
+	return newMergedIterator(its)
 }
 
 func (db *RDBDatabase) NewIteratorWithStart(start []byte) ethdb.Iterator {
 	opts := C.rocksdb_readoptions_create()
 	lowerBound := memdup(start)
 	C.rocksdb_readoptions_set_iterate_lower_bound(opts, lowerBound, C.size_t(len(start)))
-	it := C.rocksdb_create_iterator(db.db, opts)
-	C.rocksdb_iter_seek_to_first(it)
-	return &RDBIterator{
-		it:         it,
-		opts:       opts,
-		first:      true,
-		lowerBound: lowerBound,
-	}
+	return db.newIterator(opts, lowerBound, nil)
 }
 
 func (db *RDBDatabase) NewIteratorWithPrefix(prefix []byte) ethdb.Iterator {
@@ -231,15 +467,7 @@ func (db *RDBDatabase) NewIteratorWithPrefix(prefix []byte) ethdb.Iterator {
 	C.rocksdb_readoptions_set_iterate_lower_bound(opts, lowerBound, C.size_t(len(start)))
 	upperBound := memdup(end)
 	C.rocksdb_readoptions_set_iterate_upper_bound(opts, upperBound, C.size_t(len(end)))
-	it := C.rocksdb_create_iterator(db.db, opts)
-	C.rocksdb_iter_seek_to_first(it)
-	return &RDBIterator{
-		it:         it,
-		opts:       opts,
-		first:      true,
-		lowerBound: lowerBound,
-		upperBound: upperBound,
-	}
+	return db.newIterator(opts, lowerBound, upperBound)
 }
 
 func incrBytes(bz []byte) []byte {
@@ -316,26 +544,218 @@ func (it *RDBIterator) Release() {
 	it.it, it.opts, it.lowerBound, it.upperBound = nil, nil, nil, nil
 }
 
+// Stat returns a particular internal stat of the database, i.e. the RocksDB
+// property, e.g. "rocksdb.stats" or "rocksdb.levelstats". "leveldb.stats" is
+// taken for "rocksdb.stats", being the default of debug_chaindbProperty.
 func (db *RDBDatabase) Stat(property string) (string, error) {
-	return "", errors.New("Not implemented")
+	if property == "leveldb.stats" {
+		property = "rocksdb.stats"
+	}
+	if !strings.HasPrefix(property, "rocksdb.") {
+		return "", errors.New("unknown property")
+	}
+	cp := C.CString(property)
+	defer C.free(unsafe.Pointer(cp))
+	cv := C.rocksdb_property_value(db.db, cp)
+	if cv == nil {
+		return "", errors.New("unknown property")
+	}
+	defer C.rocksdb_free(unsafe.Pointer(cv))
+	return C.GoString(cv), nil
+}
+
+// propertyInt returns an integer property of the database.
+func (db *RDBDatabase) propertyInt(property string) (uint64, bool) {
+	var v C.uint64_t
+	cp := C.CString(property)
+	defer C.free(unsafe.Pointer(cp))
+	if C.rocksdb_property_int(db.db, cp, &v) != 0 {
+		return 0, false
+	}
+	return uint64(v), true
+}
+
+// statistics returns the tickers and histograms of the database.
+func (db *RDBDatabase) statistics() map[string]map[string]float64 {
+	cs := C.rocksdb_options_statistics_get_string(db.opts)
+	if cs == nil {
+		return nil
+	}
+	defer C.rocksdb_free(unsafe.Pointer(cs))
+	return parseStatistics(C.GoString(cs))
 }
 
 func (db *RDBDatabase) Compact(start []byte, limit []byte) error {
 	cs, cl := b2c(start), b2c(limit)
-	C.rocksdb_compact_range(db.db, cs, C.size_t(len(start)), cl, C.size_t(len(limit)))
+	if db.cfs == nil {
+		C.rocksdb_compact_range(db.db, cs, C.size_t(len(start)), cl, C.size_t(len(limit)))
+	}
+	for _, cf := range db.cfs {
+		C.rocksdb_compact_range_cf(db.db, cf, cs, C.size_t(len(start)), cl, C.size_t(len(limit)))
+	}
 	return nil
 }
 
+// Checkpoint implements ethdb.Checkpointer, i.e. creates a RocksDB checkpoint
+// in the directory. The sst files are hard linked if the directory is on the
+// same file system, and copied otherwise.
+func (db *RDBDatabase) Checkpoint(dir string) error {
+	var cerr *C.char
+	cp := C.rocksdb_checkpoint_object_create(db.db, &cerr)
+	if cerr != nil {
+		return cerror(cerr)
+	}
+	defer C.rocksdb_checkpoint_object_destroy(cp)
+
+	cdir := C.CString(dir)
+	defer C.free(unsafe.Pointer(cdir))
+	// flush the memtables, so the checkpoint doesn't depend on the wal
+	C.rocksdb_checkpoint_create(cp, cdir, 0, &cerr)
+	return cerror(cerr)
+}
+
+// Close stops the metrics collection, flushes any pending data to disk and closes
+// all io accesses to the underlying key-value store.
 func (db *RDBDatabase) Close() error {
+	db.quitLock.Lock()
+	if db.quitChan != nil {
+		errc := make(chan struct{})
+		db.quitChan <- errc
+		<-errc
+		db.quitChan = nil
+	}
+	db.quitLock.Unlock()
+
+	for _, cf := range db.cfs {
+		C.rocksdb_column_family_handle_destroy(cf)
+	}
+	C.rocksdb_close(db.db)
 	C.rocksdb_options_destroy(db.opts)
 	C.rocksdb_writeoptions_destroy(db.wopts)
 	C.rocksdb_readoptions_destroy(db.ropts)
-	C.rocksdb_close(db.db)
+	C.rocksdb_cache_destroy(db.cache)
 	return nil
 }
 
+// Meter registers the metrics of the database with the prefix, and starts
+// collecting them periodically. It's called by New with the namespace, and
+// does nothing if the prefix is empty or the metrics are collected already.
 func (db *RDBDatabase) Meter(prefix string) {
-	return
+	db.quitLock.Lock()
+	defer db.quitLock.Unlock()
+
+	if prefix == "" || db.quitChan != nil {
+		return
+	}
+	db.compTimeMeter = metrics.NewRegisteredMeter(prefix+"compact/time", nil)
+	db.compReadMeter = metrics.NewRegisteredMeter(prefix+"compact/input", nil)
+	db.compWriteMeter = metrics.NewRegisteredMeter(prefix+"compact/output", nil)
+	db.compPendingGauge = metrics.NewRegisteredGauge(prefix+"compact/pending", nil)
+	db.compRunningGauge = metrics.NewRegisteredGauge(prefix+"compact/running", nil)
+	db.level0FilesGauge = metrics.NewRegisteredGauge(prefix+"compact/level0", nil)
+	db.writeDelayMeter = metrics.NewRegisteredMeter(prefix+"compact/writedelay/duration", nil)
+	db.writeDelayNMeter = metrics.NewRegisteredMeter(prefix+"compact/writedelay/counter", nil)
+	db.diskSizeGauge = metrics.NewRegisteredGauge(prefix+"disk/size", nil)
+	db.diskReadMeter = metrics.NewRegisteredMeter(prefix+"disk/read", nil)
+	db.diskWriteMeter = metrics.NewRegisteredMeter(prefix+"disk/write", nil)
+	db.readAmpGauge = metrics.NewRegisteredGaugeFloat64(prefix+"disk/readamp", nil)
+	db.writeAmpGauge = metrics.NewRegisteredGaugeFloat64(prefix+"disk/writeamp", nil)
+	db.cacheHitMeter = metrics.NewRegisteredMeter(prefix+"cache/hit", nil)
+	db.cacheMissMeter = metrics.NewRegisteredMeter(prefix+"cache/miss", nil)
+	db.cacheUsageGauge = metrics.NewRegisteredGauge(prefix+"cache/usage", nil)
+	db.memTableSizeGauge = metrics.NewRegisteredGauge(prefix+"memtable/size", nil)
+
+	db.quitChan = make(chan chan struct{})
+	go db.meter(metricsGatheringInterval)
+}
+
+// meter periodically retrieves internal rocksdb counters and reports them to
+// the metrics subsystem.
+//
+// The tickers are cumulative since the database is opened, so the meters are
+// marked with the differences from the previous values. The amplifications
+// are of the last interval:
+//   - read amplification: (data read by gets + compaction reads) / data read by gets
+//   - write amplification: (wal + flush + compaction writes) / data written
+func (db *RDBDatabase) meter(refresh time.Duration) {
+	var (
+		prev             map[string]map[string]float64
+		lastWriteStopped time.Time
+	)
+	delta := func(stats map[string]map[string]float64, name string, sum bool) float64 {
+		get := counter
+		if sum {
+			get = sumOf
+		}
+		return get(stats, name) - get(prev, name)
+	}
+	gauge := func(g metrics.Gauge, property string) uint64 {
+		v, ok := db.propertyInt(property)
+		if ok {
+			g.Update(int64(v))
+		}
+		return v
+	}
+
+	timer := time.NewTimer(refresh)
+	defer timer.Stop()
+
+	for {
+		stats := db.statistics()
+		if stats != nil {
+			db.compTimeMeter.Mark(int64(delta(stats, "rocksdb.compaction.times.micros", true) * 1000))
+			compRead := delta(stats, "rocksdb.compact.read.bytes", false)
+			compWrite := delta(stats, "rocksdb.compact.write.bytes", false)
+			db.compReadMeter.Mark(int64(compRead))
+			db.compWriteMeter.Mark(int64(compWrite))
+
+			db.writeDelayNMeter.Mark(int64(delta(stats, "rocksdb.db.write.stall", false)))
+			db.writeDelayMeter.Mark(int64(delta(stats, "rocksdb.db.write.stall", true) * 1000))
+
+			read := delta(stats, "rocksdb.bytes.read", false)
+			written := delta(stats, "rocksdb.bytes.written", false)
+			flushed := delta(stats, "rocksdb.flush.write.bytes", false)
+			logged := delta(stats, "rocksdb.wal.bytes", false)
+			db.diskReadMeter.Mark(int64(read + compRead))
+			db.diskWriteMeter.Mark(int64(logged + flushed + compWrite))
+			if read > 0 {
+				db.readAmpGauge.Update((read + compRead) / read)
+			}
+			if written > 0 {
+				db.writeAmpGauge.Update((logged + flushed + compWrite) / written)
+			}
+
+			db.cacheHitMeter.Mark(int64(delta(stats, "rocksdb.block.cache.hit", false)))
+			db.cacheMissMeter.Mark(int64(delta(stats, "rocksdb.block.cache.miss", false)))
+			prev = stats
+		}
+		gauge(db.diskSizeGauge, "rocksdb.total-sst-files-size")
+		gauge(db.compPendingGauge, "rocksdb.estimate-pending-compaction-bytes")
+		gauge(db.compRunningGauge, "rocksdb.num-running-compactions")
+		gauge(db.level0FilesGauge, "rocksdb.num-files-at-level0")
+		gauge(db.cacheUsageGauge, "rocksdb.block-cache-usage")
+		gauge(db.memTableSizeGauge, "rocksdb.cur-size-all-mem-tables")
+
+		// If a warning that writes are stopped has been displayed, any
+		// subsequent warnings will be withheld for one minute not to
+		// overwhelm the user.
+		if stopped, ok := db.propertyInt("rocksdb.is-write-stopped"); ok && stopped != 0 &&
+			time.Now().After(lastWriteStopped.Add(degradationWarnInterval)) {
+			db.log.Warn("Database compacting, degraded performance")
+			lastWriteStopped = time.Now()
+		}
+
+		// Sleep a bit, then repeat the stats collection
+		select {
+		case errc := <-db.quitChan:
+			// Quit requesting, stop hammering the database
+			close(errc)
+			return
+		case <-timer.C:
+			timer.Reset(refresh)
+			// Timeout, gather a new set of stats
+		}
+	}
 }
 
 func rdbBatchFinalizer(b *rdbBatch) {
@@ -352,7 +772,7 @@ func rdbBatchFinalizer(b *rdbBatch) {
 
 func (db *RDBDatabase) NewBatch() ethdb.Batch {
 	b := C.rocksdb_writebatch_create()
-	bb := &rdbBatch{db: db.db, b: b, wopts: db.wopts, data: nil}
+	bb := &rdbBatch{db: db.db, b: b, wopts: db.wopts, handle: db.handle, data: nil}
 	runtime.SetFinalizer(bb, rdbBatchFinalizer)
 	return bb
 }
@@ -364,11 +784,12 @@ type rdbBatchOp struct {
 }
 
 type rdbBatch struct {
-	db    *C.rocksdb_t
-	b     *C.rocksdb_writebatch_t
-	wopts *C.rocksdb_writeoptions_t
-	data  []*rdbBatchOp
-	size  int
+	db     *C.rocksdb_t
+	b      *C.rocksdb_writebatch_t
+	wopts  *C.rocksdb_writeoptions_t
+	handle func(key []byte) *C.rocksdb_column_family_handle_t
+	data   []*rdbBatchOp
+	size   int
 }
 
 func (b *rdbBatch) Put(key, value []byte) error {
@@ -377,7 +798,11 @@ func (b *rdbBatch) Put(key, value []byte) error {
 		atomic.AddUint64(&_w_bytes, uint64(len(key)+len(value)))
 	}
 	ck, cv := b2c(key), b2c(value)
-	C.rocksdb_writebatch_put(b.b, ck, C.size_t(len(key)), cv, C.size_t(len(value)))
+	if cf := b.handle(key); cf != nil {
+		C.rocksdb_writebatch_put_cf(b.b, cf, ck, C.size_t(len(key)), cv, C.size_t(len(value)))
+	} else {
+		C.rocksdb_writebatch_put(b.b, ck, C.size_t(len(key)), cv, C.size_t(len(value)))
+	}
 	b.data = append(b.data, &rdbBatchOp{del: false, key: key, value: value})
 	b.size += len(value)
 	return nil
@@ -387,7 +812,11 @@ func (b *rdbBatch) Delete(key []byte) error {
 	if _stats_enabled {
 		atomic.AddUint64(&_d_count, 1)
 	}
-	C.rocksdb_writebatch_delete(b.b, b2c(key), C.size_t(len(key)))
+	if cf := b.handle(key); cf != nil {
+		C.rocksdb_writebatch_delete_cf(b.b, cf, b2c(key), C.size_t(len(key)))
+	} else {
+		C.rocksdb_writebatch_delete(b.b, b2c(key), C.size_t(len(key)))
+	}
 	b.data = append(b.data, &rdbBatchOp{del: true, key: key, value: nil})
 	b.size += 1
 	return nil
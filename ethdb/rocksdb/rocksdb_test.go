@@ -31,8 +31,17 @@ type EphemeralRDB struct {
 	file string
 }
 
-func newEphemeralRDB(file string, cache int, handles int, namespace string, readonly bool) (*EphemeralRDB, error) {
-	rdb, err := New(file, cache, handles, namespace, readonly)
+// testColumnFamily puts the hash keys, i.e. trie nodes, in their own column
+// family, and the rest in the default one.
+func testColumnFamily(key []byte) int {
+	if len(key) == 32 {
+		return CFTrie
+	}
+	return CFDefault
+}
+
+func newEphemeralRDB(file string, cache int, handles int, namespace string, readonly bool, cfg Config) (*EphemeralRDB, error) {
+	rdb, err := New(file, cache, handles, namespace, readonly, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -101,11 +110,113 @@ func (db *EphemeralRDB) NewBatch() ethdb.Batch {
 func TestRocksDB(t *testing.T) {
 	t.Run("DatabaseSuite", func(t *testing.T) {
 		dbtest.TestDatabaseSuite(t, func() ethdb.KeyValueStore {
-			db, err := newEphemeralRDB("test", 1024, 1024, "test", false)
+			db, err := newEphemeralRDB("test", 1024, 1024, "test", false, DefaultConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return db
+		})
+	})
+}
+
+func TestRocksDBStat(t *testing.T) {
+	db, err := newEphemeralRDB(t.TempDir()+"/stat", 64, 64, "", false, DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := db.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, property := range []string{"rocksdb.stats", "leveldb.stats", "rocksdb.levelstats"} {
+		if stats, err := db.Stat(property); err != nil || stats == "" {
+			t.Errorf("%s: failed to get property: %q %v", property, stats, err)
+		}
+	}
+	if _, err := db.Stat("leveldb.iostats"); err == nil {
+		t.Errorf("unknown property didn't fail")
+	}
+	if capacity, ok := db.rdb.propertyInt("rocksdb.block-cache-capacity"); !ok || capacity != 32*1024*1024 {
+		t.Errorf("block cache capacity mismatch: have %d, want %d", capacity, 32*1024*1024)
+	}
+	if stats := db.rdb.statistics(); counter(stats, "rocksdb.bytes.written") == 0 {
+		t.Errorf("statistics not collected")
+	}
+}
+
+func TestRocksDBColumnFamilies(t *testing.T) {
+	cfg := Config{
+		Compression:       []string{"none", "lz4", "zstd"},
+		MaxBackgroundJobs: 2,
+		RateLimit:         16,
+		ColumnFamilies:    true,
+		ColumnFamily:      testColumnFamily,
+	}
+
+	t.Run("DatabaseSuite", func(t *testing.T) {
+		dbtest.TestDatabaseSuite(t, func() ethdb.KeyValueStore {
+			db, err := newEphemeralRDB(t.TempDir()+"/cf", 64, 64, "", false, cfg)
 			if err != nil {
 				t.Fatal(err)
 			}
 			return db
 		})
 	})
+
+	file := t.TempDir() + "/layout"
+	db, err := newEphemeralRDB(file, 64, 64, "", false, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trieKey := make([]byte, 32)
+	if err := db.Put(trieKey, []byte("node")); err != nil {
+		t.Fatal(err)
+	}
+	db.rdb.Close()
+
+	// the layout is kept regardless of the config, but needs the keys routed
+	if _, err = New(file, 64, 64, "", true, DefaultConfig); err == nil {
+		t.Fatalf("opened column families without routing the keys")
+	}
+	db, err = newEphemeralRDB(file, 64, 64, "", true, Config{ColumnFamily: testColumnFamily})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.rdb.Close()
+	if len(db.rdb.cfs) != len(columnFamilyNames) {
+		t.Fatalf("column families not opened: have %d, want %d", len(db.rdb.cfs), len(columnFamilyNames))
+	}
+	if v, err := db.Get(trieKey); err != nil || string(v) != "node" {
+		t.Errorf("trie node mismatch: have %q %v, want %q", v, err, "node")
+	}
+}
+
+func TestRocksDBCheckpoint(t *testing.T) {
+	db, err := newEphemeralRDB(t.TempDir()+"/db", 64, 64, "", false, DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := db.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir() + "/checkpoint"
+	if err := db.rdb.Checkpoint(dir); err != nil {
+		t.Fatalf("failed to create checkpoint: %v", err)
+	}
+	if err := db.Put([]byte("key"), []byte("changed")); err != nil {
+		t.Fatal(err)
+	}
+	cp, err := New(dir, 16, 16, "", true, DefaultConfig)
+	if err != nil {
+		t.Fatalf("failed to open checkpoint: %v", err)
+	}
+	defer cp.Close()
+	if v, err := cp.Get([]byte("key")); err != nil || string(v) != "value" {
+		t.Errorf("checkpoint value mismatch: have %q %v, want %q", v, err, "value")
+	}
+	if err := db.rdb.Checkpoint(dir); err == nil {
+		t.Errorf("checkpoint overwrote the existing directory")
+	}
 }
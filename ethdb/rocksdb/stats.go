@@ -0,0 +1,44 @@
+// stats.go
+
+package rocksdb
+
+import (
+	"strconv"
+	"strings"
+)
+
+// parseStatistics parses the statistics dump of RocksDB, i.e. the lines of
+// "<ticker> COUNT : <count>" and "<histogram> P50 : <p50> ... COUNT : <count>
+// SUM : <sum>", into a map of name -> field -> value.
+func parseStatistics(s string) map[string]map[string]float64 {
+	stats := make(map[string]map[string]float64)
+	for _, line := range strings.Split(s, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "rocksdb.") {
+			continue
+		}
+		values := make(map[string]float64)
+		for i := 1; i+2 < len(fields); i += 3 {
+			if fields[i+1] != ":" {
+				break
+			}
+			v, err := strconv.ParseFloat(fields[i+2], 64)
+			if err != nil {
+				break
+			}
+			values[fields[i]] = v
+		}
+		stats[fields[0]] = values
+	}
+	return stats
+}
+
+// counter returns the COUNT of a ticker or a histogram.
+func counter(stats map[string]map[string]float64, name string) float64 {
+	return stats[name]["COUNT"]
+}
+
+// sumOf returns the SUM of a histogram.
+func sumOf(stats map[string]map[string]float64, name string) float64 {
+	return stats[name]["SUM"]
+}
@@ -0,0 +1,36 @@
+// stats_test.go
+
+package rocksdb
+
+import (
+	"testing"
+)
+
+func TestParseStatistics(t *testing.T) {
+	stats := parseStatistics(`rocksdb.block.cache.miss COUNT : 12
+rocksdb.block.cache.hit COUNT : 345
+rocksdb.db.write.stall P50 : 1.500000 P95 : 0.000000 P99 : 0.000000 P100 : 9.000000 COUNT : 3 SUM : 17
+bogus line
+rocksdb.bytes.written COUNT : x
+`)
+	for _, tt := range []struct {
+		name  string
+		value float64
+	}{
+		{"rocksdb.block.cache.miss", 12},
+		{"rocksdb.block.cache.hit", 345},
+		{"rocksdb.db.write.stall", 3},
+		{"rocksdb.bytes.written", 0},
+		{"rocksdb.unknown", 0},
+	} {
+		if have := counter(stats, tt.name); have != tt.value {
+			t.Errorf("%s: count mismatch: have %v, want %v", tt.name, have, tt.value)
+		}
+	}
+	if have := sumOf(stats, "rocksdb.db.write.stall"); have != 17 {
+		t.Errorf("sum mismatch: have %v, want 17", have)
+	}
+	if have := stats["rocksdb.db.write.stall"]["P50"]; have != 1.5 {
+		t.Errorf("P50 mismatch: have %v, want 1.5", have)
+	}
+}
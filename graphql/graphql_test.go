@@ -138,6 +138,12 @@ func TestGraphQLBlockSerialization(t *testing.T) {
 			want: `{"data":{"block":{"estimateGas":53000}}}`,
 			code: 200,
 		},
+		// blocks without rewards should return an empty list
+		{
+			body: `{"query": "{block(number:0){ wemixRewards{address,amount} }}"}`,
+			want: `{"data":{"block":{"wemixRewards":[]}}}`,
+			code: 200,
+		},
 		// should return `status` as decimal
 		{
 			body: `{"query": "{block {number call (data : {from : \"0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b\", to: \"0x6295ee1b4f6dd65047762f924ecd367c17eabf8f\", data :\"0x12a7b914\"}){data status}}}"}`,
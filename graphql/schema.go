@@ -231,6 +231,75 @@ const schema string = `
         # EstimateGas estimates the amount of gas that will be required for
         # successful execution of a transaction at the current block's state.
         estimateGas(data: CallData!): Long!
+        # WemixFees is the sum of the transaction fees of this block.
+        wemixFees: BigInt
+        # WemixRewards is the list of the block rewards of this block.
+        wemixRewards: [WemixReward!]!
+        # WemixMiner is the governance node that mined this block, if any.
+        wemixMiner: WemixNode
+        # WemixMembers is the list of the governance members at this block.
+        wemixMembers: [WemixMember!]!
+        # WemixNodes is the list of the governance nodes at this block.
+        wemixNodes: [WemixNode!]!
+        # WemixEnvValues is the list of the governance environment variables
+        # at this block.
+        wemixEnvValues: [WemixEnvValue!]!
+        # WemixRewardParameters are the governance parameters the rewards of
+        # the next block are distributed by.
+        wemixRewardParameters: WemixRewardParameters!
+    }
+
+    # WemixReward is a block reward paid to an address.
+    type WemixReward {
+        # Address is the address the reward is paid to.
+        address: Address!
+        # Amount is the amount of the reward, in wei.
+        amount: BigInt!
+    }
+
+    # WemixMember is a governance member.
+    type WemixMember {
+        # Staker is the staking address of the member.
+        staker: Address!
+        # Voter is the voting address of the member.
+        voter: Address!
+        # Reward is the address the block rewards of the member are paid to.
+        reward: Address!
+        # Stake is the locked balance of the staker, in wei.
+        stake: BigInt!
+    }
+
+    # WemixNode is a governance node.
+    type WemixNode {
+        name: String!
+        # Enode is the node id in hex.
+        enode: String!
+        ip: String!
+        port: Int!
+        # Reward is the reward address of the member of the node.
+        reward: Address!
+    }
+
+    # WemixEnvValue is a governance environment variable.
+    type WemixEnvValue {
+        name: String!
+        value: BigInt!
+    }
+
+    # WemixRewardParameters are the governance parameters the block rewards
+    # are distributed by.
+    type WemixRewardParameters {
+        # BlockReward is the amount of the reward of a block, in wei.
+        blockReward: BigInt!
+        # DistributionMethod is the shares of the block producers, the
+        # staking reward, the ecosystem and the maintenance, out of 10000.
+        distributionMethod: [BigInt!]!
+        stakingReward: Address
+        ecosystem: Address
+        maintenance: Address
+        # Members is the list of the reward addresses of the members.
+        members: [Address!]!
+        blocksPer: Long!
     }
 
     # CallData represents the data associated with a local contract call.
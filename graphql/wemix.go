@@ -0,0 +1,253 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+)
+
+var errWemixNotRunning = errors.New("wemix governance is not available")
+
+// WemixReward is a block reward paid to an address.
+type WemixReward struct {
+	r *wemixapi.WemixReward
+}
+
+func (r *WemixReward) Address(ctx context.Context) common.Address {
+	return r.r.Addr
+}
+
+func (r *WemixReward) Amount(ctx context.Context) hexutil.Big {
+	return bigOrZero(r.r.Reward)
+}
+
+// WemixMember is a governance member.
+type WemixMember struct {
+	m *wemixapi.WemixGovMember
+}
+
+func (m *WemixMember) Staker(ctx context.Context) common.Address {
+	return m.m.Staker
+}
+
+func (m *WemixMember) Voter(ctx context.Context) common.Address {
+	return m.m.Voter
+}
+
+func (m *WemixMember) Reward(ctx context.Context) common.Address {
+	return m.m.Reward
+}
+
+func (m *WemixMember) Stake(ctx context.Context) hexutil.Big {
+	return bigOrZero(m.m.Stake)
+}
+
+// WemixNode is a governance node.
+type WemixNode struct {
+	n *wemixapi.WemixGovNode
+}
+
+func (n *WemixNode) Name(ctx context.Context) string {
+	return n.n.Name
+}
+
+func (n *WemixNode) Enode(ctx context.Context) string {
+	return n.n.Enode
+}
+
+func (n *WemixNode) Ip(ctx context.Context) string {
+	return n.n.Ip
+}
+
+func (n *WemixNode) Port(ctx context.Context) int32 {
+	return int32(n.n.Port)
+}
+
+func (n *WemixNode) Reward(ctx context.Context) common.Address {
+	return n.n.Reward
+}
+
+// WemixEnvValue is a governance environment variable.
+type WemixEnvValue struct {
+	name  string
+	value *big.Int
+}
+
+func (e *WemixEnvValue) Name(ctx context.Context) string {
+	return e.name
+}
+
+func (e *WemixEnvValue) Value(ctx context.Context) hexutil.Big {
+	return bigOrZero(e.value)
+}
+
+// WemixRewardParameters are the governance parameters the block rewards are
+// distributed by.
+type WemixRewardParameters struct {
+	p *wemixapi.WemixRewardParameters
+}
+
+func (p *WemixRewardParameters) BlockReward(ctx context.Context) hexutil.Big {
+	return bigOrZero(p.p.BlockReward)
+}
+
+func (p *WemixRewardParameters) DistributionMethod(ctx context.Context) []hexutil.Big {
+	ret := make([]hexutil.Big, len(p.p.DistributionMethod))
+	for i, v := range p.p.DistributionMethod {
+		ret[i] = bigOrZero(v)
+	}
+	return ret
+}
+
+func (p *WemixRewardParameters) StakingReward(ctx context.Context) *common.Address {
+	return p.p.StakingReward
+}
+
+func (p *WemixRewardParameters) Ecosystem(ctx context.Context) *common.Address {
+	return p.p.Ecosystem
+}
+
+func (p *WemixRewardParameters) Maintenance(ctx context.Context) *common.Address {
+	return p.p.Maintenance
+}
+
+func (p *WemixRewardParameters) Members(ctx context.Context) []common.Address {
+	ret := make([]common.Address, 0, len(p.p.Members))
+	for _, addr := range p.p.Members {
+		if addr != nil {
+			ret = append(ret, *addr)
+		}
+	}
+	return ret
+}
+
+func (p *WemixRewardParameters) BlocksPer(ctx context.Context) Long {
+	return Long(p.p.BlocksPer)
+}
+
+func bigOrZero(v *big.Int) hexutil.Big {
+	if v == nil {
+		return hexutil.Big{}
+	}
+	return hexutil.Big(*v)
+}
+
+func (b *Block) WemixFees(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if header.Fees == nil {
+		return nil, nil
+	}
+	return (*hexutil.Big)(header.Fees), nil
+}
+
+func (b *Block) WemixRewards(ctx context.Context) ([]*WemixReward, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := wemixapi.BlockRewards(header)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*WemixReward, 0, len(rewards.Rewards))
+	for _, r := range rewards.Rewards {
+		ret = append(ret, &WemixReward{r})
+	}
+	return ret, nil
+}
+
+func (b *Block) WemixMiner(ctx context.Context) (*WemixNode, error) {
+	if wemixapi.GetBlockMiner == nil {
+		return nil, errWemixNotRunning
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	miner, err := wemixapi.GetBlockMiner(ctx, header)
+	if err != nil || miner.Node == nil {
+		return nil, err
+	}
+	return &WemixNode{miner.Node}, nil
+}
+
+func (b *Block) WemixMembers(ctx context.Context) ([]*WemixMember, error) {
+	if wemixapi.GetMembers == nil {
+		return nil, errWemixNotRunning
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, err := wemixapi.GetMembers(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*WemixMember, 0, len(members))
+	for _, m := range members {
+		ret = append(ret, &WemixMember{m})
+	}
+	return ret, nil
+}
+
+func (b *Block) WemixNodes(ctx context.Context) ([]*WemixNode, error) {
+	if wemixapi.GetNodes == nil {
+		return nil, errWemixNotRunning
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := wemixapi.GetNodes(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*WemixNode, 0, len(nodes))
+	for _, n := range nodes {
+		ret = append(ret, &WemixNode{n})
+	}
+	return ret, nil
+}
+
+func (b *Block) WemixEnvValues(ctx context.Context) ([]*WemixEnvValue, error) {
+	if wemixapi.GetEnvValues == nil {
+		return nil, errWemixNotRunning
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values, err := wemixapi.GetEnvValues(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*WemixEnvValue, 0, len(values))
+	for name, v := range values {
+		ret = append(ret, &WemixEnvValue{name: name, value: v})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].name < ret[j].name })
+	return ret, nil
+}
+
+func (b *Block) WemixRewardParameters(ctx context.Context) (*WemixRewardParameters, error) {
+	if wemixapi.GetRewardParameters == nil {
+		return nil, errWemixNotRunning
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := wemixapi.GetRewardParameters(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	return &WemixRewardParameters{p}, nil
+}
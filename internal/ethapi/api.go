@@ -2044,7 +2044,7 @@ func NewPrivateDebugAPI(b Backend) *PrivateDebugAPI {
 func (api *PrivateDebugAPI) ChaindbProperty(property string) (string, error) {
 	if property == "" {
 		property = "leveldb.stats"
-	} else if !strings.HasPrefix(property, "leveldb.") {
+	} else if !strings.HasPrefix(property, "leveldb.") && !strings.HasPrefix(property, "rocksdb.") && !strings.HasPrefix(property, "pebble.") {
 		property = "leveldb." + property
 	}
 	return api.b.ChainDb().Stat(property)
@@ -30,6 +30,7 @@ var Modules = map[string]string{
 	"txpool":   TxpoolJs,
 	"les":      LESJs,
 	"vflux":    VfluxJs,
+	"wemix":    WemixJs,
 }
 
 const CliqueJs = `
@@ -141,6 +142,11 @@ web3._extend({
 			call: 'admin_removeTrustedPeer',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'backupChainData',
+			call: 'admin_backupChainData',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'exportChain',
 			call: 'admin_exportChain',
@@ -206,48 +212,26 @@ web3._extend({
 			inputFormatter: [null, null]
 		}),
 		new web3._extend.Method({
-			name: 'requestEtcdAddMember',
-			call: 'admin_requestEtcdAddMember',
-			params: 1
-		}),
-		new web3._extend.Method({
-			name: 'etcdInit',
-			call: 'admin_etcdInit',
-			params: 0,
-		}),
-		new web3._extend.Method({
-			name: 'etcdAddMember',
-			call: 'admin_etcdAddMember',
-			params: 1,
-			inputFormatter: [null]
-		}),
-		new web3._extend.Method({
-			name: 'etcdRemoveMember',
-			call: 'admin_etcdRemoveMember',
-			params: 1,
-			inputFormatter: [null]
-		}),
-		new web3._extend.Method({
-			name: 'etcdJoin',
-			call: 'admin_etcdJoin',
+			name: 'raftMoveLeader',
+			call: 'admin_raftMoveLeader',
 			params: 1,
 			inputFormatter: [null]
 		}),
 		new web3._extend.Method({
-			name: 'etcdMoveLeader',
-			call: 'admin_etcdMoveLeader',
-			params: 1,
-			inputFormatter: [null]
+			name: 'raftGetWork',
+			call: 'admin_raftGetWork',
+			params: 0
 		}),
 		new web3._extend.Method({
-			name: 'etcdGetWork',
-			call: 'admin_etcdGetWork',
+			name: 'raftDeleteWork',
+			call: 'admin_raftDeleteWork',
 			params: 0
 		}),
 		new web3._extend.Method({
-			name: 'etcdDeleteWork',
-			call: 'admin_etcdDeleteWork',
-			params: 0
+			name: 'verifyBlockRewards',
+			call: 'admin_verifyBlockRewards',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 	],
 	properties: [
@@ -936,3 +920,64 @@ web3._extend({
 	]
 });
 `
+
+const WemixJs = `
+web3._extend({
+	property: 'wemix',
+	methods: [
+		new web3._extend.Method({
+			name: 'getFinalityProof',
+			call: 'wemix_getFinalityProof',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getMembers',
+			call: 'wemix_getMembers',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getNodes',
+			call: 'wemix_getNodes',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getEnvValues',
+			call: 'wemix_getEnvValues',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getRewardParameters',
+			call: 'wemix_getRewardParameters',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getMinerForBlock',
+			call: 'wemix_getMinerForBlock',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getRewardsByBlock',
+			call: 'wemix_getRewardsByBlock',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getRewardHistory',
+			call: 'wemix_getRewardHistory',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getRewardTotal',
+			call: 'wemix_getRewardTotal',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+	]
+});
+`
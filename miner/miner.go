@@ -54,6 +54,8 @@ type Config struct {
 	GasPrice   *big.Int       // Minimum gas price for mining a transaction
 	Recommit   time.Duration  // The time interval for miner to re-create mining work.
 	Noverify   bool           // Disable remote mining solution verification(only useful in ethash).
+
+	MaxTxsPerBlock int `toml:",omitempty"` // Max # of transactions in a block overriding the chain config's if set
 }
 
 // Miner creates blocks and searches for proof-of-work values.
@@ -926,6 +926,10 @@ func (w *worker) commitTransaction(env *environment, tx *types.Transaction) ([]*
 
 func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByPriceAndNonce, interrupt *int32, tstart *time.Time, committedTxs map[common.Hash]*types.Transaction) bool {
 	gasLimit := env.header.GasLimit
+	wemixParams := w.chainConfig.Wemix.Params(env.header.Number)
+	if w.config.MaxTxsPerBlock != 0 {
+		wemixParams.MaxTxsPerBlock = w.config.MaxTxsPerBlock
+	}
 	if env.gasPool == nil {
 		env.gasPool = new(core.GasPool).AddGas(gasLimit)
 	}
@@ -963,11 +967,11 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 			break
 		}
 		// Break if it has enough transactions
-		if params.MaxTxsPerBlock > 0 && env.tcount >= params.MaxTxsPerBlock {
+		if wemixParams.MaxTxsPerBlock > 0 && env.tcount >= wemixParams.MaxTxsPerBlock {
 			break
 		}
 		// Break if it took too long
-		if tstart != nil && env.till != nil && time.Until(*env.till) <= 0 && len(committedTxs) >= int(params.BlockMinBuildTxs) {
+		if tstart != nil && env.till != nil && time.Until(*env.till) <= 0 && len(committedTxs) >= int(wemixParams.BlockMinBuildTxs) {
 			break
 		}
 		// mark it processed
@@ -1051,6 +1055,7 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 
 func (w *worker) commitTransactionsSimple(env *environment, txs *TxOrderer, interrupt *int32, tstart *time.Time) bool {
 	gasLimit := env.header.GasLimit
+	wemixParams := w.chainConfig.Wemix.Params(env.header.Number)
 	if env.gasPool == nil {
 		env.gasPool = new(core.GasPool).AddGas(gasLimit)
 	}
@@ -1091,7 +1096,7 @@ func (w *worker) commitTransactionsSimple(env *environment, txs *TxOrderer, inte
 			break
 		}
 		// Break if it took too long
-		if tstart != nil && env.till != nil && time.Until(*env.till) <= 0 && txs.CommittedLength() >= int(params.BlockMinBuildTxs) {
+		if tstart != nil && env.till != nil && time.Until(*env.till) <= 0 && txs.CommittedLength() >= int(wemixParams.BlockMinBuildTxs) {
 			break
 		}
 		// mark it processed
@@ -1352,6 +1357,9 @@ func (w *worker) prepareWork(genParams *generateParams) (*environment, error) {
 	}
 	if !wemixminer.IsPoW() {
 		header.GasLimit = core.CalcGasLimit(parent.GasLimit(), blockGasLimit.Uint64())
+		if fixed := w.chainConfig.Wemix.Params(num).FixedGasLimit; fixed != 0 {
+			header.GasLimit = fixed
+		}
 	}
 	// Set the randomness field from the beacon chain if it's available.
 	if genParams.random != (common.Hash{}) {
@@ -1466,6 +1474,9 @@ func (w *worker) refreshPending(locked bool) {
 	}
 	if !wemixminer.IsPoW() {
 		header.GasLimit = core.CalcGasLimit(parent.GasLimit(), blockGasLimit.Uint64())
+		if fixed := w.chainConfig.Wemix.Params(num).FixedGasLimit; fixed != 0 {
+			header.GasLimit = fixed
+		}
 	}
 	header.Coinbase = w.coinbase
 	// Set baseFee and GasLimit if we are on an EIP-1559 chain
@@ -1511,6 +1522,7 @@ func (w *worker) timeIt(blockInterval int64) (timestamp uint64, till time.Time)
 	parent := w.chain.CurrentBlock()
 	num := parent.Number()
 	num.Add(num, common.Big1)
+	wemixParams := w.chainConfig.Wemix.Params(num)
 	now := time.Now()
 	nowInSeconds := now.Unix()
 	nowInMilliSeconds := now.UnixNano() / 1e6 // convert to millisecond
@@ -1548,8 +1560,8 @@ func (w *worker) timeIt(blockInterval int64) (timestamp uint64, till time.Time)
 		if offset > 0 {
 			ahead++
 		}
-		adjBlocks := params.BlockTimeAdjBlocks
-		for i := int64(0); i < params.BlockTimeAdjMultiple; i++ {
+		adjBlocks := wemixParams.BlockTimeAdjBlocks
+		for i := int64(0); i < wemixParams.BlockTimeAdjMultiple; i++ {
 			offset, height, _, dt = check(adjBlocks)
 			log.Debug("time-it", "round", adjBlocks, "offset", offset, "height", height, "dt", dt)
 			if offset < 0 {
@@ -1569,7 +1581,7 @@ func (w *worker) timeIt(blockInterval int64) (timestamp uint64, till time.Time)
 	}
 	switch offset {
 	case -1: // behind, i.e. too few blocks so far, need to make more
-		tms := nowInMilliSeconds + params.BlockMinBuildTime
+		tms := nowInMilliSeconds + wemixParams.BlockMinBuildTime
 		if tms/1000 <= int64(parent.Time()) {
 			// make sure that no more than 2 blocks have the same timestamp
 			tms = (nowInSeconds + 1) * 1000
@@ -1577,18 +1589,18 @@ func (w *worker) timeIt(blockInterval int64) (timestamp uint64, till time.Time)
 		till = time.Unix(tms/1e3, (tms%1e3)*1e6)
 		log.Debug("time-it", "behind", timestamp, "duration", tms-nowInMilliSeconds)
 	case 1: // ahead, i.e. too many blocks, need to slow down
-		tms := nowInMilliSeconds + blockInterval*1000 + params.BlockMinBuildTime
+		tms := nowInMilliSeconds + blockInterval*1000 + wemixParams.BlockMinBuildTime
 		if tms/1000 > nowInSeconds+blockInterval {
 			// make sure time stamp doesn't jump by blockInterval + 2
-			tms = (nowInSeconds+blockInterval+1)*1000 - params.BlockTrailTime
+			tms = (nowInSeconds+blockInterval+1)*1000 - wemixParams.BlockTrailTime
 		}
 		till = time.Unix(tms/1e3, (tms%1e3)*1e6)
 		log.Debug("time-it", "ahead", timestamp, "duration", tms-nowInMilliSeconds)
 	default: // on schedule
-		tms := nowInMilliSeconds + blockInterval*1000 - params.BlockTrailTime
+		tms := nowInMilliSeconds + blockInterval*1000 - wemixParams.BlockTrailTime
 		if tms/1000 > nowInSeconds+1 {
 			// make sure time stamp doesn't jump by 2
-			tms = (nowInSeconds+2)*1000 - params.BlockTrailTime
+			tms = (nowInSeconds+2)*1000 - wemixParams.BlockTrailTime
 		}
 		till = time.Unix(tms/1e3, (tms%1e3)*1e6)
 		log.Debug("time-it", "on-schedule", timestamp, "duration", tms-nowInMilliSeconds)
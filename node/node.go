@@ -566,7 +566,7 @@ func (n *Node) OpenDatabase(name string, cache, handles int, namespace string, r
 	if n.config.DataDir == "" {
 		db = rawdb.NewMemoryDatabase()
 	} else {
-		db, err = rawdb.NewDB(n.ResolvePath(name), cache, handles, namespace, readonly)
+		db, err = rawdb.NewDB(n.ResolvePath(name), cache, handles, namespace, readonly, n.config.RocksDB)
 	}
 
 	if err == nil {
@@ -599,7 +599,7 @@ func (n *Node) OpenDatabaseWithFreezer(name string, cache, handles int, freezer,
 		case !filepath.IsAbs(freezer):
 			freezer = n.ResolvePath(freezer)
 		}
-		db, err = rawdb.NewDBWithFreezer(root, cache, handles, freezer, namespace, readonly)
+		db, err = rawdb.NewDBWithFreezer(root, cache, handles, freezer, namespace, readonly, n.config.RocksDB)
 	}
 
 	if err == nil {
@@ -628,6 +628,15 @@ func (db *closeTrackingDB) Close() error {
 	return db.Database.Close()
 }
 
+// Checkpoint implements ethdb.Checkpointer if the database does.
+func (db *closeTrackingDB) Checkpoint(dir string) error {
+	cp, ok := db.Database.(ethdb.Checkpointer)
+	if !ok {
+		return errors.New("database doesn't support checkpoints")
+	}
+	return cp.Checkpoint(dir)
+}
+
 // wrapDatabase ensures the database will be auto-closed when Node is closed.
 func (n *Node) wrapDatabase(db ethdb.Database) ethdb.Database {
 	wrapper := &closeTrackingDB{db, n}
@@ -32,6 +32,14 @@ const (
 	// considered probably final and its rotated bits are calculated.
 	BloomConfirms = 256
 
+	// RewardIndexBlocks is the number of blocks a single reward index section
+	// contains.
+	RewardIndexBlocks uint64 = 4096
+
+	// RewardIndexConfirms is the number of confirmation blocks before a reward
+	// index section is considered probably final and indexed.
+	RewardIndexConfirms = 256
+
 	// CHTFrequency is the block frequency for creating CHTs
 	CHTFrequency = 32768
 
@@ -173,31 +173,18 @@ var (
 const (
 	ConsensusInvalid int = iota
 	ConsensusPoW
-	ConsensusPoA
-	ConsensusETCD
-	ConsensusPBFT
+	ConsensusETCD // the raft leader mines, the only mode before ConsensusPoA
+	ConsensusPoA  // the governance nodes take turns
+	ConsensusPBFT // ConsensusPoA with finality votes
 	ConsensusMax
 )
 
-// wemix parameters
+// wemix parameters, local to the node. The consensus parameters are in
+// WemixConfig of the chain config.
 var (
-	ConsensusMethod      int    = ConsensusPoW // consensus method
-	FixedDifficulty      uint64 = 1            // 0 means no fixed difficulty
-	FixedGasLimit        uint64 = 0            // 0 means no fixed gas limit,
-	MaxIdleBlockInterval uint64 = 600          // in seconds
-	BlocksPerTurn        uint64 = 100
-	DropUnderPriced      bool   = true // drop underpriced transactions
-
-	NonceLimit     uint64 = 0    // nonce limit for non-governing accounts
-	UseRocksDb     int    = 1    // LevelDB (0) or RocksDB (1)
-	PrefetchCount  int    = 0    // Transaction Prefetch count for faster db read
-	MaxTxsPerBlock int    = 5000 // Max # of transactions in a block
-	Hub            string = ""   // Hub's id
-
-	BlockInterval        int64 = 1    // Block generation interval in seconds
-	BlockTimeAdjBlocks   int64 = 120  // Block interval to adjust timestamp
-	BlockTimeAdjMultiple int64 = 4    // How many of block intervals to consider
-	BlockMinBuildTime    int64 = 300  // Minimum block generation time in ms
-	BlockMinBuildTxs     int64 = 2500 // Minimum txs in a block with pending txs
-	BlockTrailTime       int64 = 300  // Time to leave for block data transfer transfer in ms
+	ConsensusMethod int  = ConsensusPoW // consensus method flag, the chain config's takes precedence
+	DropUnderPriced bool = true         // drop underpriced transactions
+
+	UseRocksDb    int = 1 // LevelDB (0), RocksDB (1) or Pebble (2)
+	PrefetchCount int = 0 // Transaction Prefetch count for faster db read
 )
@@ -2,6 +2,12 @@
 
 package params
 
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
 var (
 	WemixMainnetBootnodes = []string{
 		"enode://bf5938431b3383742b3931b58e49af53004ec2a7d4ec78f42e1df1ba3c87bb49669c260943a02550e1b62c4047dc52136a3d9c842734f2045f3b2ee3203b95de@13.230.188.119:8589",
@@ -21,6 +27,200 @@ var (
 	}
 
 	WemixGenesisFile string
+
+	// DefaultWemixParams are the wemix parameters in effect if not set in
+	// the chain config.
+	DefaultWemixParams = WemixParams{
+		BlocksPerTurn:        100,
+		MaxIdleBlockInterval: 600,
+		BlockInterval:        1,
+		BlockTimeAdjBlocks:   120,
+		BlockTimeAdjMultiple: 4,
+		BlockMinBuildTime:    300,
+		BlockMinBuildTxs:     2500,
+		BlockTrailTime:       300,
+		MaxTxsPerBlock:       5000,
+		FinalityInterval:     10,
+		MinerTurnTimeout:     5,
+	}
 )
 
+// WemixConfig is the consensus engine configs for governance based sealing.
+// The parameters not set fall back to DefaultWemixParams, and can be changed
+// at later blocks with Forks.
+type WemixConfig struct {
+	ConsensusMethod int `json:"consensusMethod,omitempty"` // ConsensusETCD (default), ConsensusPoA or ConsensusPBFT
+
+	// StrictGovernanceBlock is the block from which failures to read the
+	// governance and invalid reward distributions reject the blocks. Before
+	// it, all the rewards go to the coinbase then, as they always did.
+	StrictGovernanceBlock *big.Int `json:"strictGovernanceBlock,omitempty"`
+
+	WemixParams
+	Forks []*WemixFork `json:"forks,omitempty"` // in ascending order of blocks
+}
+
+// WemixParams are the wemix parameters in effect at a block.
+type WemixParams struct {
+	BlocksPerTurn        uint64 `json:"blocksPerTurn,omitempty"`        // # of blocks a miner generates in its turn
+	MaxIdleBlockInterval uint64 `json:"maxIdleBlockInterval,omitempty"` // Max seconds between blocks without transactions
+	BlockInterval        int64  `json:"blockInterval,omitempty"`        // Block generation interval in seconds until governance is established
+	BlockTimeAdjBlocks   int64  `json:"blockTimeAdjBlocks,omitempty"`   // Block interval to adjust timestamp
+	BlockTimeAdjMultiple int64  `json:"blockTimeAdjMultiple,omitempty"` // How many of block intervals to consider
+	BlockMinBuildTime    int64  `json:"blockMinBuildTime,omitempty"`    // Minimum block generation time in ms
+	BlockMinBuildTxs     int64  `json:"blockMinBuildTxs,omitempty"`     // Minimum txs in a block with pending txs
+	BlockTrailTime       int64  `json:"blockTrailTime,omitempty"`       // Time to leave for block data transfer in ms
+	MaxTxsPerBlock       int    `json:"maxTxsPerBlock,omitempty"`       // Max # of transactions in a block
+	NonceLimit           uint64 `json:"nonceLimit,omitempty"`           // Nonce limit for non-governing accounts, 0 means no limit
+	FixedGasLimit        uint64 `json:"fixedGasLimit,omitempty"`        // Fixed block gas limit, 0 means no fixed gas limit
+	FinalityInterval     uint64 `json:"finalityInterval,omitempty"`     // Block interval of finality votes with ConsensusPBFT
+	MinerTurnTimeout     uint64 `json:"minerTurnTimeout,omitempty"`     // Seconds after the parent block for each next node in turn to take over with ConsensusPoA
+}
+
+// WemixFork changes the parameters set, i.e. non-zero, from Block on.
+type WemixFork struct {
+	Block *big.Int `json:"block"`
+	WemixParams
+}
+
+// String implements the stringer interface, returning the consensus engine details.
+func (c *WemixConfig) String() string {
+	return "wemix"
+}
+
+// Consensus returns the consensus method of the chain.
+func (c *WemixConfig) Consensus() int {
+	if c == nil || c.ConsensusMethod == ConsensusInvalid {
+		return ConsensusETCD
+	}
+	return c.ConsensusMethod
+}
+
+// IsStrictGovernance returns whether num is past the StrictGovernanceBlock.
+func (c *WemixConfig) IsStrictGovernance(num *big.Int) bool {
+	return c != nil && isForked(c.StrictGovernanceBlock, num)
+}
+
+// Params returns the wemix parameters in effect at block num. It's safe to
+// call on a nil config, which gives DefaultWemixParams.
+func (c *WemixConfig) Params(num *big.Int) *WemixParams {
+	p := DefaultWemixParams
+	if c == nil {
+		return &p
+	}
+	p.override(&c.WemixParams)
+	for _, fork := range c.Forks {
+		if !isForked(fork.Block, num) {
+			break
+		}
+		p.override(&fork.WemixParams)
+	}
+	return &p
+}
+
+// override replaces the parameters with the ones set in q.
+func (p *WemixParams) override(q *WemixParams) {
+	if q.BlocksPerTurn != 0 {
+		p.BlocksPerTurn = q.BlocksPerTurn
+	}
+	if q.MaxIdleBlockInterval != 0 {
+		p.MaxIdleBlockInterval = q.MaxIdleBlockInterval
+	}
+	if q.BlockInterval != 0 {
+		p.BlockInterval = q.BlockInterval
+	}
+	if q.BlockTimeAdjBlocks != 0 {
+		p.BlockTimeAdjBlocks = q.BlockTimeAdjBlocks
+	}
+	if q.BlockTimeAdjMultiple != 0 {
+		p.BlockTimeAdjMultiple = q.BlockTimeAdjMultiple
+	}
+	if q.BlockMinBuildTime != 0 {
+		p.BlockMinBuildTime = q.BlockMinBuildTime
+	}
+	if q.BlockMinBuildTxs != 0 {
+		p.BlockMinBuildTxs = q.BlockMinBuildTxs
+	}
+	if q.BlockTrailTime != 0 {
+		p.BlockTrailTime = q.BlockTrailTime
+	}
+	if q.MaxTxsPerBlock != 0 {
+		p.MaxTxsPerBlock = q.MaxTxsPerBlock
+	}
+	if q.NonceLimit != 0 {
+		p.NonceLimit = q.NonceLimit
+	}
+	if q.FixedGasLimit != 0 {
+		p.FixedGasLimit = q.FixedGasLimit
+	}
+	if q.FinalityInterval != 0 {
+		p.FinalityInterval = q.FinalityInterval
+	}
+	if q.MinerTurnTimeout != 0 {
+		p.MinerTurnTimeout = q.MinerTurnTimeout
+	}
+}
+
+// checkForkOrder checks that the forks are scheduled in ascending order.
+func (c *WemixConfig) checkForkOrder() error {
+	if c == nil {
+		return nil
+	}
+	switch c.ConsensusMethod {
+	case ConsensusInvalid, ConsensusPoA, ConsensusETCD, ConsensusPBFT:
+	default:
+		return fmt.Errorf("unsupported wemix consensus method %d", c.ConsensusMethod)
+	}
+	for i, fork := range c.Forks {
+		if fork.Block == nil {
+			return fmt.Errorf("wemix fork %d has no block", i)
+		}
+		if i > 0 && c.Forks[i-1].Block.Cmp(fork.Block) >= 0 {
+			return fmt.Errorf("unsupported wemix fork ordering: fork %d at %v, but fork %d at %v",
+				i-1, c.Forks[i-1].Block, i, fork.Block)
+		}
+	}
+	return nil
+}
+
+// checkCompatible checks that the parameters in effect up to head don't
+// change, i.e. all the blocks so far are still valid.
+func (c *WemixConfig) checkCompatible(newcfg *WemixConfig, head *big.Int) *ConfigCompatError {
+	genesis := new(big.Int)
+	if c.Consensus() != newcfg.Consensus() {
+		return newCompatError("Wemix consensus method", genesis, genesis)
+	}
+	var stored, next *big.Int
+	if c != nil {
+		stored = c.StrictGovernanceBlock
+	}
+	if newcfg != nil {
+		next = newcfg.StrictGovernanceBlock
+	}
+	if isForkIncompatible(stored, next, head) {
+		return newCompatError("Wemix strict governance fork block", stored, next)
+	}
+	// the parameters can change only at genesis or at one of the forks
+	blocks := []*big.Int{genesis}
+	for _, cfg := range []*WemixConfig{c, newcfg} {
+		if cfg == nil {
+			continue
+		}
+		for _, fork := range cfg.Forks {
+			if isForked(fork.Block, head) {
+				blocks = append(blocks, fork.Block)
+			}
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Cmp(blocks[j]) < 0
+	})
+	for _, block := range blocks {
+		if *c.Params(block) != *newcfg.Params(block) {
+			return newCompatError("Wemix parameters", block, block)
+		}
+	}
+	return nil
+}
+
 // EOF
@@ -0,0 +1,80 @@
+// wemix_config_test.go
+
+package params
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestWemixParams(t *testing.T) {
+	var nilConfig *WemixConfig
+	if p := nilConfig.Params(big.NewInt(100)); *p != DefaultWemixParams {
+		t.Fatalf("nil config: have %+v, want defaults %+v", *p, DefaultWemixParams)
+	}
+
+	config := &WemixConfig{
+		WemixParams: WemixParams{BlocksPerTurn: 10},
+		Forks: []*WemixFork{
+			{Block: big.NewInt(100), WemixParams: WemixParams{MaxTxsPerBlock: 1000, NonceLimit: 5}},
+			{Block: big.NewInt(200), WemixParams: WemixParams{BlocksPerTurn: 20}},
+		},
+	}
+	tests := []struct {
+		num            int64
+		blocksPerTurn  uint64
+		maxTxsPerBlock int
+		nonceLimit     uint64
+	}{
+		{0, 10, DefaultWemixParams.MaxTxsPerBlock, 0},
+		{99, 10, DefaultWemixParams.MaxTxsPerBlock, 0},
+		{100, 10, 1000, 5},
+		{200, 20, 1000, 5},
+		{1000, 20, 1000, 5},
+	}
+	for _, tt := range tests {
+		p := config.Params(big.NewInt(tt.num))
+		if p.BlocksPerTurn != tt.blocksPerTurn || p.MaxTxsPerBlock != tt.maxTxsPerBlock || p.NonceLimit != tt.nonceLimit {
+			t.Errorf("block %d: have (%d, %d, %d), want (%d, %d, %d)", tt.num,
+				p.BlocksPerTurn, p.MaxTxsPerBlock, p.NonceLimit, tt.blocksPerTurn, tt.maxTxsPerBlock, tt.nonceLimit)
+		}
+		if p.BlockTrailTime != DefaultWemixParams.BlockTrailTime {
+			t.Errorf("block %d: unset parameter changed to %d", tt.num, p.BlockTrailTime)
+		}
+	}
+
+	config.Forks[0], config.Forks[1] = config.Forks[1], config.Forks[0]
+	if err := config.checkForkOrder(); err == nil {
+		t.Fatalf("unordered forks accepted")
+	}
+}
+
+func TestWemixCheckCompatible(t *testing.T) {
+	fork := func(block int64, maxTxs int) *ChainConfig {
+		return &ChainConfig{Wemix: &WemixConfig{Forks: []*WemixFork{
+			{Block: big.NewInt(block), WemixParams: WemixParams{MaxTxsPerBlock: maxTxs}},
+		}}}
+	}
+	tests := []struct {
+		stored, new *ChainConfig
+		head        uint64
+		wantErr     bool
+	}{
+		{stored: &ChainConfig{}, new: &ChainConfig{Wemix: &WemixConfig{}}, head: 100},
+		{stored: fork(10, 100), new: fork(20, 100), head: 9},
+		{stored: fork(10, 100), new: fork(10, 100), head: 30},
+		{stored: fork(10, 100), new: fork(20, 100), head: 30, wantErr: true},
+		{stored: fork(10, 100), new: fork(10, 200), head: 30, wantErr: true},
+		{stored: fork(10, 100), new: &ChainConfig{Wemix: &WemixConfig{ConsensusMethod: ConsensusETCD, Forks: fork(10, 100).Wemix.Forks}}, head: 30},
+		{stored: fork(10, 100), new: &ChainConfig{Wemix: &WemixConfig{ConsensusMethod: ConsensusPoA}}, head: 30, wantErr: true},
+		{stored: &ChainConfig{Wemix: &WemixConfig{}}, new: &ChainConfig{Wemix: &WemixConfig{StrictGovernanceBlock: big.NewInt(50)}}, head: 30},
+		{stored: &ChainConfig{Wemix: &WemixConfig{}}, new: &ChainConfig{Wemix: &WemixConfig{StrictGovernanceBlock: big.NewInt(20)}}, head: 30, wantErr: true},
+		{stored: &ChainConfig{Wemix: &WemixConfig{StrictGovernanceBlock: big.NewInt(0)}}, new: &ChainConfig{Wemix: &WemixConfig{StrictGovernanceBlock: big.NewInt(0)}}, head: 30},
+	}
+	for i, tt := range tests {
+		err := tt.stored.CheckCompatible(tt.new, tt.head)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("test %d: have error %v, want error %v", i, err, tt.wantErr)
+		}
+	}
+}
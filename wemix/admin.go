@@ -14,18 +14,20 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	clientv3 "go.etcd.io/etcd/client/v3"
-	"go.etcd.io/etcd/server/v3/embed"
-
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	wemixengine "github.com/ethereum/go-ethereum/consensus/wemix"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p"
@@ -33,7 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
-	"github.com/ethereum/go-ethereum/wemix/metclient"
+	"github.com/ethereum/go-ethereum/wemix/governance"
 	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
 )
 
@@ -55,30 +57,37 @@ type wemixMember struct {
 }
 
 type wemixAdmin struct {
-	stack *node.Node
+	stack       *node.Node
+	chainConfig *params.ChainConfig
+	consensus   int            // consensus method of the engine
+	eth         ethapi.Backend // the local chain
 
 	bootNodeId  string // allowed to generate block without admin contract
 	bootAccount common.Address
-	nodeInfo    *p2p.NodeInfo
-	registry    *metclient.RemoteContract
-	gov         *metclient.RemoteContract
-	staking     *metclient.RemoteContract
-	envStorage  *metclient.RemoteContract
+	nodeInfo    atomic.Value // *p2p.NodeInfo of the local node once known
+	registry    *common.Address
+	gov         *common.Address
+	staking     *common.Address
+	envStorage  *common.Address
 	Updates     chan bool
 	rpcCli      *rpc.Client
-	cli         *ethclient.Client
+	caller      *stateCaller // reads governance from the local state
+	signers     signerCache  // signers by the hash of the parent block
 
-	etcd        *embed.Etcd
-	etcdCli     *clientv3.Client
-	etcdDir     string
-	etcdPort    int
-	etcdTimeout time.Duration
+	raft          *raftNode
+	raftDir       string
+	raftTimeout   time.Duration
+	raftPeers     atomic.Value // map[uint64]*wemixNode by raft id
+	raftHeard     int32        // heard from a raft cluster before starting
+	raftWaitSince time.Time
+	raftHeldBack  string // the reason the last membership change is held back
 
 	lastBlock     int64
 	modifiedBlock int64
 
 	blockInterval        int64
 	blocksPer            int64
+	maxIdleBlockInterval int64
 	blockReward          *big.Int
 	maxPriorityFeePerGas *big.Int
 	maxBaseFee           *big.Int
@@ -93,6 +102,12 @@ type wemixAdmin struct {
 
 	// # of blocks consecutively mined by this node
 	blocksMined int64
+
+	// recent leadership events to reconstruct fail-over timelines
+	leaderEvents *leadershipEvents
+
+	// in PoA, whether this node is to mine the next block
+	poaMiner int32
 }
 
 // latest block generated
@@ -122,18 +137,17 @@ type rewardParameters struct {
 
 var (
 	// "Wemix Registry"
-	magic, _        = big.NewInt(0).SetString("0x57656d6978205265676973747279", 0)
-	etcdClusterName = "Wemix"
-	big0            = big.NewInt(0)
-	nilAddress      = common.Address{}
-	admin           *wemixAdmin
+	magic, _   = big.NewInt(0).SetString("0x57656d6978205265676973747279", 0)
+	big0       = big.NewInt(0)
+	nilAddress = common.Address{}
+	admin      *wemixAdmin
 
 	ErrNotRunning     = errors.New("not running")
 	ErrAlreadyRunning = errors.New("already running")
 	ErrInvalidEnode   = errors.New("invalid enode")
 
-	etcdCompactFrequency = int64(100)
-	etcdCompactWindow    = int64(100)
+	errNoBootNode         = errors.New("no bootnode id in the genesis block")
+	errRewardDistribution = errors.New("invalid reward distribution method")
 
 	// cached block build parameters
 	blockBuildParamsLock = &sync.Mutex{}
@@ -173,14 +187,14 @@ func (ma *wemixAdmin) getGenesisInfo() (string, common.Address, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	block, err := ma.cli.HeaderByNumber(ctx, big0)
+	block, err := ma.caller.headerAt(ctx, big0)
 	if err != nil {
 		return "", common.Address{}, err
 	}
 
 	var nodeId string
 	if len(block.Extra) < 64 {
-		return "", common.Address{}, fmt.Errorf("Invalid bootnode id in the genesis block")
+		return "", common.Address{}, errNoBootNode
 	} else if len(block.Extra) == 64 {
 		nodeId = hex.EncodeToString(block.Extra)
 	} else if len(block.Extra) <= 128 {
@@ -192,22 +206,21 @@ func (ma *wemixAdmin) getGenesisInfo() (string, common.Address, error) {
 	return nodeId, block.Coinbase, nil
 }
 
-func (ma *wemixAdmin) getRegistryAddress(ctx context.Context, cli *ethclient.Client, registryAbi abi.ABI, height *big.Int) (*common.Address, error) {
-	contract := &metclient.RemoteContract{
-		Cli: cli,
-		Abi: registryAbi,
+// getRegistryAddress finds the Registry deployed by the boot account at the
+// given block. ErrNotInitialized is returned if it's not deployed yet, and
+// errStateUnavailable if the state of the block is not available.
+func (ma *wemixAdmin) getRegistryAddress(ctx context.Context, height *big.Int) (*common.Address, error) {
+	if _, _, err := ma.caller.stateAt(ctx, height); err != nil {
+		return nil, err
 	}
-	for i := uint64(0); i < 10; i++ {
-		addr := crypto.CreateAddress(ma.bootAccount, i)
-		contract.To = &addr
-
-		var v *big.Int
-		err := metclient.CallContract(ctx, contract, "magic", nil, &v, height)
-		if err == nil && v.Cmp(magic) == 0 {
-			return &addr, nil
-		}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+	addr, err := governance.FindRegistry(opts, ma.caller, ma.bootAccount)
+	if err == governance.ErrRegistryNotFound {
+		return nil, wemixminer.ErrNotInitialized
+	} else if err != nil {
+		return nil, err
 	}
-	return nil, wemixminer.ErrNotInitialized
+	return &addr, nil
 }
 
 // it should be the first transaction of the coinbase of the genesis block
@@ -216,102 +229,80 @@ func (ma *wemixAdmin) getAdminAddresses() (registry, gov, staking, envStorage *c
 	defer cancel()
 
 	registry, gov, staking, envStorage = nil, nil, nil, nil
-	contract := &metclient.RemoteContract{
-		Cli: ma.cli,
-		Abi: ma.registry.Abi,
-	}
-	if ma.registry != nil && ma.registry.To != nil {
-		registry = ma.registry.To
+	if ma.registry != nil {
+		registry = ma.registry
 	} else {
-		registry, err = ma.getRegistryAddress(ctx, ma.cli, ma.registry.Abi, nil)
+		registry, err = ma.getRegistryAddress(ctx, nil)
 		if err != nil {
 			err = ethereum.NotFound
 			return
 		}
 	}
-	contract.To = registry
+	reg := governance.RegistryAt(*registry, ma.caller)
+	opts := &bind.CallOpts{Context: ctx}
 
-	n1 := metclient.ToBytes32("GovernanceContract")
-	n2 := metclient.ToBytes32("Staking")
-	n3 := metclient.ToBytes32("EnvStorage")
 	var a1, a2, a3 common.Address
-	input := []interface{}{n1}
-	if err = metclient.CallContract(ctx, contract, "getContractAddress", input, &a1, nil); err != nil {
+	if a1, err = reg.GetContractAddress(opts, governance.GovernanceContractName); err != nil {
 		return
 	}
-	input = []interface{}{n2}
-	if err = metclient.CallContract(ctx, contract, "getContractAddress", input, &a2, nil); err != nil {
+	if a2, err = reg.GetContractAddress(opts, governance.StakingName); err != nil {
 		return
 	}
-	input = []interface{}{n3}
-	if err = metclient.CallContract(ctx, contract, "getContractAddress", input, &a3, nil); err != nil {
+	if a3, err = reg.GetContractAddress(opts, governance.EnvStorageName); err != nil {
 		return
 	}
 
 	log.Debug("Wemix Contract Address",
-		hex.EncodeToString(n1[:]), a1.Hex(),
-		hex.EncodeToString(n2[:]), a2.Hex(),
-		hex.EncodeToString(n3[:]), a3.Hex())
+		hex.EncodeToString(governance.GovernanceContractName[:]), a1.Hex(),
+		hex.EncodeToString(governance.StakingName[:]), a2.Hex(),
+		hex.EncodeToString(governance.EnvStorageName[:]), a3.Hex())
 
 	gov, staking, envStorage = &a1, &a2, &a3
 	return
 }
 
-func (ma *wemixAdmin) getInt(ctx context.Context, contract *metclient.RemoteContract, block *big.Int, name string) (int64, error) {
-	var v *big.Int
-	err := metclient.CallContract(ctx, contract, name, nil, &v, block)
-	if err != nil {
-		return 0, err
-	} else {
-		return v.Int64(), nil
-	}
-}
-
-// TODO: error handling
-func (ma *wemixAdmin) getRegGovEnvContracts(ctx context.Context, height *big.Int) (reg, gov, env *metclient.RemoteContract, err error) {
-	if ma.registry == nil {
-		err = wemixminer.ErrNotInitialized
+// getRegGovEnvContracts returns the governance contracts at the given block.
+// ErrNotInitialized is returned only if the governance is not established at
+// the block, i.e. the Registry is not deployed yet, it has no Gov contract,
+// or the Gov contract has no members yet, as in the middle of the governance
+// deployment. Any other failure, e.g. the state of the block not being
+// available, is returned as is, not to mistake it for the boot-only phase.
+func (ma *wemixAdmin) getRegGovEnvContracts(ctx context.Context, height *big.Int) (reg *governance.RegistryCaller, gov *governance.GovImpCaller, env *governance.EnvStorageImpCaller, govAddr common.Address, err error) {
+	if _, _, err = ma.caller.stateAt(ctx, height); err != nil {
 		return
 	}
-	reg = &metclient.RemoteContract{
-		Cli: ma.cli,
-		Abi: ma.registry.Abi,
-	}
-	env = &metclient.RemoteContract{
-		Cli: ma.cli,
-		Abi: ma.envStorage.Abi,
-	}
-	gov = &metclient.RemoteContract{
-		Cli: ma.cli,
-		Abi: ma.gov.Abi,
-	}
-	if ma.registry.To != nil {
-		reg.To = ma.registry.To
-	} else {
-		var addr *common.Address
-		if addr, err = ma.getRegistryAddress(ctx, ma.cli, reg.Abi, height); err != nil {
-			err = wemixminer.ErrNotInitialized
+	regAddr := ma.registry
+	if regAddr == nil {
+		if regAddr, err = ma.getRegistryAddress(ctx, height); err != nil {
 			return
 		}
-		reg.To = addr
 	}
+	reg = governance.RegistryAt(*regAddr, ma.caller)
 
-	var addr common.Address
-	input := []interface{}{metclient.ToBytes32("GovernanceContract")}
-	if err = metclient.CallContract(ctx, reg, "getContractAddress", input, &addr, height); err != nil {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+	govAddr, err = reg.GetContractAddress(opts, governance.GovernanceContractName)
+	if err == bind.ErrNoCode || errors.Is(err, vm.ErrExecutionReverted) || (err == nil && govAddr == common.Address{}) {
+		// the registry is deployed later, or the Gov contract is not
+		// registered yet, which the registry reverts on
 		err = wemixminer.ErrNotInitialized
 		return
+	} else if err != nil {
+		return
 	}
-	gov.To = &common.Address{}
-	gov.To.SetBytes(addr.Bytes())
-
-	input = []interface{}{metclient.ToBytes32("EnvStorage")}
-	if err = metclient.CallContract(ctx, reg, "getContractAddress", input, &addr, height); err != nil {
+	gov = governance.GovImpAt(govAddr, ma.caller)
+	if count, err2 := gov.GetMemberLength(opts); err2 != nil {
+		err = err2
+		return
+	} else if count.Sign() == 0 {
 		err = wemixminer.ErrNotInitialized
 		return
 	}
-	env.To = &common.Address{}
-	env.To.SetBytes(addr.Bytes())
+
+	envAddr, err := reg.GetContractAddress(opts, governance.EnvStorageName)
+	if err != nil {
+		return
+	}
+	env = governance.EnvStorageImpAt(envAddr, ma.caller)
 
 	return
 }
@@ -364,179 +355,186 @@ func (ma *wemixAdmin) getMinerNodes(height int64, locked bool) (*wemixNode, *wem
 		}
 	}
 
-	_, leaderNode := ma.etcdLeader(locked)
-	var miner, next *wemixNode
-	ix := int(height/admin.blocksPer) % len(nodes)
-	i := ix
-	for j := 0; j < len(nodes); j++ {
-		if miner != nil && next != nil {
-			break
-		}
-		n := nodes[i]
-		if miner == nil && leaderNode != nil && n.Name == leaderNode.Name {
-			miner = n
-			miner.Miner = true
-		}
-		if next == nil && n.Status == "up" {
-			next = n
+	_, leaderNode := ma.raftLeader(locked)
+	var miner *wemixNode
+	if leaderNode != nil {
+		for _, n := range nodes {
+			if n.Name == leaderNode.Name {
+				miner = n
+				miner.Miner = true
+				break
+			}
 		}
-		i = (i + 1) % len(nodes)
 	}
 
-	return miner, next, nodes
+	return miner, nextMiner(nodes, height, admin.blocksPer), nodes
+}
+
+// consensusMethod returns the consensus method of the engine, i.e. the one
+// in the chain config, or given with the flag for the older chains.
+func consensusMethod() int {
+	if admin == nil {
+		return params.ConsensusMethod
+	}
+	return admin.consensus
+}
+
+// isPoA returns true if the miners take turns without the raft leader, i.e.
+// with ConsensusPoA, or ConsensusPBFT that adds finality votes on top of it.
+func isPoA() bool {
+	method := consensusMethod()
+	return method == params.ConsensusPoA || method == params.ConsensusPBFT
+}
+
+// nextMiner returns the node whose turn it is to mine the block at the given
+// height, i.e. each node mines blocksPer blocks in turn in the given order.
+// It's up to the node to mine, whether or not it's up, until it times out.
+func nextMiner(nodes []*wemixNode, height, blocksPer int64) *wemixNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+	if blocksPer <= 0 {
+		blocksPer = 1
+	}
+	return nodes[int(height/blocksPer)%len(nodes)]
 }
 
 // get nodes from the Governance contract
-func (ma *wemixAdmin) getWemixNodes(ctx context.Context, block *big.Int) ([]*wemixNode, error) {
-	var (
-		nodes           []*wemixNode
-		addr            common.Address
-		name, enode, ip []byte
-		port            *big.Int
-		count           int64
-		input, output   []interface{}
-		err             error
-	)
+func (ma *wemixAdmin) getWemixNodes(ctx context.Context, gov *governance.GovImpCaller, block *big.Int) ([]*wemixNode, error) {
+	var nodes []*wemixNode
 
-	count, err = ma.getInt(ctx, ma.gov, block, "getNodeLength")
-	for i := int64(1); i <= count; i++ {
-		input = []interface{}{big.NewInt(int64(i))}
-		output = []interface{}{&name, &enode, &ip, &port}
-		if err = metclient.CallContract(ctx, ma.gov, "getNode", input, &output, block); err != nil {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+	count, err := gov.GetNodeLength(opts)
+	if err != nil {
+		return nil, err
+	}
+	for i := int64(1); i <= count.Int64(); i++ {
+		idx := big.NewInt(i)
+		node, err := gov.GetNode(opts, idx)
+		if err != nil {
 			return nil, err
 		}
-
-		if err = metclient.CallContract(ctx, ma.gov, "getReward", input, &addr, block); err != nil {
+		addr, err := gov.GetReward(opts, idx)
+		if err != nil {
 			return nil, err
 		}
 
-		sid := hex.EncodeToString(enode)
+		sid := hex.EncodeToString(node.Enode)
 		if len(sid) != 128 {
 			return nil, ErrInvalidEnode
 		}
 		idv4, _ := toIdv4(sid)
 		nodes = append(nodes, &wemixNode{
-			Name:  string(name),
+			Name:  string(node.Name),
 			Enode: sid,
-			Ip:    string(ip),
+			Ip:    string(node.Ip),
 			Id:    idv4,
-			Port:  int(port.Int64()),
+			Port:  int(node.Port.Int64()),
 			Addr:  addr,
 		})
 	}
 	sort.Slice(nodes, func(i, j int) bool {
 		return nodes[i].Name < nodes[j].Name
 	})
-	return nodes, err
+	return nodes, nil
 }
 
 func (ma *wemixAdmin) getRewardParams(ctx context.Context, height *big.Int) (*rewardParameters, error) {
 	rp := &rewardParameters{}
-	reg, gov, env, err := ma.getRegGovEnvContracts(ctx, height)
+	reg, gov, env, _, err := ma.getRegGovEnvContracts(ctx, height)
 	if err != nil {
 		return nil, err
 	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
 
-	if err = metclient.CallContract(ctx, env, "getBlockRewardAmount", nil, &rp.rewardAmount, height); err != nil {
+	if rp.rewardAmount, err = env.GetBlockRewardAmount(opts); err != nil {
 		return nil, err
 	}
 
 	rp.distributionMethod = make([]*big.Int, 4, 4)
-	if err = metclient.CallContract(ctx, env, "getBlockRewardDistributionMethod", nil, &rp.distributionMethod, height); err != nil {
+	dm := rp.distributionMethod
+	if dm[0], dm[1], dm[2], dm[3], err = env.GetBlockRewardDistributionMethod(opts); err != nil {
 		return nil, err
 	}
 
-	var addr common.Address
-	input := []interface{}{metclient.ToBytes32("StakingReward")}
-	if err = metclient.CallContract(ctx, reg, "getContractAddress", input, &addr, height); err != nil {
+	staker, err := reg.GetContractAddress(opts, governance.StakingRewardName)
+	if err != nil {
 		return nil, err
 	}
-	rp.staker = &common.Address{}
-	rp.staker.SetBytes(addr.Bytes())
+	rp.staker = &staker
 
-	input = []interface{}{metclient.ToBytes32("Ecosystem")}
-	if err = metclient.CallContract(ctx, reg, "getContractAddress", input, &addr, height); err != nil {
+	ecoSystem, err := reg.GetContractAddress(opts, governance.EcosystemName)
+	if err != nil {
 		return nil, err
 	}
-	rp.ecoSystem = &common.Address{}
-	rp.ecoSystem.SetBytes(addr.Bytes())
+	rp.ecoSystem = &ecoSystem
 
-	input = []interface{}{metclient.ToBytes32("Maintenance")}
-	if err = metclient.CallContract(ctx, reg, "getContractAddress", input, &addr, height); err != nil {
+	maintenance, err := reg.GetContractAddress(opts, governance.MaintenanceName)
+	if err != nil {
 		return nil, err
 	}
-	rp.maintenance = &common.Address{}
-	rp.maintenance.SetBytes(addr.Bytes())
+	rp.maintenance = &maintenance
 
-	rp.blocksPer, err = ma.getInt(ctx, env, height, "getBlocksPer")
+	blocksPer, err := env.GetBlocksPer(opts)
 	if err != nil {
 		return nil, err
 	}
+	rp.blocksPer = blocksPer.Int64()
 
-	if count, err := ma.getInt(ctx, gov, height, "getMemberLength"); err != nil {
+	count, err := gov.GetMemberLength(opts)
+	if err != nil {
 		return nil, err
-	} else {
-		for i := int64(1); i <= count; i++ {
-			input = []interface{}{big.NewInt(int64(i))}
-			if err = metclient.CallContract(ctx, gov, "getReward", input, &addr, height); err != nil {
-				return nil, err
-			}
-			input = []interface{}{addr}
-			// NB. no staking consideration
-			// if err = metclient.CallContract(ctx, staking, "lockedBalanceOf", input, &stake, height); err != nil {
-			//	return nil, err
-			// }
-			rp.members = append(rp.members, &wemixMember{
-				Addr: addr,
-			})
+	}
+	for i := int64(1); i <= count.Int64(); i++ {
+		addr, err := gov.GetReward(opts, big.NewInt(i))
+		if err != nil {
+			return nil, err
 		}
+		// NB. no staking consideration
+		rp.members = append(rp.members, &wemixMember{
+			Addr: addr,
+		})
 	}
 
 	return rp, nil
 }
 
 func (ma *wemixAdmin) getRewardAccounts(ctx context.Context, block *big.Int) (rewardPoolAccount, maintenanceAccount *common.Address, members []*wemixMember, err error) {
-	var (
-		addr  common.Address
-		count int64
-		stake *big.Int
-		input []interface{}
-	)
-
-	if ma.registry == nil || ma.registry.To == nil {
+	if ma.registry == nil {
 		err = wemixminer.ErrNotInitialized
 		return
 	}
 
-	input = []interface{}{metclient.ToBytes32("RewardPool")}
-	err = metclient.CallContract(ctx, ma.registry, "getContractAddress", input, &addr, block)
-	if err == nil {
-		rewardPoolAccount = &common.Address{}
-		rewardPoolAccount.SetBytes(addr.Bytes())
-	}
+	var (
+		reg     = governance.RegistryAt(*ma.registry, ma.caller)
+		gov     = governance.GovImpAt(*ma.gov, ma.caller)
+		staking = governance.StakingAt(*ma.staking, ma.caller)
+		opts    = &bind.CallOpts{Context: ctx, BlockNumber: block}
+		addr    common.Address
+		count   *big.Int
+		stake   *big.Int
+	)
 
-	input = []interface{}{metclient.ToBytes32("Maintenance")}
-	err = metclient.CallContract(ctx, ma.registry, "getContractAddress", input, &addr, block)
-	if err == nil {
-		maintenanceAccount = &common.Address{}
-		maintenanceAccount.SetBytes(addr.Bytes())
+	if addr, err = reg.GetContractAddress(opts, governance.RewardPoolName); err == nil {
+		rewardPoolAccount = new(common.Address)
+		*rewardPoolAccount = addr
+	}
+	if addr, err = reg.GetContractAddress(opts, governance.MaintenanceName); err == nil {
+		maintenanceAccount = new(common.Address)
+		*maintenanceAccount = addr
 	}
 
-	count, err = ma.getInt(ctx, ma.gov, block, "getMemberLength")
+	count, err = gov.GetMemberLength(opts)
 	if err != nil {
 		return
 	}
 
-	for i := int64(1); i <= count; i++ {
-		input = []interface{}{big.NewInt(int64(i))}
-		err = metclient.CallContract(ctx, ma.gov, "getReward", input,
-			&addr, block)
+	for i := int64(1); i <= count.Int64(); i++ {
+		addr, err = gov.GetReward(opts, big.NewInt(i))
 		if err != nil {
 			return
 		}
-		input = []interface{}{addr}
-		err = metclient.CallContract(ctx, ma.staking, "lockedBalanceOf", input,
-			&stake, block)
+		stake, err = staking.LockedBalanceOf(opts, addr)
 		if err != nil {
 			return
 		}
@@ -566,7 +564,7 @@ func (ma *wemixAdmin) getGovData(refresh bool) (data *govdata, err error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	block, err := ma.cli.HeaderByNumber(ctx, nil)
+	block, err := ma.caller.headerAt(ctx, nil)
 	if err != nil {
 		return
 	}
@@ -575,56 +573,64 @@ func (ma *wemixAdmin) getGovData(refresh bool) (data *govdata, err error) {
 		return
 	}
 
-	data.modifiedBlock, err = ma.getInt(ctx, ma.gov, block.Number,
-		"modifiedBlock")
-	if err != nil {
+	var (
+		gov  = governance.GovImpAt(*ma.gov, ma.caller)
+		env  = governance.EnvStorageImpAt(*ma.envStorage, ma.caller)
+		opts = &bind.CallOpts{Context: ctx, BlockNumber: block.Number}
+		v    *big.Int
+	)
+
+	if v, err = gov.ModifiedBlock(opts); err != nil {
 		return
 	}
+	data.modifiedBlock = v.Int64()
 	if !refresh && ma.modifiedBlock == data.modifiedBlock {
 		return
 	}
 
-	data.blockInterval, err = ma.getInt(ctx, ma.envStorage, block.Number, "getBlockCreationTime")
-	if err != nil {
+	if v, err = env.GetBlockCreationTime(opts); err != nil {
 		// TODO: ignore this error for now
 		data.blockInterval = ma.blockInterval
 		//return
+	} else {
+		data.blockInterval = v.Int64()
 	}
-	data.blocksPer, err = ma.getInt(ctx, ma.envStorage, block.Number, "getBlocksPer")
-	if err != nil {
+	if v, err = env.GetBlocksPer(opts); err != nil {
 		// TODO: ignore this error for now
 		data.blocksPer = ma.blocksPer
 		//return
+	} else {
+		data.blocksPer = v.Int64()
 	}
-	data.maxIdleBlockInterval, err = ma.getInt(ctx, ma.envStorage, block.Number, "getMaxIdleBlockInterval")
-	if err != nil {
+	if v, err = env.GetMaxIdleBlockInterval(opts); err != nil {
 		// TODO: ignore this error for now
-		data.maxIdleBlockInterval = int64(params.MaxIdleBlockInterval)
+		data.maxIdleBlockInterval = int64(ma.chainConfig.Wemix.Params(block.Number).MaxIdleBlockInterval)
 		//return
+	} else {
+		data.maxIdleBlockInterval = v.Int64()
 	}
-	err = metclient.CallContract(ctx, ma.envStorage, "getBlockRewardAmount", nil, &data.blockReward, block.Number)
+	data.blockReward, err = env.GetBlockRewardAmount(opts)
 	if err != nil {
 		return
 	}
-	err = metclient.CallContract(ctx, ma.envStorage, "getMaxPriorityFeePerGas", nil, &data.maxPriorityFeePerGas, block.Number)
+	data.maxPriorityFeePerGas, err = env.GetMaxPriorityFeePerGas(opts)
 	if err != nil {
 		return
 	}
-	gasLimitAndBaseFee := make([]*big.Int, 3, 3)
-	err = metclient.CallContract(ctx, ma.envStorage, "getGasLimitAndBaseFee", nil, &gasLimitAndBaseFee, block.Number)
+	var baseFeeMaxChangeRate, gasTargetPercentage *big.Int
+	data.gasLimit, baseFeeMaxChangeRate, gasTargetPercentage, err = env.GetGasLimitAndBaseFee(opts)
 	if err != nil {
 		return
 	}
-	data.gasLimit = gasLimitAndBaseFee[0]
-	data.baseFeeMaxChangeRate = gasLimitAndBaseFee[1].Int64()
-	data.gasTargetPercentage = gasLimitAndBaseFee[2].Int64()
+	data.baseFeeMaxChangeRate = baseFeeMaxChangeRate.Int64()
+	data.gasTargetPercentage = gasTargetPercentage.Int64()
 
-	err = metclient.CallContract(ctx, ma.envStorage, "getMaxBaseFee", nil, &data.maxBaseFee, block.Number)
+	data.maxBaseFee, err = env.GetMaxBaseFee(opts)
 	if err != nil {
 		return
 	}
 
-	data.nodes, err = ma.getWemixNodes(ctx, block.Number)
+	data.nodes, err = ma.getWemixNodes(ctx, gov, block.Number)
 	if err != nil {
 		return
 	}
@@ -672,11 +678,18 @@ func (ma *wemixAdmin) getGovData(refresh bool) (data *govdata, err error) {
 	return
 }
 
-func StartAdmin(stack *node.Node, datadir string) {
-	if !(params.ConsensusMethod == params.ConsensusPoA ||
-		params.ConsensusMethod == params.ConsensusETCD ||
-		params.ConsensusMethod == params.ConsensusPBFT) {
-		utils.Fatalf("Invalid Consensus Method: %d\n", params.ConsensusMethod)
+// InitAdmin sets up the governance of the wemix engine, before the node is
+// started so that the blocks synced are checked against it.
+func InitAdmin(stack *node.Node, datadir string, backend ethapi.Backend) {
+	// no governance to run without the wemix engine, e.g. on ethash chains
+	engine := wemixengine.FromEngine(backend.Engine())
+	if engine == nil {
+		return
+	}
+	if !(engine.ConsensusMethod() == params.ConsensusPoA ||
+		engine.ConsensusMethod() == params.ConsensusETCD ||
+		engine.ConsensusMethod() == params.ConsensusPBFT) {
+		utils.Fatalf("Invalid Consensus Method: %d\n", engine.ConsensusMethod())
 	}
 
 	rpcCli, err := stack.Attach()
@@ -684,59 +697,57 @@ func StartAdmin(stack *node.Node, datadir string) {
 		utils.Fatalf("Failed to attach to self: %v", err)
 	}
 
-	registryContract, err := metclient.LoadJsonContract(strings.NewReader(RegistryAbi))
-	if err != nil {
-		utils.Fatalf("Loading ABI failed: %v", err)
-	}
-	govContract, err := metclient.LoadJsonContract(strings.NewReader(GovAbi))
-	if err != nil {
-		utils.Fatalf("Loading ABI failed: %v", err)
-	}
-	stakingContract, err := metclient.LoadJsonContract(strings.NewReader(StakingAbi))
-	if err != nil {
-		utils.Fatalf("Loading ABI failed: %v", err)
-	}
-	envStorageImpContract, err := metclient.LoadJsonContract(strings.NewReader(EnvStorageImpAbi))
-	if err != nil {
-		utils.Fatalf("Loading ABI failed: %v", err)
-	}
-
-	cli := ethclient.NewClient(rpcCli)
-	admin = &wemixAdmin{
-		stack: stack,
-		lock:  &sync.Mutex{},
-		registry: &metclient.RemoteContract{
-			Cli: cli, Abi: registryContract.Abi},
-		gov: &metclient.RemoteContract{
-			Cli: cli, Abi: govContract.Abi},
-		staking: &metclient.RemoteContract{
-			Cli: cli, Abi: stakingContract.Abi},
-		envStorage: &metclient.RemoteContract{
-			Cli: cli, Abi: envStorageImpContract.Abi},
-		Updates:     make(chan bool, 10),
-		rpcCli:      rpcCli,
-		cli:         cli,
-		blocksPer:   100,
-		etcdDir:     path.Join(datadir, "etcd"),
-		etcdTimeout: 30 * time.Second,
-	}
-
-	admin.bootNodeId, admin.bootAccount, err = admin.getGenesisInfo()
-	if err != nil {
+	chainConfig := backend.ChainConfig()
+	wemixParams := chainConfig.Wemix.Params(nil)
+	ma := &wemixAdmin{
+		stack:                stack,
+		chainConfig:          chainConfig,
+		consensus:            engine.ConsensusMethod(),
+		eth:                  backend,
+		lock:                 &sync.Mutex{},
+		Updates:              make(chan bool, 10),
+		rpcCli:               rpcCli,
+		caller:               &stateCaller{backend: backend},
+		blocksPer:            int64(wemixParams.BlocksPerTurn),
+		maxIdleBlockInterval: int64(wemixParams.MaxIdleBlockInterval),
+		raftDir:              path.Join(datadir, "raft"),
+		raftTimeout:          5 * time.Second,
+		leaderEvents:         newLeadershipEvents(leadershipEventCount),
+	}
+
+	ma.bootNodeId, ma.bootAccount, err = ma.getGenesisInfo()
+	if err == errNoBootNode {
+		// no governance in the genesis, the blocks with rewards are rejected
+		log.Warn("Not running wemix governance", "err", err)
 		return
+	} else if err != nil {
+		utils.Fatalf("Failed to read the governance genesis: %v", err)
 	}
+	admin = ma
+	engine.SetBackend(admin)
+}
 
+// StartAdmin starts the governance set up by InitAdmin, once the node is
+// started.
+func StartAdmin() {
+	if admin == nil {
+		return
+	}
 	go admin.run()
-	go func() {
-		for {
-			admin.updateMiner(false)
-			time.Sleep(1 * time.Second)
-		}
-	}()
+	if isPoA() {
+		go admin.poaLoop()
+	} else {
+		go func() {
+			for {
+				admin.updateMiner(false)
+				time.Sleep(1 * time.Second)
+			}
+		}()
+	}
 }
 
 func (ma *wemixAdmin) addPeer(node *wemixNode) error {
-	if node.Id == ma.nodeInfo.ID || ma.self == nil {
+	if info := ma.selfInfo(); (info != nil && node.Id == info.ID) || ma.self == nil {
 		return nil
 	}
 
@@ -762,14 +773,14 @@ func (ma *wemixAdmin) update() {
 	registry, gov, staking, envStorage, err := ma.getAdminAddresses()
 	if err != nil {
 		return
-	} else if !bytes.Equal(registry[:], ma.registry.To[:]) ||
-		!bytes.Equal(gov[:], ma.gov.To[:]) ||
-		!bytes.Equal(staking[:], ma.staking.To[:]) ||
-		!bytes.Equal(envStorage[:], ma.envStorage.To[:]) {
-		ma.registry.To = registry
-		ma.gov.To = gov
-		ma.staking.To = staking
-		ma.envStorage.To = envStorage
+	} else if !bytes.Equal(registry[:], ma.registry[:]) ||
+		!bytes.Equal(gov[:], ma.gov[:]) ||
+		!bytes.Equal(staking[:], ma.staking[:]) ||
+		!bytes.Equal(envStorage[:], ma.envStorage[:]) {
+		ma.registry = registry
+		ma.gov = gov
+		ma.staking = staking
+		ma.envStorage = envStorage
 		refresh = true
 	}
 
@@ -783,6 +794,7 @@ func (ma *wemixAdmin) update() {
 		ma.modifiedBlock = data.modifiedBlock
 		ma.blockInterval = data.blockInterval
 		ma.blocksPer = data.blocksPer
+		ma.maxIdleBlockInterval = data.maxIdleBlockInterval
 		ma.blockReward = data.blockReward
 		ma.maxPriorityFeePerGas = data.maxPriorityFeePerGas
 		ma.maxBaseFee = data.maxBaseFee
@@ -791,13 +803,15 @@ func (ma *wemixAdmin) update() {
 		ma.gasTargetPercentage = data.gasTargetPercentage
 
 		_nodes := map[string]*wemixNode{}
+		info := ma.selfInfo()
 		for _, i := range data.nodes {
 			_nodes[i.Id] = i
-			if i.Id == ma.nodeInfo.ID {
+			if info != nil && i.Id == info.ID {
 				ma.self = i
 			}
 		}
 		ma.nodes = _nodes
+		ma.raftSetPeers(data.nodes)
 
 		if len(data.addedNodes) > 0 {
 			log.Debug("Added:\n")
@@ -819,10 +833,6 @@ func (ma *wemixAdmin) update() {
 			}
 		}
 
-		if params.MaxIdleBlockInterval != uint64(data.maxIdleBlockInterval) {
-			params.MaxIdleBlockInterval = uint64(data.maxIdleBlockInterval)
-		}
-
 		// set coinbase and minimum gas price
 		setGasCoinbase := func() {
 			ctx, cancel := context.WithCancel(context.Background())
@@ -858,7 +868,7 @@ func (ma *wemixAdmin) update() {
 
 func (ma *wemixAdmin) checkMining() {
 	on := false
-	if ma.nodeInfo != nil && ma.nodeInfo.ID == admin.bootNodeId {
+	if ma.isBootNode() {
 		on = true
 	} else if ma.self != nil {
 		on = true
@@ -898,31 +908,37 @@ func (ma *wemixAdmin) checkMining() {
 func (ma *wemixAdmin) run() {
 	lt := time.Now()
 	for {
-		if ma.nodeInfo == nil {
+		if ma.selfInfo() == nil {
 			nodeInfo, err := ma.getNodeInfo()
 			if err != nil {
 				log.Error("Failed to get node info", "error", err)
-			} else {
-				ma.nodeInfo = nodeInfo
+			} else if nodeInfo != nil {
+				ma.nodeInfo.Store(nodeInfo)
 			}
 		}
-		if ma.registry.To == nil {
+		if ma.registry == nil {
 			registry, gov, staking, envStorage, err := ma.getAdminAddresses()
 			if err == nil {
-				ma.registry.To = registry
-				ma.gov.To = gov
-				ma.staking.To = staking
-				ma.envStorage.To = envStorage
+				ma.registry = registry
+				ma.gov = gov
+				ma.staking = staking
+				ma.envStorage = envStorage
 			}
 		}
-		if ma.registry.To != nil && ma.nodeInfo != nil {
+		if ma.registry != nil && ma.selfInfo() != nil {
 			ma.update()
-			if ma.amPartner() && ma.self != nil && !ma.etcdIsRunning() {
-				EtcdStart()
+			if ma.consensus == params.ConsensusETCD &&
+				ma.amPartner() && ma.self != nil {
+				if !ma.raftIsRunning() {
+					RaftStart()
+				} else {
+					ma.raftReconcile()
+				}
 			}
 		}
 
 		if ma.amPartner() {
+			ma.updateIdleMetrics()
 			ma.checkMining()
 
 			t := time.Now()
@@ -1073,31 +1089,6 @@ func (ma *wemixAdmin) calculateRewards_old(num, blockReward, fees *big.Int, addB
 	return
 }
 
-func (ma *wemixAdmin) verifyRewards(r1, r2 []byte) error {
-	var err error
-	var a, b []reward
-
-	if err = json.Unmarshal(r1, &a); err != nil {
-		return err
-	}
-	if err = json.Unmarshal(r2, &b); err != nil {
-		return err
-	}
-
-	err = fmt.Errorf("Incorrect Rewards")
-	if len(a) != len(b) {
-		return err
-	}
-	for i := 0; i < len(a); i++ {
-		if !bytes.Equal(a[i].Addr.Bytes(), b[i].Addr.Bytes()) ||
-			a[i].Reward != b[i].Reward {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // new rewards
 // TODO: needs to check errors or inconsistencies
 //   - incorrect parametesr, i.e. distribution methods values don't add up to 1000
@@ -1109,7 +1100,7 @@ func distributeRewards(height *big.Int, rp *rewardParameters, fees *big.Int) ([]
 		dm.Add(dm, rp.distributionMethod[i])
 	}
 	if dm.Int64() != 10000 {
-		return nil, wemixminer.ErrNotInitialized
+		return nil, fmt.Errorf("%w: sum %v, want 10000", errRewardDistribution, dm)
 	}
 
 	v10000 := big.NewInt(10000)
@@ -1163,33 +1154,47 @@ func distributeRewards(height *big.Int, rp *rewardParameters, fees *big.Int) ([]
 	return rewards, nil
 }
 
-func (ma *wemixAdmin) calculateRewards(num, blockReward, fees *big.Int, addBalance func(common.Address, *big.Int)) (coinbase *common.Address, rewards []byte, err error) {
+// CalculateRewards implements wemixengine.Backend, distributing the rewards
+// of the child block of 'parent' according to the governance at the parent.
+func (ma *wemixAdmin) CalculateRewards(parent *types.Header, blockReward, fees *big.Int, addBalance func(common.Address, *big.Int)) (coinbase *common.Address, rewards []byte, err error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-
-	rp, err := ma.getRewardParams(ctx, big.NewInt(num.Int64()-1))
+	ctx = withBlock(ctx, parent)
+
+	num := new(big.Int).Add(parent.Number, common.Big1)
+	strict := ma.isStrictGovernance(num)
+	// ErrNotInitialized, i.e. all goes to the coinbase, only before the
+	// governance is established, or before the strict governance fork, on
+	// any failure to read it but the state not being available. Any other
+	// failure rejects the block.
+	rp, err := ma.getRewardParams(ctx, parent.Number)
 	if err != nil {
-		// all goes to the coinbase
-		err = wemixminer.ErrNotInitialized
+		if !strict && !errors.Is(err, errStateUnavailable) && ctx.Err() == nil {
+			err = wemixminer.ErrNotInitialized
+		}
 		return
 	}
-
-	// TODO: need more basic checks
-	if rp.staker == nil && rp.ecoSystem == nil && rp.maintenance == nil && len(rp.members) == 0 {
-		err = wemixminer.ErrNotInitialized
+	if rp.blocksPer <= 0 && !strict {
+		rp.blocksPer = ma.blocksPer
+	}
+	if rp.blocksPer <= 0 {
+		err = fmt.Errorf("invalid blocksPer %d at block %v", rp.blocksPer, parent.Number)
 		return
 	}
 
 	// determine coinbase
 	if len(rp.members) > 0 {
-		mix := int(num.Int64()/ma.blocksPer) % len(rp.members)
+		mix := int(num.Int64()/rp.blocksPer) % len(rp.members)
 		coinbase = &common.Address{}
 		coinbase.SetBytes(rp.members[mix].Addr.Bytes())
 	}
 
 	rr, errr := distributeRewards(num, rp, fees)
 	if errr != nil {
-		err = errr
+		if errors.Is(errr, errRewardDistribution) && !strict {
+			errr = wemixminer.ErrNotInitialized
+		}
+		coinbase, err = nil, errr
 		return
 	}
 
@@ -1203,31 +1208,73 @@ func (ma *wemixAdmin) calculateRewards(num, blockReward, fees *big.Int, addBalan
 	return
 }
 
-func calculateRewards(num, blockReward, fees *big.Int, addBalance func(common.Address, *big.Int)) (*common.Address, []byte, error) {
-	return admin.calculateRewards(num, blockReward, fees, addBalance)
+// isStrictGovernance returns whether the block num is past the strict
+// governance fork.
+func (ma *wemixAdmin) isStrictGovernance(num *big.Int) bool {
+	return ma.chainConfig != nil && ma.chainConfig.Wemix.IsStrictGovernance(num)
 }
 
-func verifyRewards(num *big.Int, rewards string) error {
-	return nil
-	//return admin.verifyRewards(num, rewards)
+// VerifyRewards implements wemixengine.Backend.
+func (ma *wemixAdmin) VerifyRewards(num *big.Int, expected, actual []byte) error {
+	return verifyRewards(num, expected, actual)
 }
 
-func signBlock(hash common.Hash) (nodeId, sig []byte, err error) {
-	if admin == nil {
-		err = wemixminer.ErrNotInitialized
-		return
+// verifyRewards checks if the rewards in the header of block 'num', 'actual',
+// are identical to 'expected', the ones calculated from the governance state.
+func verifyRewards(num *big.Int, expected, actual []byte) error {
+	var a, b []reward
+	if err := json.Unmarshal(expected, &a); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(actual, &b); err != nil {
+		return &consensus.RewardsError{
+			Number: num,
+			Reason: fmt.Sprintf("malformed rewards: %v", err),
+		}
 	}
 
-	prvKey := admin.stack.Server().PrivateKey
+	if len(a) != len(b) {
+		return &consensus.RewardsError{
+			Number: num,
+			Reason: fmt.Sprintf("rewards count mismatch: have %d, want %d", len(b), len(a)),
+		}
+	}
+	for i := 0; i < len(a); i++ {
+		if a[i].Addr != b[i].Addr {
+			return &consensus.RewardsError{
+				Number: num,
+				Reason: fmt.Sprintf("rewards[%d] address mismatch: have %v, want %v", i, b[i].Addr, a[i].Addr),
+			}
+		}
+		if b[i].Reward == nil || a[i].Reward.Cmp(b[i].Reward) != 0 {
+			return &consensus.RewardsError{
+				Number: num,
+				Reason: fmt.Sprintf("rewards[%d] amount mismatch for %v: have %v, want %v", i, a[i].Addr, b[i].Reward, a[i].Reward),
+			}
+		}
+	}
+	return nil
+}
+
+// SignBlock implements wemixengine.Backend, signing the hash with the node key.
+func (ma *wemixAdmin) SignBlock(hash common.Hash) (nodeId, sig []byte, err error) {
+	prvKey := ma.stack.Server().PrivateKey
 	sig, err = crypto.Sign(hash.Bytes(), prvKey)
 	nodeId = crypto.FromECDSAPub(&prvKey.PublicKey)[1:]
 	return
 }
 
-func verifyBlockSig(height *big.Int, nodeId []byte, hash common.Hash, sig []byte) bool {
-	// TODO: need to check if nodeId is a valid partner in the 'height' block.
-	pubKey, err := crypto.Ecrecover(hash.Bytes(), sig)
-	return err == nil && nodeId != nil && len(pubKey) > 1 && bytes.Equal(nodeId, pubKey[1:])
+// selfInfo returns the node info of the local node, or nil if it's not known
+// yet. It's safe to call without ma.lock.
+func (ma *wemixAdmin) selfInfo() *p2p.NodeInfo {
+	info, _ := ma.nodeInfo.Load().(*p2p.NodeInfo)
+	return info
+}
+
+// isBootNode checks if the local node is the boot node.
+func (ma *wemixAdmin) isBootNode() bool {
+	info := ma.selfInfo()
+	return info != nil && info.ID == ma.bootNodeId
 }
 
 func (ma *wemixAdmin) getNodeInfo() (*p2p.NodeInfo, error) {
@@ -1261,76 +1308,79 @@ func (ma *wemixAdmin) amPartner() bool {
 	if admin == nil {
 		return false
 	}
-	return admin.self != nil || (admin.nodeInfo != nil && admin.nodeInfo.ID == admin.bootNodeId)
+	return admin.self != nil || admin.isBootNode()
 }
 
+// isGovernanceNode tells if the v4 id is of a governance node, from the
+// nodes raftSetPeers keeps without admin.lock
+func (ma *wemixAdmin) isGovernanceNode(id string) bool {
+	peers, _ := ma.raftPeers.Load().(map[uint64]*wemixNode)
+	n, ok := peers[raftID(id)]
+	return ok && n.Id == id
+}
+
+// AmPartner is called on the message loops of the peers, including the raft
+// messages, so it doesn't take admin.lock, which LogBlock holds while waiting
+// for raft.
 func AmPartner() bool {
 	if admin == nil {
 		return false
 	}
-
-	admin.lock.Lock()
-	defer admin.lock.Unlock()
-
-	return admin.amPartner()
+	info := admin.selfInfo()
+	return info != nil &&
+		(admin.isGovernanceNode(info.ID) || info.ID == admin.bootNodeId)
 }
 
-// id is v4 id
+// id is v4 id. It doesn't take admin.lock as AmPartner.
 func IsPartner(id string) bool {
 	if admin == nil {
 		return false
 	}
-
-	admin.lock.Lock()
-	defer admin.lock.Unlock()
-
-	_, ok := admin.nodes[id]
-	if !ok {
-		if id == admin.bootNodeId {
-			return true
-		} else {
-			return false
-		}
-	}
-
-	return true
+	return admin.isGovernanceNode(id) || id == admin.bootNodeId
 }
 
-// id is v4 id
+// id is v4 id. It's called on every transaction broadcast, so it doesn't
+// take admin.lock, which LogBlock holds while waiting for raft.
 func AmHub(id string) int {
-	if admin == nil || admin.self == nil {
+	if admin == nil {
+		return -1
+	}
+	info := admin.selfInfo()
+	if info == nil {
 		return -1
 	}
 
-	admin.lock.Lock()
-	defer admin.lock.Unlock()
-	if strings.HasPrefix(strings.ToUpper(admin.self.Id), strings.ToUpper(id)) {
+	if strings.HasPrefix(strings.ToUpper(info.ID), strings.ToUpper(id)) {
 		return 1
 	} else {
 		return 0
 	}
 }
 
-func (ma *wemixAdmin) pendingEmpty() bool {
-	type txpool_status struct {
-		Pending hexutil.Uint `json:"pending"`
-		Queued  hexutil.Uint `json:"queued"`
+// GovernanceNodes returns the ids of the governance nodes in the order of
+// their names, without admin.lock as AmHub
+func GovernanceNodes() []string {
+	if admin == nil {
+		return nil
 	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	var status txpool_status
-	if err := admin.rpcCli.CallContext(ctx, &status, "txpool_status"); err != nil {
-		log.Error("Canot get txpool.status", "error", err)
-		return false
+	peers, _ := admin.raftPeers.Load().(map[uint64]*wemixNode)
+	var nodes []*wemixNode
+	for _, i := range peers {
+		nodes = append(nodes, i)
 	}
-
-	return status.Pending == 0
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	var ids []string
+	for _, i := range nodes {
+		ids = append(ids, i.Id)
+	}
+	return ids
 }
 
 func LogBlock(height int64, hash common.Hash) {
-	if admin == nil || admin.self == nil {
+	// in PoA, miners take turns by block height, no need to log and yield
+	if admin == nil || admin.self == nil || admin.consensus != params.ConsensusETCD {
 		return
 	}
 
@@ -1346,27 +1396,19 @@ func LogBlock(height int64, hash common.Hash) {
 	}
 
 	tstart := time.Now()
-	rev, err := admin.etcdPut("work", string(work))
+	_, err = admin.raftPut("work", string(work))
+	logBlockTimer.UpdateSince(tstart)
 	if err != nil {
+		logBlockFailureMeter.Mark(1)
 		log.Error("failed to log the latest block",
 			"height", height, "hash", hash, "took", time.Since(tstart))
 	} else {
 		log.Debug("logged the latest block",
 			"height", height, "hash", hash, "took", time.Since(tstart))
-
-		if ((rev%etcdCompactFrequency == 0) && (rev > etcdCompactFrequency)) && (rev > etcdCompactWindow) {
-			defer func() {
-				go func() {
-					if err := admin.etcdCompact(rev - etcdCompactWindow + 1); err != nil {
-						log.Error("failed to compact",
-							"rev", rev, "took", time.Since(tstart))
-					}
-				}()
-			}()
-		}
 	}
 
 	admin.blocksMined++
+	blocksMinedGauge.Update(admin.blocksMined)
 	height++
 	if admin.blocksMined >= admin.blocksPer &&
 		height%admin.blocksPer == 0 {
@@ -1377,13 +1419,18 @@ func LogBlock(height int64, hash common.Hash) {
 			log.Debug("yield to self", "mined", admin.blocksMined,
 				"new miner", "self")
 		} else {
-			if err := admin.etcdMoveLeader(next.Name); err == nil {
+			if err := admin.raftMoveLeader(next.Name); err == nil {
 				log.Debug("yielded", "mined", admin.blocksMined,
 					"new miner", next.Name)
-				admin.blocksMined = 0
+				admin.leaderEvents.add(leadershipEvent{Kind: leaderYielded,
+					Name: next.Name, Height: height, Mined: admin.blocksMined})
+				admin.endTurn()
 			} else {
 				log.Error("yield failed", "mined", admin.blocksMined,
 					"new miner", next.Name, "error", err)
+				admin.leaderEvents.add(leadershipEvent{Kind: leaderYieldFail,
+					Name: next.Name, Height: height, Mined: admin.blocksMined,
+					Detail: err.Error()})
 			}
 		}
 	}
@@ -1391,11 +1438,12 @@ func LogBlock(height int64, hash common.Hash) {
 
 func getMaxPriorityFeePerGas() *big.Int {
 	defaultFee := big.NewInt(100 * params.GWei)
-	if admin == nil || admin.envStorage == nil || admin.envStorage.To == nil {
+	if admin == nil || admin.envStorage == nil {
 		return defaultFee
 	}
-	var fee *big.Int
-	if err := metclient.CallContract(context.Background(), admin.envStorage, "getMaxPriorityFeePerGas", nil, &fee, nil); err != nil {
+	env := governance.EnvStorageImpAt(*admin.envStorage, admin.caller)
+	fee, err := env.GetMaxPriorityFeePerGas(&bind.CallOpts{})
+	if err != nil {
 		return defaultFee
 	}
 	return fee
@@ -1403,11 +1451,12 @@ func getMaxPriorityFeePerGas() *big.Int {
 
 func suggestGasPrice() *big.Int {
 	defaultFee := big.NewInt(100 * params.GWei)
-	if admin == nil || admin.envStorage == nil || admin.envStorage.To == nil {
+	if admin == nil || admin.envStorage == nil {
 		return defaultFee
 	}
-	var fee *big.Int
-	if err := metclient.CallContract(context.Background(), admin.envStorage, "getMaxPriorityFeePerGas", nil, &fee, nil); err != nil {
+	env := governance.EnvStorageImpAt(*admin.envStorage, admin.caller)
+	fee, err := env.GetMaxPriorityFeePerGas(&bind.CallOpts{})
+	if err != nil {
 		return defaultFee
 	}
 	return fee
@@ -1431,7 +1480,7 @@ func getBlockBuildParameters(height *big.Int) (blockInterval int64, maxBaseFee,
 	blockBuildParamsLock.Unlock()
 
 	// default values
-	blockInterval = 15
+	blockInterval = params.DefaultWemixParams.BlockInterval * 1000
 	maxBaseFee = big.NewInt(0)
 	gasLimit = big.NewInt(0)
 	baseFeeMaxChangeRate = 0
@@ -1440,31 +1489,33 @@ func getBlockBuildParameters(height *big.Int) (blockInterval int64, maxBaseFee,
 	if admin == nil {
 		return
 	}
+	blockInterval = admin.chainConfig.Wemix.Params(height).BlockInterval * 1000
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	var env *metclient.RemoteContract
-	if _, _, env, err = admin.getRegGovEnvContracts(ctx, height); err != nil {
+	var env *governance.EnvStorageImpCaller
+	if _, _, env, _, err = admin.getRegGovEnvContracts(ctx, height); err != nil {
 		err = wemixminer.ErrNotInitialized
 		return
 	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
 	var v *big.Int
-	if err = metclient.CallContract(ctx, env, "getBlockCreationTime", nil, &v, height); err != nil {
+	if v, err = env.GetBlockCreationTime(opts); err != nil {
 		err = wemixminer.ErrNotInitialized
 		return
 	}
 	blockInterval = v.Int64()
 
-	gasLimitAndBaseFee := make([]*big.Int, 3, 3)
-	if err = metclient.CallContract(ctx, env, "getGasLimitAndBaseFee", nil, &gasLimitAndBaseFee, height); err != nil {
+	var rate, target *big.Int
+	if gasLimit, rate, target, err = env.GetGasLimitAndBaseFee(opts); err != nil {
 		err = wemixminer.ErrNotInitialized
 		return
 	}
-	gasLimit = gasLimitAndBaseFee[0]
-	baseFeeMaxChangeRate = gasLimitAndBaseFee[1].Int64()
-	gasTargetPercentage = gasLimitAndBaseFee[2].Int64()
+	baseFeeMaxChangeRate = rate.Int64()
+	gasTargetPercentage = target.Int64()
 
-	if err = metclient.CallContract(ctx, env, "getMaxBaseFee", nil, &maxBaseFee, height); err != nil {
+	if maxBaseFee, err = env.GetMaxBaseFee(opts); err != nil {
 		err = wemixminer.ErrNotInitialized
 		return
 	}
@@ -1499,14 +1550,7 @@ func (ma *wemixAdmin) toMiningPeers(nodes []*wemixNode) string {
 }
 
 func (ma *wemixAdmin) miners() string {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	block, err := ma.cli.HeaderByNumber(ctx, nil)
-	if err != nil {
-		return ""
-	}
-	height := block.Number.Int64()
+	height := ma.eth.CurrentHeader().Number.Int64()
 
 	_, _, nodes := ma.getMinerNodes(height+1, false)
 	return ma.toMiningPeers(nodes)
@@ -1526,10 +1570,10 @@ func Info() interface{} {
 		})
 
 		info := &map[string]interface{}{
-			"consensus":            params.ConsensusMethod,
-			"registry":             admin.registry.To,
-			"governance":           admin.gov.To,
-			"staking":              admin.staking.To,
+			"consensus":            admin.consensus,
+			"registry":             admin.registry,
+			"governance":           admin.gov,
+			"staking":              admin.staking,
 			"modifiedblock":        admin.modifiedBlock,
 			"blocksPer":            admin.blocksPer,
 			"blockInterval":        admin.blockInterval,
@@ -1542,8 +1586,10 @@ func Info() interface{} {
 			"self":                 self,
 			"nodes":                nodes,
 			"miners":               admin.miners(),
-			"etcd":                 admin.etcdInfo(),
-			"maxIdle":              params.MaxIdleBlockInterval,
+			"raft":                 admin.raftInfo(),
+			"maxIdle":              admin.maxIdleBlockInterval,
+			"idle":                 admin.idleTime(),
+			"leaderEvents":         admin.leaderEvents.list(),
 		}
 		return info
 	}
@@ -1554,13 +1600,7 @@ func getMinerStatus() *wemixapi.WemixMinerStatus {
 		return nil
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	header, err := admin.cli.HeaderByNumber(ctx, nil)
-	if err != nil {
-		return nil
-	}
+	header := admin.eth.CurrentHeader()
 	height := header.Number.Int64()
 
 	_, _, nodes := admin.getMinerNodes(height+1, false)
@@ -1596,9 +1636,6 @@ func getMiners(id string, timeout int) []*wemixapi.WemixMinerStatus {
 		timeout = 60
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	nodes := admin.getNodes()
 
 	var node *wemixNode
@@ -1620,99 +1657,43 @@ func getMiners(id string, timeout int) []*wemixapi.WemixMinerStatus {
 		}
 	}
 
-	var miners []*wemixapi.WemixMinerStatus
-	var err error
-	msgch := make(chan interface{}, len(nodes)*2+1)
-	wemixapi.SetMsgChannel(msgch)
-	defer func() {
-		wemixapi.SetMsgChannel(nil)
-		close(msgch)
-	}()
-
-	startTime := time.Now().UnixNano()
-	timer := time.NewTimer(time.Duration(timeout) * time.Second)
-	peers := map[string]*wemixNode{}
-	count := 0
-
+	var targets []*wemixNode
 	if node != nil {
-		if admin.self != nil && admin.self.Id == node.Id {
-			miners = append(miners, getMinerStatus())
-			return miners
-		} else if !admin.isPeerUp(node.Id) {
-			miners = append(miners, getDownStatus(node))
-			return miners
-		}
+		targets = append(targets, node)
+	} else {
+		targets = nodes
+	}
 
-		err = admin.rpcCli.CallContext(ctx, nil, "admin_requestMinerStatus", &node.Id)
-		if err != nil {
-			log.Error("RequestMinerStatus Failed", "id", node.Id, "error", err)
-			status := getDownStatus(node)
-			status.RttMs = big.NewInt((time.Now().UnixNano() - startTime) / 1000000)
-			miners = append(miners, status)
-		} else {
-			peers[node.Name] = node
-			count++
+	// each request has its own response and timeout, so concurrent
+	// callers don't step on each other
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	miners := make([]*wemixapi.WemixMinerStatus, len(targets))
+	var wg sync.WaitGroup
+	for i, n := range targets {
+		if admin.self != nil && admin.self.Id == n.Id {
+			miners[i] = getMinerStatus()
+			continue
+		} else if !admin.isPeerUp(n.Id) || wemixapi.RequestMinerStatus == nil {
+			miners[i] = getDownStatus(n)
+			continue
 		}
-	} else {
-		for _, n := range nodes {
-			if admin.self != nil && admin.self.Id == n.Id {
-				miners = append(miners, getMinerStatus())
-				continue
-			} else if !admin.isPeerUp(n.Id) {
-				miners = append(miners, getDownStatus(n))
-				continue
-			}
 
-			err = admin.rpcCli.CallContext(ctx, nil, "admin_requestMinerStatus", n.Id)
+		wg.Add(1)
+		go func(i int, n *wemixNode) {
+			defer wg.Done()
+			startTime := time.Now()
+			s, err := wemixapi.RequestMinerStatus(ctx, n.Id)
 			if err != nil {
-				status := getDownStatus(n)
-				status.RttMs = big.NewInt((time.Now().UnixNano() - startTime) / 1000000)
-				miners = append(miners, status)
 				log.Error("RequestMinerStatus Failed", "id", n.Id, "error", err)
-			} else {
-				peers[n.Name] = n
-				count++
+				s = getDownStatus(n)
 			}
-		}
-	}
-
-	done := false
-	if count == 0 {
-		done = true
-	}
-	for {
-		if done {
-			break
-		}
-		select {
-		case msg := <-msgch:
-			s, ok := msg.(*wemixapi.WemixMinerStatus)
-			if !ok {
-				continue
-			}
-			if n, exists := peers[s.NodeName]; exists {
-				s.RttMs = big.NewInt((time.Now().UnixNano() - startTime) / 1000000)
-				miners = append(miners, s)
-				if n != nil {
-					peers[s.NodeName] = nil
-					count--
-					if count <= 0 {
-						done = true
-					}
-				}
-			}
-		case <-timer.C:
-			done = true
-		}
-	}
-
-	for _, n := range peers {
-		if n != nil {
-			status := getDownStatus(n)
-			status.RttMs = big.NewInt((time.Now().UnixNano() - startTime) / 1000000)
-			miners = append(miners, status)
-		}
+			s.RttMs = big.NewInt(time.Since(startTime).Milliseconds())
+			miners[i] = s
+		}(i, n)
 	}
+	wg.Wait()
 
 	if len(miners) > 1 {
 		sort.Slice(miners, func(i, j int) bool {
@@ -1722,26 +1703,9 @@ func getMiners(id string, timeout int) []*wemixapi.WemixMinerStatus {
 	return miners
 }
 
-func (ma *wemixAdmin) getTxPoolStatus() (pending, queued uint, err error) {
-	var data map[string]hexutil.Uint
-
-	ctx, cancel := context.WithCancel(context.Background())
-	err = ma.rpcCli.CallContext(ctx, &data, "txpool_status")
-	cancel()
-
-	if err != nil {
-		return
-	}
-	p, b1 := data["pending"]
-	q, b2 := data["queued"]
-	if !b1 || !b2 {
-		err = fmt.Errorf("Invalid Data")
-	} else {
-		pending = uint(p)
-		queued = uint(q)
-	}
-
-	return
+func (ma *wemixAdmin) getTxPoolStatus() (pending, queued uint) {
+	p, q := ma.eth.Stats()
+	return uint(p), uint(q)
 }
 
 func requirePendingTxs() bool {
@@ -1749,10 +1713,7 @@ func requirePendingTxs() bool {
 		return false
 	}
 
-	p, _, e := admin.getTxPoolStatus()
-	if e != nil {
-		return false
-	} else if p > 0 {
+	if p, _ := admin.getTxPoolStatus(); p > 0 {
 		return false
 	}
 
@@ -1760,65 +1721,263 @@ func requirePendingTxs() bool {
 }
 
 // checks
-// 1. fees total and per governance accounts are accurate
-// 2. sum(rewards) == fees + block reward
-// 3. rewards distribution is correct
-// 4. reward members, reward pool and maintenance account are correct
-// 5. balances of governance accounts are accurate.
-//   Note that it doesn't take account of internal transactions,
-//   so balance checks won't be accurate if there are contract transactions.
+//  1. fees total and per governance accounts are accurate
+//  2. sum(rewards) == fees + block reward
+//  3. rewards distribution is correct
+//  4. reward members, reward pool and maintenance account are correct
+//  5. balances of governance accounts and the state root are accurate.
+//     The block is replayed against the state of its parent, so internal
+//     transactions are accounted for as in block import.
 func verifyBlockRewards(height *big.Int) interface{} {
-	type result struct {
-		Status bool `json:"status"`
-		// txs counts: total, contract calls and simple ether transfers
-		Txs         int `json:"txs"` // # of txs
-		ContractTxs int `json:"contractTxs"`
-		SimpleTxs   int `json:"simpleTxs"`
-		// this will be 0 for now
-		BlockReward *big.Int `json:"blockReward"`
-		// fees: total and per accounts in governance contract
-		Fees map[string]*big.Int `json:"fees"`
-		// error and messsages if any
-		Error   string `json:"error"`
-		Message string `json:"message"`
-	}
-
-	r := &result{
+	r := &wemixapi.BlockRewardsReport{
+		Height: height,
 		Status: false,
-		Error:  "Not initialized",
+		Fees:   map[string]*big.Int{},
 	}
 
-	if admin == nil {
+	if admin == nil || admin.eth == nil {
+		r.Error = "Not initialized"
 		return r
 	}
 
+	if err := admin.verifyBlockRewards(height, r); err != nil {
+		r.Error = err.Error()
+	}
 	return r
 }
 
+// chainContext is the core.ChainContext of the local chain to replay blocks
+// with.
+type chainContext struct {
+	eth ethapi.Backend
+}
+
+func (c *chainContext) Engine() consensus.Engine {
+	return c.eth.Engine()
+}
+
+func (c *chainContext) GetHeader(hash common.Hash, number uint64) *types.Header {
+	header, err := c.eth.HeaderByHash(context.Background(), hash)
+	if err != nil || header == nil || header.Number.Uint64() != number {
+		return nil
+	}
+	return header
+}
+
+func (ma *wemixAdmin) verifyBlockRewards(height *big.Int, r *wemixapi.BlockRewardsReport) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bn := rpc.LatestBlockNumber
+	if height != nil {
+		bn = rpc.BlockNumber(height.Int64())
+	}
+	block, err := ma.eth.BlockByNumber(ctx, bn)
+	if err != nil {
+		return err
+	} else if block == nil {
+		return ethereum.NotFound
+	}
+	num := block.Number()
+	r.Height = num
+	if num.Sign() == 0 {
+		return fmt.Errorf("No rewards in the genesis block")
+	}
+	parent := new(big.Int).Sub(num, common.Big1)
+	parentHeader, err := ma.caller.headerByHash(ctx, block.ParentHash())
+	if err != nil {
+		return err
+	}
+	ctx = withBlock(ctx, parentHeader)
+
+	// the state replayed from the parent, and the one imported
+	statedb, _, err := ma.caller.stateAt(ctx, parent)
+	if err != nil {
+		return err
+	}
+	imported, _, err := ma.eth.StateAndHeaderByNumberOrHash(ctx, rpc.BlockNumberOrHashWithHash(block.Hash(), false))
+	if err != nil {
+		return err
+	} else if imported == nil {
+		return errStateUnavailable
+	}
+
+	var messages []string
+	failed := false
+	mismatch := func(format string, args ...interface{}) {
+		messages = append(messages, fmt.Sprintf(format, args...))
+		failed = true
+	}
+
+	// replay the transactions to total the fees
+	var (
+		config  = ma.eth.ChainConfig()
+		chain   = &chainContext{eth: ma.eth}
+		header  = block.Header()
+		gp      = new(core.GasPool).AddGas(block.GasLimit())
+		usedGas uint64
+		fees    = new(big.Int)
+	)
+	for i, tx := range block.Transactions() {
+		if to := tx.To(); to == nil || len(tx.Data()) > 0 || statedb.GetCodeSize(*to) > 0 {
+			r.ContractTxs++
+		} else {
+			r.SimpleTxs++
+		}
+		statedb.Prepare(tx.Hash(), i)
+		if _, err := core.ApplyTransaction(config, chain, nil, gp, statedb, header, tx, &usedGas, fees, vm.Config{}); err != nil {
+			return fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
+		}
+	}
+	r.Txs = len(block.Transactions())
+	if usedGas != block.GasUsed() {
+		mismatch("gas used: have %d, want %d", block.GasUsed(), usedGas)
+	}
+	r.Fees["total"] = fees
+	r.Fees["header"] = block.Fees()
+	if block.Fees() == nil || block.Fees().Cmp(fees) != 0 {
+		mismatch("fees: have %v, want %v", block.Fees(), fees)
+	}
+
+	// recalculate the rewards as in block import, crediting the replayed
+	// state
+	rp, err := ma.getRewardParams(ctx, parent)
+	if err != nil {
+		return err
+	}
+	r.BlockReward = rp.rewardAmount
+
+	coinbase, data, err := ma.CalculateRewards(parentHeader, big0, fees, func(addr common.Address, amt *big.Int) {
+		statedb.AddBalance(addr, amt)
+	})
+	if err != nil {
+		return err
+	}
+	if coinbase != nil && *coinbase != block.Coinbase() {
+		mismatch("coinbase: have %v, want %v", block.Coinbase(), *coinbase)
+	}
+
+	var expected, actual []reward
+	if err = json.Unmarshal(data, &expected); err != nil {
+		return err
+	}
+	if err = json.Unmarshal(block.Rewards(), &actual); err != nil {
+		mismatch("malformed rewards: %v", err)
+	}
+
+	// fee shares of governance accounts
+	if noFees, err := distributeRewards(num, rp, big0); err == nil && len(noFees) == len(expected) {
+		for i := range expected {
+			if share := new(big.Int).Sub(expected[i].Reward, noFees[i].Reward); share.Sign() != 0 {
+				r.Fees[expected[i].Addr.Hex()] = share
+			}
+		}
+	}
+
+	// per-account rewards
+	sumRewards := func(rr []reward) (map[common.Address]*big.Int, *big.Int) {
+		m, total := map[common.Address]*big.Int{}, new(big.Int)
+		for _, i := range rr {
+			if i.Reward == nil {
+				continue
+			}
+			if v, ok := m[i.Addr]; ok {
+				v.Add(v, i.Reward)
+			} else {
+				m[i.Addr] = new(big.Int).Set(i.Reward)
+			}
+			total.Add(total, i.Reward)
+		}
+		return m, total
+	}
+	want, _ := sumRewards(expected)
+	have, total := sumRewards(actual)
+	if sum := new(big.Int).Add(rp.rewardAmount, fees); total.Cmp(sum) != 0 {
+		mismatch("sum of rewards: have %v, want %v", total, sum)
+	}
+	var addrs []common.Address
+	for addr := range want {
+		addrs = append(addrs, addr)
+	}
+	for addr := range have {
+		if _, ok := want[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	for _, addr := range addrs {
+		e, a := want[addr], have[addr]
+		if e == nil {
+			e = big0
+		}
+		if a == nil {
+			a = big0
+		}
+		if e.Cmp(a) != 0 {
+			r.Discrepancies = append(r.Discrepancies, &wemixapi.RewardsDiscrepancy{
+				Addr:     addr,
+				Kind:     "reward",
+				Expected: e,
+				Actual:   a,
+			})
+		}
+	}
+	if len(expected) != len(actual) {
+		mismatch("rewards count: have %d, want %d", len(actual), len(expected))
+	} else {
+		for i := range expected {
+			if expected[i].Addr != actual[i].Addr {
+				mismatch("rewards[%d] address: have %v, want %v", i, actual[i].Addr, expected[i].Addr)
+			}
+		}
+	}
+
+	// balances of governance accounts, replayed vs. imported
+	for _, addr := range addrs {
+		e, a := statedb.GetBalance(addr), imported.GetBalance(addr)
+		if e.Cmp(a) != 0 {
+			r.Discrepancies = append(r.Discrepancies, &wemixapi.RewardsDiscrepancy{
+				Addr:     addr,
+				Kind:     "balance",
+				Expected: e,
+				Actual:   a,
+			})
+		}
+	}
+	if root := statedb.IntermediateRoot(config.IsEIP158(num)); root != block.Root() {
+		mismatch("state root: have %v, want %v", block.Root(), root)
+	}
+
+	r.Status = !failed && len(r.Discrepancies) == 0
+	r.Message = strings.Join(messages, "; ")
+	return nil
+}
+
 func init() {
 	wemixminer.IsMinerFunc = IsMiner
 	wemixminer.AmPartnerFunc = AmPartner
 	wemixminer.IsPartnerFunc = IsPartner
 	wemixminer.AmHubFunc = AmHub
+	wemixminer.GovernanceNodesFunc = GovernanceNodes
 	wemixminer.LogBlockFunc = LogBlock
 	wemixminer.SuggestGasPriceFunc = suggestGasPrice
-	wemixminer.CalculateRewardsFunc = calculateRewards
-	wemixminer.VerifyRewardsFunc = verifyRewards
-	wemixminer.SignBlockFunc = signBlock
-	wemixminer.VerifyBlockSigFunc = verifyBlockSig
 	wemixminer.RequirePendingTxsFunc = requirePendingTxs
 	wemixminer.VerifyBlockRewardsFunc = verifyBlockRewards
 	wemixminer.GetBlockBuildParametersFunc = getBlockBuildParameters
 	wemixapi.Info = Info
 	wemixapi.GetMiners = getMiners
 	wemixapi.GetMinerStatus = getMinerStatus
-	wemixapi.EtcdInit = EtcdInit
-	wemixapi.EtcdAddMember = EtcdAddMember
-	wemixapi.EtcdRemoveMember = EtcdRemoveMember
-	wemixapi.EtcdJoin = EtcdJoin
-	wemixapi.EtcdMoveLeader = EtcdMoveLeader
-	wemixapi.EtcdGetWork = EtcdGetWork
-	wemixapi.EtcdDeleteWork = EtcdDeleteWork
+	wemixapi.RaftStep = RaftStep
+	wemixapi.RaftMoveLeader = RaftMoveLeader
+	wemixapi.RaftGetWork = RaftGetWork
+	wemixapi.RaftDeleteWork = RaftDeleteWork
+	wemixapi.GetMembers = getMembers
+	wemixapi.GetNodes = getNodes
+	wemixapi.GetEnvValues = getEnvValues
+	wemixapi.GetRewardParameters = getRewardParameters
+	wemixapi.GetBlockMiner = getBlockMiner
 }
 
 /* EOF */
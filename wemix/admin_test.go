@@ -0,0 +1,299 @@
+// admin_test.go
+
+package wemix
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+	"github.com/ethereum/go-ethereum/wemix/governance"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+func testRewardParams() *rewardParameters {
+	staker := common.HexToAddress("0x1000")
+	ecoSystem := common.HexToAddress("0x2000")
+	maintenance := common.HexToAddress("0x3000")
+	return &rewardParameters{
+		rewardAmount: big.NewInt(1e18),
+		staker:       &staker,
+		ecoSystem:    &ecoSystem,
+		maintenance:  &maintenance,
+		members: []*wemixMember{
+			{Addr: common.HexToAddress("0x0001")},
+			{Addr: common.HexToAddress("0x0002")},
+			{Addr: common.HexToAddress("0x0003")},
+		},
+		distributionMethod: []*big.Int{
+			big.NewInt(4000), big.NewInt(1000), big.NewInt(2500), big.NewInt(2500),
+		},
+		blocksPer: 1,
+	}
+}
+
+func TestVerifyRewards(t *testing.T) {
+	num := big.NewInt(100)
+	rr, err := distributeRewards(num, testRewardParams(), big.NewInt(21000))
+	if err != nil {
+		t.Fatalf("failed to distribute rewards: %v", err)
+	}
+	expected, _ := json.Marshal(rr)
+
+	if err := verifyRewards(num, expected, expected); err != nil {
+		t.Fatalf("identical rewards rejected: %v", err)
+	}
+
+	tamper := func(f func([]reward) []reward) []byte {
+		var r []reward
+		json.Unmarshal(expected, &r)
+		data, _ := json.Marshal(f(r))
+		return data
+	}
+	tests := map[string][]byte{
+		"skimmed": tamper(func(r []reward) []reward {
+			r[0].Reward.Sub(r[0].Reward, big.NewInt(1))
+			r[len(r)-1].Reward.Add(r[len(r)-1].Reward, big.NewInt(1))
+			return r
+		}),
+		"redirected": tamper(func(r []reward) []reward {
+			r[1].Addr = common.HexToAddress("0xbad")
+			return r
+		}),
+		"dropped": tamper(func(r []reward) []reward {
+			return r[:len(r)-1]
+		}),
+		"missing": tamper(func(r []reward) []reward {
+			r[2].Reward = nil
+			return r
+		}),
+		"empty":     nil,
+		"malformed": []byte("{"),
+	}
+	for name, actual := range tests {
+		err := verifyRewards(num, expected, actual)
+		if !errors.Is(err, consensus.ErrInvalidRewards) {
+			t.Errorf("%s: expected invalid rewards error, got %v", name, err)
+		}
+	}
+}
+
+func TestNextMiner(t *testing.T) {
+	nodes := []*wemixNode{
+		{Name: "a", Id: "1", Status: "up"},
+		{Name: "b", Id: "2", Status: "down"},
+		{Name: "c", Id: "3", Status: "up"},
+	}
+	tests := []struct {
+		height int64
+		miner  string
+	}{
+		{0, "a"},
+		{9, "a"},
+		{10, "b"}, // down, but it's up to the others to time it out
+		{25, "c"},
+		{30, "a"},
+	}
+	for _, tt := range tests {
+		if miner := nextMiner(nodes, tt.height, 10); miner == nil || miner.Name != tt.miner {
+			t.Errorf("height %d: have %v, want %s", tt.height, miner, tt.miner)
+		}
+	}
+	if miner := nextMiner(nil, 0, 10); miner != nil {
+		t.Errorf("no nodes: have %s, want none", miner.Name)
+	}
+}
+
+func TestBlockRewards(t *testing.T) {
+	num := big.NewInt(100)
+	rr, err := distributeRewards(num, testRewardParams(), big.NewInt(21000))
+	if err != nil {
+		t.Fatalf("failed to distribute rewards: %v", err)
+	}
+	data, _ := json.Marshal(rr)
+	header := &types.Header{Number: num, Fees: big.NewInt(21000), Rewards: data}
+
+	br, err := wemixapi.BlockRewards(header)
+	if err != nil {
+		t.Fatalf("failed to decode rewards: %v", err)
+	}
+	if br.Number.Cmp(num) != 0 || br.Fees.Cmp(header.Fees) != 0 || len(br.Rewards) != len(rr) {
+		t.Fatalf("unexpected block rewards %+v", br)
+	}
+	for i, r := range br.Rewards {
+		if r.Addr != rr[i].Addr || r.Reward.Cmp(rr[i].Reward) != 0 {
+			t.Errorf("reward %d: have %x %v, want %x %v", i, r.Addr, r.Reward, rr[i].Addr, rr[i].Reward)
+		}
+	}
+
+	header.Rewards = []byte("{")
+	if _, err := wemixapi.BlockRewards(header); err == nil {
+		t.Errorf("malformed rewards decoded")
+	}
+}
+
+// Tests that only the missing governance is taken for the boot-only phase,
+// and the other failures to read it are returned as they are from the strict
+// governance fork on.
+func TestGovernanceNotInitialized(t *testing.T) {
+	var (
+		key, _ = crypto.GenerateKey()
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		db     = rawdb.NewMemoryDatabase()
+		gspec  = &core.Genesis{
+			Config:   params.TestChainConfig,
+			GasLimit: 10000000,
+			Alloc:    core.GenesisAlloc{addr: {Balance: big.NewInt(1e18)}},
+		}
+		genesis = gspec.MustCommit(db)
+		signer  = types.LatestSigner(gspec.Config)
+	)
+	// the registry is deployed in block 2, without a Gov contract, which is
+	// registered at an address without code in block 3
+	registryABI, _ := governance.RegistryMetaData.GetAbi()
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 3, func(i int, b *core.BlockGen) {
+		switch i {
+		case 1:
+			tx, _ := types.SignTx(types.NewContractCreation(0, nil, 5000000, b.BaseFee(),
+				common.FromHex(governance.RegistryMetaData.Bin)), signer, key)
+			b.AddTx(tx)
+		case 2:
+			data, _ := registryABI.Pack("setContractDomain", governance.GovernanceContractName, common.HexToAddress("0xdead"))
+			tx, _ := types.SignTx(types.NewTransaction(1, crypto.CreateAddress(addr, 0), nil, 1000000, b.BaseFee(), data), signer, key)
+			b.AddTx(tx)
+		}
+	})
+	chain, _ := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	defer chain.Stop()
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	ma := &wemixAdmin{
+		caller:      &stateCaller{backend: &testStateBackend{chain: chain}},
+		bootAccount: addr,
+	}
+
+	for _, tt := range []struct {
+		name   string
+		parent *types.Header
+		err    error
+	}{
+		{"no registry", blocks[0].Header(), wemixminer.ErrNotInitialized},
+		{"no gov", blocks[1].Header(), wemixminer.ErrNotInitialized},
+		{"broken gov", blocks[2].Header(), wemixminer.ErrNotInitialized},
+		{"unknown parent", &types.Header{Number: big.NewInt(2), ParentHash: common.Hash{0x01}}, errStateUnavailable},
+	} {
+		if _, _, err := ma.CalculateRewards(tt.parent, big.NewInt(0), big.NewInt(0), nil); err != tt.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", tt.name, err, tt.err)
+		}
+	}
+	// from the strict governance fork on, the broken governance rejects the
+	// blocks
+	config := *params.TestChainConfig
+	config.Wemix = &params.WemixConfig{StrictGovernanceBlock: big.NewInt(4)}
+	ma.chainConfig = &config
+	if _, _, err := ma.CalculateRewards(blocks[2].Header(), big.NewInt(0), big.NewInt(0), nil); err == nil || err == wemixminer.ErrNotInitialized {
+		t.Errorf("broken gov at the strict governance fork: have %v, want the error reading it", err)
+	}
+	if _, _, err := ma.CalculateRewards(blocks[1].Header(), big.NewInt(0), big.NewInt(0), nil); err != wemixminer.ErrNotInitialized {
+		t.Errorf("no gov at the strict governance fork: have %v, want %v", err, wemixminer.ErrNotInitialized)
+	}
+	ma.chainConfig = nil
+	if _, _, _, _, err := ma.getRegGovEnvContracts(context.Background(), big.NewInt(4)); err != errStateUnavailable {
+		t.Errorf("error mismatch of unknown block: have %v, want %v", err, errStateUnavailable)
+	}
+
+	// only the boot node signs before governance, and the signers of an
+	// unknown parent are not taken for the boot-only ones
+	bootKey, _ := crypto.GenerateKey()
+	bootId := crypto.FromECDSAPub(&bootKey.PublicKey)[1:]
+	ma.bootNodeId = hex.EncodeToString(crypto.Keccak256(bootId))
+	if ok, err := ma.IsBlockSigner(blocks[1].Header(), bootId); !ok || err != nil {
+		t.Errorf("boot node rejected before governance: %v, %v", ok, err)
+	}
+	if ok, err := ma.IsBlockSigner(blocks[1].Header(), crypto.FromECDSAPub(&key.PublicKey)[1:]); ok || err != nil {
+		t.Errorf("non-boot node accepted before governance: %v, %v", ok, err)
+	}
+	unknown := &types.Header{Number: big.NewInt(2), ParentHash: common.Hash{0x01}}
+	if ok, err := ma.IsBlockSigner(unknown, bootId); ok || err != errStateUnavailable {
+		t.Errorf("signer of unknown parent: have %v, %v, want false, %v", ok, err, errStateUnavailable)
+	}
+
+	// the miner of a block of an unknown parent is not taken for the boot node
+	defer func(saved *wemixAdmin) { admin = saved }(admin)
+	admin = ma
+	if m, err := getBlockMiner(context.Background(), blocks[1].Header()); err != nil || m.Node != nil {
+		t.Errorf("miner before governance: have %v, %v, want the boot node", m, err)
+	}
+	orphan := &types.Header{Number: big.NewInt(3), ParentHash: common.Hash{0x01}}
+	if _, err := getBlockMiner(context.Background(), orphan); err == nil {
+		t.Errorf("miner of block of unknown parent found")
+	}
+}
+
+// Tests that the local node info is read without ma.lock as it's set.
+func TestSelfInfo(t *testing.T) {
+	defer func(saved *wemixAdmin) { admin = saved }(admin)
+	admin = &wemixAdmin{bootNodeId: "0abc"}
+	if AmHub("0abc") != -1 || admin.isBootNode() {
+		t.Fatalf("local node known before its info is set")
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			AmHub("0abc")
+		}
+	}()
+	admin.nodeInfo.Store(&p2p.NodeInfo{ID: "0abc"})
+	<-done
+	if AmHub("0abc") != 1 || AmHub("0def") != 0 || !admin.isBootNode() {
+		t.Errorf("local node info mismatch")
+	}
+}
+
+// Tests that the partners are told without ma.lock, which LogBlock holds
+// while waiting for raft, whose messages are only taken from the partners.
+func TestPartnerWithoutLock(t *testing.T) {
+	var (
+		boot  = "0000000000000001" + strings.Repeat("ab", 24)
+		gov   = "0000000000000002" + strings.Repeat("ab", 24)
+		other = "0000000000000002" + strings.Repeat("cd", 24)
+	)
+	defer func(saved *wemixAdmin) { admin = saved }(admin)
+	admin = &wemixAdmin{lock: &sync.Mutex{}, bootNodeId: boot}
+	admin.lock.Lock()
+	defer admin.lock.Unlock()
+
+	admin.nodeInfo.Store(&p2p.NodeInfo{ID: gov})
+	if AmPartner() || IsPartner(gov) || !IsPartner(boot) {
+		t.Fatalf("partner before governance")
+	}
+	admin.raftSetPeers([]*wemixNode{{Name: "gov", Id: gov}})
+	if !AmPartner() || !IsPartner(gov) || !IsPartner(boot) {
+		t.Errorf("governance node not a partner")
+	}
+	if IsPartner(other) {
+		t.Errorf("colliding id taken for a partner")
+	}
+	admin.nodeInfo.Store(&p2p.NodeInfo{ID: boot})
+	if !AmPartner() {
+		t.Errorf("boot node not a partner")
+	}
+}
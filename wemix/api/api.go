@@ -3,10 +3,13 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
-	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 type WemixMinerStatus struct {
@@ -25,44 +28,147 @@ type WemixMinerStatus struct {
 	RttMs *big.Int `json:"rttMs"`
 }
 
-var (
-	msgChannelLock = &sync.Mutex{}
-	msgChannel     chan interface{}
+// RewardsDiscrepancy is a mismatch found in the rewards of a block for an
+// account. Kind is either "reward" or "balance".
+type RewardsDiscrepancy struct {
+	Addr     common.Address `json:"addr"`
+	Kind     string         `json:"kind"`
+	Expected *big.Int       `json:"expected"`
+	Actual   *big.Int       `json:"actual"`
+}
 
-	Info func() interface{}
+// BlockRewardsReport is the result of verifying the fees and rewards of a
+// block against the governance state of its parent block.
+type BlockRewardsReport struct {
+	Height *big.Int `json:"height"`
+	Status bool     `json:"status"`
+	// txs counts: total, contract calls and simple ether transfers
+	Txs         int `json:"txs"`
+	ContractTxs int `json:"contractTxs"`
+	SimpleTxs   int `json:"simpleTxs"`
+	BlockReward *big.Int `json:"blockReward"`
+	// fees: "total" replayed, "header" in the block header and shares of
+	// governance accounts by address
+	Fees          map[string]*big.Int   `json:"fees"`
+	Discrepancies []*RewardsDiscrepancy `json:"discrepancies"`
+	// error and messsages if any
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
 
-	GetMinerStatus func() *WemixMinerStatus
-	GetMiners      func(node string, timeout int) []*WemixMinerStatus
+// WemixGovMember is a governance member at a block.
+type WemixGovMember struct {
+	Staker common.Address `json:"staker"`
+	Voter  common.Address `json:"voter"`
+	Reward common.Address `json:"reward"`
+	Stake  *big.Int       `json:"stake"` // locked balance of the staker
+}
 
-	EtcdInit         func() error
-	EtcdAddMember    func(name string) (string, error)
-	EtcdRemoveMember func(name string) (string, error)
-	EtcdJoin         func(cluster string) error
-	EtcdMoveLeader   func(name string) error
-	EtcdGetWork      func() (string, error)
-	EtcdDeleteWork   func() error
-)
+// WemixGovNode is a governance node at a block.
+type WemixGovNode struct {
+	Name   string         `json:"name"`
+	Enode  string         `json:"enode"`
+	Id     string         `json:"id"`
+	Ip     string         `json:"ip"`
+	Port   int            `json:"port"`
+	Reward common.Address `json:"reward"`
+}
 
-func SetMsgChannel(ch chan interface{}) {
-	msgChannelLock.Lock()
-	defer msgChannelLock.Unlock()
-	msgChannel = ch
+// WemixRewardParameters are the governance parameters the rewards of the
+// next block are distributed by.
+type WemixRewardParameters struct {
+	BlockReward *big.Int `json:"blockReward"`
+	// shares of block producers, staking reward, ecosystem and maintenance
+	// out of 10000
+	DistributionMethod []*big.Int        `json:"distributionMethod"`
+	StakingReward      *common.Address   `json:"stakingReward"`
+	Ecosystem          *common.Address   `json:"ecosystem"`
+	Maintenance        *common.Address   `json:"maintenance"`
+	Members            []*common.Address `json:"members"` // reward addresses
+	BlocksPer          int64             `json:"blocksPer"`
 }
 
-func GotStatusEx(status *WemixMinerStatus) {
-	msgChannelLock.Lock()
-	defer msgChannelLock.Unlock()
-	if msgChannel != nil {
-		msgChannel <- status
-	}
+// WemixReward is a reward of a block, in the format of Header.Rewards.
+type WemixReward struct {
+	Addr   common.Address `json:"addr"`
+	Reward *big.Int       `json:"reward"`
+}
+
+// WemixBlockRewards are the fees and the rewards of a block.
+type WemixBlockRewards struct {
+	Number   *big.Int       `json:"number"`
+	Coinbase common.Address `json:"coinbase"`
+	Fees     *big.Int       `json:"fees"`
+	Rewards  []*WemixReward `json:"rewards"`
 }
 
-func GotEtcdCluster(cluster string) {
-	msgChannelLock.Lock()
-	defer msgChannelLock.Unlock()
-	if msgChannel != nil {
-		msgChannel <- cluster
+// WemixBlockMiner is the node that mined a block. Node is nil if the node
+// is not found among the governance nodes of the parent block.
+type WemixBlockMiner struct {
+	Number      *big.Int       `json:"number"`
+	Coinbase    common.Address `json:"coinbase"`
+	MinerNodeId hexutil.Bytes  `json:"minerNodeId"`
+	Node        *WemixGovNode  `json:"node"`
+}
+
+// WemixRewardEntry is the block reward an address received in a block. Fees
+// are the transaction fees of the block if the address is its coinbase, for
+// information only, as they are distributed in the rewards.
+type WemixRewardEntry struct {
+	Number uint64   `json:"number"`
+	Reward *big.Int `json:"reward"`
+	Fees   *big.Int `json:"fees"`
+}
+
+// WemixRewardTotal is the sum of the block rewards an address received in a
+// block range. Fees is the sum of the transaction fees of the blocks mined by
+// the address, for information only, as they are part of the rewards.
+type WemixRewardTotal struct {
+	Address common.Address `json:"address"`
+	From    uint64         `json:"from"`
+	To      uint64         `json:"to"`
+	Blocks  int            `json:"blocks"` // # of the blocks with the rewards
+	Rewards *big.Int       `json:"rewards"`
+	Fees    *big.Int       `json:"fees"`
+}
+
+// BlockRewards decodes the rewards in the header.
+func BlockRewards(header *types.Header) (*WemixBlockRewards, error) {
+	r := &WemixBlockRewards{
+		Number:   header.Number,
+		Coinbase: header.Coinbase,
+		Fees:     header.Fees,
+		Rewards:  []*WemixReward{},
+	}
+	if len(header.Rewards) > 0 {
+		if err := json.Unmarshal(header.Rewards, &r.Rewards); err != nil {
+			return nil, err
+		}
 	}
+	return r, nil
 }
 
+var (
+	Info func() interface{}
+
+	GetMinerStatus func() *WemixMinerStatus
+	GetMiners      func(node string, timeout int) []*WemixMinerStatus
+
+	RaftStep       func(id string, data []byte) error
+	RaftMoveLeader func(name string) error
+	RaftGetWork    func() (string, error)
+	RaftDeleteWork func() error
+
+	// the governance at the given block
+	GetMembers          func(ctx context.Context, header *types.Header) ([]*WemixGovMember, error)
+	GetNodes            func(ctx context.Context, header *types.Header) ([]*WemixGovNode, error)
+	GetEnvValues        func(ctx context.Context, header *types.Header) (map[string]*big.Int, error)
+	GetRewardParameters func(ctx context.Context, header *types.Header) (*WemixRewardParameters, error)
+	GetBlockMiner       func(ctx context.Context, header *types.Header) (*WemixBlockMiner, error)
+
+	// set by the eth protocol handler
+	SendRaftMessage    func(id string, data []byte) error
+	RequestMinerStatus func(ctx context.Context, id string) (*WemixMinerStatus, error)
+)
+
 // EOF
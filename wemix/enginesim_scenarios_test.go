@@ -0,0 +1,98 @@
+// enginesim_scenarios_test.go
+
+package wemix
+
+import (
+	"testing"
+	"time"
+)
+
+// Tests that the followers elect a new leader that goes on mining when the
+// leader crashes, and that it catches up and rejoins once restarted.
+func TestEngineSimLeaderCrash(t *testing.T) {
+	c := newSimCluster(t, 3, 3)
+	leader := c.waitLeader(c.nodes)
+	c.waitConverged(c.nodes, 2)
+
+	c.stop(leader)
+	rest := c.except(c.nodes, leader)
+	if c.waitLeader(rest) == leader {
+		t.Fatalf("crashed %s still the leader", leader)
+	}
+	from := c.head(rest[0]) + 1
+	head := c.waitConverged(rest, from+3)
+	if signers := c.signers(rest[0], from, uint64(head.Number)); signers[leader] != 0 {
+		t.Fatalf("blocks signed by the crashed %s: %v", leader, signers)
+	}
+
+	c.start(leader)
+	c.waitConverged(c.nodes, uint64(head.Number)+2)
+	c.waitLeader(c.nodes)
+}
+
+// Tests that a partner added by a ballot joins the cluster and mines, and
+// that the leader removed by a ballot hands over the leadership and stops
+// mining.
+func TestEngineSimAddRemovePartner(t *testing.T) {
+	c := newSimCluster(t, 4, 3)
+	members, joiner := c.nodes[:3], c.nodes[3]
+	c.waitLeader(members)
+	c.addPeer(joiner, members[0])
+	c.waitConverged(c.nodes, 2)
+
+	c.addPartner(members, joiner)
+	c.waitLeader(c.nodes)
+	head := c.waitConverged(c.nodes, c.head(joiner)+2)
+
+	leader := c.waitLeader(c.nodes)
+	rest := c.except(c.nodes, leader)
+	c.removePartner(rest, leader)
+	// the removed node doesn't hear of its removal, the others drop its
+	// messages, and it stays a follower out of the cluster
+	c.waitFor("the removed partner to leave the cluster", func() bool {
+		if c.leader(rest) == nil {
+			return false
+		}
+		for _, n := range rest {
+			if r := c.raft(n); r == nil || len(r.Members) != len(rest) {
+				return false
+			}
+		}
+		return true
+	})
+	from := c.head(rest[0]) + 1
+	head = c.waitConverged(c.nodes, from+3)
+	if signers := c.signers(rest[0], from, uint64(head.Number)); signers[leader] != 0 {
+		t.Fatalf("blocks signed by the removed %s: %v", leader, signers)
+	}
+}
+
+// Tests that the majority side of a partition elects a leader and goes on
+// mining, while the leader cut off in the minority stops, and that the
+// minority takes the majority's chain once the partition heals.
+func TestEngineSimPartition(t *testing.T) {
+	c := newSimCluster(t, 3, 3)
+	leader := c.waitLeader(c.nodes)
+	c.waitConverged(c.nodes, 2)
+
+	majority := c.except(c.nodes, leader)
+	c.partition([]*simNode{leader}, majority)
+	if c.waitLeader(majority) == leader {
+		t.Fatalf("%s cut off still the leader", leader)
+	}
+	c.waitFor("the cut off leader to step down", func() bool {
+		r := c.raft(leader)
+		return r != nil && r.State != "StateLeader"
+	})
+	// a block underway when it stepped down may still land
+	time.Sleep(2 * time.Second)
+	stalled := c.head(leader)
+	head := c.waitConverged(majority, c.head(majority[0])+3)
+	if have := c.head(leader); have != stalled {
+		t.Fatalf("cut off %s mined: head %d, was %d", leader, have, stalled)
+	}
+
+	c.heal(c.nodes)
+	c.waitConverged(c.nodes, uint64(head.Number))
+	c.waitLeader(c.nodes)
+}
@@ -0,0 +1,812 @@
+// enginesim_test.go
+
+package wemix
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/simulations"
+	"github.com/ethereum/go-ethereum/p2p/simulations/adapters"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/wemix/governance"
+)
+
+const (
+	simServiceName = "wemix"
+	simTimeout     = 2 * time.Minute
+)
+
+var (
+	simStakingMin, _ = new(big.Int).SetString("4980000000000000000000000", 10)
+	simBalance, _    = new(big.Int).SetString("100000000000000000000000000", 10)
+
+	simStakingReward = common.HexToAddress("0x5701")
+	simEcosystem     = common.HexToAddress("0x5702")
+	simMaintenance   = common.HexToAddress("0x5703")
+
+	errSimBlocked = errors.New("blocked by the partition")
+)
+
+func init() {
+	// runs the node instead of the tests in the processes the exec adapter
+	// starts
+	adapters.RegisterLifecycles(adapters.LifecycleConstructors{
+		simServiceName: newSimWemix,
+	})
+}
+
+// simWemix is the service of a simulated node: eth with the wemix admin set
+// up as gwemix does, and the simwemix API to partition the node off.
+type simWemix struct {
+	stack  *node.Node
+	dialer *simDialer
+}
+
+// newSimWemix runs in the node's own process, as the admin, raft and the
+// miner hooks are process wide. The properties of the node config are the
+// genesis file and the etherbase, as gwemix.sh gives --miner.etherbase.
+func newSimWemix(ctx *adapters.ServiceContext, stack *node.Node) (node.Lifecycle, error) {
+	if len(ctx.Config.Properties) != 2 {
+		return nil, errors.New("no genesis or etherbase given")
+	}
+	data, err := ioutil.ReadFile(ctx.Config.Properties[0])
+	if err != nil {
+		return nil, err
+	}
+	genesis := new(core.Genesis)
+	if err := json.Unmarshal(data, genesis); err != nil {
+		return nil, err
+	}
+
+	// as gwemix sets it by the flag
+	params.ConsensusMethod = genesis.Config.Wemix.Consensus()
+	config := ethconfig.Defaults
+	config.Genesis = genesis
+	config.NetworkId = genesis.Config.ChainID.Uint64()
+	config.SyncMode = downloader.FullSync
+	config.DatabaseCache = 16
+	config.TrieCleanCache = 16
+	config.TrieDirtyCache = 16
+	config.SnapshotCache = 0
+	config.Miner.Etherbase = common.HexToAddress(ctx.Config.Properties[1])
+	backend, err := eth.New(stack, &config)
+	if err != nil {
+		return nil, err
+	}
+	InitAdmin(stack, stack.Config().DataDir, backend.APIBackend)
+
+	s := &simWemix{stack: stack, dialer: &simDialer{}}
+	stack.Server().Dialer = s.dialer
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "simwemix",
+		Version:   "1.0",
+		Service:   s,
+		Public:    true,
+	}})
+	stack.RegisterLifecycle(s)
+	return s, nil
+}
+
+func (s *simWemix) Start() error {
+	StartAdmin()
+	return nil
+}
+
+func (s *simWemix) Stop() error {
+	return nil
+}
+
+// Partition drops the peers among the given nodes, and keeps the node from
+// dialing them until Heal.
+func (s *simWemix) Partition(ids []enode.ID) {
+	s.dialer.block(ids)
+	for _, p := range s.stack.Server().Peers() {
+		if s.dialer.blocked(p.ID()) {
+			p.Disconnect(p2p.DiscRequested)
+		}
+	}
+}
+
+// Heal lets the node dial all the nodes again.
+func (s *simWemix) Heal() {
+	s.dialer.block(nil)
+}
+
+// simDialer dials the nodes over TCP, but for the blocked ones. As all the
+// nodes in a partition block the ones on the other side, there are no
+// connections across it either way.
+type simDialer struct {
+	lock sync.Mutex
+	ids  map[enode.ID]bool
+}
+
+func (d *simDialer) block(ids []enode.ID) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.ids = map[enode.ID]bool{}
+	for _, id := range ids {
+		d.ids[id] = true
+	}
+}
+
+func (d *simDialer) blocked(id enode.ID) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.ids[id]
+}
+
+func (d *simDialer) Dial(ctx context.Context, n *enode.Node) (net.Conn, error) {
+	if d.blocked(n.ID()) {
+		return nil, errSimBlocked
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", (&net.TCPAddr{IP: n.IP(), Port: n.TCP()}).String())
+}
+
+// simCluster is a network of gwemix nodes on localhost, each a node.Node
+// with eth, the wemix admin and raft in its own process, run with the
+// p2p/simulations exec adapter. The genesis has the governance contracts
+// deployed by the first node's account, as deploy-governance.js does, and
+// the ETCD consensus, i.e. the raft leader mines, under the strict
+// governance rules from genesis on.
+//
+// The tests drive the cluster over RPC like an operator would: they crash
+// and restart the nodes, partition them with the simwemix API, and add and
+// remove partners with the governance ballots.
+type simCluster struct {
+	t       *testing.T
+	dir     string
+	net     *simulations.Network
+	nodes   []*simNode
+	chainID *big.Int
+
+	gov, staking, ballotStorage common.Address
+}
+
+// simNode is a node in simCluster, with the staker, voter and reward account
+// of its member in the governance.
+type simNode struct {
+	name   string
+	config *adapters.NodeConfig
+	key    *ecdsa.PrivateKey
+	addr   common.Address
+}
+
+func (n *simNode) String() string {
+	return n.name
+}
+
+// enode returns the node's public key without the 0x04 prefix, as the
+// governance keeps it.
+func (n *simNode) enode() []byte {
+	return crypto.FromECDSAPub(&n.config.PrivateKey.PublicKey)[1:]
+}
+
+func (n *simNode) url() string {
+	port := int(n.config.Port)
+	return enode.NewV4(&n.config.PrivateKey.PublicKey, net.ParseIP("127.0.0.1"), port, port).URLv4()
+}
+
+// newSimCluster starts count nodes, the first members of which are in the
+// governance from genesis on, and the rest are to be added later.
+func newSimCluster(t *testing.T, count, members int) *simCluster {
+	if testing.Short() {
+		t.Skip("skipping the cluster simulation in short mode")
+	}
+	c := &simCluster{t: t, dir: t.TempDir(), chainID: big.NewInt(1111)}
+	genesisFile := filepath.Join(c.dir, "genesis.json")
+	for i := 0; i < count; i++ {
+		config := adapters.RandomNodeConfig()
+		config.Name = fmt.Sprintf("node%d", i+1)
+		config.Lifecycles = []string{simServiceName}
+		key, _ := crypto.GenerateKey()
+		config.Properties = []string{genesisFile, crypto.PubkeyToAddress(key.PublicKey).Hex()}
+		config.EnableMsgEvents = false
+		config.LogFile = filepath.Join(c.dir, config.Name+".log")
+		c.nodes = append(c.nodes, &simNode{
+			name:   config.Name,
+			config: config,
+			key:    key,
+			addr:   crypto.PubkeyToAddress(key.PublicKey),
+		})
+	}
+
+	data, err := json.Marshal(c.genesis(members))
+	if err != nil {
+		t.Fatalf("failed to encode the genesis: %v", err)
+	}
+	if err := ioutil.WriteFile(genesisFile, data, 0644); err != nil {
+		t.Fatalf("failed to write the genesis: %v", err)
+	}
+
+	c.net = simulations.NewNetwork(adapters.NewExecAdapter(c.dir), &simulations.NetworkConfig{
+		DefaultService: simServiceName,
+	})
+	t.Cleanup(func() {
+		c.net.Shutdown()
+		if t.Failed() {
+			c.logs()
+		}
+	})
+	for _, n := range c.nodes {
+		sn, err := c.net.NewNodeWithConfig(n.config)
+		if err != nil {
+			t.Fatalf("failed to create %s: %v", n, err)
+		}
+		// WSExposeAll isn't honored, the modules are to be listed
+		sn.Node.(*adapters.ExecNode).Config.Stack.WSModules = []string{
+			"admin", "eth", "miner", "net", "simwemix", "web3"}
+		c.start(n)
+	}
+	return c
+}
+
+// genesis deploys the governance contracts on a simulated backend with the
+// first members as the members, staked the minimum, and returns
+// the genesis with the resulting state. The first node is the boot node,
+// whose account deploys the contracts, i.e. the coinbase of the genesis.
+func (c *simCluster) genesis(members int) *core.Genesis {
+	var (
+		boot    = c.nodes[0]
+		alloc   = core.GenesisAlloc{}
+		stakes  bytes.Buffer
+		nodes   bytes.Buffer
+		ctx     = context.Background()
+		backend *backends.SimulatedBackend
+	)
+	for i, n := range c.nodes {
+		alloc[n.addr] = core.GenesisAccount{Balance: simBalance}
+		if i >= members {
+			continue
+		}
+		stakes.Write(common.LeftPadBytes(n.addr[:], 32))
+		stakes.Write(math.U256Bytes(simStakingMin))
+		// staker, name, enode, ip and port, the variable length ones
+		// prefixed with their lengths
+		nodes.Write(common.LeftPadBytes(n.addr[:], 32))
+		for _, b := range [][]byte{[]byte(n.name), n.enode(), []byte("127.0.0.1")} {
+			nodes.Write(math.U256Bytes(big.NewInt(int64(len(b)))))
+			nodes.Write(b)
+		}
+		nodes.Write(math.U256Bytes(big.NewInt(int64(n.config.Port))))
+	}
+	backend = backends.NewSimulatedBackend(alloc, 50000000)
+	defer backend.Close()
+
+	opts, _ := bind.NewKeyedTransactorWithChainID(boot.key, params.AllEthashProtocolChanges.ChainID)
+	opts.GasLimit = 20000000
+	check := func(what string, tx *types.Transaction, err error) {
+		if err != nil {
+			c.t.Fatalf("failed to %s: %v", what, err)
+		}
+		backend.Commit()
+		if r, err := backend.TransactionReceipt(ctx, tx.Hash()); err != nil || r.Status != types.ReceiptStatusSuccessful {
+			c.t.Fatalf("failed to %s: receipt %v, error %v", what, r, err)
+		}
+	}
+
+	var (
+		reg                                         *governance.Registry
+		registry, envStorageImp, envStorage, govImp common.Address
+		tx                                          *types.Transaction
+		err                                         error
+	)
+	registry, tx, reg, err = governance.DeployRegistry(opts, backend)
+	check("deploy Registry", tx, err)
+	envStorageImp, tx, _, err = governance.DeployEnvStorageImp(opts, backend)
+	check("deploy EnvStorageImp", tx, err)
+	c.staking, tx, _, err = governance.DeployStaking(opts, backend, registry, stakes.Bytes())
+	check("deploy Staking", tx, err)
+	c.ballotStorage, tx, _, err = governance.DeployBallotStorage(opts, backend, registry)
+	check("deploy BallotStorage", tx, err)
+	envStorage, tx, _, err = governance.DeployEnvStorage(opts, backend, envStorageImp)
+	check("deploy EnvStorage", tx, err)
+	govImp, tx, _, err = governance.DeployGovImp(opts, backend)
+	check("deploy GovImp", tx, err)
+	c.gov, tx, _, err = governance.DeployGov(opts, backend, govImp)
+	check("deploy Gov", tx, err)
+
+	for _, domain := range []struct {
+		name [32]byte
+		addr common.Address
+	}{
+		{governance.StakingName, c.staking},
+		{governance.BallotStorageName, c.ballotStorage},
+		{governance.EnvStorageName, envStorage},
+		{governance.GovernanceContractName, c.gov},
+		{governance.StakingRewardName, simStakingReward},
+		{governance.EcosystemName, simEcosystem},
+		{governance.MaintenanceName, simMaintenance},
+	} {
+		tx, err = reg.SetContractDomain(opts, domain.name, domain.addr)
+		check("set contract domain", tx, err)
+	}
+
+	env, _ := governance.NewEnvStorageImpTransactor(envStorage, backend)
+	var (
+		names  [][32]byte
+		values []*big.Int
+	)
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{"blocksPer", "1"},
+		{"ballotDurationMin", "86400"},
+		{"ballotDurationMax", "604800"},
+		{"stakingMin", simStakingMin.String()},
+		{"stakingMax", "39840000000000000000000000"},
+		{"MaxIdleBlockInterval", "5"},
+		{"blockCreationTime", "1000"},
+		{"blockRewardAmount", "1000000000000000000"},
+		{"maxPriorityFeePerGas", "100000000000"},
+		{"blockRewardDistributionBlockProducer", "4000"},
+		{"blockRewardDistributionStakingReward", "1000"},
+		{"blockRewardDistributionEcosystem", "2500"},
+		{"blockRewardDistributionMaintenance", "2500"},
+		{"maxBaseFee", "50000000000000"},
+		{"blockGasLimit", "105000000"},
+		{"baseFeeMaxChangeRate", "55"},
+		{"gasTargetPercentage", "30"},
+	} {
+		value, _ := new(big.Int).SetString(v.value, 10)
+		names = append(names, crypto.Keccak256Hash([]byte(v.name)))
+		values = append(values, value)
+	}
+	tx, err = env.Initialize(opts, registry, names, values)
+	check("initialize EnvStorage", tx, err)
+
+	govTx, _ := governance.NewGovImpTransactor(c.gov, backend)
+	tx, err = govTx.InitOnce(opts, registry, nodes.Bytes())
+	check("initialize Gov", tx, err)
+
+	config := &params.ChainConfig{
+		ChainID:             c.chainID,
+		HomesteadBlock:      common.Big0,
+		EIP150Block:         common.Big0,
+		EIP155Block:         common.Big0,
+		EIP158Block:         common.Big0,
+		ByzantiumBlock:      common.Big0,
+		ConstantinopleBlock: common.Big0,
+		PetersburgBlock:     common.Big0,
+		IstanbulBlock:       common.Big0,
+		MuirGlacierBlock:    common.Big0,
+		BerlinBlock:         common.Big0,
+		LondonBlock:         common.Big0,
+		Wemix: &params.WemixConfig{
+			ConsensusMethod:       params.ConsensusETCD,
+			StrictGovernanceBlock: common.Big0,
+		},
+	}
+	return &core.Genesis{
+		Config:     config,
+		Coinbase:   boot.addr,
+		Difficulty: common.Big1,
+		GasLimit:   105000000,
+		ExtraData:  boot.enode(),
+		Alloc:      c.dumpState(backend.Blockchain(), alloc),
+	}
+}
+
+// dumpState returns the state at the head of the simulated chain as the
+// genesis accounts. The chain is replayed with the preimages of the trie
+// keys recorded, as the storage is dumped by its keys.
+func (c *simCluster) dumpState(sim *core.BlockChain, alloc core.GenesisAlloc) core.GenesisAlloc {
+	db := rawdb.NewMemoryDatabase()
+	genesis := core.Genesis{Config: params.AllEthashProtocolChanges, GasLimit: sim.Genesis().GasLimit(), Alloc: alloc}
+	genesis.MustCommit(db)
+	cacheConfig := &core.CacheConfig{
+		TrieCleanLimit: 16,
+		TrieDirtyLimit: 16,
+		TrieTimeLimit:  time.Minute,
+		Preimages:      true,
+	}
+	chain, err := core.NewBlockChain(db, cacheConfig, genesis.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		c.t.Fatalf("failed to create the chain: %v", err)
+	}
+	defer chain.Stop()
+
+	var blocks types.Blocks
+	for num := uint64(1); num <= sim.CurrentBlock().NumberU64(); num++ {
+		blocks = append(blocks, sim.GetBlockByNumber(num))
+	}
+	if _, err := chain.InsertChain(blocks); err != nil {
+		c.t.Fatalf("failed to replay the governance deployment: %v", err)
+	}
+	statedb, err := chain.State()
+	if err != nil {
+		c.t.Fatalf("failed to get the state: %v", err)
+	}
+
+	accounts := core.GenesisAlloc{}
+	for addr, account := range statedb.RawDump(nil).Accounts {
+		balance, _ := new(big.Int).SetString(account.Balance, 10)
+		storage := map[common.Hash]common.Hash{}
+		for key, value := range account.Storage {
+			storage[key] = common.HexToHash(value)
+		}
+		accounts[addr] = core.GenesisAccount{
+			Balance: balance,
+			Nonce:   account.Nonce,
+			Code:    account.Code,
+			Storage: storage,
+		}
+	}
+	return accounts
+}
+
+// logs reports the tails of the node logs.
+func (c *simCluster) logs() {
+	for _, n := range c.nodes {
+		data, err := ioutil.ReadFile(n.config.LogFile)
+		if err != nil {
+			continue
+		}
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		if len(lines) > 40 {
+			lines = lines[len(lines)-40:]
+		}
+		c.t.Logf("%s log:\n%s", n, strings.Join(lines, "\n"))
+	}
+}
+
+func (c *simCluster) start(n *simNode) {
+	if err := c.net.Start(n.config.ID); err != nil {
+		c.t.Fatalf("failed to start %s: %v", n, err)
+	}
+}
+
+func (c *simCluster) stop(n *simNode) {
+	if err := c.net.Stop(n.config.ID); err != nil {
+		c.t.Fatalf("failed to stop %s: %v", n, err)
+	}
+}
+
+func (c *simCluster) client(n *simNode) *rpc.Client {
+	client, err := c.net.GetNode(n.config.ID).Client()
+	if err != nil || client == nil {
+		c.t.Fatalf("no rpc client of %s: %v", n, err)
+	}
+	return client
+}
+
+func (c *simCluster) call(n *simNode, result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return c.client(n).CallContext(ctx, result, method, args...)
+}
+
+// except returns the nodes but the given ones.
+func (c *simCluster) except(nodes []*simNode, excluded ...*simNode) []*simNode {
+	var rest []*simNode
+	for _, n := range nodes {
+		found := false
+		for _, m := range excluded {
+			found = found || n == m
+		}
+		if !found {
+			rest = append(rest, n)
+		}
+	}
+	return rest
+}
+
+func (c *simCluster) byId(id []byte) *simNode {
+	for _, n := range c.nodes {
+		if bytes.Equal(n.enode(), id) {
+			return n
+		}
+	}
+	return nil
+}
+
+// waitFor polls cond until it's true, or fails the test.
+func (c *simCluster) waitFor(what string, cond func() bool) {
+	c.t.Helper()
+	for deadline := time.Now().Add(simTimeout); ; {
+		if cond() {
+			return
+		}
+		if time.Now().After(deadline) {
+			c.t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+// simRaft is the raft part of admin_wemixInfo.
+type simRaft struct {
+	State  string `json:"state"`
+	Leader *struct {
+		Name string `json:"name"`
+	} `json:"leader"`
+	Members []struct {
+		Name string `json:"name"`
+	} `json:"members"`
+}
+
+// raft returns the raft status of the node, nil if it's not running.
+func (c *simCluster) raft(n *simNode) *simRaft {
+	var info struct {
+		Raft json.RawMessage `json:"raft"`
+	}
+	if err := c.call(n, &info, "admin_wemixInfo"); err != nil {
+		return nil
+	}
+	r := new(simRaft)
+	if err := json.Unmarshal(info.Raft, r); err != nil || r.State == "" {
+		return nil
+	}
+	return r
+}
+
+// leader returns the leader all the nodes agree on, with all of them the
+// voting members of the cluster, or nil if there's none.
+func (c *simCluster) leader(nodes []*simNode) *simNode {
+	var leader string
+	for _, n := range nodes {
+		r := c.raft(n)
+		if r == nil || r.Leader == nil || (leader != "" && r.Leader.Name != leader) {
+			return nil
+		}
+		leader = r.Leader.Name
+		var members []string
+		for _, m := range r.Members {
+			members = append(members, m.Name)
+		}
+		for _, m := range nodes {
+			if !strings.Contains(" "+strings.Join(members, " ")+" ", " "+m.name+" ") {
+				return nil
+			}
+		}
+	}
+	for _, n := range nodes {
+		if n.name == leader {
+			return n
+		}
+	}
+	return nil
+}
+
+// waitLeader waits for the nodes to be the members of the cluster with a
+// leader among them, and returns it.
+func (c *simCluster) waitLeader(nodes []*simNode) *simNode {
+	c.t.Helper()
+	var leader *simNode
+	c.waitFor(fmt.Sprintf("a leader of %v", nodes), func() bool {
+		leader = c.leader(nodes)
+		return leader != nil
+	})
+	return leader
+}
+
+// simBlock is the part of the block the tests look at.
+type simBlock struct {
+	Number      hexutil.Uint64 `json:"number"`
+	Hash        common.Hash    `json:"hash"`
+	MinerNodeId hexutil.Bytes  `json:"minerNodeId"`
+}
+
+// block returns the block of the node, the head if num is nil.
+func (c *simCluster) block(n *simNode, num *uint64) *simBlock {
+	arg := "latest"
+	if num != nil {
+		arg = hexutil.EncodeUint64(*num)
+	}
+	var b *simBlock
+	if err := c.call(n, &b, "eth_getBlockByNumber", arg, false); err != nil {
+		return nil
+	}
+	return b
+}
+
+func (c *simCluster) head(n *simNode) uint64 {
+	if b := c.block(n, nil); b != nil {
+		return uint64(b.Number)
+	}
+	return 0
+}
+
+// waitConverged waits for the nodes to agree on the block at a height
+// beyond num, and returns it.
+func (c *simCluster) waitConverged(nodes []*simNode, num uint64) *simBlock {
+	c.t.Helper()
+	var block *simBlock
+	c.waitFor(fmt.Sprintf("%v to converge beyond %d", nodes, num), func() bool {
+		height := uint64(0)
+		for i, n := range nodes {
+			if h := c.head(n); i == 0 || h < height {
+				height = h
+			}
+		}
+		if height <= num {
+			return false
+		}
+		block = nil
+		for _, n := range nodes {
+			b := c.block(n, &height)
+			if b == nil || (block != nil && b.Hash != block.Hash) {
+				return false
+			}
+			block = b
+		}
+		return true
+	})
+	return block
+}
+
+// signers returns the nodes that signed the blocks from..to of the node.
+func (c *simCluster) signers(n *simNode, from, to uint64) map[*simNode]int {
+	signers := map[*simNode]int{}
+	for num := from; num <= to; num++ {
+		b := c.block(n, &num)
+		if b == nil {
+			c.t.Fatalf("%s has no block %d", n, num)
+		}
+		signers[c.byId(b.MinerNodeId)]++
+	}
+	return signers
+}
+
+// partition splits the nodes into the given groups.
+func (c *simCluster) partition(groups ...[]*simNode) {
+	for i, group := range groups {
+		var others []enode.ID
+		for j, other := range groups {
+			if i == j {
+				continue
+			}
+			for _, n := range other {
+				others = append(others, n.config.ID)
+			}
+		}
+		for _, n := range group {
+			if err := c.call(n, nil, "simwemix_partition", others); err != nil {
+				c.t.Fatalf("failed to partition %s: %v", n, err)
+			}
+		}
+	}
+}
+
+// heal ends the partition, and reconnects the nodes.
+func (c *simCluster) heal(nodes []*simNode) {
+	for _, n := range nodes {
+		if err := c.call(n, nil, "simwemix_heal"); err != nil {
+			c.t.Fatalf("failed to heal %s: %v", n, err)
+		}
+	}
+	for _, n := range nodes {
+		for _, m := range nodes {
+			if n != m {
+				c.addPeer(n, m)
+			}
+		}
+	}
+}
+
+func (c *simCluster) addPeer(n, peer *simNode) {
+	var ok bool
+	if err := c.call(n, &ok, "admin_addPeer", peer.url()); err != nil || !ok {
+		c.t.Fatalf("failed to add %s to %s: %v", peer, n, err)
+	}
+}
+
+// transact calls the method of the contract with the value from the node's
+// account via the node, and waits for the transaction to be mined.
+func (c *simCluster) transact(n *simNode, contract common.Address, meta *bind.MetaData, value *big.Int, method string, args ...interface{}) *types.Receipt {
+	c.t.Helper()
+	client := ethclient.NewClient(c.client(n))
+	opts, _ := bind.NewKeyedTransactorWithChainID(n.key, c.chainID)
+	opts.GasLimit = 2000000
+	opts.Value = value
+	abi, _ := meta.GetAbi()
+	tx, err := bind.NewBoundContract(contract, *abi, client, client, client).Transact(opts, method, args...)
+	if err != nil {
+		c.t.Fatalf("failed to send %s: %v", method, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), simTimeout)
+	defer cancel()
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+		c.t.Fatalf("%s failed: receipt %v, error %v", method, receipt, err)
+	}
+	return receipt
+}
+
+// propose sends the proposal, and has the voters accept it.
+func (c *simCluster) propose(voters []*simNode, method string, args ...interface{}) {
+	c.t.Helper()
+	receipt := c.transact(voters[0], c.gov, governance.GovImpMetaData, nil, method, args...)
+	filterer, _ := governance.NewBallotStorageFilterer(c.ballotStorage, nil)
+	var id *big.Int
+	for _, l := range receipt.Logs {
+		if ev, err := filterer.ParseBallotCreated(*l); l.Address == c.ballotStorage && err == nil {
+			id = ev.BallotId
+		}
+	}
+	if id == nil {
+		c.t.Fatalf("no ballot created by %s", method)
+	}
+
+	bs := governance.BallotStorageAt(c.ballotStorage, ethclient.NewClient(c.client(voters[0])))
+	govFilterer, _ := governance.NewGovImpFilterer(c.gov, nil)
+	for _, n := range voters {
+		receipt := c.transact(n, c.gov, governance.GovImpMetaData, nil, "vote", id, true)
+		b, err := governance.ReadBallot(&bind.CallOpts{}, bs, id)
+		if err != nil {
+			c.t.Fatalf("failed to read ballot %d: %v", id, err)
+		}
+		if b.IsFinalized {
+			if b.State != "accepted" {
+				reason := ""
+				for _, l := range receipt.Logs {
+					if ev, err := govFilterer.ParseNotApplicable(*l); l.Address == c.gov && err == nil {
+						reason = ev.Reason
+					}
+				}
+				c.t.Fatalf("ballot %d %s: %s", id, b.State, reason)
+			}
+			return
+		}
+	}
+	c.t.Fatalf("ballot %d not accepted", id)
+}
+
+// addPartner has the node deposit its stake, and the voters add it to the
+// governance.
+func (c *simCluster) addPartner(voters []*simNode, n *simNode) {
+	c.t.Helper()
+	c.transact(n, c.staking, governance.StakingMetaData, simStakingMin, "deposit")
+	c.propose(voters, "addProposalToAddMember", governance.GovImpMemberInfo{
+		Staker:     n.addr,
+		Voter:      n.addr,
+		Reward:     n.addr,
+		Name:       []byte(n.name),
+		Enode:      n.enode(),
+		Ip:         []byte("127.0.0.1"),
+		Port:       big.NewInt(int64(n.config.Port)),
+		LockAmount: simStakingMin,
+		Memo:       []byte("add " + n.name),
+		Duration:   big.NewInt(86400),
+	})
+}
+
+// removePartner removes the node from the governance by the voters.
+func (c *simCluster) removePartner(voters []*simNode, n *simNode) {
+	c.t.Helper()
+	c.propose(voters, "addProposalToRemoveMember", n.addr, simStakingMin,
+		[]byte("remove "+n.name), big.NewInt(86400))
+}
@@ -0,0 +1,258 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package governance
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Ballot types, BallotEnums.BallotTypes of the contracts.
+const (
+	BallotTypeInvalid = iota
+	BallotTypeMemberAdd
+	BallotTypeMemberRemoval
+	BallotTypeMemberChange
+	BallotTypeGovernanceChange
+	BallotTypeEnvValChange
+)
+
+// Ballot states, BallotEnums.BallotStates of the contracts.
+const (
+	BallotStateInvalid = iota
+	BallotStateReady
+	BallotStateInProgress
+	BallotStateAccepted
+	BallotStateRejected
+	BallotStateCanceled
+)
+
+// Environment variable types, EnvConstants.VariableTypes of the contracts.
+const (
+	EnvTypeInvalid = iota
+	EnvTypeInt
+	EnvTypeUint
+	EnvTypeAddress
+	EnvTypeBytes32
+	EnvTypeBytes
+	EnvTypeString
+)
+
+var (
+	ballotTypeNames = []string{"invalid", "add-member", "remove-member",
+		"change-member", "change-gov", "change-env"}
+	ballotStateNames = []string{"invalid", "ready", "in-progress",
+		"accepted", "rejected", "canceled"}
+	envTypeNames = []string{"invalid", "int", "uint", "address", "bytes32",
+		"bytes", "string"}
+
+	// "Wemix Registry"
+	RegistryMagic, _ = new(big.Int).SetString("0x57656d6978205265676973747279", 0)
+
+	ErrRegistryNotFound = errors.New("registry not found")
+)
+
+func enumName(names []string, v *big.Int) string {
+	if v == nil || !v.IsInt64() || v.Int64() < 0 || v.Int64() >= int64(len(names)) {
+		return "unknown"
+	}
+	return names[v.Int64()]
+}
+
+// EnvNames are the names of the environment variables, which are stored
+// by their hashes.
+var EnvNames = []string{
+	"blocksPer",
+	"ballotDurationMin",
+	"ballotDurationMax",
+	"ballotDurationMinMax",
+	"stakingMin",
+	"stakingMax",
+	"stakingMinMax",
+	"MaxIdleBlockInterval",
+	"blockCreationTime",
+	"blockRewardAmount",
+	"maxPriorityFeePerGas",
+	"blockRewardDistributionMethod",
+	"blockRewardDistributionBlockProducer",
+	"blockRewardDistributionStakingReward",
+	"blockRewardDistributionEcosystem",
+	"blockRewardDistributionMaintenance",
+	"gasLimitAndBaseFee",
+	"maxBaseFee",
+	"blockGasLimit",
+	"baseFeeMaxChangeRate",
+	"gasTargetPercentage",
+}
+
+// EnvName returns the name of the environment variable with the given hash,
+// or the hash in hex if it's not known.
+func EnvName(hash common.Hash) string {
+	for _, name := range EnvNames {
+		if crypto.Keccak256Hash([]byte(name)) == hash {
+			return name
+		}
+	}
+	return hash.Hex()
+}
+
+// BallotTypeName returns the name of the ballot type t.
+func BallotTypeName(t *big.Int) string { return enumName(ballotTypeNames, t) }
+
+// BallotStateName returns the name of the ballot state s.
+func BallotStateName(s *big.Int) string { return enumName(ballotStateNames, s) }
+
+// EnvTypeName returns the name of the environment variable type t.
+func EnvTypeName(t *big.Int) string { return enumName(envTypeNames, t) }
+
+// EnvType returns the environment variable type of the given name, or
+// EnvTypeInvalid.
+func EnvType(name string) int {
+	for i, n := range envTypeNames {
+		if n == name {
+			return i
+		}
+	}
+	return EnvTypeInvalid
+}
+
+// BallotStorageAt returns the read-only binding of the BallotStorage at
+// address.
+func BallotStorageAt(address common.Address, caller bind.ContractCaller) *BallotStorageCaller {
+	return &BallotStorageCaller{contract: boundAt(BallotStorageMetaData, address, caller)}
+}
+
+// FindRegistry returns the address of the Registry, which is one of the
+// first contracts created by creator, the coinbase of the genesis block.
+func FindRegistry(opts *bind.CallOpts, caller bind.ContractCaller, creator common.Address) (common.Address, error) {
+	for i := uint64(0); i < 10; i++ {
+		addr := crypto.CreateAddress(creator, i)
+		v, err := RegistryAt(addr, caller).Magic(opts)
+		if err == nil && v.Cmp(RegistryMagic) == 0 {
+			return addr, nil
+		}
+	}
+	return common.Address{}, ErrRegistryNotFound
+}
+
+// Ballot is a ballot in the BallotStorage with the result of its voting.
+type Ballot struct {
+	Id             *big.Int       `json:"id"`
+	Type           string         `json:"type"`
+	State          string         `json:"state"`
+	IsFinalized    bool           `json:"isFinalized"`
+	Creator        common.Address `json:"creator"`
+	Memo           string         `json:"memo"`
+	StartTime      *big.Int       `json:"startTime"`
+	EndTime        *big.Int       `json:"endTime"`
+	Duration       *big.Int       `json:"duration"`
+	TotalVoters    *big.Int       `json:"totalVoters"`
+	PowerOfAccepts *big.Int       `json:"powerOfAccepts"`
+	PowerOfRejects *big.Int       `json:"powerOfRejects"`
+
+	// one of the following by the type
+	Member *BallotMember   `json:"member,omitempty"`
+	Env    *BallotEnv      `json:"env,omitempty"`
+	NewGov *common.Address `json:"newGov,omitempty"`
+}
+
+// BallotMember is the subject of the member ballots.
+type BallotMember struct {
+	OldStaker  common.Address `json:"oldStaker"`
+	NewStaker  common.Address `json:"newStaker"`
+	NewVoter   common.Address `json:"newVoter"`
+	NewReward  common.Address `json:"newReward"`
+	Name       string         `json:"name"`
+	Enode      hexutil.Bytes  `json:"enode"`
+	Ip         string         `json:"ip"`
+	Port       *big.Int       `json:"port"`
+	LockAmount *big.Int       `json:"lockAmount"`
+}
+
+// BallotEnv is the subject of the environment variable ballots.
+type BallotEnv struct {
+	Hash  common.Hash   `json:"hash"`
+	Name  string        `json:"name"`
+	Type  string        `json:"type"`
+	Value hexutil.Bytes `json:"value"`
+}
+
+// ReadBallot reads the ballot with the given id from the BallotStorage.
+func ReadBallot(opts *bind.CallOpts, bs *BallotStorageCaller, id *big.Int) (*Ballot, error) {
+	basic, err := bs.GetBallotBasic(opts, id)
+	if err != nil {
+		return nil, err
+	}
+	if basic.BallotType == nil || basic.BallotType.Sign() == 0 {
+		return nil, errors.New("no such ballot")
+	}
+	b := &Ballot{
+		Id:             id,
+		Type:           BallotTypeName(basic.BallotType),
+		State:          BallotStateName(basic.State),
+		IsFinalized:    basic.IsFinalized,
+		Creator:        basic.Creator,
+		Memo:           string(basic.Memo),
+		StartTime:      basic.StartTime,
+		EndTime:        basic.EndTime,
+		Duration:       basic.Duration,
+		TotalVoters:    basic.TotalVoters,
+		PowerOfAccepts: basic.PowerOfAccepts,
+		PowerOfRejects: basic.PowerOfRejects,
+	}
+
+	switch basic.BallotType.Int64() {
+	case BallotTypeMemberAdd, BallotTypeMemberRemoval, BallotTypeMemberChange:
+		m, err := bs.GetBallotMember(opts, id)
+		if err != nil {
+			return nil, err
+		}
+		b.Member = &BallotMember{
+			OldStaker:  m.OldStakerAddress,
+			NewStaker:  m.NewStakerAddress,
+			NewVoter:   m.NewVoterAddress,
+			NewReward:  m.NewRewardAddress,
+			Name:       string(m.NewNodeName),
+			Enode:      m.NewNodeId,
+			Ip:         string(m.NewNodeIp),
+			Port:       m.NewNodePort,
+			LockAmount: m.LockAmount,
+		}
+	case BallotTypeGovernanceChange:
+		addr, err := bs.GetBallotAddress(opts, id)
+		if err != nil {
+			return nil, err
+		}
+		b.NewGov = &addr
+	case BallotTypeEnvValChange:
+		v, err := bs.GetBallotVariable(opts, id)
+		if err != nil {
+			return nil, err
+		}
+		b.Env = &BallotEnv{
+			Hash:  v.EnvVariableName,
+			Name:  EnvName(v.EnvVariableName),
+			Type:  EnvTypeName(v.EnvVariableType),
+			Value: v.EnvVariableValue,
+		}
+	}
+	return b, nil
+}
+
+// ReadBallots reads the ballots from first to last, inclusive.
+func ReadBallots(opts *bind.CallOpts, bs *BallotStorageCaller, first, last uint64) ([]*Ballot, error) {
+	var ballots []*Ballot
+	for id := first; id <= last && id != 0; id++ {
+		b, err := ReadBallot(opts, bs, new(big.Int).SetUint64(id))
+		if err != nil {
+			return nil, err
+		}
+		ballots = append(ballots, b)
+	}
+	return ballots, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package governance
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// deployGovernance deploys the governance contracts with a single member,
+// and returns the addresses of the Registry, the Gov proxy and the
+// BallotStorage.
+func deployGovernance(t *testing.T, backend *backends.SimulatedBackend, key *ecdsa.PrivateKey) (registry, gov, ballotStorage common.Address) {
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	opts, _ := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	opts.GasLimit = 20000000
+	check := func(what string, tx *types.Transaction, err error) {
+		if err != nil {
+			t.Fatalf("failed to %s: %v", what, err)
+		}
+		backend.Commit()
+		if r, err := backend.TransactionReceipt(context.Background(), tx.Hash()); err != nil || r.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("failed to %s: receipt %v, error %v", what, r, err)
+		}
+	}
+
+	var (
+		reg                                        *Registry
+		envStorageImp, staking, envStorage, govImp common.Address
+		tx                                         *types.Transaction
+		err                                        error
+	)
+	registry, tx, reg, err = DeployRegistry(opts, backend)
+	check("deploy Registry", tx, err)
+	envStorageImp, tx, _, err = DeployEnvStorageImp(opts, backend)
+	check("deploy EnvStorageImp", tx, err)
+
+	stake, _ := new(big.Int).SetString("4980000000000000000000000", 10)
+	var stakes bytes.Buffer
+	stakes.Write(common.LeftPadBytes(addr[:], 32))
+	stakes.Write(math.U256Bytes(stake))
+	staking, tx, _, err = DeployStaking(opts, backend, registry, stakes.Bytes())
+	check("deploy Staking", tx, err)
+	ballotStorage, tx, _, err = DeployBallotStorage(opts, backend, registry)
+	check("deploy BallotStorage", tx, err)
+	envStorage, tx, _, err = DeployEnvStorage(opts, backend, envStorageImp)
+	check("deploy EnvStorage", tx, err)
+	govImp, tx, _, err = DeployGovImp(opts, backend)
+	check("deploy GovImp", tx, err)
+	gov, tx, _, err = DeployGov(opts, backend, govImp)
+	check("deploy Gov", tx, err)
+
+	for _, domain := range []struct {
+		name [32]byte
+		addr common.Address
+	}{
+		{StakingName, staking},
+		{BallotStorageName, ballotStorage},
+		{EnvStorageName, envStorage},
+		{GovernanceContractName, gov},
+	} {
+		tx, err = reg.SetContractDomain(opts, domain.name, domain.addr)
+		check("set contract domain", tx, err)
+	}
+
+	env, _ := NewEnvStorageImpTransactor(envStorage, backend)
+	var (
+		names  [][32]byte
+		values []*big.Int
+	)
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{"blocksPer", "1"},
+		{"ballotDurationMin", "86400"},
+		{"ballotDurationMax", "604800"},
+		{"stakingMin", "4980000000000000000000000"},
+		{"stakingMax", "39840000000000000000000000"},
+		{"MaxIdleBlockInterval", "5"},
+		{"blockCreationTime", "1000"},
+		{"blockRewardAmount", "1000000000000000000"},
+		{"maxPriorityFeePerGas", "100000000000"},
+		{"blockRewardDistributionBlockProducer", "4000"},
+		{"blockRewardDistributionStakingReward", "1000"},
+		{"blockRewardDistributionEcosystem", "2500"},
+		{"blockRewardDistributionMaintenance", "2500"},
+		{"maxBaseFee", "50000000000000"},
+		{"blockGasLimit", "105000000"},
+		{"baseFeeMaxChangeRate", "55"},
+		{"gasTargetPercentage", "30"},
+	} {
+		value, _ := new(big.Int).SetString(v.value, 10)
+		names = append(names, crypto.Keccak256Hash([]byte(v.name)))
+		values = append(values, value)
+	}
+	tx, err = env.Initialize(opts, registry, names, values)
+	check("initialize EnvStorage", tx, err)
+
+	// staker, name, enode, ip and port, the variable length ones prefixed
+	// with their lengths
+	var nodes bytes.Buffer
+	nodes.Write(common.LeftPadBytes(addr[:], 32))
+	for _, b := range [][]byte{[]byte("tom"), crypto.FromECDSAPub(&key.PublicKey)[1:], []byte("127.0.0.1")} {
+		nodes.Write(math.U256Bytes(big.NewInt(int64(len(b)))))
+		nodes.Write(b)
+	}
+	nodes.Write(math.U256Bytes(big.NewInt(8589)))
+	govTx, _ := NewGovImpTransactor(gov, backend)
+	tx, err = govTx.InitOnce(opts, registry, nodes.Bytes())
+	check("initialize Gov", tx, err)
+	return
+}
+
+// Tests that the ballots are read with the subjects and the results.
+func TestBallots(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	balance, _ := new(big.Int).SetString("100000000000000000000000000", 10)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{addr: {Balance: balance}}, 50000000)
+	defer backend.Close()
+
+	registry, gov, ballotStorage := deployGovernance(t, backend, key)
+	callOpts := &bind.CallOpts{}
+	if have, err := FindRegistry(callOpts, backend, addr); err != nil || have != registry {
+		t.Fatalf("registry mismatch: have %x, %v, want %x", have, err, registry)
+	}
+	if _, err := FindRegistry(callOpts, backend, common.Address{1}); err != ErrRegistryNotFound {
+		t.Fatalf("missing registry error mismatch: have %v, want %v", err, ErrRegistryNotFound)
+	}
+
+	opts, _ := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	opts.GasLimit = 20000000
+	govTx, _ := NewGovImpTransactor(gov, backend)
+	value := math.U256Bytes(big.NewInt(3))
+	if _, err := govTx.AddProposalToChangeEnv(opts, crypto.Keccak256Hash([]byte("blocksPer")),
+		big.NewInt(EnvTypeUint), value, []byte("blocksPer to 3"), big.NewInt(86400)); err != nil {
+		t.Fatalf("failed to propose: %v", err)
+	}
+	backend.Commit()
+
+	bs := BallotStorageAt(ballotStorage, backend)
+	b, err := ReadBallot(callOpts, bs, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("failed to read ballot: %v", err)
+	}
+	if b.Type != "change-env" || b.State != "ready" || b.IsFinalized || b.Creator != addr || b.Memo != "blocksPer to 3" {
+		t.Fatalf("unexpected ballot %+v", b)
+	}
+	if b.Env == nil || b.Env.Name != "blocksPer" || b.Env.Type != "uint" || !bytes.Equal(b.Env.Value, value) {
+		t.Fatalf("unexpected ballot subject %+v", b.Env)
+	}
+
+	if _, err := govTx.Vote(opts, big.NewInt(1), true); err != nil {
+		t.Fatalf("failed to vote: %v", err)
+	}
+	backend.Commit()
+	if b, err = ReadBallot(callOpts, bs, big.NewInt(1)); err != nil {
+		t.Fatalf("failed to read ballot: %v", err)
+	}
+	if b.State != "accepted" || !b.IsFinalized || b.PowerOfAccepts.Sign() == 0 || b.PowerOfRejects.Sign() != 0 {
+		t.Fatalf("unexpected ballot result %+v", b)
+	}
+
+	if ballots, err := ReadBallots(callOpts, bs, 1, 1); err != nil || len(ballots) != 1 {
+		t.Fatalf("unexpected ballots %v, error %v", ballots, err)
+	}
+	if _, err := ReadBallot(callOpts, bs, big.NewInt(2)); err == nil {
+		t.Fatalf("read a ballot that doesn't exist")
+	}
+}
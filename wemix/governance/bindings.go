@@ -0,0 +1,14983 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package governance
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// GovImpMemberInfo is an auto generated low-level Go binding around an user-defined struct.
+type GovImpMemberInfo struct {
+	Staker     common.Address
+	Voter      common.Address
+	Reward     common.Address
+	Name       []byte
+	Enode      []byte
+	Ip         []byte
+	Port       *big.Int
+	LockAmount *big.Int
+	Memo       []byte
+	Duration   *big.Int
+}
+
+// BallotStorageMetaData contains all meta data concerning the BallotStorage contract.
+var BallotStorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_registry\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"}],\"name\":\"BallotCanceled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotType\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"BallotCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"state\",\"type\":\"uint256\"}],\"name\":\"BallotFinalized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"}],\"name\":\"BallotStarted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"}],\"name\":\"BallotUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"voteid\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"decision\",\"type\":\"uint256\"}],\"name\":\"Voted\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BALLOT_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ENV_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GOV_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAINTENANCE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REWARD_POOL_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_ballotId\",\"type\":\"uint256\"}],\"name\":\"cancelBallot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_ballotType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_newGovernanceAddress\",\"type\":\"address\"}],\"name\":\"createBallotForAddress\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_ballotType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_oldStakerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_newStakerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_newVoterAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_newRewardAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_newNodeName\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_newNodeId\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_newNodeIp\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"_newNodePort\",\"type\":\"uint256\"}],\"name\":\"createBallotForMember\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_ballotType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_creator\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_envVariableName\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_envVariableType\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_envVariableValue\",\"type\":\"bytes\"}],\"name\":\"createBallotForVariable\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_voteId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_ballotId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_voter\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_decision\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_power\",\"type\":\"uint256\"}],\"name\":\"createVote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_ballotId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_ballotState\",\"type\":\"uint256\"}],\"name\":\"finalizeBallot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getBallotAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"newGovernanceAddress\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getBallotBasic\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ballotType\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"memo\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"totalVoters\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"powerOfAccepts\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"powerOfRejects\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"state\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isFinalized\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBallotCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getBallotMember\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"oldStakerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"newStakerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"newVoterAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"newRewardAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"newNodeName\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"newNodeId\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"newNodeIp\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"newNodePort\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockAmount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getBallotPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getBallotState\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"ballotType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"state\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isFinalized\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getBallotVariable\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"envVariableName\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"envVariableType\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"envVariableValue\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getBallotVotingInfo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"totalVoters\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"powerOfAccepts\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"powerOfRejects\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxVotingDuration\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinVotingDuration\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPreviousBallotStorage\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_voteId\",\"type\":\"uint256\"}],\"name\":\"getVote\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"voteId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"decision\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"power\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"time\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint56\",\"name\":\"_ballotId\",\"type\":\"uint56\"},{\"internalType\":\"address\",\"name\":\"_voter\",\"type\":\"address\"}],\"name\":\"hasAlreadyVoted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isDisabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"reg\",\"outputs\":[{\"internalType\":\"contractIRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setPreviousBallotStorage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"setRegistry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_ballotId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_endTime\",\"type\":\"uint256\"}],\"name\":\"startBallot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_ballotId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"}],\"name\":\"updateBallotDuration\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_ballotId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_lockAmount\",\"type\":\"uint256\"}],\"name\":\"updateBallotMemberLockAmount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_ballotId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_memo\",\"type\":\"bytes\"}],\"name\":\"updateBallotMemo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040526000606d553480156200001657600080fd5b50604051620039173803806200391783398101604081905262000039916200018e565b620000443362000056565b6200004f81620000a8565b50620001c0565b603380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b620000b262000130565b6001600160a01b0381166200010e5760405162461bcd60e51b815260206004820152601a60248201527f416464726573732073686f756c64206265206e6f6e2d7a65726f00000000000060448201526064015b60405180910390fd5b606580546001600160a01b0319166001600160a01b0392909216919091179055565b6033546001600160a01b031633146200018c5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640162000105565b565b600060208284031215620001a157600080fd5b81516001600160a01b0381168114620001b957600080fd5b9392505050565b61374780620001d06000396000f3fe608060405234801561001057600080fd5b50600436106102275760003560e01c8063715018a611610130578063a91e59ba116100b8578063c0b6f1861161007c578063c0b6f18614610566578063ce04b9d414610579578063daacbb9514610581578063f2fde38b14610594578063f680e555146105a757600080fd5b8063a91e59ba14610514578063a91ee0dc14610527578063b23c676c1461053a578063b47414951461054b578063bce0dbc11461055357600080fd5b80637bf46530116100ff5780637bf46530146104995780637efa9ae3146104ad5780638da5cb5b146104d957806396462b9c146104ea5780639986e4b9146104fd57600080fd5b8063715018a61461042b57806372d0ec9214610433578063738fdd1a1461044657806373df4e011461047157600080fd5b80634a57823e116101b35780635a55c1f0116101825780635a55c1f014610366578063656bc633146103b4578063688ca5b2146103c75780636c57f5a9146103f75780636c78d2cf1461040f57600080fd5b80634a57823e146103255780634bd1ed7614610338578063557ed1ba1461034d57806356ba988e1461035357600080fd5b80631c150171116101fa5780631c150171146102c35780631d940da2146102cb5780631e0cba0d146102ed5780632a74f38c146102fe5780632f40992e1461031157600080fd5b806302b385fb1461022c578063099706881461025f5780630a3a63fe1461028d578063155ca224146102ae575b600080fd5b61023f61023a366004612fdd565b6105eb565b6040516102569b9a99989796959493929190613616565b60405180910390f35b61027261026d366004612fdd565b6107b6565b60408051938452602084019290925290820152606001610256565b6102a061029b3660046130ed565b610916565b604051908152602001610256565b6102c16102bc366004612fdd565b610a2e565b005b6102a0610b8f565b6102de6102d9366004612fdd565b610c0e565b604051610256939291906133ee565b6102a0665374616b696e6760c81b81565b6102c161030c366004612f9c565b610cc6565b6102a06914995dd85c99141bdbdb60b21b81565b6102a061033336600461325d565b610d38565b6102a06a4d61696e74656e616e636560a81b81565b426102a0565b610272610361366004612fdd565b610e3f565b610379610374366004612fdd565b610f90565b6040805196875260208701959095526001600160a01b03909316938501939093526060840152608083019190915260a082015260c001610256565b6102c16103c2366004613056565b61105e565b6103da6103d5366004612fdd565b611271565b604080519384526020840192909252151590820152606001610256565b6103ff6113c7565b6040519015158152602001610256565b6102a07111dbdd995c9b985b98d950dbdb9d1c9858dd60721b81565b6102c16113eb565b6102c1610441366004613056565b6113ff565b606554610459906001600160a01b031681565b6040516001600160a01b039091168152602001610256565b61048461047f366004612fdd565b611599565b6040516102569998979695949392919061336f565b6102a069456e7653746f7261676560b01b81565b6104596104bb366004612fdd565b6000908152606860205260409020600101546001600160a01b031690565b6033546001600160a01b0316610459565b6102c16104f8366004613078565b6117a6565b6102a06c42616c6c6f7453746f7261676560981b81565b6102c1610522366004613056565b611a91565b6102c1610535366004612f9c565b611c0f565b606c546001600160a01b0316610459565b606d546102a0565b6102c161056136600461300f565b611c8f565b6102c16105743660046130c1565b611dd4565b6102a0611fb4565b6102c161058f366004613143565b611ff6565b6102c16105a2366004612f9c565b612306565b6103ff6105b53660046132dd565b66ffffffffffffff82166000908152606b602090815260408083206001600160a01b038516845290915290205460ff1692915050565b60008060008060606000806000806000806000606660008e815260200190815260200160002060405180610180016040529081600082015481526020016001820154815260200160028201548152602001600382015481526020016004820160009054906101000a90046001600160a01b03166001600160a01b03166001600160a01b03168152602001600582018054610684906136ab565b80601f01602080910402602001604051908101604052809291908181526020018280546106b0906136ab565b80156106fd5780601f106106d2576101008083540402835291602001916106fd565b820191906000526020600020905b8154815290600101906020018083116106e057829003601f168201915b5050505050815260200160068201548152602001600782015481526020016008820154815260200160098201548152602001600a820160009054906101000a900460ff16151515158152602001600b82015481525050905080602001519b5080604001519a5080606001519950806080015198508060a0015197508060c0015196508060e00151955080610100015194508061012001519350806101400151925080610160015191505091939597999b90929496989a50565b6000818152606660209081526040808320815161018081018352815481526001820154938101939093526002810154918301919091526003810154606083015260048101546001600160a01b031660808301526005810180548493849384939192909160a0840191610827906136ab565b80601f0160208091040260200160405190810160405280929190818152602001828054610853906136ab565b80156108a05780601f10610875576101008083540402835291602001916108a0565b820191906000526020600020905b81548152906001019060200180831161088357829003601f168201915b5050505050815260200160068201548152602001600782015481526020016008820154815260200160098201548152602001600a820160009054906101000a900460ff16151515158152602001600b82015481525050905080602001519350806040015192508061016001519150509193909250565b60003361092161237f565b6001600160a01b0316146109505760405162461bcd60e51b815260040161094790613479565b60405180910390fd5b61095861239f565b6001600160a01b0316306001600160a01b0316146109885760405162461bcd60e51b81526004016109479061358f565b600485146109a85760405162461bcd60e51b8152600401610947906134d7565b6001600160a01b0382166109ce5760405162461bcd60e51b8152600401610947906135b4565b6109da868686866123ba565b506040805180820182528681526001600160a01b0392831660208083019182526000898152606890915292909220905181559051600190910180546001600160a01b03191691909216179055509192915050565b8033610a3861237f565b6001600160a01b03161480610a6657506000818152606660205260409020600401546001600160a01b031633145b610a825760405162461bcd60e51b815260040161094790613479565b610a8a61239f565b6001600160a01b0316306001600160a01b031614610aba5760405162461bcd60e51b81526004016109479061358f565b6000828152606660205260409020548214610ae75760405162461bcd60e51b815260040161094790613416565b6000828152606660205260409020600a015460ff1615610b195760405162461bcd60e51b815260040161094790613504565b600160008381526066602052604090206009015414610b4a5760405162461bcd60e51b815260040161094790613566565b60008281526066602052604090206005600982015560405183907fd5e541d004c50564e5e05fcbc6be2916c68d817507693dc3774c69dde4ce13dc90600090a2505050565b6000610b99612675565b6001600160a01b03166333be496e6040518163ffffffff1660e01b815260040160206040518083038186803b158015610bd157600080fd5b505afa158015610be5573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610c099190612ff6565b905090565b600081815260696020526040902060018101546002820154600383018054929391926060929190610c3e906136ab565b80601f0160208091040260200160405190810160405280929190818152602001828054610c6a906136ab565b8015610cb75780601f10610c8c57610100808354040283529160200191610cb7565b820191906000526020600020905b815481529060010190602001808311610c9a57829003601f168201915b50505050509150509193909250565b610cce61268d565b6001600160a01b038116610d165760405162461bcd60e51b815260206004820152600f60248201526e496e76616c6964206164647265737360881b6044820152606401610947565b606c80546001600160a01b0319166001600160a01b0392909216919091179055565b600033610d4361237f565b6001600160a01b031614610d695760405162461bcd60e51b815260040161094790613479565b610d7161239f565b6001600160a01b0316306001600160a01b031614610da15760405162461bcd60e51b81526004016109479061358f565b610dac8785846126e7565b610dc85760405162461bcd60e51b8152600401610947906135b4565b610dd4888888886123ba565b6040805160808101825289815260208082018781528284018781526060840187815260008e81526069855295909520845181559151600183015551600282015592518051929384939092610e2f926003850192910190612e66565b50999a9950505050505050505050565b6000818152606660209081526040808320815161018081018352815481526001820154938101939093526002810154918301919091526003810154606083015260048101546001600160a01b031660808301526005810180548493849384939192909160a0840191610eb0906136ab565b80601f0160208091040260200160405190810160405280929190818152602001828054610edc906136ab565b8015610f295780601f10610efe57610100808354040283529160200191610f29565b820191906000526020600020905b815481529060010190602001808311610f0c57829003601f168201915b505050918352505060068201546020820152600782015460408201526008820154606082015260098201546080820152600a82015460ff16151560a0820152600b9091015460c09182015281015160e0820151610100909201519097919650945092505050565b6000818152606a6020526040812054819081908190819081908714610fec5760405162461bcd60e51b81526020600482015260126024820152711b9bdd08195e1a5cdd1959081d9bdd19525960721b6044820152606401610947565b50505060009384525050606a6020908152604092839020835160c0810185528154808252600183015493820184905260028301546001600160a01b031695820186905260038301546060830181905260048401546080840181905260059094015460a090930183905290969395945092565b813361106861237f565b6001600160a01b0316148061109657506000818152606660205260409020600401546001600160a01b031633145b6110b25760405162461bcd60e51b815260040161094790613479565b6110ba61239f565b6001600160a01b0316306001600160a01b0316146110ea5760405162461bcd60e51b81526004016109479061358f565b81806110f4610b8f565b11156111425760405162461bcd60e51b815260206004820152601c60248201527f556e646572206d696e2076616c7565206f6620206475726174696f6e000000006044820152606401610947565b61114a611fb4565b8111156111995760405162461bcd60e51b815260206004820152601a60248201527f4f766572206d61782076616c7565206f66206475726174696f6e0000000000006044820152606401610947565b60008481526066602052604090205484146111c65760405162461bcd60e51b815260040161094790613416565b6000848152606660205260409020600a015460ff16156111f85760405162461bcd60e51b815260040161094790613504565b6001600085815260666020526040902060090154146112295760405162461bcd60e51b815260040161094790613566565b600084815260666020526040808220600b810186905590519091339187917ff0855c0e0ad9b8a162b87f2e4e07d4b2a3f0a45126b15ff4a78b217ad19a901a91a35050505050565b6000818152606660209081526040808320815161018081018352815481526001820154938101939093526002810154918301919091526003810154606083015260048101546001600160a01b031660808301526005810180548493849384939192909160a08401916112e2906136ab565b80601f016020809104026020016040519081016040528092919081815260200182805461130e906136ab565b801561135b5780601f106113305761010080835404028352916020019161135b565b820191906000526020600020905b81548152906001019060200180831161133e57829003601f168201915b50505091835250506006820154602082015260078201546040820152600882015460608083019190915260098301546080830152600a83015460ff16151560a0830152600b9092015460c090910152810151610120820151610140909201519097919650945092505050565b60006113d161239f565b6001600160a01b0316306001600160a01b03161415905090565b6113f361268d565b6113fd60006127c6565b565b3361140861237f565b6001600160a01b03161461142e5760405162461bcd60e51b815260040161094790613479565b61143661239f565b6001600160a01b0316306001600160a01b0316146114665760405162461bcd60e51b81526004016109479061358f565b60008281526066602052604090205482146114935760405162461bcd60e51b815260040161094790613416565b60008281526067602052604090205482146114f05760405162461bcd60e51b815260206004820152601860248201527f6e6f7420657869737465642042616c6c6f744d656d62657200000000000000006044820152606401610947565b6000828152606660205260409020600a015460ff16156115225760405162461bcd60e51b815260040161094790613504565b6001600083815260666020526040902060090154146115535760405162461bcd60e51b815260040161094790613566565b6000828152606760205260408082206009810184905590519091339185917ff0855c0e0ad9b8a162b87f2e4e07d4b2a3f0a45126b15ff4a78b217ad19a901a91a3505050565b600081815260676020526040812060018101546002820154600383015460048401546005850180546001600160a01b0395861697948616969386169590921693606093849384938392916115ec906136ab565b80601f0160208091040260200160405190810160405280929190818152602001828054611618906136ab565b80156116655780601f1061163a57610100808354040283529160200191611665565b820191906000526020600020905b81548152906001019060200180831161164857829003601f168201915b5050505050955080600601805461167b906136ab565b80601f01602080910402602001604051908101604052809291908181526020018280546116a7906136ab565b80156116f45780601f106116c9576101008083540402835291602001916116f4565b820191906000526020600020905b8154815290600101906020018083116116d757829003601f168201915b5050505050945080600701805461170a906136ab565b80601f0160208091040260200160405190810160405280929190818152602001828054611736906136ab565b80156117835780601f1061175857610100808354040283529160200191611783565b820191906000526020600020905b81548152906001019060200180831161176657829003601f168201915b505050505093508060080154925080600901549150509193959799909294969850565b336117af61237f565b6001600160a01b0316146117d55760405162461bcd60e51b815260040161094790613479565b6117dd61239f565b6001600160a01b0316306001600160a01b03161461180d5760405162461bcd60e51b81526004016109479061358f565b600182148061181c5750600282145b61185b5760405162461bcd60e51b815260206004820152601060248201526f24b73b30b634b2103232b1b4b9b4b7b760811b6044820152606401610947565b60008481526066602052604090205484146118885760405162461bcd60e51b815260040161094790613416565b6000858152606a60205260409020548514156118df5760405162461bcd60e51b8152602060048201526016602482015275185b1c9958591e48195e1a5cdd1959081d9bdd19525960521b6044820152606401610947565b6000848152606b602090815260408083206001600160a01b038716845290915290205460ff16156119425760405162461bcd60e51b815260206004820152600d60248201526c185b1c9958591e481d9bdd1959609a1b6044820152606401610947565b60026000858152606660205260409020600901541461199a5760405162461bcd60e51b81526020600482015260146024820152734e6f7420496e50726f677265737320537461746560601b6044820152606401610947565b6040518060c00160405280868152602001858152602001846001600160a01b031681526020018381526020018281526020016119d34290565b90526000868152606a602090815260409182902083518155908301516001820155908201516002820180546001600160a01b0319166001600160a01b03909216919091179055606082015160038201556080820151600482015560a090910151600590910155611a4584848484612818565b826001600160a01b031684867f41df84b3b467b06744e40c92613c666324e7c640ce0a41ec06efdf602d36760685604051611a8291815260200190565b60405180910390a45050505050565b33611a9a61237f565b6001600160a01b031614611ac05760405162461bcd60e51b815260040161094790613479565b611ac861239f565b6001600160a01b0316306001600160a01b031614611af85760405162461bcd60e51b81526004016109479061358f565b6000828152606660205260409020548214611b255760405162461bcd60e51b815260040161094790613416565b6000828152606660205260409020600a015460ff1615611b575760405162461bcd60e51b815260040161094790613504565b6003811480611b665750600481145b611ba95760405162461bcd60e51b8152602060048201526014602482015273496e76616c69642042616c6c6f7420537461746560601b6044820152606401610947565b6000828152606660205260409081902060098101839055600a8101805460ff19166001179055905183907fdc921f027328d7238b58d77649ebcbd0c8b1c494c66ba53dfe53e0de65f6dd9f90611c029085815260200190565b60405180910390a2505050565b611c1761268d565b6001600160a01b038116611c6d5760405162461bcd60e51b815260206004820152601a60248201527f416464726573732073686f756c64206265206e6f6e2d7a65726f0000000000006044820152606401610947565b606580546001600160a01b0319166001600160a01b0392909216919091179055565b8133611c9961237f565b6001600160a01b03161480611cc757506000818152606660205260409020600401546001600160a01b031633145b611ce35760405162461bcd60e51b815260040161094790613479565b611ceb61239f565b6001600160a01b0316306001600160a01b031614611d1b5760405162461bcd60e51b81526004016109479061358f565b6000838152606660205260409020548314611d485760405162461bcd60e51b815260040161094790613416565b6000838152606660205260409020600a015460ff1615611d7a5760405162461bcd60e51b815260040161094790613504565b600083815260666020908152604090912083519091611da0916005840191860190612e66565b50604051339085907ff0855c0e0ad9b8a162b87f2e4e07d4b2a3f0a45126b15ff4a78b217ad19a901a90600090a350505050565b33611ddd61237f565b6001600160a01b031614611e035760405162461bcd60e51b815260040161094790613479565b611e0b61239f565b6001600160a01b0316306001600160a01b031614611e3b5760405162461bcd60e51b81526004016109479061358f565b8181600082118015611e4d5750600081115b611e8d5760405162461bcd60e51b815260206004820152601160248201527007374617274206f7220656e64206973203607c1b6044820152606401610947565b818111611ecb5760405162461bcd60e51b815260206004820152600c60248201526b1cdd185c9d080f8f48195b9960a21b6044820152606401610947565b6000858152606660205260409020548514611ef85760405162461bcd60e51b815260040161094790613416565b6000858152606660205260409020600a015460ff1615611f2a5760405162461bcd60e51b815260040161094790613504565b600160008681526066602052604090206009015414611f5b5760405162461bcd60e51b815260040161094790613566565b600085815260666020526040902060018101859055600280820185905560098201556040518490869088907fd9938a514dab5cdce149a77493f694bd70c24a4833a278fd4d86fbdf859099c590600090a4505050505050565b6000611fbe612675565b6001600160a01b0316631b27e01b6040518163ffffffff1660e01b815260040160206040518083038186803b158015610bd157600080fd5b33611fff61237f565b6001600160a01b0316146120255760405162461bcd60e51b815260040161094790613479565b61202d61239f565b6001600160a01b0316306001600160a01b03161461205d5760405162461bcd60e51b81526004016109479061358f565b61206e8b8989898989898989612981565b61208a5760405162461bcd60e51b8152600401610947906135b4565b6120968c8c8c8c6123ba565b6121106040518061014001604052806000815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001606081526020016060815260200160008152602001600081525090565b8c8160000181815250508881602001906001600160a01b031690816001600160a01b0316815250508781604001906001600160a01b031690816001600160a01b0316815250508681606001906001600160a01b031690816001600160a01b0316815250508581608001906001600160a01b031690816001600160a01b031681525050848160a00181905250838160c00181905250828160e00181905250818161010001818152505080606760008f81526020019081526020016000206000820151816000015560208201518160010160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060408201518160020160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060608201518160030160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060808201518160040160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060a08201518160050190805190602001906122a6929190612e66565b5060c082015180516122c2916006840191602090910190612e66565b5060e082015180516122de916007840191602090910190612e66565b5061010082015160088201556101209091015160099091015550505050505050505050505050565b61230e61268d565b6001600160a01b0381166123735760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610947565b61237c816127c6565b50565b6000610c097111dbdd995c9b985b98d950dbdb9d1c9858dd60721b612de3565b6000610c096c42616c6c6f7453746f7261676560981b612de3565b81806123c4610b8f565b11156124125760405162461bcd60e51b815260206004820152601c60248201527f556e646572206d696e2076616c7565206f6620206475726174696f6e000000006044820152606401610947565b61241a611fb4565b8111156124695760405162461bcd60e51b815260206004820152601a60248201527f4f766572206d61782076616c7565206f66206475726174696f6e0000000000006044820152606401610947565b6000858152606660205260409020548514156124c05760405162461bcd60e51b8152602060048201526016602482015275105b1c9958591e48195e1a5cdd19590818985b1b1bdd60521b6044820152606401610947565b61252f6040518061018001604052806000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600015158152602001600081525090565b858152606081018590526001600160a01b0383166080820152600161012082015260006101408201819052610160820185905286815260666020908152604091829020835181558184015160018201559183015160028301556060830151600383015560808301516004830180546001600160a01b0319166001600160a01b0390921691909117905560a083015180518493926125d3926005850192910190612e66565b5060c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a8201805460ff191691151591909117905561016090910151600b90910155606d54612632906001613685565b606d556040516001600160a01b03841690869088907fd1ba591c76ef71222e2d30b8277758713cc6eef1de29efaf98a716744ac2420b90600090a4505050505050565b6000610c0969456e7653746f7261676560b01b612de3565b6033546001600160a01b031633146113fd5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610947565b6000600584146127095760405162461bcd60e51b8152600401610947906134d7565b826127605760405162461bcd60e51b815260206004820152602160248201527f496e76616c696420656e7669726f6e6d656e74207661726961626c65206e616d6044820152606560f81b6064820152608401610947565b60008251116127bc5760405162461bcd60e51b815260206004820152602260248201527f496e76616c696420656e7669726f6e6d656e74207661726961626c652076616c604482015261756560f01b6064820152608401610947565b5060019392505050565b603380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b60018214806128275750600282145b6128665760405162461bcd60e51b815260206004820152601060248201526f24b73b30b634b2103232b1b4b9b4b7b760811b6044820152606401610947565b60008481526066602052604090205484146128935760405162461bcd60e51b815260040161094790613416565b6000848152606b602090815260408083206001600160a01b038716845290915290205460ff16156128f65760405162461bcd60e51b815260206004820152600d60248201526c185b1c9958591e481d9bdd1959609a1b6044820152606401610947565b6000848152606660209081526040808320606b83528184206001600160a01b0388168552909252909120805460ff19166001908117909155600682015461293c91613685565b600682015560018314156129645781816007015461295a9190613685565b600782015561297a565b8181600801546129749190613685565b60088201555b5050505050565b600060018a10158015612995575060038a11155b6129b15760405162461bcd60e51b8152600401610947906134d7565b60028a1415612b71576001600160a01b0389166129e05760405162461bcd60e51b81526004016109479061352f565b6001600160a01b03881615612a075760405162461bcd60e51b8152600401610947906134a0565b6001600160a01b03871615612a2e5760405162461bcd60e51b8152600401610947906135df565b6001600160a01b03861615612a555760405162461bcd60e51b815260040161094790613442565b845115612a9c5760405162461bcd60e51b8152602060048201526015602482015274496e76616c6964206e6577206e6f6465206e616d6560581b6044820152606401610947565b835115612ae15760405162461bcd60e51b8152602060048201526013602482015272125b9d985b1a59081b995dc81b9bd919481a59606a1b6044820152606401610947565b825115612b265760405162461bcd60e51b81526020600482015260136024820152720496e76616c6964206e6577206e6f646520495606c1b6044820152606401610947565b8115612b6c5760405162461bcd60e51b8152602060048201526015602482015274125b9d985b1a59081b995dc81b9bd91948141bdc9d605a1b6044820152606401610947565b612dd3565b6000855111612bba5760405162461bcd60e51b8152602060048201526015602482015274496e76616c6964206e6577206e6f6465206e616d6560581b6044820152606401610947565b8351604014612c015760405162461bcd60e51b8152602060048201526013602482015272125b9d985b1a59081b995dc81b9bd919481a59606a1b6044820152606401610947565b6000835111612c485760405162461bcd60e51b81526020600482015260136024820152720496e76616c6964206e6577206e6f646520495606c1b6044820152606401610947565b60008211612c905760405162461bcd60e51b8152602060048201526015602482015274125b9d985b1a59081b995dc81b9bd91948141bdc9d605a1b6044820152606401610947565b60018a1415612d32576001600160a01b03891615612cc05760405162461bcd60e51b81526004016109479061352f565b6001600160a01b038816612ce65760405162461bcd60e51b8152600401610947906134a0565b6001600160a01b038716612d0c5760405162461bcd60e51b8152600401610947906135df565b6001600160a01b038616612b6c5760405162461bcd60e51b815260040161094790613442565b60038a1415612dd3576001600160a01b038916612d615760405162461bcd60e51b81526004016109479061352f565b6001600160a01b038816612d875760405162461bcd60e51b8152600401610947906134a0565b6001600160a01b038716612dad5760405162461bcd60e51b8152600401610947906135df565b6001600160a01b038616612dd35760405162461bcd60e51b815260040161094790613442565b5060019998505050505050505050565b606554604051630d2020dd60e01b8152600481018390526000916001600160a01b031690630d2020dd9060240160206040518083038186803b158015612e2857600080fd5b505afa158015612e3c573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612e609190612fc0565b92915050565b828054612e72906136ab565b90600052602060002090601f016020900481019282612e945760008555612eda565b82601f10612ead57805160ff1916838001178555612eda565b82800160010185558215612eda579182015b82811115612eda578251825591602001919060010190612ebf565b50612ee6929150612eea565b5090565b5b80821115612ee65760008155600101612eeb565b8035612f0a816136fc565b919050565b600082601f830112612f2057600080fd5b813567ffffffffffffffff80821115612f3b57612f3b6136e6565b604051601f8301601f19908116603f01168101908282118183101715612f6357612f636136e6565b81604052838152866020858801011115612f7c57600080fd5b836020870160208301376000602085830101528094505050505092915050565b600060208284031215612fae57600080fd5b8135612fb9816136fc565b9392505050565b600060208284031215612fd257600080fd5b8151612fb9816136fc565b600060208284031215612fef57600080fd5b5035919050565b60006020828403121561300857600080fd5b5051919050565b6000806040838503121561302257600080fd5b82359150602083013567ffffffffffffffff81111561304057600080fd5b61304c85828601612f0f565b9150509250929050565b6000806040838503121561306957600080fd5b50508035926020909101359150565b600080600080600060a0868803121561309057600080fd5b853594506020860135935060408601356130a9816136fc565b94979396509394606081013594506080013592915050565b6000806000606084860312156130d657600080fd5b505081359360208301359350604090920135919050565b600080600080600060a0868803121561310557600080fd5b8535945060208601359350604086013592506060860135613125816136fc565b91506080860135613135816136fc565b809150509295509295909350565b6000806000806000806000806000806000806101808d8f03121561316657600080fd5b8c359b5060208d01359a5060408d0135995061318460608e01612eff565b985061319260808e01612eff565b97506131a060a08e01612eff565b96506131ae60c08e01612eff565b95506131bc60e08e01612eff565b945067ffffffffffffffff6101008e013511156131d857600080fd5b6131e98e6101008f01358f01612f0f565b935067ffffffffffffffff6101208e0135111561320557600080fd5b6132168e6101208f01358f01612f0f565b925067ffffffffffffffff6101408e0135111561323257600080fd5b6132438e6101408f01358f01612f0f565b91506101608d013590509295989b509295989b509295989b565b600080600080600080600060e0888a03121561327857600080fd5b8735965060208801359550604088013594506060880135613298816136fc565b93506080880135925060a0880135915060c088013567ffffffffffffffff8111156132c257600080fd5b6132ce8a828b01612f0f565b91505092959891949750929550565b600080604083850312156132f057600080fd5b823566ffffffffffffff8116811461330757600080fd5b91506020830135613317816136fc565b809150509250929050565b6000815180845260005b818110156133485760208185018101518683018201520161332c565b8181111561335a576000602083870101525b50601f01601f19169290920160200192915050565b6001600160a01b038a811682528981166020830152888116604083015287166060820152610120608082018190526000906133ac83820189613322565b905082810360a08401526133c08188613322565b905082810360c08401526133d48187613322565b60e084019590955250506101000152979650505050505050565b83815282602082015260606040820152600061340d6060830184613322565b95945050505050565b6020808252601290820152711b9bdd08195e1a5cdd19590810985b1b1bdd60721b604082015260600190565b6020808252601a908201527f496e76616c6964206e6577207265776172642061646472657373000000000000604082015260600190565b6020808252600d908201526c2737902832b936b4b9b9b4b7b760991b604082015260600190565b6020808252601a908201527f496e76616c6964206e6577207374616b65722061646472657373000000000000604082015260600190565b602080825260139082015272496e76616c69642042616c6c6f74205479706560681b604082015260600190565b602080825260119082015270185b1c9958591e48199a5b985b1a5e9959607a1b604082015260600190565b6020808252601a908201527f496e76616c6964206f6c64207374616b65722061646472657373000000000000604082015260600190565b6020808252600f908201526e4e6f7420526561647920537461746560881b604082015260600190565b6020808252600b908201526a125cc8111a5cd8589b195960aa1b604082015260600190565b60208082526011908201527024b73b30b634b2102830b930b6b2ba32b960791b604082015260600190565b60208082526019908201527f496e76616c6964206e657720766f746572206164647265737300000000000000604082015260600190565b60006101608d83528c60208401528b604084015260018060a01b038b16606084015280608084015261364a8184018b613322565b60a0840199909952505060c081019590955260e085019390935261010084019190915215156101208301526101409091015295945050505050565b600082198211156136a657634e487b7160e01b600052601160045260246000fd5b500190565b600181811c908216806136bf57607f821691505b602082108114156136e057634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052604160045260246000fd5b6001600160a01b038116811461237c57600080fdfea264697066735822122015afbd7ae3ad4549b5611603642f7fc4f2331916c24ff550b3fb749f9c7534ea64736f6c63430008060033",
+}
+
+// BallotStorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use BallotStorageMetaData.ABI instead.
+var BallotStorageABI = BallotStorageMetaData.ABI
+
+// BallotStorageBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use BallotStorageMetaData.Bin instead.
+var BallotStorageBin = BallotStorageMetaData.Bin
+
+// DeployBallotStorage deploys a new Ethereum contract, binding an instance of BallotStorage to it.
+func DeployBallotStorage(auth *bind.TransactOpts, backend bind.ContractBackend, _registry common.Address) (common.Address, *types.Transaction, *BallotStorage, error) {
+	parsed, err := BallotStorageMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(BallotStorageBin), backend, _registry)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &BallotStorage{BallotStorageCaller: BallotStorageCaller{contract: contract}, BallotStorageTransactor: BallotStorageTransactor{contract: contract}, BallotStorageFilterer: BallotStorageFilterer{contract: contract}}, nil
+}
+
+// BallotStorage is an auto generated Go binding around an Ethereum contract.
+type BallotStorage struct {
+	BallotStorageCaller     // Read-only binding to the contract
+	BallotStorageTransactor // Write-only binding to the contract
+	BallotStorageFilterer   // Log filterer for contract events
+}
+
+// BallotStorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BallotStorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BallotStorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BallotStorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BallotStorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BallotStorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BallotStorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BallotStorageSession struct {
+	Contract     *BallotStorage    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BallotStorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BallotStorageCallerSession struct {
+	Contract *BallotStorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// BallotStorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BallotStorageTransactorSession struct {
+	Contract     *BallotStorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// BallotStorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BallotStorageRaw struct {
+	Contract *BallotStorage // Generic contract binding to access the raw methods on
+}
+
+// BallotStorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BallotStorageCallerRaw struct {
+	Contract *BallotStorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BallotStorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BallotStorageTransactorRaw struct {
+	Contract *BallotStorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBallotStorage creates a new instance of BallotStorage, bound to a specific deployed contract.
+func NewBallotStorage(address common.Address, backend bind.ContractBackend) (*BallotStorage, error) {
+	contract, err := bindBallotStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorage{BallotStorageCaller: BallotStorageCaller{contract: contract}, BallotStorageTransactor: BallotStorageTransactor{contract: contract}, BallotStorageFilterer: BallotStorageFilterer{contract: contract}}, nil
+}
+
+// NewBallotStorageCaller creates a new read-only instance of BallotStorage, bound to a specific deployed contract.
+func NewBallotStorageCaller(address common.Address, caller bind.ContractCaller) (*BallotStorageCaller, error) {
+	contract, err := bindBallotStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageCaller{contract: contract}, nil
+}
+
+// NewBallotStorageTransactor creates a new write-only instance of BallotStorage, bound to a specific deployed contract.
+func NewBallotStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*BallotStorageTransactor, error) {
+	contract, err := bindBallotStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageTransactor{contract: contract}, nil
+}
+
+// NewBallotStorageFilterer creates a new log filterer instance of BallotStorage, bound to a specific deployed contract.
+func NewBallotStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*BallotStorageFilterer, error) {
+	contract, err := bindBallotStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageFilterer{contract: contract}, nil
+}
+
+// bindBallotStorage binds a generic wrapper to an already deployed contract.
+func bindBallotStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(BallotStorageABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BallotStorage *BallotStorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BallotStorage.Contract.BallotStorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BallotStorage *BallotStorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BallotStorage.Contract.BallotStorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BallotStorage *BallotStorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BallotStorage.Contract.BallotStorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BallotStorage *BallotStorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BallotStorage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BallotStorage *BallotStorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BallotStorage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BallotStorage *BallotStorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BallotStorage.Contract.contract.Transact(opts, method, params...)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCaller) BALLOTSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "BALLOT_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _BallotStorage.Contract.BALLOTSTORAGENAME(&_BallotStorage.CallOpts)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCallerSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _BallotStorage.Contract.BALLOTSTORAGENAME(&_BallotStorage.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCaller) ENVSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "ENV_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _BallotStorage.Contract.ENVSTORAGENAME(&_BallotStorage.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCallerSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _BallotStorage.Contract.ENVSTORAGENAME(&_BallotStorage.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCaller) GOVNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "GOV_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageSession) GOVNAME() ([32]byte, error) {
+	return _BallotStorage.Contract.GOVNAME(&_BallotStorage.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCallerSession) GOVNAME() ([32]byte, error) {
+	return _BallotStorage.Contract.GOVNAME(&_BallotStorage.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCaller) MAINTENANCENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "MAINTENANCE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageSession) MAINTENANCENAME() ([32]byte, error) {
+	return _BallotStorage.Contract.MAINTENANCENAME(&_BallotStorage.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCallerSession) MAINTENANCENAME() ([32]byte, error) {
+	return _BallotStorage.Contract.MAINTENANCENAME(&_BallotStorage.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCaller) REWARDPOOLNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "REWARD_POOL_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _BallotStorage.Contract.REWARDPOOLNAME(&_BallotStorage.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCallerSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _BallotStorage.Contract.REWARDPOOLNAME(&_BallotStorage.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCaller) STAKINGNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "STAKING_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageSession) STAKINGNAME() ([32]byte, error) {
+	return _BallotStorage.Contract.STAKINGNAME(&_BallotStorage.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_BallotStorage *BallotStorageCallerSession) STAKINGNAME() ([32]byte, error) {
+	return _BallotStorage.Contract.STAKINGNAME(&_BallotStorage.CallOpts)
+}
+
+// GetBallotAddress is a free data retrieval call binding the contract method 0x7efa9ae3.
+//
+// Solidity: function getBallotAddress(uint256 _id) view returns(address newGovernanceAddress)
+func (_BallotStorage *BallotStorageCaller) GetBallotAddress(opts *bind.CallOpts, _id *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotAddress", _id)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetBallotAddress is a free data retrieval call binding the contract method 0x7efa9ae3.
+//
+// Solidity: function getBallotAddress(uint256 _id) view returns(address newGovernanceAddress)
+func (_BallotStorage *BallotStorageSession) GetBallotAddress(_id *big.Int) (common.Address, error) {
+	return _BallotStorage.Contract.GetBallotAddress(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotAddress is a free data retrieval call binding the contract method 0x7efa9ae3.
+//
+// Solidity: function getBallotAddress(uint256 _id) view returns(address newGovernanceAddress)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotAddress(_id *big.Int) (common.Address, error) {
+	return _BallotStorage.Contract.GetBallotAddress(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotBasic is a free data retrieval call binding the contract method 0x02b385fb.
+//
+// Solidity: function getBallotBasic(uint256 _id) view returns(uint256 startTime, uint256 endTime, uint256 ballotType, address creator, bytes memo, uint256 totalVoters, uint256 powerOfAccepts, uint256 powerOfRejects, uint256 state, bool isFinalized, uint256 duration)
+func (_BallotStorage *BallotStorageCaller) GetBallotBasic(opts *bind.CallOpts, _id *big.Int) (struct {
+	StartTime      *big.Int
+	EndTime        *big.Int
+	BallotType     *big.Int
+	Creator        common.Address
+	Memo           []byte
+	TotalVoters    *big.Int
+	PowerOfAccepts *big.Int
+	PowerOfRejects *big.Int
+	State          *big.Int
+	IsFinalized    bool
+	Duration       *big.Int
+}, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotBasic", _id)
+
+	outstruct := new(struct {
+		StartTime      *big.Int
+		EndTime        *big.Int
+		BallotType     *big.Int
+		Creator        common.Address
+		Memo           []byte
+		TotalVoters    *big.Int
+		PowerOfAccepts *big.Int
+		PowerOfRejects *big.Int
+		State          *big.Int
+		IsFinalized    bool
+		Duration       *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StartTime = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.EndTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.BallotType = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Creator = *abi.ConvertType(out[3], new(common.Address)).(*common.Address)
+	outstruct.Memo = *abi.ConvertType(out[4], new([]byte)).(*[]byte)
+	outstruct.TotalVoters = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.PowerOfAccepts = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.PowerOfRejects = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+	outstruct.State = *abi.ConvertType(out[8], new(*big.Int)).(**big.Int)
+	outstruct.IsFinalized = *abi.ConvertType(out[9], new(bool)).(*bool)
+	outstruct.Duration = *abi.ConvertType(out[10], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBallotBasic is a free data retrieval call binding the contract method 0x02b385fb.
+//
+// Solidity: function getBallotBasic(uint256 _id) view returns(uint256 startTime, uint256 endTime, uint256 ballotType, address creator, bytes memo, uint256 totalVoters, uint256 powerOfAccepts, uint256 powerOfRejects, uint256 state, bool isFinalized, uint256 duration)
+func (_BallotStorage *BallotStorageSession) GetBallotBasic(_id *big.Int) (struct {
+	StartTime      *big.Int
+	EndTime        *big.Int
+	BallotType     *big.Int
+	Creator        common.Address
+	Memo           []byte
+	TotalVoters    *big.Int
+	PowerOfAccepts *big.Int
+	PowerOfRejects *big.Int
+	State          *big.Int
+	IsFinalized    bool
+	Duration       *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotBasic(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotBasic is a free data retrieval call binding the contract method 0x02b385fb.
+//
+// Solidity: function getBallotBasic(uint256 _id) view returns(uint256 startTime, uint256 endTime, uint256 ballotType, address creator, bytes memo, uint256 totalVoters, uint256 powerOfAccepts, uint256 powerOfRejects, uint256 state, bool isFinalized, uint256 duration)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotBasic(_id *big.Int) (struct {
+	StartTime      *big.Int
+	EndTime        *big.Int
+	BallotType     *big.Int
+	Creator        common.Address
+	Memo           []byte
+	TotalVoters    *big.Int
+	PowerOfAccepts *big.Int
+	PowerOfRejects *big.Int
+	State          *big.Int
+	IsFinalized    bool
+	Duration       *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotBasic(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotCount is a free data retrieval call binding the contract method 0xb4741495.
+//
+// Solidity: function getBallotCount() view returns(uint256)
+func (_BallotStorage *BallotStorageCaller) GetBallotCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBallotCount is a free data retrieval call binding the contract method 0xb4741495.
+//
+// Solidity: function getBallotCount() view returns(uint256)
+func (_BallotStorage *BallotStorageSession) GetBallotCount() (*big.Int, error) {
+	return _BallotStorage.Contract.GetBallotCount(&_BallotStorage.CallOpts)
+}
+
+// GetBallotCount is a free data retrieval call binding the contract method 0xb4741495.
+//
+// Solidity: function getBallotCount() view returns(uint256)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotCount() (*big.Int, error) {
+	return _BallotStorage.Contract.GetBallotCount(&_BallotStorage.CallOpts)
+}
+
+// GetBallotMember is a free data retrieval call binding the contract method 0x73df4e01.
+//
+// Solidity: function getBallotMember(uint256 _id) view returns(address oldStakerAddress, address newStakerAddress, address newVoterAddress, address newRewardAddress, bytes newNodeName, bytes newNodeId, bytes newNodeIp, uint256 newNodePort, uint256 lockAmount)
+func (_BallotStorage *BallotStorageCaller) GetBallotMember(opts *bind.CallOpts, _id *big.Int) (struct {
+	OldStakerAddress common.Address
+	NewStakerAddress common.Address
+	NewVoterAddress  common.Address
+	NewRewardAddress common.Address
+	NewNodeName      []byte
+	NewNodeId        []byte
+	NewNodeIp        []byte
+	NewNodePort      *big.Int
+	LockAmount       *big.Int
+}, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotMember", _id)
+
+	outstruct := new(struct {
+		OldStakerAddress common.Address
+		NewStakerAddress common.Address
+		NewVoterAddress  common.Address
+		NewRewardAddress common.Address
+		NewNodeName      []byte
+		NewNodeId        []byte
+		NewNodeIp        []byte
+		NewNodePort      *big.Int
+		LockAmount       *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.OldStakerAddress = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.NewStakerAddress = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.NewVoterAddress = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	outstruct.NewRewardAddress = *abi.ConvertType(out[3], new(common.Address)).(*common.Address)
+	outstruct.NewNodeName = *abi.ConvertType(out[4], new([]byte)).(*[]byte)
+	outstruct.NewNodeId = *abi.ConvertType(out[5], new([]byte)).(*[]byte)
+	outstruct.NewNodeIp = *abi.ConvertType(out[6], new([]byte)).(*[]byte)
+	outstruct.NewNodePort = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+	outstruct.LockAmount = *abi.ConvertType(out[8], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBallotMember is a free data retrieval call binding the contract method 0x73df4e01.
+//
+// Solidity: function getBallotMember(uint256 _id) view returns(address oldStakerAddress, address newStakerAddress, address newVoterAddress, address newRewardAddress, bytes newNodeName, bytes newNodeId, bytes newNodeIp, uint256 newNodePort, uint256 lockAmount)
+func (_BallotStorage *BallotStorageSession) GetBallotMember(_id *big.Int) (struct {
+	OldStakerAddress common.Address
+	NewStakerAddress common.Address
+	NewVoterAddress  common.Address
+	NewRewardAddress common.Address
+	NewNodeName      []byte
+	NewNodeId        []byte
+	NewNodeIp        []byte
+	NewNodePort      *big.Int
+	LockAmount       *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotMember(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotMember is a free data retrieval call binding the contract method 0x73df4e01.
+//
+// Solidity: function getBallotMember(uint256 _id) view returns(address oldStakerAddress, address newStakerAddress, address newVoterAddress, address newRewardAddress, bytes newNodeName, bytes newNodeId, bytes newNodeIp, uint256 newNodePort, uint256 lockAmount)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotMember(_id *big.Int) (struct {
+	OldStakerAddress common.Address
+	NewStakerAddress common.Address
+	NewVoterAddress  common.Address
+	NewRewardAddress common.Address
+	NewNodeName      []byte
+	NewNodeId        []byte
+	NewNodeIp        []byte
+	NewNodePort      *big.Int
+	LockAmount       *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotMember(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotPeriod is a free data retrieval call binding the contract method 0x09970688.
+//
+// Solidity: function getBallotPeriod(uint256 _id) view returns(uint256 startTime, uint256 endTime, uint256 duration)
+func (_BallotStorage *BallotStorageCaller) GetBallotPeriod(opts *bind.CallOpts, _id *big.Int) (struct {
+	StartTime *big.Int
+	EndTime   *big.Int
+	Duration  *big.Int
+}, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotPeriod", _id)
+
+	outstruct := new(struct {
+		StartTime *big.Int
+		EndTime   *big.Int
+		Duration  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StartTime = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.EndTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Duration = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBallotPeriod is a free data retrieval call binding the contract method 0x09970688.
+//
+// Solidity: function getBallotPeriod(uint256 _id) view returns(uint256 startTime, uint256 endTime, uint256 duration)
+func (_BallotStorage *BallotStorageSession) GetBallotPeriod(_id *big.Int) (struct {
+	StartTime *big.Int
+	EndTime   *big.Int
+	Duration  *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotPeriod(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotPeriod is a free data retrieval call binding the contract method 0x09970688.
+//
+// Solidity: function getBallotPeriod(uint256 _id) view returns(uint256 startTime, uint256 endTime, uint256 duration)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotPeriod(_id *big.Int) (struct {
+	StartTime *big.Int
+	EndTime   *big.Int
+	Duration  *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotPeriod(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotState is a free data retrieval call binding the contract method 0x688ca5b2.
+//
+// Solidity: function getBallotState(uint256 _id) view returns(uint256 ballotType, uint256 state, bool isFinalized)
+func (_BallotStorage *BallotStorageCaller) GetBallotState(opts *bind.CallOpts, _id *big.Int) (struct {
+	BallotType  *big.Int
+	State       *big.Int
+	IsFinalized bool
+}, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotState", _id)
+
+	outstruct := new(struct {
+		BallotType  *big.Int
+		State       *big.Int
+		IsFinalized bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.BallotType = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.State = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.IsFinalized = *abi.ConvertType(out[2], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetBallotState is a free data retrieval call binding the contract method 0x688ca5b2.
+//
+// Solidity: function getBallotState(uint256 _id) view returns(uint256 ballotType, uint256 state, bool isFinalized)
+func (_BallotStorage *BallotStorageSession) GetBallotState(_id *big.Int) (struct {
+	BallotType  *big.Int
+	State       *big.Int
+	IsFinalized bool
+}, error) {
+	return _BallotStorage.Contract.GetBallotState(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotState is a free data retrieval call binding the contract method 0x688ca5b2.
+//
+// Solidity: function getBallotState(uint256 _id) view returns(uint256 ballotType, uint256 state, bool isFinalized)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotState(_id *big.Int) (struct {
+	BallotType  *big.Int
+	State       *big.Int
+	IsFinalized bool
+}, error) {
+	return _BallotStorage.Contract.GetBallotState(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotVariable is a free data retrieval call binding the contract method 0x1d940da2.
+//
+// Solidity: function getBallotVariable(uint256 _id) view returns(bytes32 envVariableName, uint256 envVariableType, bytes envVariableValue)
+func (_BallotStorage *BallotStorageCaller) GetBallotVariable(opts *bind.CallOpts, _id *big.Int) (struct {
+	EnvVariableName  [32]byte
+	EnvVariableType  *big.Int
+	EnvVariableValue []byte
+}, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotVariable", _id)
+
+	outstruct := new(struct {
+		EnvVariableName  [32]byte
+		EnvVariableType  *big.Int
+		EnvVariableValue []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.EnvVariableName = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.EnvVariableType = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.EnvVariableValue = *abi.ConvertType(out[2], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// GetBallotVariable is a free data retrieval call binding the contract method 0x1d940da2.
+//
+// Solidity: function getBallotVariable(uint256 _id) view returns(bytes32 envVariableName, uint256 envVariableType, bytes envVariableValue)
+func (_BallotStorage *BallotStorageSession) GetBallotVariable(_id *big.Int) (struct {
+	EnvVariableName  [32]byte
+	EnvVariableType  *big.Int
+	EnvVariableValue []byte
+}, error) {
+	return _BallotStorage.Contract.GetBallotVariable(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotVariable is a free data retrieval call binding the contract method 0x1d940da2.
+//
+// Solidity: function getBallotVariable(uint256 _id) view returns(bytes32 envVariableName, uint256 envVariableType, bytes envVariableValue)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotVariable(_id *big.Int) (struct {
+	EnvVariableName  [32]byte
+	EnvVariableType  *big.Int
+	EnvVariableValue []byte
+}, error) {
+	return _BallotStorage.Contract.GetBallotVariable(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotVotingInfo is a free data retrieval call binding the contract method 0x56ba988e.
+//
+// Solidity: function getBallotVotingInfo(uint256 _id) view returns(uint256 totalVoters, uint256 powerOfAccepts, uint256 powerOfRejects)
+func (_BallotStorage *BallotStorageCaller) GetBallotVotingInfo(opts *bind.CallOpts, _id *big.Int) (struct {
+	TotalVoters    *big.Int
+	PowerOfAccepts *big.Int
+	PowerOfRejects *big.Int
+}, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getBallotVotingInfo", _id)
+
+	outstruct := new(struct {
+		TotalVoters    *big.Int
+		PowerOfAccepts *big.Int
+		PowerOfRejects *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TotalVoters = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.PowerOfAccepts = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.PowerOfRejects = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBallotVotingInfo is a free data retrieval call binding the contract method 0x56ba988e.
+//
+// Solidity: function getBallotVotingInfo(uint256 _id) view returns(uint256 totalVoters, uint256 powerOfAccepts, uint256 powerOfRejects)
+func (_BallotStorage *BallotStorageSession) GetBallotVotingInfo(_id *big.Int) (struct {
+	TotalVoters    *big.Int
+	PowerOfAccepts *big.Int
+	PowerOfRejects *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotVotingInfo(&_BallotStorage.CallOpts, _id)
+}
+
+// GetBallotVotingInfo is a free data retrieval call binding the contract method 0x56ba988e.
+//
+// Solidity: function getBallotVotingInfo(uint256 _id) view returns(uint256 totalVoters, uint256 powerOfAccepts, uint256 powerOfRejects)
+func (_BallotStorage *BallotStorageCallerSession) GetBallotVotingInfo(_id *big.Int) (struct {
+	TotalVoters    *big.Int
+	PowerOfAccepts *big.Int
+	PowerOfRejects *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetBallotVotingInfo(&_BallotStorage.CallOpts, _id)
+}
+
+// GetMaxVotingDuration is a free data retrieval call binding the contract method 0xce04b9d4.
+//
+// Solidity: function getMaxVotingDuration() view returns(uint256)
+func (_BallotStorage *BallotStorageCaller) GetMaxVotingDuration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getMaxVotingDuration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxVotingDuration is a free data retrieval call binding the contract method 0xce04b9d4.
+//
+// Solidity: function getMaxVotingDuration() view returns(uint256)
+func (_BallotStorage *BallotStorageSession) GetMaxVotingDuration() (*big.Int, error) {
+	return _BallotStorage.Contract.GetMaxVotingDuration(&_BallotStorage.CallOpts)
+}
+
+// GetMaxVotingDuration is a free data retrieval call binding the contract method 0xce04b9d4.
+//
+// Solidity: function getMaxVotingDuration() view returns(uint256)
+func (_BallotStorage *BallotStorageCallerSession) GetMaxVotingDuration() (*big.Int, error) {
+	return _BallotStorage.Contract.GetMaxVotingDuration(&_BallotStorage.CallOpts)
+}
+
+// GetMinVotingDuration is a free data retrieval call binding the contract method 0x1c150171.
+//
+// Solidity: function getMinVotingDuration() view returns(uint256)
+func (_BallotStorage *BallotStorageCaller) GetMinVotingDuration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getMinVotingDuration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinVotingDuration is a free data retrieval call binding the contract method 0x1c150171.
+//
+// Solidity: function getMinVotingDuration() view returns(uint256)
+func (_BallotStorage *BallotStorageSession) GetMinVotingDuration() (*big.Int, error) {
+	return _BallotStorage.Contract.GetMinVotingDuration(&_BallotStorage.CallOpts)
+}
+
+// GetMinVotingDuration is a free data retrieval call binding the contract method 0x1c150171.
+//
+// Solidity: function getMinVotingDuration() view returns(uint256)
+func (_BallotStorage *BallotStorageCallerSession) GetMinVotingDuration() (*big.Int, error) {
+	return _BallotStorage.Contract.GetMinVotingDuration(&_BallotStorage.CallOpts)
+}
+
+// GetPreviousBallotStorage is a free data retrieval call binding the contract method 0xb23c676c.
+//
+// Solidity: function getPreviousBallotStorage() view returns(address)
+func (_BallotStorage *BallotStorageCaller) GetPreviousBallotStorage(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getPreviousBallotStorage")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetPreviousBallotStorage is a free data retrieval call binding the contract method 0xb23c676c.
+//
+// Solidity: function getPreviousBallotStorage() view returns(address)
+func (_BallotStorage *BallotStorageSession) GetPreviousBallotStorage() (common.Address, error) {
+	return _BallotStorage.Contract.GetPreviousBallotStorage(&_BallotStorage.CallOpts)
+}
+
+// GetPreviousBallotStorage is a free data retrieval call binding the contract method 0xb23c676c.
+//
+// Solidity: function getPreviousBallotStorage() view returns(address)
+func (_BallotStorage *BallotStorageCallerSession) GetPreviousBallotStorage() (common.Address, error) {
+	return _BallotStorage.Contract.GetPreviousBallotStorage(&_BallotStorage.CallOpts)
+}
+
+// GetTime is a free data retrieval call binding the contract method 0x557ed1ba.
+//
+// Solidity: function getTime() view returns(uint256)
+func (_BallotStorage *BallotStorageCaller) GetTime(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getTime")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTime is a free data retrieval call binding the contract method 0x557ed1ba.
+//
+// Solidity: function getTime() view returns(uint256)
+func (_BallotStorage *BallotStorageSession) GetTime() (*big.Int, error) {
+	return _BallotStorage.Contract.GetTime(&_BallotStorage.CallOpts)
+}
+
+// GetTime is a free data retrieval call binding the contract method 0x557ed1ba.
+//
+// Solidity: function getTime() view returns(uint256)
+func (_BallotStorage *BallotStorageCallerSession) GetTime() (*big.Int, error) {
+	return _BallotStorage.Contract.GetTime(&_BallotStorage.CallOpts)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0x5a55c1f0.
+//
+// Solidity: function getVote(uint256 _voteId) view returns(uint256 voteId, uint256 ballotId, address voter, uint256 decision, uint256 power, uint256 time)
+func (_BallotStorage *BallotStorageCaller) GetVote(opts *bind.CallOpts, _voteId *big.Int) (struct {
+	VoteId   *big.Int
+	BallotId *big.Int
+	Voter    common.Address
+	Decision *big.Int
+	Power    *big.Int
+	Time     *big.Int
+}, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "getVote", _voteId)
+
+	outstruct := new(struct {
+		VoteId   *big.Int
+		BallotId *big.Int
+		Voter    common.Address
+		Decision *big.Int
+		Power    *big.Int
+		Time     *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.VoteId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.BallotId = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Voter = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	outstruct.Decision = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.Power = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.Time = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetVote is a free data retrieval call binding the contract method 0x5a55c1f0.
+//
+// Solidity: function getVote(uint256 _voteId) view returns(uint256 voteId, uint256 ballotId, address voter, uint256 decision, uint256 power, uint256 time)
+func (_BallotStorage *BallotStorageSession) GetVote(_voteId *big.Int) (struct {
+	VoteId   *big.Int
+	BallotId *big.Int
+	Voter    common.Address
+	Decision *big.Int
+	Power    *big.Int
+	Time     *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetVote(&_BallotStorage.CallOpts, _voteId)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0x5a55c1f0.
+//
+// Solidity: function getVote(uint256 _voteId) view returns(uint256 voteId, uint256 ballotId, address voter, uint256 decision, uint256 power, uint256 time)
+func (_BallotStorage *BallotStorageCallerSession) GetVote(_voteId *big.Int) (struct {
+	VoteId   *big.Int
+	BallotId *big.Int
+	Voter    common.Address
+	Decision *big.Int
+	Power    *big.Int
+	Time     *big.Int
+}, error) {
+	return _BallotStorage.Contract.GetVote(&_BallotStorage.CallOpts, _voteId)
+}
+
+// HasAlreadyVoted is a free data retrieval call binding the contract method 0xf680e555.
+//
+// Solidity: function hasAlreadyVoted(uint56 _ballotId, address _voter) view returns(bool)
+func (_BallotStorage *BallotStorageCaller) HasAlreadyVoted(opts *bind.CallOpts, _ballotId *big.Int, _voter common.Address) (bool, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "hasAlreadyVoted", _ballotId, _voter)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasAlreadyVoted is a free data retrieval call binding the contract method 0xf680e555.
+//
+// Solidity: function hasAlreadyVoted(uint56 _ballotId, address _voter) view returns(bool)
+func (_BallotStorage *BallotStorageSession) HasAlreadyVoted(_ballotId *big.Int, _voter common.Address) (bool, error) {
+	return _BallotStorage.Contract.HasAlreadyVoted(&_BallotStorage.CallOpts, _ballotId, _voter)
+}
+
+// HasAlreadyVoted is a free data retrieval call binding the contract method 0xf680e555.
+//
+// Solidity: function hasAlreadyVoted(uint56 _ballotId, address _voter) view returns(bool)
+func (_BallotStorage *BallotStorageCallerSession) HasAlreadyVoted(_ballotId *big.Int, _voter common.Address) (bool, error) {
+	return _BallotStorage.Contract.HasAlreadyVoted(&_BallotStorage.CallOpts, _ballotId, _voter)
+}
+
+// IsDisabled is a free data retrieval call binding the contract method 0x6c57f5a9.
+//
+// Solidity: function isDisabled() view returns(bool)
+func (_BallotStorage *BallotStorageCaller) IsDisabled(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "isDisabled")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsDisabled is a free data retrieval call binding the contract method 0x6c57f5a9.
+//
+// Solidity: function isDisabled() view returns(bool)
+func (_BallotStorage *BallotStorageSession) IsDisabled() (bool, error) {
+	return _BallotStorage.Contract.IsDisabled(&_BallotStorage.CallOpts)
+}
+
+// IsDisabled is a free data retrieval call binding the contract method 0x6c57f5a9.
+//
+// Solidity: function isDisabled() view returns(bool)
+func (_BallotStorage *BallotStorageCallerSession) IsDisabled() (bool, error) {
+	return _BallotStorage.Contract.IsDisabled(&_BallotStorage.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BallotStorage *BallotStorageCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BallotStorage *BallotStorageSession) Owner() (common.Address, error) {
+	return _BallotStorage.Contract.Owner(&_BallotStorage.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BallotStorage *BallotStorageCallerSession) Owner() (common.Address, error) {
+	return _BallotStorage.Contract.Owner(&_BallotStorage.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_BallotStorage *BallotStorageCaller) Reg(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BallotStorage.contract.Call(opts, &out, "reg")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_BallotStorage *BallotStorageSession) Reg() (common.Address, error) {
+	return _BallotStorage.Contract.Reg(&_BallotStorage.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_BallotStorage *BallotStorageCallerSession) Reg() (common.Address, error) {
+	return _BallotStorage.Contract.Reg(&_BallotStorage.CallOpts)
+}
+
+// CancelBallot is a paid mutator transaction binding the contract method 0x155ca224.
+//
+// Solidity: function cancelBallot(uint256 _ballotId) returns()
+func (_BallotStorage *BallotStorageTransactor) CancelBallot(opts *bind.TransactOpts, _ballotId *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "cancelBallot", _ballotId)
+}
+
+// CancelBallot is a paid mutator transaction binding the contract method 0x155ca224.
+//
+// Solidity: function cancelBallot(uint256 _ballotId) returns()
+func (_BallotStorage *BallotStorageSession) CancelBallot(_ballotId *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CancelBallot(&_BallotStorage.TransactOpts, _ballotId)
+}
+
+// CancelBallot is a paid mutator transaction binding the contract method 0x155ca224.
+//
+// Solidity: function cancelBallot(uint256 _ballotId) returns()
+func (_BallotStorage *BallotStorageTransactorSession) CancelBallot(_ballotId *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CancelBallot(&_BallotStorage.TransactOpts, _ballotId)
+}
+
+// CreateBallotForAddress is a paid mutator transaction binding the contract method 0x0a3a63fe.
+//
+// Solidity: function createBallotForAddress(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, address _newGovernanceAddress) returns(uint256)
+func (_BallotStorage *BallotStorageTransactor) CreateBallotForAddress(opts *bind.TransactOpts, _id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _newGovernanceAddress common.Address) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "createBallotForAddress", _id, _ballotType, _duration, _creator, _newGovernanceAddress)
+}
+
+// CreateBallotForAddress is a paid mutator transaction binding the contract method 0x0a3a63fe.
+//
+// Solidity: function createBallotForAddress(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, address _newGovernanceAddress) returns(uint256)
+func (_BallotStorage *BallotStorageSession) CreateBallotForAddress(_id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _newGovernanceAddress common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateBallotForAddress(&_BallotStorage.TransactOpts, _id, _ballotType, _duration, _creator, _newGovernanceAddress)
+}
+
+// CreateBallotForAddress is a paid mutator transaction binding the contract method 0x0a3a63fe.
+//
+// Solidity: function createBallotForAddress(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, address _newGovernanceAddress) returns(uint256)
+func (_BallotStorage *BallotStorageTransactorSession) CreateBallotForAddress(_id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _newGovernanceAddress common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateBallotForAddress(&_BallotStorage.TransactOpts, _id, _ballotType, _duration, _creator, _newGovernanceAddress)
+}
+
+// CreateBallotForMember is a paid mutator transaction binding the contract method 0xdaacbb95.
+//
+// Solidity: function createBallotForMember(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, address _oldStakerAddress, address _newStakerAddress, address _newVoterAddress, address _newRewardAddress, bytes _newNodeName, bytes _newNodeId, bytes _newNodeIp, uint256 _newNodePort) returns()
+func (_BallotStorage *BallotStorageTransactor) CreateBallotForMember(opts *bind.TransactOpts, _id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _oldStakerAddress common.Address, _newStakerAddress common.Address, _newVoterAddress common.Address, _newRewardAddress common.Address, _newNodeName []byte, _newNodeId []byte, _newNodeIp []byte, _newNodePort *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "createBallotForMember", _id, _ballotType, _duration, _creator, _oldStakerAddress, _newStakerAddress, _newVoterAddress, _newRewardAddress, _newNodeName, _newNodeId, _newNodeIp, _newNodePort)
+}
+
+// CreateBallotForMember is a paid mutator transaction binding the contract method 0xdaacbb95.
+//
+// Solidity: function createBallotForMember(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, address _oldStakerAddress, address _newStakerAddress, address _newVoterAddress, address _newRewardAddress, bytes _newNodeName, bytes _newNodeId, bytes _newNodeIp, uint256 _newNodePort) returns()
+func (_BallotStorage *BallotStorageSession) CreateBallotForMember(_id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _oldStakerAddress common.Address, _newStakerAddress common.Address, _newVoterAddress common.Address, _newRewardAddress common.Address, _newNodeName []byte, _newNodeId []byte, _newNodeIp []byte, _newNodePort *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateBallotForMember(&_BallotStorage.TransactOpts, _id, _ballotType, _duration, _creator, _oldStakerAddress, _newStakerAddress, _newVoterAddress, _newRewardAddress, _newNodeName, _newNodeId, _newNodeIp, _newNodePort)
+}
+
+// CreateBallotForMember is a paid mutator transaction binding the contract method 0xdaacbb95.
+//
+// Solidity: function createBallotForMember(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, address _oldStakerAddress, address _newStakerAddress, address _newVoterAddress, address _newRewardAddress, bytes _newNodeName, bytes _newNodeId, bytes _newNodeIp, uint256 _newNodePort) returns()
+func (_BallotStorage *BallotStorageTransactorSession) CreateBallotForMember(_id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _oldStakerAddress common.Address, _newStakerAddress common.Address, _newVoterAddress common.Address, _newRewardAddress common.Address, _newNodeName []byte, _newNodeId []byte, _newNodeIp []byte, _newNodePort *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateBallotForMember(&_BallotStorage.TransactOpts, _id, _ballotType, _duration, _creator, _oldStakerAddress, _newStakerAddress, _newVoterAddress, _newRewardAddress, _newNodeName, _newNodeId, _newNodeIp, _newNodePort)
+}
+
+// CreateBallotForVariable is a paid mutator transaction binding the contract method 0x4a57823e.
+//
+// Solidity: function createBallotForVariable(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, bytes32 _envVariableName, uint256 _envVariableType, bytes _envVariableValue) returns(uint256)
+func (_BallotStorage *BallotStorageTransactor) CreateBallotForVariable(opts *bind.TransactOpts, _id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _envVariableName [32]byte, _envVariableType *big.Int, _envVariableValue []byte) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "createBallotForVariable", _id, _ballotType, _duration, _creator, _envVariableName, _envVariableType, _envVariableValue)
+}
+
+// CreateBallotForVariable is a paid mutator transaction binding the contract method 0x4a57823e.
+//
+// Solidity: function createBallotForVariable(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, bytes32 _envVariableName, uint256 _envVariableType, bytes _envVariableValue) returns(uint256)
+func (_BallotStorage *BallotStorageSession) CreateBallotForVariable(_id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _envVariableName [32]byte, _envVariableType *big.Int, _envVariableValue []byte) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateBallotForVariable(&_BallotStorage.TransactOpts, _id, _ballotType, _duration, _creator, _envVariableName, _envVariableType, _envVariableValue)
+}
+
+// CreateBallotForVariable is a paid mutator transaction binding the contract method 0x4a57823e.
+//
+// Solidity: function createBallotForVariable(uint256 _id, uint256 _ballotType, uint256 _duration, address _creator, bytes32 _envVariableName, uint256 _envVariableType, bytes _envVariableValue) returns(uint256)
+func (_BallotStorage *BallotStorageTransactorSession) CreateBallotForVariable(_id *big.Int, _ballotType *big.Int, _duration *big.Int, _creator common.Address, _envVariableName [32]byte, _envVariableType *big.Int, _envVariableValue []byte) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateBallotForVariable(&_BallotStorage.TransactOpts, _id, _ballotType, _duration, _creator, _envVariableName, _envVariableType, _envVariableValue)
+}
+
+// CreateVote is a paid mutator transaction binding the contract method 0x96462b9c.
+//
+// Solidity: function createVote(uint256 _voteId, uint256 _ballotId, address _voter, uint256 _decision, uint256 _power) returns()
+func (_BallotStorage *BallotStorageTransactor) CreateVote(opts *bind.TransactOpts, _voteId *big.Int, _ballotId *big.Int, _voter common.Address, _decision *big.Int, _power *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "createVote", _voteId, _ballotId, _voter, _decision, _power)
+}
+
+// CreateVote is a paid mutator transaction binding the contract method 0x96462b9c.
+//
+// Solidity: function createVote(uint256 _voteId, uint256 _ballotId, address _voter, uint256 _decision, uint256 _power) returns()
+func (_BallotStorage *BallotStorageSession) CreateVote(_voteId *big.Int, _ballotId *big.Int, _voter common.Address, _decision *big.Int, _power *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateVote(&_BallotStorage.TransactOpts, _voteId, _ballotId, _voter, _decision, _power)
+}
+
+// CreateVote is a paid mutator transaction binding the contract method 0x96462b9c.
+//
+// Solidity: function createVote(uint256 _voteId, uint256 _ballotId, address _voter, uint256 _decision, uint256 _power) returns()
+func (_BallotStorage *BallotStorageTransactorSession) CreateVote(_voteId *big.Int, _ballotId *big.Int, _voter common.Address, _decision *big.Int, _power *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.CreateVote(&_BallotStorage.TransactOpts, _voteId, _ballotId, _voter, _decision, _power)
+}
+
+// FinalizeBallot is a paid mutator transaction binding the contract method 0xa91e59ba.
+//
+// Solidity: function finalizeBallot(uint256 _ballotId, uint256 _ballotState) returns()
+func (_BallotStorage *BallotStorageTransactor) FinalizeBallot(opts *bind.TransactOpts, _ballotId *big.Int, _ballotState *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "finalizeBallot", _ballotId, _ballotState)
+}
+
+// FinalizeBallot is a paid mutator transaction binding the contract method 0xa91e59ba.
+//
+// Solidity: function finalizeBallot(uint256 _ballotId, uint256 _ballotState) returns()
+func (_BallotStorage *BallotStorageSession) FinalizeBallot(_ballotId *big.Int, _ballotState *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.FinalizeBallot(&_BallotStorage.TransactOpts, _ballotId, _ballotState)
+}
+
+// FinalizeBallot is a paid mutator transaction binding the contract method 0xa91e59ba.
+//
+// Solidity: function finalizeBallot(uint256 _ballotId, uint256 _ballotState) returns()
+func (_BallotStorage *BallotStorageTransactorSession) FinalizeBallot(_ballotId *big.Int, _ballotState *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.FinalizeBallot(&_BallotStorage.TransactOpts, _ballotId, _ballotState)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BallotStorage *BallotStorageTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BallotStorage *BallotStorageSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BallotStorage.Contract.RenounceOwnership(&_BallotStorage.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BallotStorage *BallotStorageTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BallotStorage.Contract.RenounceOwnership(&_BallotStorage.TransactOpts)
+}
+
+// SetPreviousBallotStorage is a paid mutator transaction binding the contract method 0x2a74f38c.
+//
+// Solidity: function setPreviousBallotStorage(address _address) returns()
+func (_BallotStorage *BallotStorageTransactor) SetPreviousBallotStorage(opts *bind.TransactOpts, _address common.Address) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "setPreviousBallotStorage", _address)
+}
+
+// SetPreviousBallotStorage is a paid mutator transaction binding the contract method 0x2a74f38c.
+//
+// Solidity: function setPreviousBallotStorage(address _address) returns()
+func (_BallotStorage *BallotStorageSession) SetPreviousBallotStorage(_address common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.SetPreviousBallotStorage(&_BallotStorage.TransactOpts, _address)
+}
+
+// SetPreviousBallotStorage is a paid mutator transaction binding the contract method 0x2a74f38c.
+//
+// Solidity: function setPreviousBallotStorage(address _address) returns()
+func (_BallotStorage *BallotStorageTransactorSession) SetPreviousBallotStorage(_address common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.SetPreviousBallotStorage(&_BallotStorage.TransactOpts, _address)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_BallotStorage *BallotStorageTransactor) SetRegistry(opts *bind.TransactOpts, _addr common.Address) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "setRegistry", _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_BallotStorage *BallotStorageSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.SetRegistry(&_BallotStorage.TransactOpts, _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_BallotStorage *BallotStorageTransactorSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.SetRegistry(&_BallotStorage.TransactOpts, _addr)
+}
+
+// StartBallot is a paid mutator transaction binding the contract method 0xc0b6f186.
+//
+// Solidity: function startBallot(uint256 _ballotId, uint256 _startTime, uint256 _endTime) returns()
+func (_BallotStorage *BallotStorageTransactor) StartBallot(opts *bind.TransactOpts, _ballotId *big.Int, _startTime *big.Int, _endTime *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "startBallot", _ballotId, _startTime, _endTime)
+}
+
+// StartBallot is a paid mutator transaction binding the contract method 0xc0b6f186.
+//
+// Solidity: function startBallot(uint256 _ballotId, uint256 _startTime, uint256 _endTime) returns()
+func (_BallotStorage *BallotStorageSession) StartBallot(_ballotId *big.Int, _startTime *big.Int, _endTime *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.StartBallot(&_BallotStorage.TransactOpts, _ballotId, _startTime, _endTime)
+}
+
+// StartBallot is a paid mutator transaction binding the contract method 0xc0b6f186.
+//
+// Solidity: function startBallot(uint256 _ballotId, uint256 _startTime, uint256 _endTime) returns()
+func (_BallotStorage *BallotStorageTransactorSession) StartBallot(_ballotId *big.Int, _startTime *big.Int, _endTime *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.StartBallot(&_BallotStorage.TransactOpts, _ballotId, _startTime, _endTime)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BallotStorage *BallotStorageTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BallotStorage *BallotStorageSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.TransferOwnership(&_BallotStorage.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BallotStorage *BallotStorageTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BallotStorage.Contract.TransferOwnership(&_BallotStorage.TransactOpts, newOwner)
+}
+
+// UpdateBallotDuration is a paid mutator transaction binding the contract method 0x656bc633.
+//
+// Solidity: function updateBallotDuration(uint256 _ballotId, uint256 _duration) returns()
+func (_BallotStorage *BallotStorageTransactor) UpdateBallotDuration(opts *bind.TransactOpts, _ballotId *big.Int, _duration *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "updateBallotDuration", _ballotId, _duration)
+}
+
+// UpdateBallotDuration is a paid mutator transaction binding the contract method 0x656bc633.
+//
+// Solidity: function updateBallotDuration(uint256 _ballotId, uint256 _duration) returns()
+func (_BallotStorage *BallotStorageSession) UpdateBallotDuration(_ballotId *big.Int, _duration *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.UpdateBallotDuration(&_BallotStorage.TransactOpts, _ballotId, _duration)
+}
+
+// UpdateBallotDuration is a paid mutator transaction binding the contract method 0x656bc633.
+//
+// Solidity: function updateBallotDuration(uint256 _ballotId, uint256 _duration) returns()
+func (_BallotStorage *BallotStorageTransactorSession) UpdateBallotDuration(_ballotId *big.Int, _duration *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.UpdateBallotDuration(&_BallotStorage.TransactOpts, _ballotId, _duration)
+}
+
+// UpdateBallotMemberLockAmount is a paid mutator transaction binding the contract method 0x72d0ec92.
+//
+// Solidity: function updateBallotMemberLockAmount(uint256 _ballotId, uint256 _lockAmount) returns()
+func (_BallotStorage *BallotStorageTransactor) UpdateBallotMemberLockAmount(opts *bind.TransactOpts, _ballotId *big.Int, _lockAmount *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "updateBallotMemberLockAmount", _ballotId, _lockAmount)
+}
+
+// UpdateBallotMemberLockAmount is a paid mutator transaction binding the contract method 0x72d0ec92.
+//
+// Solidity: function updateBallotMemberLockAmount(uint256 _ballotId, uint256 _lockAmount) returns()
+func (_BallotStorage *BallotStorageSession) UpdateBallotMemberLockAmount(_ballotId *big.Int, _lockAmount *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.UpdateBallotMemberLockAmount(&_BallotStorage.TransactOpts, _ballotId, _lockAmount)
+}
+
+// UpdateBallotMemberLockAmount is a paid mutator transaction binding the contract method 0x72d0ec92.
+//
+// Solidity: function updateBallotMemberLockAmount(uint256 _ballotId, uint256 _lockAmount) returns()
+func (_BallotStorage *BallotStorageTransactorSession) UpdateBallotMemberLockAmount(_ballotId *big.Int, _lockAmount *big.Int) (*types.Transaction, error) {
+	return _BallotStorage.Contract.UpdateBallotMemberLockAmount(&_BallotStorage.TransactOpts, _ballotId, _lockAmount)
+}
+
+// UpdateBallotMemo is a paid mutator transaction binding the contract method 0xbce0dbc1.
+//
+// Solidity: function updateBallotMemo(uint256 _ballotId, bytes _memo) returns()
+func (_BallotStorage *BallotStorageTransactor) UpdateBallotMemo(opts *bind.TransactOpts, _ballotId *big.Int, _memo []byte) (*types.Transaction, error) {
+	return _BallotStorage.contract.Transact(opts, "updateBallotMemo", _ballotId, _memo)
+}
+
+// UpdateBallotMemo is a paid mutator transaction binding the contract method 0xbce0dbc1.
+//
+// Solidity: function updateBallotMemo(uint256 _ballotId, bytes _memo) returns()
+func (_BallotStorage *BallotStorageSession) UpdateBallotMemo(_ballotId *big.Int, _memo []byte) (*types.Transaction, error) {
+	return _BallotStorage.Contract.UpdateBallotMemo(&_BallotStorage.TransactOpts, _ballotId, _memo)
+}
+
+// UpdateBallotMemo is a paid mutator transaction binding the contract method 0xbce0dbc1.
+//
+// Solidity: function updateBallotMemo(uint256 _ballotId, bytes _memo) returns()
+func (_BallotStorage *BallotStorageTransactorSession) UpdateBallotMemo(_ballotId *big.Int, _memo []byte) (*types.Transaction, error) {
+	return _BallotStorage.Contract.UpdateBallotMemo(&_BallotStorage.TransactOpts, _ballotId, _memo)
+}
+
+// BallotStorageBallotCanceledIterator is returned from FilterBallotCanceled and is used to iterate over the raw logs and unpacked data for BallotCanceled events raised by the BallotStorage contract.
+type BallotStorageBallotCanceledIterator struct {
+	Event *BallotStorageBallotCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageBallotCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageBallotCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageBallotCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageBallotCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageBallotCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageBallotCanceled represents a BallotCanceled event raised by the BallotStorage contract.
+type BallotStorageBallotCanceled struct {
+	BallotId *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterBallotCanceled is a free log retrieval operation binding the contract event 0xd5e541d004c50564e5e05fcbc6be2916c68d817507693dc3774c69dde4ce13dc.
+//
+// Solidity: event BallotCanceled(uint256 indexed ballotId)
+func (_BallotStorage *BallotStorageFilterer) FilterBallotCanceled(opts *bind.FilterOpts, ballotId []*big.Int) (*BallotStorageBallotCanceledIterator, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "BallotCanceled", ballotIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageBallotCanceledIterator{contract: _BallotStorage.contract, event: "BallotCanceled", logs: logs, sub: sub}, nil
+}
+
+// WatchBallotCanceled is a free log subscription operation binding the contract event 0xd5e541d004c50564e5e05fcbc6be2916c68d817507693dc3774c69dde4ce13dc.
+//
+// Solidity: event BallotCanceled(uint256 indexed ballotId)
+func (_BallotStorage *BallotStorageFilterer) WatchBallotCanceled(opts *bind.WatchOpts, sink chan<- *BallotStorageBallotCanceled, ballotId []*big.Int) (event.Subscription, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "BallotCanceled", ballotIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageBallotCanceled)
+				if err := _BallotStorage.contract.UnpackLog(event, "BallotCanceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBallotCanceled is a log parse operation binding the contract event 0xd5e541d004c50564e5e05fcbc6be2916c68d817507693dc3774c69dde4ce13dc.
+//
+// Solidity: event BallotCanceled(uint256 indexed ballotId)
+func (_BallotStorage *BallotStorageFilterer) ParseBallotCanceled(log types.Log) (*BallotStorageBallotCanceled, error) {
+	event := new(BallotStorageBallotCanceled)
+	if err := _BallotStorage.contract.UnpackLog(event, "BallotCanceled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BallotStorageBallotCreatedIterator is returned from FilterBallotCreated and is used to iterate over the raw logs and unpacked data for BallotCreated events raised by the BallotStorage contract.
+type BallotStorageBallotCreatedIterator struct {
+	Event *BallotStorageBallotCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageBallotCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageBallotCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageBallotCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageBallotCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageBallotCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageBallotCreated represents a BallotCreated event raised by the BallotStorage contract.
+type BallotStorageBallotCreated struct {
+	BallotId   *big.Int
+	BallotType *big.Int
+	Creator    common.Address
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterBallotCreated is a free log retrieval operation binding the contract event 0xd1ba591c76ef71222e2d30b8277758713cc6eef1de29efaf98a716744ac2420b.
+//
+// Solidity: event BallotCreated(uint256 indexed ballotId, uint256 indexed ballotType, address indexed creator)
+func (_BallotStorage *BallotStorageFilterer) FilterBallotCreated(opts *bind.FilterOpts, ballotId []*big.Int, ballotType []*big.Int, creator []common.Address) (*BallotStorageBallotCreatedIterator, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var ballotTypeRule []interface{}
+	for _, ballotTypeItem := range ballotType {
+		ballotTypeRule = append(ballotTypeRule, ballotTypeItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "BallotCreated", ballotIdRule, ballotTypeRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageBallotCreatedIterator{contract: _BallotStorage.contract, event: "BallotCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchBallotCreated is a free log subscription operation binding the contract event 0xd1ba591c76ef71222e2d30b8277758713cc6eef1de29efaf98a716744ac2420b.
+//
+// Solidity: event BallotCreated(uint256 indexed ballotId, uint256 indexed ballotType, address indexed creator)
+func (_BallotStorage *BallotStorageFilterer) WatchBallotCreated(opts *bind.WatchOpts, sink chan<- *BallotStorageBallotCreated, ballotId []*big.Int, ballotType []*big.Int, creator []common.Address) (event.Subscription, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var ballotTypeRule []interface{}
+	for _, ballotTypeItem := range ballotType {
+		ballotTypeRule = append(ballotTypeRule, ballotTypeItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "BallotCreated", ballotIdRule, ballotTypeRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageBallotCreated)
+				if err := _BallotStorage.contract.UnpackLog(event, "BallotCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBallotCreated is a log parse operation binding the contract event 0xd1ba591c76ef71222e2d30b8277758713cc6eef1de29efaf98a716744ac2420b.
+//
+// Solidity: event BallotCreated(uint256 indexed ballotId, uint256 indexed ballotType, address indexed creator)
+func (_BallotStorage *BallotStorageFilterer) ParseBallotCreated(log types.Log) (*BallotStorageBallotCreated, error) {
+	event := new(BallotStorageBallotCreated)
+	if err := _BallotStorage.contract.UnpackLog(event, "BallotCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BallotStorageBallotFinalizedIterator is returned from FilterBallotFinalized and is used to iterate over the raw logs and unpacked data for BallotFinalized events raised by the BallotStorage contract.
+type BallotStorageBallotFinalizedIterator struct {
+	Event *BallotStorageBallotFinalized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageBallotFinalizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageBallotFinalized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageBallotFinalized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageBallotFinalizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageBallotFinalizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageBallotFinalized represents a BallotFinalized event raised by the BallotStorage contract.
+type BallotStorageBallotFinalized struct {
+	BallotId *big.Int
+	State    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterBallotFinalized is a free log retrieval operation binding the contract event 0xdc921f027328d7238b58d77649ebcbd0c8b1c494c66ba53dfe53e0de65f6dd9f.
+//
+// Solidity: event BallotFinalized(uint256 indexed ballotId, uint256 state)
+func (_BallotStorage *BallotStorageFilterer) FilterBallotFinalized(opts *bind.FilterOpts, ballotId []*big.Int) (*BallotStorageBallotFinalizedIterator, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "BallotFinalized", ballotIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageBallotFinalizedIterator{contract: _BallotStorage.contract, event: "BallotFinalized", logs: logs, sub: sub}, nil
+}
+
+// WatchBallotFinalized is a free log subscription operation binding the contract event 0xdc921f027328d7238b58d77649ebcbd0c8b1c494c66ba53dfe53e0de65f6dd9f.
+//
+// Solidity: event BallotFinalized(uint256 indexed ballotId, uint256 state)
+func (_BallotStorage *BallotStorageFilterer) WatchBallotFinalized(opts *bind.WatchOpts, sink chan<- *BallotStorageBallotFinalized, ballotId []*big.Int) (event.Subscription, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "BallotFinalized", ballotIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageBallotFinalized)
+				if err := _BallotStorage.contract.UnpackLog(event, "BallotFinalized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBallotFinalized is a log parse operation binding the contract event 0xdc921f027328d7238b58d77649ebcbd0c8b1c494c66ba53dfe53e0de65f6dd9f.
+//
+// Solidity: event BallotFinalized(uint256 indexed ballotId, uint256 state)
+func (_BallotStorage *BallotStorageFilterer) ParseBallotFinalized(log types.Log) (*BallotStorageBallotFinalized, error) {
+	event := new(BallotStorageBallotFinalized)
+	if err := _BallotStorage.contract.UnpackLog(event, "BallotFinalized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BallotStorageBallotStartedIterator is returned from FilterBallotStarted and is used to iterate over the raw logs and unpacked data for BallotStarted events raised by the BallotStorage contract.
+type BallotStorageBallotStartedIterator struct {
+	Event *BallotStorageBallotStarted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageBallotStartedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageBallotStarted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageBallotStarted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageBallotStartedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageBallotStartedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageBallotStarted represents a BallotStarted event raised by the BallotStorage contract.
+type BallotStorageBallotStarted struct {
+	BallotId  *big.Int
+	StartTime *big.Int
+	EndTime   *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBallotStarted is a free log retrieval operation binding the contract event 0xd9938a514dab5cdce149a77493f694bd70c24a4833a278fd4d86fbdf859099c5.
+//
+// Solidity: event BallotStarted(uint256 indexed ballotId, uint256 indexed startTime, uint256 indexed endTime)
+func (_BallotStorage *BallotStorageFilterer) FilterBallotStarted(opts *bind.FilterOpts, ballotId []*big.Int, startTime []*big.Int, endTime []*big.Int) (*BallotStorageBallotStartedIterator, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var startTimeRule []interface{}
+	for _, startTimeItem := range startTime {
+		startTimeRule = append(startTimeRule, startTimeItem)
+	}
+	var endTimeRule []interface{}
+	for _, endTimeItem := range endTime {
+		endTimeRule = append(endTimeRule, endTimeItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "BallotStarted", ballotIdRule, startTimeRule, endTimeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageBallotStartedIterator{contract: _BallotStorage.contract, event: "BallotStarted", logs: logs, sub: sub}, nil
+}
+
+// WatchBallotStarted is a free log subscription operation binding the contract event 0xd9938a514dab5cdce149a77493f694bd70c24a4833a278fd4d86fbdf859099c5.
+//
+// Solidity: event BallotStarted(uint256 indexed ballotId, uint256 indexed startTime, uint256 indexed endTime)
+func (_BallotStorage *BallotStorageFilterer) WatchBallotStarted(opts *bind.WatchOpts, sink chan<- *BallotStorageBallotStarted, ballotId []*big.Int, startTime []*big.Int, endTime []*big.Int) (event.Subscription, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var startTimeRule []interface{}
+	for _, startTimeItem := range startTime {
+		startTimeRule = append(startTimeRule, startTimeItem)
+	}
+	var endTimeRule []interface{}
+	for _, endTimeItem := range endTime {
+		endTimeRule = append(endTimeRule, endTimeItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "BallotStarted", ballotIdRule, startTimeRule, endTimeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageBallotStarted)
+				if err := _BallotStorage.contract.UnpackLog(event, "BallotStarted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBallotStarted is a log parse operation binding the contract event 0xd9938a514dab5cdce149a77493f694bd70c24a4833a278fd4d86fbdf859099c5.
+//
+// Solidity: event BallotStarted(uint256 indexed ballotId, uint256 indexed startTime, uint256 indexed endTime)
+func (_BallotStorage *BallotStorageFilterer) ParseBallotStarted(log types.Log) (*BallotStorageBallotStarted, error) {
+	event := new(BallotStorageBallotStarted)
+	if err := _BallotStorage.contract.UnpackLog(event, "BallotStarted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BallotStorageBallotUpdatedIterator is returned from FilterBallotUpdated and is used to iterate over the raw logs and unpacked data for BallotUpdated events raised by the BallotStorage contract.
+type BallotStorageBallotUpdatedIterator struct {
+	Event *BallotStorageBallotUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageBallotUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageBallotUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageBallotUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageBallotUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageBallotUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageBallotUpdated represents a BallotUpdated event raised by the BallotStorage contract.
+type BallotStorageBallotUpdated struct {
+	BallotId  *big.Int
+	UpdatedBy common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBallotUpdated is a free log retrieval operation binding the contract event 0xf0855c0e0ad9b8a162b87f2e4e07d4b2a3f0a45126b15ff4a78b217ad19a901a.
+//
+// Solidity: event BallotUpdated(uint256 indexed ballotId, address indexed updatedBy)
+func (_BallotStorage *BallotStorageFilterer) FilterBallotUpdated(opts *bind.FilterOpts, ballotId []*big.Int, updatedBy []common.Address) (*BallotStorageBallotUpdatedIterator, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var updatedByRule []interface{}
+	for _, updatedByItem := range updatedBy {
+		updatedByRule = append(updatedByRule, updatedByItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "BallotUpdated", ballotIdRule, updatedByRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageBallotUpdatedIterator{contract: _BallotStorage.contract, event: "BallotUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchBallotUpdated is a free log subscription operation binding the contract event 0xf0855c0e0ad9b8a162b87f2e4e07d4b2a3f0a45126b15ff4a78b217ad19a901a.
+//
+// Solidity: event BallotUpdated(uint256 indexed ballotId, address indexed updatedBy)
+func (_BallotStorage *BallotStorageFilterer) WatchBallotUpdated(opts *bind.WatchOpts, sink chan<- *BallotStorageBallotUpdated, ballotId []*big.Int, updatedBy []common.Address) (event.Subscription, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var updatedByRule []interface{}
+	for _, updatedByItem := range updatedBy {
+		updatedByRule = append(updatedByRule, updatedByItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "BallotUpdated", ballotIdRule, updatedByRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageBallotUpdated)
+				if err := _BallotStorage.contract.UnpackLog(event, "BallotUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBallotUpdated is a log parse operation binding the contract event 0xf0855c0e0ad9b8a162b87f2e4e07d4b2a3f0a45126b15ff4a78b217ad19a901a.
+//
+// Solidity: event BallotUpdated(uint256 indexed ballotId, address indexed updatedBy)
+func (_BallotStorage *BallotStorageFilterer) ParseBallotUpdated(log types.Log) (*BallotStorageBallotUpdated, error) {
+	event := new(BallotStorageBallotUpdated)
+	if err := _BallotStorage.contract.UnpackLog(event, "BallotUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BallotStorageInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the BallotStorage contract.
+type BallotStorageInitializedIterator struct {
+	Event *BallotStorageInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageInitialized represents a Initialized event raised by the BallotStorage contract.
+type BallotStorageInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_BallotStorage *BallotStorageFilterer) FilterInitialized(opts *bind.FilterOpts) (*BallotStorageInitializedIterator, error) {
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageInitializedIterator{contract: _BallotStorage.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_BallotStorage *BallotStorageFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *BallotStorageInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageInitialized)
+				if err := _BallotStorage.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_BallotStorage *BallotStorageFilterer) ParseInitialized(log types.Log) (*BallotStorageInitialized, error) {
+	event := new(BallotStorageInitialized)
+	if err := _BallotStorage.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BallotStorageOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the BallotStorage contract.
+type BallotStorageOwnershipTransferredIterator struct {
+	Event *BallotStorageOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageOwnershipTransferred represents a OwnershipTransferred event raised by the BallotStorage contract.
+type BallotStorageOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BallotStorage *BallotStorageFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*BallotStorageOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageOwnershipTransferredIterator{contract: _BallotStorage.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BallotStorage *BallotStorageFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *BallotStorageOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageOwnershipTransferred)
+				if err := _BallotStorage.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BallotStorage *BallotStorageFilterer) ParseOwnershipTransferred(log types.Log) (*BallotStorageOwnershipTransferred, error) {
+	event := new(BallotStorageOwnershipTransferred)
+	if err := _BallotStorage.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BallotStorageVotedIterator is returned from FilterVoted and is used to iterate over the raw logs and unpacked data for Voted events raised by the BallotStorage contract.
+type BallotStorageVotedIterator struct {
+	Event *BallotStorageVoted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BallotStorageVotedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BallotStorageVoted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BallotStorageVoted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BallotStorageVotedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BallotStorageVotedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BallotStorageVoted represents a Voted event raised by the BallotStorage contract.
+type BallotStorageVoted struct {
+	Voteid   *big.Int
+	BallotId *big.Int
+	Voter    common.Address
+	Decision *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterVoted is a free log retrieval operation binding the contract event 0x41df84b3b467b06744e40c92613c666324e7c640ce0a41ec06efdf602d367606.
+//
+// Solidity: event Voted(uint256 indexed voteid, uint256 indexed ballotId, address indexed voter, uint256 decision)
+func (_BallotStorage *BallotStorageFilterer) FilterVoted(opts *bind.FilterOpts, voteid []*big.Int, ballotId []*big.Int, voter []common.Address) (*BallotStorageVotedIterator, error) {
+
+	var voteidRule []interface{}
+	for _, voteidItem := range voteid {
+		voteidRule = append(voteidRule, voteidItem)
+	}
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.FilterLogs(opts, "Voted", voteidRule, ballotIdRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotStorageVotedIterator{contract: _BallotStorage.contract, event: "Voted", logs: logs, sub: sub}, nil
+}
+
+// WatchVoted is a free log subscription operation binding the contract event 0x41df84b3b467b06744e40c92613c666324e7c640ce0a41ec06efdf602d367606.
+//
+// Solidity: event Voted(uint256 indexed voteid, uint256 indexed ballotId, address indexed voter, uint256 decision)
+func (_BallotStorage *BallotStorageFilterer) WatchVoted(opts *bind.WatchOpts, sink chan<- *BallotStorageVoted, voteid []*big.Int, ballotId []*big.Int, voter []common.Address) (event.Subscription, error) {
+
+	var voteidRule []interface{}
+	for _, voteidItem := range voteid {
+		voteidRule = append(voteidRule, voteidItem)
+	}
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _BallotStorage.contract.WatchLogs(opts, "Voted", voteidRule, ballotIdRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BallotStorageVoted)
+				if err := _BallotStorage.contract.UnpackLog(event, "Voted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVoted is a log parse operation binding the contract event 0x41df84b3b467b06744e40c92613c666324e7c640ce0a41ec06efdf602d367606.
+//
+// Solidity: event Voted(uint256 indexed voteid, uint256 indexed ballotId, address indexed voter, uint256 decision)
+func (_BallotStorage *BallotStorageFilterer) ParseVoted(log types.Log) (*BallotStorageVoted, error) {
+	event := new(BallotStorageVoted)
+	if err := _BallotStorage.contract.UnpackLog(event, "Voted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageMetaData contains all meta data concerning the EnvStorage contract.
+var EnvStorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_implementation\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"implementation\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x608060405234801561001057600080fd5b506040516106e83803806106e883398101604081905261002f916102f7565b80604051806020016040528060008152506100528282600061005a60201b60201c565b50505061039b565b61006383610090565b6000825111806100705750805b1561008b5761008983836100d060201b61008b1760201c565b505b505050565b610099816100fc565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b60606100f583836040518060600160405280602781526020016106c1602791396101ce565b9392505050565b61010f816102ac60201b6100b71760201c565b6101765760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b60648201526084015b60405180910390fd5b806101ad7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc60001b6102bb60201b6100c61760201c565b80546001600160a01b0319166001600160a01b039290921691909117905550565b60606001600160a01b0384163b6102365760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b606482015260840161016d565b600080856001600160a01b0316856040516102519190610320565b600060405180830381855af49150503d806000811461028c576040519150601f19603f3d011682016040523d82523d6000602084013e610291565b606091505b5090925090506102a28282866102be565b9695505050505050565b6001600160a01b03163b151590565b90565b606083156102cd5750816100f5565b8251156102dd5782518084602001fd5b8160405162461bcd60e51b815260040161016d919061033c565b60006020828403121561030957600080fd5b81516001600160a01b03811681146100f557600080fd5b6000825161033281846020870161036f565b9190910192915050565b602081526000825180602084015261035b81604085016020870161036f565b601f01601f19169190910160400192915050565b60005b8381101561038a578181015183820152602001610372565b838111156100895750506000910152565b610317806103aa6000396000f3fe6080604052600436106100225760003560e01c80635c60da1b1461003957610031565b366100315761002f61006a565b005b61002f61006a565b34801561004557600080fd5b5061004e61007c565b6040516001600160a01b03909116815260200160405180910390f35b61007a6100756100c9565b6100fc565b565b60006100866100c9565b905090565b60606100b083836040518060600160405280602781526020016102bb60279139610120565b9392505050565b6001600160a01b03163b151590565b90565b60006100867f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc546001600160a01b031690565b3660008037600080366000845af43d6000803e80801561011b573d6000f35b3d6000fd5b60606001600160a01b0384163b61018d5760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b60648201526084015b60405180910390fd5b600080856001600160a01b0316856040516101a8919061023b565b600060405180830381855af49150503d80600081146101e3576040519150601f19603f3d011682016040523d82523d6000602084013e6101e8565b606091505b50915091506101f8828286610202565b9695505050505050565b606083156102115750816100b0565b8251156102215782518084602001fd5b8160405162461bcd60e51b81526004016101849190610257565b6000825161024d81846020870161028a565b9190910192915050565b602081526000825180602084015261027681604085016020870161028a565b601f01601f19169190910160400192915050565b60005b838110156102a557818101518382015260200161028d565b838111156102b4576000848401525b5050505056fe416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a2646970667358221220a9b419bbd362ef5d93e3dddbe794f787a7ac009e9066467fb7fd76c4ab5f8cea64736f6c63430008060033416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564",
+}
+
+// EnvStorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use EnvStorageMetaData.ABI instead.
+var EnvStorageABI = EnvStorageMetaData.ABI
+
+// EnvStorageBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use EnvStorageMetaData.Bin instead.
+var EnvStorageBin = EnvStorageMetaData.Bin
+
+// DeployEnvStorage deploys a new Ethereum contract, binding an instance of EnvStorage to it.
+func DeployEnvStorage(auth *bind.TransactOpts, backend bind.ContractBackend, _implementation common.Address) (common.Address, *types.Transaction, *EnvStorage, error) {
+	parsed, err := EnvStorageMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(EnvStorageBin), backend, _implementation)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &EnvStorage{EnvStorageCaller: EnvStorageCaller{contract: contract}, EnvStorageTransactor: EnvStorageTransactor{contract: contract}, EnvStorageFilterer: EnvStorageFilterer{contract: contract}}, nil
+}
+
+// EnvStorage is an auto generated Go binding around an Ethereum contract.
+type EnvStorage struct {
+	EnvStorageCaller     // Read-only binding to the contract
+	EnvStorageTransactor // Write-only binding to the contract
+	EnvStorageFilterer   // Log filterer for contract events
+}
+
+// EnvStorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EnvStorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EnvStorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EnvStorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EnvStorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EnvStorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EnvStorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EnvStorageSession struct {
+	Contract     *EnvStorage       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EnvStorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EnvStorageCallerSession struct {
+	Contract *EnvStorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// EnvStorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EnvStorageTransactorSession struct {
+	Contract     *EnvStorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// EnvStorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EnvStorageRaw struct {
+	Contract *EnvStorage // Generic contract binding to access the raw methods on
+}
+
+// EnvStorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EnvStorageCallerRaw struct {
+	Contract *EnvStorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EnvStorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EnvStorageTransactorRaw struct {
+	Contract *EnvStorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEnvStorage creates a new instance of EnvStorage, bound to a specific deployed contract.
+func NewEnvStorage(address common.Address, backend bind.ContractBackend) (*EnvStorage, error) {
+	contract, err := bindEnvStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorage{EnvStorageCaller: EnvStorageCaller{contract: contract}, EnvStorageTransactor: EnvStorageTransactor{contract: contract}, EnvStorageFilterer: EnvStorageFilterer{contract: contract}}, nil
+}
+
+// NewEnvStorageCaller creates a new read-only instance of EnvStorage, bound to a specific deployed contract.
+func NewEnvStorageCaller(address common.Address, caller bind.ContractCaller) (*EnvStorageCaller, error) {
+	contract, err := bindEnvStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageCaller{contract: contract}, nil
+}
+
+// NewEnvStorageTransactor creates a new write-only instance of EnvStorage, bound to a specific deployed contract.
+func NewEnvStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*EnvStorageTransactor, error) {
+	contract, err := bindEnvStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageTransactor{contract: contract}, nil
+}
+
+// NewEnvStorageFilterer creates a new log filterer instance of EnvStorage, bound to a specific deployed contract.
+func NewEnvStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*EnvStorageFilterer, error) {
+	contract, err := bindEnvStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageFilterer{contract: contract}, nil
+}
+
+// bindEnvStorage binds a generic wrapper to an already deployed contract.
+func bindEnvStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(EnvStorageABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EnvStorage *EnvStorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EnvStorage.Contract.EnvStorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EnvStorage *EnvStorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EnvStorage.Contract.EnvStorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EnvStorage *EnvStorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EnvStorage.Contract.EnvStorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EnvStorage *EnvStorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EnvStorage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EnvStorage *EnvStorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EnvStorage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EnvStorage *EnvStorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EnvStorage.Contract.contract.Transact(opts, method, params...)
+}
+
+// Implementation is a free data retrieval call binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() view returns(address)
+func (_EnvStorage *EnvStorageCaller) Implementation(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EnvStorage.contract.Call(opts, &out, "implementation")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Implementation is a free data retrieval call binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() view returns(address)
+func (_EnvStorage *EnvStorageSession) Implementation() (common.Address, error) {
+	return _EnvStorage.Contract.Implementation(&_EnvStorage.CallOpts)
+}
+
+// Implementation is a free data retrieval call binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() view returns(address)
+func (_EnvStorage *EnvStorageCallerSession) Implementation() (common.Address, error) {
+	return _EnvStorage.Contract.Implementation(&_EnvStorage.CallOpts)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_EnvStorage *EnvStorageTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _EnvStorage.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_EnvStorage *EnvStorageSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _EnvStorage.Contract.Fallback(&_EnvStorage.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_EnvStorage *EnvStorageTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _EnvStorage.Contract.Fallback(&_EnvStorage.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_EnvStorage *EnvStorageTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EnvStorage.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_EnvStorage *EnvStorageSession) Receive() (*types.Transaction, error) {
+	return _EnvStorage.Contract.Receive(&_EnvStorage.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_EnvStorage *EnvStorageTransactorSession) Receive() (*types.Transaction, error) {
+	return _EnvStorage.Contract.Receive(&_EnvStorage.TransactOpts)
+}
+
+// EnvStorageAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the EnvStorage contract.
+type EnvStorageAdminChangedIterator struct {
+	Event *EnvStorageAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageAdminChanged represents a AdminChanged event raised by the EnvStorage contract.
+type EnvStorageAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_EnvStorage *EnvStorageFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*EnvStorageAdminChangedIterator, error) {
+
+	logs, sub, err := _EnvStorage.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageAdminChangedIterator{contract: _EnvStorage.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_EnvStorage *EnvStorageFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _EnvStorage.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageAdminChanged)
+				if err := _EnvStorage.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_EnvStorage *EnvStorageFilterer) ParseAdminChanged(log types.Log) (*EnvStorageAdminChanged, error) {
+	event := new(EnvStorageAdminChanged)
+	if err := _EnvStorage.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the EnvStorage contract.
+type EnvStorageBeaconUpgradedIterator struct {
+	Event *EnvStorageBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageBeaconUpgraded represents a BeaconUpgraded event raised by the EnvStorage contract.
+type EnvStorageBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_EnvStorage *EnvStorageFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*EnvStorageBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _EnvStorage.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageBeaconUpgradedIterator{contract: _EnvStorage.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_EnvStorage *EnvStorageFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *EnvStorageBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _EnvStorage.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageBeaconUpgraded)
+				if err := _EnvStorage.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_EnvStorage *EnvStorageFilterer) ParseBeaconUpgraded(log types.Log) (*EnvStorageBeaconUpgraded, error) {
+	event := new(EnvStorageBeaconUpgraded)
+	if err := _EnvStorage.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the EnvStorage contract.
+type EnvStorageUpgradedIterator struct {
+	Event *EnvStorageUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageUpgraded represents a Upgraded event raised by the EnvStorage contract.
+type EnvStorageUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_EnvStorage *EnvStorageFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*EnvStorageUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _EnvStorage.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageUpgradedIterator{contract: _EnvStorage.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_EnvStorage *EnvStorageFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *EnvStorageUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _EnvStorage.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageUpgraded)
+				if err := _EnvStorage.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_EnvStorage *EnvStorageFilterer) ParseUpgraded(log types.Log) (*EnvStorageUpgraded, error) {
+	event := new(EnvStorageUpgraded)
+	if err := _EnvStorage.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpMetaData contains all meta data concerning the EnvStorageImp contract.
+var EnvStorageImpMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_value\",\"type\":\"address\"}],\"name\":\"AddressVarableChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"_value\",\"type\":\"bytes32\"}],\"name\":\"Bytes32VarableChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"BytesVarableChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"}],\"name\":\"IntVarableChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_value\",\"type\":\"string\"}],\"name\":\"StringVarableChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"UintVarableChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"_type\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_value\",\"type\":\"string\"}],\"name\":\"VarableChanged\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BALLOT_DURATION_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BALLOT_DURATION_MIN_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BALLOT_DURATION_MIN_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BALLOT_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BASE_FEE_MAX_CHANGE_RATE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCKS_PER_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_CREATION_TIME_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_GASLIMIT_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_AMOUNT_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_METHOD_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DENOMINATOR\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ENV_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GASLIMIT_AND_BASE_FEE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GAS_TARGET_PERCENTAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GOV_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAINTENANCE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_BASE_FEE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_IDLE_BLOCK_INTERVAL_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_PRIORITY_FEE_PER_GAS_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REWARD_POOL_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_MIN_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_MIN_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"envKey\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"envVal\",\"type\":\"bytes\"}],\"name\":\"checkVariableCondition\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"h\",\"type\":\"bytes32\"}],\"name\":\"getAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBallotDurationMax\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBallotDurationMin\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBallotDurationMinMax\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockCreationTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockRewardAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockRewardDistributionMethod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlocksPer\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"h\",\"type\":\"bytes32\"}],\"name\":\"getBoolean\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"h\",\"type\":\"bytes32\"}],\"name\":\"getBytes\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"h\",\"type\":\"bytes32\"}],\"name\":\"getBytes32\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGasLimitAndBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"h\",\"type\":\"bytes32\"}],\"name\":\"getInt\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxIdleBlockInterval\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxPriorityFeePerGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getStakingMax\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getStakingMin\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getStakingMinMax\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"h\",\"type\":\"bytes32\"}],\"name\":\"getString\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"h\",\"type\":\"bytes32\"}],\"name\":\"getUint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_registry\",\"type\":\"address\"},{\"internalType\":\"bytes32[]\",\"name\":\"names\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"infos\",\"type\":\"uint256[]\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"reg\",\"outputs\":[{\"internalType\":\"contractIRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setBallotDurationMax\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setBallotDurationMaxByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setBallotDurationMin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setBallotDurationMinByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_min\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_max\",\"type\":\"uint256\"}],\"name\":\"setBallotDurationMinMax\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setBallotDurationMinMaxByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setBlockCreationTime\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setBlockCreationTimeByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setBlockRewardAmount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setBlockRewardAmountByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block_producer\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_staking_reward\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_ecofund\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_maintanance\",\"type\":\"uint256\"}],\"name\":\"setBlockRewardDistributionMethod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setBlockRewardDistributionMethodByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setBlocksPer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setBlocksPerByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block_GasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_baseFeeMaxChangeRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasTargetPercentage\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_maxBaseFee\",\"type\":\"uint256\"}],\"name\":\"setGasLimitAndBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setGasLimitAndBaseFeeByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setMaxBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setMaxBaseFeeByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setMaxIdleBlockInterval\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setMaxIdleBlockIntervalByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setMaxPriorityFeePerGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setMaxPriorityFeePerGasByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"setRegistry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setStakingMax\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setStakingMaxByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"setStakingMin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setStakingMinByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_min\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_max\",\"type\":\"uint256\"}],\"name\":\"setStakingMinMax\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_value\",\"type\":\"bytes\"}],\"name\":\"setStakingMinMaxByBytes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"envKey\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"envVal\",\"type\":\"bytes\"}],\"name\":\"setVariable\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	Bin: "0x60a06040523060601b60805234801561001757600080fd5b5060805160601c6135e16100446000396000818161198e01528181611a130152611aeb01526135e16000f3fe6080604052600436106104ca5760003560e01c80636fe1317711610276578063a91ee0dc1161014f578063c6713baf116100c1578063e078869f11610085578063e078869f14611376578063ec3df87914611396578063f2fde38b146113b6578063f38ecf47146113d6578063f6fd7129146113f8578063f82145911461141857600080fd5b8063c6713baf14611281578063c7d3da34146112b5578063c95437be146112e9578063cdb9f64314611334578063dc97d9621461134957600080fd5b8063bd02d0f511610113578063bd02d0f51461119c578063be33732a146111c9578063c00ace6c146111e9578063c031a1801461120b578063c0b4fe151461122b578063c42a0abc1461124d57600080fd5b8063a91ee0dc146110ee578063a9b629b21461110e578063aba01fee14611130578063b128f8801461115a578063b4d6bd3b1461117c57600080fd5b80638d5cdd7e116101e8578063986e791a116101ac578063986e791a14610ffc5780639986e4b914611029578063a36f259b1461104d578063a5d6a5811461106d578063a6868b7d1461108d578063a6ed563e146110c157600080fd5b80638d5cdd7e14610eaa5780638da5cb5b14610eca5780638f6fe72514610ee8578063918f867414610fc65780639801bff914610fdc57600080fd5b806379e858591161023a57806379e8585914610dd55780637b2bfb0114610df55780637bf4653014610e29578063852cf38f14610e4a57806388c2801914610e6a5780638c8b887e14610e8a57600080fd5b80636fe1317714610d15578063715018a614610d3557806371c6960d14610d4a578063737c59b814610d6a578063738fdd1a14610db557600080fd5b80633659cfe6116103a85780634d273e281161031a5780636167eb45116102de5780636167eb4514610b89578063656e305214610bbd57806367d1a2e014610bf15780636c78d2cf14610ca85780636d583ca714610cd15780636fde207a14610cf357600080fd5b80634d273e2814610ac25780634f1ef28614610af657806352d1902d14610b09578063539927be14610b1e5780635dba8c4a14610b3e57600080fd5b80633e8daafe1161036c5780633e8daafe146109fe5780633f35c8fe14610a1e578063408d79cf14610a4057806344b8991414610a6057806345b5ec2914610a805780634bd1ed7614610aa057600080fd5b80633659cfe6146109115780633690057a14610931578063382944191461097c5780633848207a1461099e5780633d4c65f3146109de57600080fd5b806321f8a721116104415780632eccd832116104055780632eccd832146107fa5780632ed19cd51461081a5780632f40992e1461083a5780633305508e1461085b57806333be496e1461087b57806333e31184146108c657600080fd5b806321f8a721146106d9578063238737b614610727578063278bb12a1461075b5780632b2eaa921461078f5780632eb57c65146107da57600080fd5b80630fc238bf116104935780630fc238bf146105c55780630fca11d2146105e5578063124cea3714610605578063185582f1146106255780631b27e01b146106705780631e0cba0d146106bb57600080fd5b806215a73b146104cf578063076cd77f146105045780630add66dd1461054f5780630b1d39b8146105715780630b90a39a146105a5575b600080fd5b3480156104db57600080fd5b506104f160008051602061348583398151915281565b6040519081526020015b60405180910390f35b34801561051057600080fd5b506000805160206134e583398151915260005260026020527fd7a437163435b79030132855d9146a10e26570f1767311219983e0c430cfa690546104f1565b34801561055b57600080fd5b5061056f61056a3660046131a9565b61143d565b005b34801561057d57600080fd5b506104f17fdd5a41a7fc01f5c6d30816b17f638d6531625f1e1eaa599673ab2f6079f2dd9d81565b3480156105b157600080fd5b5061056f6105c0366004613126565b611593565b3480156105d157600080fd5b5061056f6105e0366004613126565b6115d3565b3480156105f157600080fd5b5061056f6106003660046131a9565b611610565b34801561061157600080fd5b5061056f610620366004613126565b6116d5565b34801561063157600080fd5b5060008051602061344583398151915260005260026020527fbc779bbce8d4fef90926dc39b56d9135e8e3795964b3adae769767961d4a94d8546104f1565b34801561067c57600080fd5b5060008051602061348583398151915260005260026020527f5a1993193b8890c75d83157b2c2c12b144545357ab60021d7429856434de051b546104f1565b3480156106c757600080fd5b506104f1665374616b696e6760c81b81565b3480156106e557600080fd5b5061070f6106f43660046130c3565b6000908152600460205260409020546001600160a01b031690565b6040516001600160a01b0390911681526020016104fb565b34801561073357600080fd5b506104f17f1d36f8ce53f59e624857e1d8dc7932d19981a2ea1b8faa4eb8ff843fc3e5a27881565b34801561076757600080fd5b506104f17f9b2e0c7fdae148f225bae7deb92d7e7bd24bb77edb12956e8fa7204900dd8a2281565b34801561079b57600080fd5b506000805160206134c583398151915260005260026020527f270fb31b28afd06ad35288bc3cb901686a4c5a15239e966fd9b64269abd226c3546104f1565b3480156107e657600080fd5b5061056f6107f53660046130c3565b611712565b34801561080657600080fd5b5061056f610815366004613126565b611759565b34801561082657600080fd5b5061056f6108353660046130c3565b611796565b34801561084657600080fd5b506104f16914995dd85c99141bdbdb60b21b81565b34801561086757600080fd5b5061056f6108763660046130c3565b6117dd565b34801561088757600080fd5b5060008051602061356c83398151915260005260026020527f8e8c0bef3b4557d74ba01e0433728c574990153e35cacae69cb26f23a8a40175546104f1565b3480156108d257600080fd5b5060008051602061346583398151915260005260026020527ff3497fe1496bfc19eaef6d253f75ec2e9cfe1b4f6080c29192641567c6fc5d87546104f1565b34801561091d57600080fd5b5061056f61092c366004612f65565b611824565b34801561093d57600080fd5b5060008051602061354c83398151915260005260026020527f14e650c949c7cc3fca2efe79b3711fc734b4df488cc27c4137f35bd0adaae7fa546104f1565b34801561098857600080fd5b506104f16000805160206134c583398151915281565b3480156109aa57600080fd5b506109ce6109b93660046130c3565b60009081526020819052604090205460ff1690565b60405190151581526020016104fb565b3480156109ea57600080fd5b5061056f6109f9366004613126565b611847565b348015610a0a57600080fd5b5061056f610a19366004613126565b611884565b348015610a2a57600080fd5b506104f160008051602061354c83398151915281565b348015610a4c57600080fd5b5061056f610a5b366004613126565b6118c1565b348015610a6c57600080fd5b5061056f610a7b366004613126565b6118fe565b348015610a8c57600080fd5b5061056f610a9b366004613126565b61193b565b348015610aac57600080fd5b506104f16a4d61696e74656e616e636560a81b81565b348015610ace57600080fd5b506104f17f77884798208df1e64f70968be41ef2d3211ec53613397ca59313416813df088881565b61056f610b04366004613073565b611983565b348015610b1557600080fd5b506104f1611ade565b348015610b2a57600080fd5b5061056f610b39366004612f9f565b611b91565b348015610b4a57600080fd5b506000805160206134a583398151915260005260026020527f38eb620cc1355554391bc9d0c224a726d66edf7c3eb2d67af166d6e81fe44562546104f1565b348015610b9557600080fd5b506104f17f9f1de481f899d76889aa8a2b44cc7b604d42691aa93d4ba618a1a1fd439f505081565b348015610bc957600080fd5b506104f17fe10074dceffb75f13bf0ce50145afd35182d63796823f1280ce40e01c19109e781565b348015610bfd57600080fd5b5060026020527fc77500645c8acbf6137a0e04460f85359483e3b08db30285cca2957db0c90904547fca55256fa8d248a334a7cb4ca72d9aec97700e81b3784b43c388b9a8d0e2b8e3547f77884798208df1e64f70968be41ef2d3211ec53613397ca59313416813df08886000527fba5524e01c8da4a46e6f70a8f29f4dc63d299ad01d2f01ccae9666cb85f6f7c054604080519384526020840192909252908201526060016104fb565b348015610cb457600080fd5b506104f17111dbdd995c9b985b98d950dbdb9d1c9858dd60721b81565b348015610cdd57600080fd5b506104f160008051602061356c83398151915281565b348015610cff57600080fd5b506104f16000805160206134e583398151915281565b348015610d2157600080fd5b5061056f610d303660046130c3565b611e29565b348015610d4157600080fd5b5061056f611e70565b348015610d5657600080fd5b5061056f610d65366004613126565b611e84565b348015610d7657600080fd5b5060008051602061342583398151915260005260026020527f673c817b54d53459fa437d6b11ba6445e517d8dc789e6b815675ff10e6aeeb5d546104f1565b348015610dc157600080fd5b50609d5461070f906001600160a01b031681565b348015610de157600080fd5b5061056f610df03660046130c3565b611ec1565b348015610e0157600080fd5b506104f17f9346226931826838eedd13d9677fa33551e7c81f604b171ef3fac355458da9aa81565b348015610e3557600080fd5b506104f169456e7653746f7261676560b01b81565b348015610e5657600080fd5b5061056f610e653660046130c3565b611f08565b348015610e7657600080fd5b5061056f610e853660046130f5565b611f4f565b348015610e9657600080fd5b5061056f610ea5366004613126565b6120cd565b348015610eb657600080fd5b5061056f610ec5366004613163565b612123565b348015610ed657600080fd5b50606b546001600160a01b031661070f565b348015610ef457600080fd5b5060026020527fe6679752544e0074a1c9bec26775117fd40d133ce43cc443fb5f2512ebd7c2af547f89fc1b873e9ef0767cf41719c69200c3f894c43d73c49eb537ca60ec0a2d14bc547fa04c555f883d07b5c5fe85061c632b6bfec696b67afa8ad5e9773b89249984b2547fdd5a41a7fc01f5c6d30816b17f638d6531625f1e1eaa599673ab2f6079f2dd9d6000527f286a480438fb7eb9f5465ec436e6de865b71284ceb2be7ff1cea98f38c263082546040805194855260208501939093529183015260608201526080016104fb565b348015610fd257600080fd5b506104f161271081565b348015610fe857600080fd5b506109ce610ff73660046130f5565b6121f8565b34801561100857600080fd5b5061101c6110173660046130c3565b612359565b6040516104fb9190613259565b34801561103557600080fd5b506104f16c42616c6c6f7453746f7261676560981b81565b34801561105957600080fd5b5061056f6110683660046130c3565b6123fb565b34801561107957600080fd5b5061056f611088366004613126565b612442565b34801561109957600080fd5b506104f17f6c6f69f426081752a5d3e73746599acd2a4cb145d5de4203ca1e3473b281680b81565b3480156110cd57600080fd5b506104f16110dc3660046130c3565b60009081526006602052604090205490565b3480156110fa57600080fd5b5061056f611109366004612f65565b61247f565b34801561111a57600080fd5b506104f16000805160206134a583398151915281565b34801561113c57600080fd5b506111456124ff565b604080519283526020830191909152016104fb565b34801561116657600080fd5b506104f160008051602061344583398151915281565b34801561118857600080fd5b5061056f611197366004613163565b612569565b3480156111a857600080fd5b506104f16111b73660046130c3565b60009081526002602052604090205490565b3480156111d557600080fd5b5061056f6111e4366004613126565b612640565b3480156111f557600080fd5b506104f160008051602061342583398151915281565b34801561121757600080fd5b5061101c6112263660046130c3565b61268f565b34801561123757600080fd5b506104f160008051602061346583398151915281565b34801561125957600080fd5b506104f17fb38b2c133e931937bd95337c65c8aefa7040ed64bbed732e3e29a4944c65747381565b34801561128d57600080fd5b506104f17fc9e15e34073efbcd0328740feaf503caac9124b55b38c73d1a97b53da80a2f6081565b3480156112c157600080fd5b506104f17f04f7b94450bbcad85f37ea47cd1062728f884bb2040e357738f8fd53056134bc81565b3480156112f557600080fd5b5060008051602061358c83398151915260005260026020527f38ddebd16170f2328156b72e009b30efe6e74a1b9fc4389d05f50efa2a42c6f8546104f1565b34801561134057600080fd5b506111456126ac565b34801561135557600080fd5b506104f16113643660046130c3565b60009081526001602052604090205490565b34801561138257600080fd5b5061056f611391366004613126565b612712565b3480156113a257600080fd5b5061056f6113b13660046130c3565b612755565b3480156113c257600080fd5b5061056f6113d1366004612f65565b61279c565b3480156113e257600080fd5b506104f160008051602061358c83398151915281565b34801561140457600080fd5b5061056f6114133660046130c3565b612812565b34801561142457600080fd5b5061056f6114333660046130c3565b612859565b905090565b336114466128a0565b6001600160a01b0316146114755760405162461bcd60e51b815260040161146c9061326c565b60405180910390fd5b61271081836114848688613333565b61148e9190613333565b6114989190613333565b146114e55760405162461bcd60e51b815260206004820152601e60248201527f57726f6e6720726577617264206469737472756274696f6e20726174696f0000604482015260640161146c565b61150f7fc9e15e34073efbcd0328740feaf503caac9124b55b38c73d1a97b53da80a2f60856128c0565b6115397f9f1de481f899d76889aa8a2b44cc7b604d42691aa93d4ba618a1a1fd439f5050846128c0565b6115637f9346226931826838eedd13d9677fa33551e7c81f604b171ef3fac355458da9aa836128c0565b61158d7fdd5a41a7fc01f5c6d30816b17f638d6531625f1e1eaa599673ab2f6079f2dd9d826128c0565b50505050565b3361159c6128a0565b6001600160a01b0316146115c25760405162461bcd60e51b815260040161146c9061326c565b6115d0611413826020015190565b50565b336115dc6128a0565b6001600160a01b0316146116025760405162461bcd60e51b815260040161146c9061326c565b6115d06113b1826020015190565b336116196128a0565b6001600160a01b03161461163f5760405162461bcd60e51b815260040161146c9061326c565b6116697f1d36f8ce53f59e624857e1d8dc7932d19981a2ea1b8faa4eb8ff843fc3e5a278856128c0565b6116937fb38b2c133e931937bd95337c65c8aefa7040ed64bbed732e3e29a4944c657473846128c0565b6116bd7f77884798208df1e64f70968be41ef2d3211ec53613397ca59313416813df0888836128c0565b61158d60008051602061358c833981519152826128c0565b336116de6128a0565b6001600160a01b0316146117045760405162461bcd60e51b815260040161146c9061326c565b6115d0611068826020015190565b3361171b6128a0565b6001600160a01b0316146117415760405162461bcd60e51b815260040161146c9061326c565b6115d06000805160206134e5833981519152826128c0565b336117626128a0565b6001600160a01b0316146117885760405162461bcd60e51b815260040161146c9061326c565b6115d0611433826020015190565b3361179f6128a0565b6001600160a01b0316146117c55760405162461bcd60e51b815260040161146c9061326c565b6115d06000805160206134a5833981519152826128c0565b336117e66128a0565b6001600160a01b03161461180c5760405162461bcd60e51b815260040161146c9061326c565b6115d0600080516020613465833981519152826128c0565b61182c61290f565b6115d081604051806020016040528060008152506000612969565b336118506128a0565b6001600160a01b0316146118765760405162461bcd60e51b815260040161146c9061326c565b6115d0610835826020015190565b3361188d6128a0565b6001600160a01b0316146118b35760405162461bcd60e51b815260040161146c9061326c565b6115d0610df0826020015190565b336118ca6128a0565b6001600160a01b0316146118f05760405162461bcd60e51b815260040161146c9061326c565b6115d0610d30826020015190565b336119076128a0565b6001600160a01b03161461192d5760405162461bcd60e51b815260040161146c9061326c565b6115d0610876826020015190565b336119446128a0565b6001600160a01b03161461196a5760405162461bcd60e51b815260040161146c9061326c565b6020810151604082015161197e8282612569565b505050565b306001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161415611a115760405162461bcd60e51b815260206004820152602c60248201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060448201526b19195b1959d85d1958d85b1b60a21b606482015260840161146c565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316611a5a600080516020613505833981519152546001600160a01b031690565b6001600160a01b031614611ac55760405162461bcd60e51b815260206004820152602c60248201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060448201526b6163746976652070726f787960a01b606482015260840161146c565b611ace82612ae3565b611ada82826001612969565b5050565b6000306001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614611b7e5760405162461bcd60e51b815260206004820152603860248201527f555550535570677261646561626c653a206d757374206e6f742062652063616c60448201527f6c6564207468726f7567682064656c656761746563616c6c0000000000000000606482015260840161146c565b5060008051602061350583398151915290565b603854610100900460ff1615808015611bb15750603854600160ff909116105b80611bcb5750303b158015611bcb575060385460ff166001145b611c2e5760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b606482015260840161146c565b6038805460ff191660011790558015611c51576038805461ff0019166101001790555b600080516020613505833981519152546001600160a01b0385811691161415611cd25760405162461bcd60e51b815260206004820152602d60248201527f72656769737472792073686f756c64206e6f742062652073616d65206173206960448201526c36b83632b6b2b73a30ba34b7b760991b606482015260840161146c565b611cda612b12565b611ce38461247f565b60005b8251811015611ddd576000611d20858381518110611d0657611d066133e3565b602002602001015160009081526002602052604090205490565b9050838281518110611d3457611d346133e3565b60200260200101516000141580611d4a57508015155b611d895760405162461bcd60e51b815260206004820152601060248201526f696e76616c6964207661726961626c6560801b604482015260640161146c565b80611dca57611dca858381518110611da357611da36133e3565b6020026020010151858481518110611dbd57611dbd6133e3565b60200260200101516128c0565b5080611dd5816133b2565b915050611ce6565b50801561158d576038805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a150505050565b33611e326128a0565b6001600160a01b031614611e585760405162461bcd60e51b815260040161146c9061326c565b6115d060008051602061358c833981519152826128c0565b611e7861290f565b611e826000612b41565b565b33611e8d6128a0565b6001600160a01b031614611eb35760405162461bcd60e51b815260040161146c9061326c565b6115d06107f5826020015190565b33611eca6128a0565b6001600160a01b031614611ef05760405162461bcd60e51b815260040161146c9061326c565b6115d060008051602061354c833981519152826128c0565b33611f116128a0565b6001600160a01b031614611f375760405162461bcd60e51b815260040161146c9061326c565b6115d0600080516020613485833981519152826128c0565b60008051602061354c833981519152821415611f6e57611ada81611884565b7fe10074dceffb75f13bf0ce50145afd35182d63796823f1280ce40e01c19109e7821415611f9f57611ada8161193b565b7f6c6f69f426081752a5d3e73746599acd2a4cb145d5de4203ca1e3473b281680b821415611fd057611ada81612712565b600080516020613445833981519152821415611fef57611ada81611593565b60008051602061346583398151915282141561200e57611ada816118fe565b6000805160206134a583398151915282141561202d57611ada81611847565b6000805160206134c583398151915282141561204c57611ada816115d3565b7f9b2e0c7fdae148f225bae7deb92d7e7bd24bb77edb12956e8fa7204900dd8a2282141561207d57611ada816120cd565b7f04f7b94450bbcad85f37ea47cd1062728f884bb2040e357738f8fd53056134bc8214156120ae57611ada81612640565b60008051602061358c833981519152821415611ada57611ada816118c1565b336120d66128a0565b6001600160a01b0316146120fc5760405162461bcd60e51b815260040161146c9061326c565b602081015160408201516060830151608084015161211c8484848461143d565b5050505050565b3361212c6128a0565b6001600160a01b0316146121525760405162461bcd60e51b815260040161146c9061326c565b808211156121c85760405162461bcd60e51b815260206004820152603e60248201527f4d696e696d756d207374616b696e67206d75737420626520736d616c6c65722060448201527f616e6420657175616c207468616e206d6178696d756d207374616b696e670000606482015260840161146c565b6121e06000805160206134e5833981519152836128c0565b611ada600080516020613425833981519152826128c0565b60007f9b2e0c7fdae148f225bae7deb92d7e7bd24bb77edb12956e8fa7204900dd8a22831415612284576000806000808580602001905181019061223c91906131db565b9350935093509350612710818385876122559190613333565b61225f9190613333565b6122699190613333565b1461227b576000945050505050612353565b5050505061234f565b7f6c6f69f426081752a5d3e73746599acd2a4cb145d5de4203ca1e3473b281680b8314806122d157507fe10074dceffb75f13bf0ce50145afd35182d63796823f1280ce40e01c19109e783145b1561230b57600080838060200190518101906122ed9190613185565b915091508082111561230457600092505050612353565b505061234f565b60008051602061346583398151915283141561234f5760008280602001905181019061233791906130dc565b90506103e881101561234d576000915050612353565b505b5060015b92915050565b600081815260036020526040902080546060919061237690613377565b80601f01602080910402602001604051908101604052809291908181526020018280546123a290613377565b80156123ef5780601f106123c4576101008083540402835291602001916123ef565b820191906000526020600020905b8154815290600101906020018083116123d257829003601f168201915b50505050509050919050565b336124046128a0565b6001600160a01b03161461242a5760405162461bcd60e51b815260040161146c9061326c565b6115d060008051602061356c833981519152826128c0565b3361244b6128a0565b6001600160a01b0316146124715760405162461bcd60e51b815260040161146c9061326c565b6115d0610e65826020015190565b61248761290f565b6001600160a01b0381166124dd5760405162461bcd60e51b815260206004820152601a60248201527f416464726573732073686f756c64206265206e6f6e2d7a65726f000000000000604482015260640161146c565b609d80546001600160a01b0319166001600160a01b0392909216919091179055565b60026020527fd7a437163435b79030132855d9146a10e26570f1767311219983e0c430cfa6905460008051602061342583398151915260009081527f673c817b54d53459fa437d6b11ba6445e517d8dc789e6b815675ff10e6aeeb5d54909182915b915091509091565b336125726128a0565b6001600160a01b0316146125985760405162461bcd60e51b815260040161146c9061326c565b80821115612610576040805162461bcd60e51b81526020600482015260248101919091527f4d696e696d756d206475726174696f6e206d75737420626520736d616c6c657260448201527f20616e6420657175616c207468616e206d6178696d756d206475726174696f6e606482015260840161146c565b61262860008051602061356c833981519152836128c0565b611ada600080516020613485833981519152826128c0565b336126496128a0565b6001600160a01b03161461266f5760405162461bcd60e51b815260040161146c9061326c565b602081015160408201516060830151608084015161211c84848484611610565b600081815260056020526040902080546060919061237690613377565b60026020527f8e8c0bef3b4557d74ba01e0433728c574990153e35cacae69cb26f23a8a401755460008051602061348583398151915260009081527f5a1993193b8890c75d83157b2c2c12b144545357ab60021d7429856434de051b5490918291612561565b3361271b6128a0565b6001600160a01b0316146127415760405162461bcd60e51b815260040161146c9061326c565b6020810151604082015161197e8282612123565b3361275e6128a0565b6001600160a01b0316146127845760405162461bcd60e51b815260040161146c9061326c565b6115d06000805160206134c5833981519152826128c0565b6127a461290f565b6001600160a01b0381166128095760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b606482015260840161146c565b6115d081612b41565b3361281b6128a0565b6001600160a01b0316146128415760405162461bcd60e51b815260040161146c9061326c565b6115d0600080516020613445833981519152826128c0565b336128626128a0565b6001600160a01b0316146128885760405162461bcd60e51b815260040161146c9061326c565b6115d0600080516020613425833981519152826128c0565b60006114387111dbdd995c9b985b98d950dbdb9d1c9858dd60721b612b93565b6000828152600260205260409020819055817f58d7c10adfd5778016889c15d422d57f2975b9292415b54fe5d8cd42412006128260405161290391815260200190565b60405180910390a25050565b606b546001600160a01b03163314611e825760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640161146c565b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd91435460ff161561299c5761197e83612c10565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b815260040160206040518083038186803b1580156129d557600080fd5b505afa925050508015612a05575060408051601f3d908101601f19168201909252612a02918101906130dc565b60015b612a685760405162461bcd60e51b815260206004820152602e60248201527f45524331393637557067726164653a206e657720696d706c656d656e7461746960448201526d6f6e206973206e6f74205555505360901b606482015260840161146c565b6000805160206135058339815191528114612ad75760405162461bcd60e51b815260206004820152602960248201527f45524331393637557067726164653a20756e737570706f727465642070726f786044820152681a58589b195555525160ba1b606482015260840161146c565b5061197e838383612cac565b33612aec6128a0565b6001600160a01b0316146115d05760405162461bcd60e51b815260040161146c9061326c565b603854610100900460ff16612b395760405162461bcd60e51b815260040161146c90613293565b611e82612cd1565b606b80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b609d54604051630d2020dd60e01b8152600481018390526000916001600160a01b031690630d2020dd9060240160206040518083038186803b158015612bd857600080fd5b505afa158015612bec573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906123539190612f82565b6001600160a01b0381163b612c7d5760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b606482015260840161146c565b60008051602061350583398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b612cb583612d01565b600082511180612cc25750805b1561197e5761158d8383612d41565b603854610100900460ff16612cf85760405162461bcd60e51b815260040161146c90613293565b611e8233612b41565b612d0a81612c10565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b6060612d66838360405180606001604052806027815260200161352560279139612d6d565b9392505050565b60606001600160a01b0384163b612dd55760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b606482015260840161146c565b600080856001600160a01b031685604051612df0919061323d565b600060405180830381855af49150503d8060008114612e2b576040519150601f19603f3d011682016040523d82523d6000602084013e612e30565b606091505b5091509150612e40828286612e4a565b9695505050505050565b60608315612e59575081612d66565b825115612e695782518084602001fd5b8160405162461bcd60e51b815260040161146c9190613259565b600082601f830112612e9457600080fd5b81356020612ea9612ea48361330f565b6132de565b80838252828201915082860187848660051b8901011115612ec957600080fd5b60005b85811015612ee857813584529284019290840190600101612ecc565b5090979650505050505050565b600082601f830112612f0657600080fd5b813567ffffffffffffffff811115612f2057612f206133f9565b612f33601f8201601f19166020016132de565b818152846020838601011115612f4857600080fd5b816020850160208301376000918101602001919091529392505050565b600060208284031215612f7757600080fd5b8135612d668161340f565b600060208284031215612f9457600080fd5b8151612d668161340f565b600080600060608486031215612fb457600080fd5b8335612fbf8161340f565b925060208481013567ffffffffffffffff80821115612fdd57600080fd5b818701915087601f830112612ff157600080fd5b8135612fff612ea48261330f565b8082825285820191508585018b878560051b880101111561301f57600080fd5b600095505b83861015613042578035835260019590950194918601918601613024565b5096505050604087013592508083111561305b57600080fd5b505061306986828701612e83565b9150509250925092565b6000806040838503121561308657600080fd5b82356130918161340f565b9150602083013567ffffffffffffffff8111156130ad57600080fd5b6130b985828601612ef5565b9150509250929050565b6000602082840312156130d557600080fd5b5035919050565b6000602082840312156130ee57600080fd5b5051919050565b6000806040838503121561310857600080fd5b82359150602083013567ffffffffffffffff8111156130ad57600080fd5b60006020828403121561313857600080fd5b813567ffffffffffffffff81111561314f57600080fd5b61315b84828501612ef5565b949350505050565b6000806040838503121561317657600080fd5b50508035926020909101359150565b6000806040838503121561319857600080fd5b505080516020909101519092909150565b600080600080608085870312156131bf57600080fd5b5050823594602084013594506040840135936060013592509050565b600080600080608085870312156131f157600080fd5b505082516020840151604085015160609095015191969095509092509050565b6000815180845261322981602086016020860161334b565b601f01601f19169290920160200192915050565b6000825161324f81846020870161334b565b9190910192915050565b602081526000612d666020830184613211565b6020808252600d908201526c2737902832b936b4b9b9b4b7b760991b604082015260600190565b6020808252602b908201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960408201526a6e697469616c697a696e6760a81b606082015260800190565b604051601f8201601f1916810167ffffffffffffffff81118282101715613307576133076133f9565b604052919050565b600067ffffffffffffffff821115613329576133296133f9565b5060051b60200190565b60008219821115613346576133466133cd565b500190565b60005b8381101561336657818101518382015260200161334e565b8381111561158d5750506000910152565b600181811c9082168061338b57607f821691505b602082108114156133ac57634e487b7160e01b600052602260045260246000fd5b50919050565b60006000198214156133c6576133c66133cd565b5060010190565b634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052604160045260246000fd5b6001600160a01b03811681146115d057600080fdfe18ad4415ef4a621ce1a136395c51ab6c3712bb2e24b79d526059925cea58dcb8829561ab7af084b7efc6600518d2df79b8d95f3f4c3a550f54f8f7ec7d2b80578086da5becff4dfac91a3105821b361078d2d4abba0ccc2401b974cf0dcf05c10c4fbe9dc9de15dd7c0d064975ee1a2f2f9b954fa0e65d4f6cddba94884bdc3e89dd490ecaf395283ed4ff2fd9557ca767fc425dce063451a9b0da6d72f600c3be90e461bbdb9a95a694f7796912ea04244caf7f5b60ad7ded17e16821d3e44c0b09c9badbbeb6c813a598ee910770a39ccda797a1940439bb6e47fc6c87548b360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c65642a268972a70c8c688b62366bdfdd9bb09cf19d3e5b6e7e7bb158e671ffdcedd2c69fc6b7d0efc934fd5a3581c7253a7107a952526bb6dbcd814ef8d8dae1f44a7c1150f0e1a39ff55552d52764f97e6c387e2a247e1df344369f122c4254be2fa2646970667358221220565915d280f3ef5971e7a1ac6ed87c3a23d2863e620792485052ea77c829c81f64736f6c63430008060033",
+}
+
+// EnvStorageImpABI is the input ABI used to generate the binding from.
+// Deprecated: Use EnvStorageImpMetaData.ABI instead.
+var EnvStorageImpABI = EnvStorageImpMetaData.ABI
+
+// EnvStorageImpBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use EnvStorageImpMetaData.Bin instead.
+var EnvStorageImpBin = EnvStorageImpMetaData.Bin
+
+// DeployEnvStorageImp deploys a new Ethereum contract, binding an instance of EnvStorageImp to it.
+func DeployEnvStorageImp(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *EnvStorageImp, error) {
+	parsed, err := EnvStorageImpMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(EnvStorageImpBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &EnvStorageImp{EnvStorageImpCaller: EnvStorageImpCaller{contract: contract}, EnvStorageImpTransactor: EnvStorageImpTransactor{contract: contract}, EnvStorageImpFilterer: EnvStorageImpFilterer{contract: contract}}, nil
+}
+
+// EnvStorageImp is an auto generated Go binding around an Ethereum contract.
+type EnvStorageImp struct {
+	EnvStorageImpCaller     // Read-only binding to the contract
+	EnvStorageImpTransactor // Write-only binding to the contract
+	EnvStorageImpFilterer   // Log filterer for contract events
+}
+
+// EnvStorageImpCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EnvStorageImpCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EnvStorageImpTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EnvStorageImpTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EnvStorageImpFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EnvStorageImpFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EnvStorageImpSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EnvStorageImpSession struct {
+	Contract     *EnvStorageImp    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EnvStorageImpCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EnvStorageImpCallerSession struct {
+	Contract *EnvStorageImpCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// EnvStorageImpTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EnvStorageImpTransactorSession struct {
+	Contract     *EnvStorageImpTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// EnvStorageImpRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EnvStorageImpRaw struct {
+	Contract *EnvStorageImp // Generic contract binding to access the raw methods on
+}
+
+// EnvStorageImpCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EnvStorageImpCallerRaw struct {
+	Contract *EnvStorageImpCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EnvStorageImpTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EnvStorageImpTransactorRaw struct {
+	Contract *EnvStorageImpTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEnvStorageImp creates a new instance of EnvStorageImp, bound to a specific deployed contract.
+func NewEnvStorageImp(address common.Address, backend bind.ContractBackend) (*EnvStorageImp, error) {
+	contract, err := bindEnvStorageImp(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImp{EnvStorageImpCaller: EnvStorageImpCaller{contract: contract}, EnvStorageImpTransactor: EnvStorageImpTransactor{contract: contract}, EnvStorageImpFilterer: EnvStorageImpFilterer{contract: contract}}, nil
+}
+
+// NewEnvStorageImpCaller creates a new read-only instance of EnvStorageImp, bound to a specific deployed contract.
+func NewEnvStorageImpCaller(address common.Address, caller bind.ContractCaller) (*EnvStorageImpCaller, error) {
+	contract, err := bindEnvStorageImp(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpCaller{contract: contract}, nil
+}
+
+// NewEnvStorageImpTransactor creates a new write-only instance of EnvStorageImp, bound to a specific deployed contract.
+func NewEnvStorageImpTransactor(address common.Address, transactor bind.ContractTransactor) (*EnvStorageImpTransactor, error) {
+	contract, err := bindEnvStorageImp(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpTransactor{contract: contract}, nil
+}
+
+// NewEnvStorageImpFilterer creates a new log filterer instance of EnvStorageImp, bound to a specific deployed contract.
+func NewEnvStorageImpFilterer(address common.Address, filterer bind.ContractFilterer) (*EnvStorageImpFilterer, error) {
+	contract, err := bindEnvStorageImp(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpFilterer{contract: contract}, nil
+}
+
+// bindEnvStorageImp binds a generic wrapper to an already deployed contract.
+func bindEnvStorageImp(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(EnvStorageImpABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EnvStorageImp *EnvStorageImpRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EnvStorageImp.Contract.EnvStorageImpCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EnvStorageImp *EnvStorageImpRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.EnvStorageImpTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EnvStorageImp *EnvStorageImpRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.EnvStorageImpTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EnvStorageImp *EnvStorageImpCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EnvStorageImp.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EnvStorageImp *EnvStorageImpTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EnvStorageImp *EnvStorageImpTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.contract.Transact(opts, method, params...)
+}
+
+// BALLOTDURATIONMAXNAME is a free data retrieval call binding the contract method 0x0015a73b.
+//
+// Solidity: function BALLOT_DURATION_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BALLOTDURATIONMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BALLOT_DURATION_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTDURATIONMAXNAME is a free data retrieval call binding the contract method 0x0015a73b.
+//
+// Solidity: function BALLOT_DURATION_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BALLOTDURATIONMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTDURATIONMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BALLOTDURATIONMAXNAME is a free data retrieval call binding the contract method 0x0015a73b.
+//
+// Solidity: function BALLOT_DURATION_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BALLOTDURATIONMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTDURATIONMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BALLOTDURATIONMINMAXNAME is a free data retrieval call binding the contract method 0x656e3052.
+//
+// Solidity: function BALLOT_DURATION_MIN_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BALLOTDURATIONMINMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BALLOT_DURATION_MIN_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTDURATIONMINMAXNAME is a free data retrieval call binding the contract method 0x656e3052.
+//
+// Solidity: function BALLOT_DURATION_MIN_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BALLOTDURATIONMINMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTDURATIONMINMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BALLOTDURATIONMINMAXNAME is a free data retrieval call binding the contract method 0x656e3052.
+//
+// Solidity: function BALLOT_DURATION_MIN_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BALLOTDURATIONMINMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTDURATIONMINMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BALLOTDURATIONMINNAME is a free data retrieval call binding the contract method 0x6d583ca7.
+//
+// Solidity: function BALLOT_DURATION_MIN_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BALLOTDURATIONMINNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BALLOT_DURATION_MIN_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTDURATIONMINNAME is a free data retrieval call binding the contract method 0x6d583ca7.
+//
+// Solidity: function BALLOT_DURATION_MIN_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BALLOTDURATIONMINNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTDURATIONMINNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BALLOTDURATIONMINNAME is a free data retrieval call binding the contract method 0x6d583ca7.
+//
+// Solidity: function BALLOT_DURATION_MIN_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BALLOTDURATIONMINNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTDURATIONMINNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BALLOTSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BALLOT_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTSTORAGENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BALLOTSTORAGENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BASEFEEMAXCHANGERATENAME is a free data retrieval call binding the contract method 0xc42a0abc.
+//
+// Solidity: function BASE_FEE_MAX_CHANGE_RATE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BASEFEEMAXCHANGERATENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BASE_FEE_MAX_CHANGE_RATE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BASEFEEMAXCHANGERATENAME is a free data retrieval call binding the contract method 0xc42a0abc.
+//
+// Solidity: function BASE_FEE_MAX_CHANGE_RATE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BASEFEEMAXCHANGERATENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BASEFEEMAXCHANGERATENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BASEFEEMAXCHANGERATENAME is a free data retrieval call binding the contract method 0xc42a0abc.
+//
+// Solidity: function BASE_FEE_MAX_CHANGE_RATE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BASEFEEMAXCHANGERATENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BASEFEEMAXCHANGERATENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKSPERNAME is a free data retrieval call binding the contract method 0x3f35c8fe.
+//
+// Solidity: function BLOCKS_PER_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKSPERNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCKS_PER_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKSPERNAME is a free data retrieval call binding the contract method 0x3f35c8fe.
+//
+// Solidity: function BLOCKS_PER_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKSPERNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKSPERNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKSPERNAME is a free data retrieval call binding the contract method 0x3f35c8fe.
+//
+// Solidity: function BLOCKS_PER_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKSPERNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKSPERNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKCREATIONTIMENAME is a free data retrieval call binding the contract method 0xc0b4fe15.
+//
+// Solidity: function BLOCK_CREATION_TIME_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKCREATIONTIMENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_CREATION_TIME_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKCREATIONTIMENAME is a free data retrieval call binding the contract method 0xc0b4fe15.
+//
+// Solidity: function BLOCK_CREATION_TIME_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKCREATIONTIMENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKCREATIONTIMENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKCREATIONTIMENAME is a free data retrieval call binding the contract method 0xc0b4fe15.
+//
+// Solidity: function BLOCK_CREATION_TIME_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKCREATIONTIMENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKCREATIONTIMENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKGASLIMITNAME is a free data retrieval call binding the contract method 0x238737b6.
+//
+// Solidity: function BLOCK_GASLIMIT_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKGASLIMITNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_GASLIMIT_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKGASLIMITNAME is a free data retrieval call binding the contract method 0x238737b6.
+//
+// Solidity: function BLOCK_GASLIMIT_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKGASLIMITNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKGASLIMITNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKGASLIMITNAME is a free data retrieval call binding the contract method 0x238737b6.
+//
+// Solidity: function BLOCK_GASLIMIT_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKGASLIMITNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKGASLIMITNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDAMOUNTNAME is a free data retrieval call binding the contract method 0xa9b629b2.
+//
+// Solidity: function BLOCK_REWARD_AMOUNT_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKREWARDAMOUNTNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_REWARD_AMOUNT_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDAMOUNTNAME is a free data retrieval call binding the contract method 0xa9b629b2.
+//
+// Solidity: function BLOCK_REWARD_AMOUNT_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKREWARDAMOUNTNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDAMOUNTNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDAMOUNTNAME is a free data retrieval call binding the contract method 0xa9b629b2.
+//
+// Solidity: function BLOCK_REWARD_AMOUNT_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKREWARDAMOUNTNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDAMOUNTNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME is a free data retrieval call binding the contract method 0xc6713baf.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME is a free data retrieval call binding the contract method 0xc6713baf.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME is a free data retrieval call binding the contract method 0xc6713baf.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME is a free data retrieval call binding the contract method 0x7b2bfb01.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME is a free data retrieval call binding the contract method 0x7b2bfb01.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME is a free data retrieval call binding the contract method 0x7b2bfb01.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMAINTANANCENAME is a free data retrieval call binding the contract method 0x0b1d39b8.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKREWARDDISTRIBUTIONMAINTANANCENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONMAINTANANCENAME is a free data retrieval call binding the contract method 0x0b1d39b8.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKREWARDDISTRIBUTIONMAINTANANCENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONMAINTANANCENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMAINTANANCENAME is a free data retrieval call binding the contract method 0x0b1d39b8.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKREWARDDISTRIBUTIONMAINTANANCENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONMAINTANANCENAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMETHODNAME is a free data retrieval call binding the contract method 0x278bb12a.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_METHOD_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKREWARDDISTRIBUTIONMETHODNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_METHOD_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONMETHODNAME is a free data retrieval call binding the contract method 0x278bb12a.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_METHOD_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKREWARDDISTRIBUTIONMETHODNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONMETHODNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMETHODNAME is a free data retrieval call binding the contract method 0x278bb12a.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_METHOD_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKREWARDDISTRIBUTIONMETHODNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONMETHODNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME is a free data retrieval call binding the contract method 0x6167eb45.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME is a free data retrieval call binding the contract method 0x6167eb45.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME(&_EnvStorageImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME is a free data retrieval call binding the contract method 0x6167eb45.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME(&_EnvStorageImp.CallOpts)
+}
+
+// DENOMINATOR is a free data retrieval call binding the contract method 0x918f8674.
+//
+// Solidity: function DENOMINATOR() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) DENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DENOMINATOR is a free data retrieval call binding the contract method 0x918f8674.
+//
+// Solidity: function DENOMINATOR() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) DENOMINATOR() (*big.Int, error) {
+	return _EnvStorageImp.Contract.DENOMINATOR(&_EnvStorageImp.CallOpts)
+}
+
+// DENOMINATOR is a free data retrieval call binding the contract method 0x918f8674.
+//
+// Solidity: function DENOMINATOR() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) DENOMINATOR() (*big.Int, error) {
+	return _EnvStorageImp.Contract.DENOMINATOR(&_EnvStorageImp.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) ENVSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "ENV_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.ENVSTORAGENAME(&_EnvStorageImp.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.ENVSTORAGENAME(&_EnvStorageImp.CallOpts)
+}
+
+// GASLIMITANDBASEFEENAME is a free data retrieval call binding the contract method 0xc7d3da34.
+//
+// Solidity: function GASLIMIT_AND_BASE_FEE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) GASLIMITANDBASEFEENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "GASLIMIT_AND_BASE_FEE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GASLIMITANDBASEFEENAME is a free data retrieval call binding the contract method 0xc7d3da34.
+//
+// Solidity: function GASLIMIT_AND_BASE_FEE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) GASLIMITANDBASEFEENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.GASLIMITANDBASEFEENAME(&_EnvStorageImp.CallOpts)
+}
+
+// GASLIMITANDBASEFEENAME is a free data retrieval call binding the contract method 0xc7d3da34.
+//
+// Solidity: function GASLIMIT_AND_BASE_FEE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GASLIMITANDBASEFEENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.GASLIMITANDBASEFEENAME(&_EnvStorageImp.CallOpts)
+}
+
+// GASTARGETPERCENTAGENAME is a free data retrieval call binding the contract method 0x4d273e28.
+//
+// Solidity: function GAS_TARGET_PERCENTAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) GASTARGETPERCENTAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "GAS_TARGET_PERCENTAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GASTARGETPERCENTAGENAME is a free data retrieval call binding the contract method 0x4d273e28.
+//
+// Solidity: function GAS_TARGET_PERCENTAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) GASTARGETPERCENTAGENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.GASTARGETPERCENTAGENAME(&_EnvStorageImp.CallOpts)
+}
+
+// GASTARGETPERCENTAGENAME is a free data retrieval call binding the contract method 0x4d273e28.
+//
+// Solidity: function GAS_TARGET_PERCENTAGE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GASTARGETPERCENTAGENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.GASTARGETPERCENTAGENAME(&_EnvStorageImp.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) GOVNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "GOV_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) GOVNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.GOVNAME(&_EnvStorageImp.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GOVNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.GOVNAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) MAINTENANCENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "MAINTENANCE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) MAINTENANCENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAINTENANCENAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) MAINTENANCENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAINTENANCENAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAXBASEFEENAME is a free data retrieval call binding the contract method 0xf38ecf47.
+//
+// Solidity: function MAX_BASE_FEE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) MAXBASEFEENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "MAX_BASE_FEE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAXBASEFEENAME is a free data retrieval call binding the contract method 0xf38ecf47.
+//
+// Solidity: function MAX_BASE_FEE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) MAXBASEFEENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAXBASEFEENAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAXBASEFEENAME is a free data retrieval call binding the contract method 0xf38ecf47.
+//
+// Solidity: function MAX_BASE_FEE_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) MAXBASEFEENAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAXBASEFEENAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAXIDLEBLOCKINTERVALNAME is a free data retrieval call binding the contract method 0xb128f880.
+//
+// Solidity: function MAX_IDLE_BLOCK_INTERVAL_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) MAXIDLEBLOCKINTERVALNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "MAX_IDLE_BLOCK_INTERVAL_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAXIDLEBLOCKINTERVALNAME is a free data retrieval call binding the contract method 0xb128f880.
+//
+// Solidity: function MAX_IDLE_BLOCK_INTERVAL_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) MAXIDLEBLOCKINTERVALNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAXIDLEBLOCKINTERVALNAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAXIDLEBLOCKINTERVALNAME is a free data retrieval call binding the contract method 0xb128f880.
+//
+// Solidity: function MAX_IDLE_BLOCK_INTERVAL_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) MAXIDLEBLOCKINTERVALNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAXIDLEBLOCKINTERVALNAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAXPRIORITYFEEPERGASNAME is a free data retrieval call binding the contract method 0x38294419.
+//
+// Solidity: function MAX_PRIORITY_FEE_PER_GAS_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) MAXPRIORITYFEEPERGASNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "MAX_PRIORITY_FEE_PER_GAS_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAXPRIORITYFEEPERGASNAME is a free data retrieval call binding the contract method 0x38294419.
+//
+// Solidity: function MAX_PRIORITY_FEE_PER_GAS_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) MAXPRIORITYFEEPERGASNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAXPRIORITYFEEPERGASNAME(&_EnvStorageImp.CallOpts)
+}
+
+// MAXPRIORITYFEEPERGASNAME is a free data retrieval call binding the contract method 0x38294419.
+//
+// Solidity: function MAX_PRIORITY_FEE_PER_GAS_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) MAXPRIORITYFEEPERGASNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.MAXPRIORITYFEEPERGASNAME(&_EnvStorageImp.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) REWARDPOOLNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "REWARD_POOL_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.REWARDPOOLNAME(&_EnvStorageImp.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.REWARDPOOLNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGMAXNAME is a free data retrieval call binding the contract method 0xc00ace6c.
+//
+// Solidity: function STAKING_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) STAKINGMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "STAKING_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGMAXNAME is a free data retrieval call binding the contract method 0xc00ace6c.
+//
+// Solidity: function STAKING_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) STAKINGMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGMAXNAME is a free data retrieval call binding the contract method 0xc00ace6c.
+//
+// Solidity: function STAKING_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) STAKINGMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGMINMAXNAME is a free data retrieval call binding the contract method 0xa6868b7d.
+//
+// Solidity: function STAKING_MIN_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) STAKINGMINMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "STAKING_MIN_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGMINMAXNAME is a free data retrieval call binding the contract method 0xa6868b7d.
+//
+// Solidity: function STAKING_MIN_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) STAKINGMINMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGMINMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGMINMAXNAME is a free data retrieval call binding the contract method 0xa6868b7d.
+//
+// Solidity: function STAKING_MIN_MAX_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) STAKINGMINMAXNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGMINMAXNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGMINNAME is a free data retrieval call binding the contract method 0x6fde207a.
+//
+// Solidity: function STAKING_MIN_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) STAKINGMINNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "STAKING_MIN_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGMINNAME is a free data retrieval call binding the contract method 0x6fde207a.
+//
+// Solidity: function STAKING_MIN_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) STAKINGMINNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGMINNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGMINNAME is a free data retrieval call binding the contract method 0x6fde207a.
+//
+// Solidity: function STAKING_MIN_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) STAKINGMINNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGMINNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) STAKINGNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "STAKING_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) STAKINGNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGNAME(&_EnvStorageImp.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) STAKINGNAME() ([32]byte, error) {
+	return _EnvStorageImp.Contract.STAKINGNAME(&_EnvStorageImp.CallOpts)
+}
+
+// CheckVariableCondition is a free data retrieval call binding the contract method 0x9801bff9.
+//
+// Solidity: function checkVariableCondition(bytes32 envKey, bytes envVal) pure returns(bool)
+func (_EnvStorageImp *EnvStorageImpCaller) CheckVariableCondition(opts *bind.CallOpts, envKey [32]byte, envVal []byte) (bool, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "checkVariableCondition", envKey, envVal)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckVariableCondition is a free data retrieval call binding the contract method 0x9801bff9.
+//
+// Solidity: function checkVariableCondition(bytes32 envKey, bytes envVal) pure returns(bool)
+func (_EnvStorageImp *EnvStorageImpSession) CheckVariableCondition(envKey [32]byte, envVal []byte) (bool, error) {
+	return _EnvStorageImp.Contract.CheckVariableCondition(&_EnvStorageImp.CallOpts, envKey, envVal)
+}
+
+// CheckVariableCondition is a free data retrieval call binding the contract method 0x9801bff9.
+//
+// Solidity: function checkVariableCondition(bytes32 envKey, bytes envVal) pure returns(bool)
+func (_EnvStorageImp *EnvStorageImpCallerSession) CheckVariableCondition(envKey [32]byte, envVal []byte) (bool, error) {
+	return _EnvStorageImp.Contract.CheckVariableCondition(&_EnvStorageImp.CallOpts, envKey, envVal)
+}
+
+// GetAddress is a free data retrieval call binding the contract method 0x21f8a721.
+//
+// Solidity: function getAddress(bytes32 h) view returns(address)
+func (_EnvStorageImp *EnvStorageImpCaller) GetAddress(opts *bind.CallOpts, h [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getAddress", h)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetAddress is a free data retrieval call binding the contract method 0x21f8a721.
+//
+// Solidity: function getAddress(bytes32 h) view returns(address)
+func (_EnvStorageImp *EnvStorageImpSession) GetAddress(h [32]byte) (common.Address, error) {
+	return _EnvStorageImp.Contract.GetAddress(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetAddress is a free data retrieval call binding the contract method 0x21f8a721.
+//
+// Solidity: function getAddress(bytes32 h) view returns(address)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetAddress(h [32]byte) (common.Address, error) {
+	return _EnvStorageImp.Contract.GetAddress(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetBallotDurationMax is a free data retrieval call binding the contract method 0x1b27e01b.
+//
+// Solidity: function getBallotDurationMax() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBallotDurationMax(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBallotDurationMax")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBallotDurationMax is a free data retrieval call binding the contract method 0x1b27e01b.
+//
+// Solidity: function getBallotDurationMax() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetBallotDurationMax() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBallotDurationMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetBallotDurationMax is a free data retrieval call binding the contract method 0x1b27e01b.
+//
+// Solidity: function getBallotDurationMax() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBallotDurationMax() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBallotDurationMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetBallotDurationMin is a free data retrieval call binding the contract method 0x33be496e.
+//
+// Solidity: function getBallotDurationMin() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBallotDurationMin(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBallotDurationMin")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBallotDurationMin is a free data retrieval call binding the contract method 0x33be496e.
+//
+// Solidity: function getBallotDurationMin() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetBallotDurationMin() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBallotDurationMin(&_EnvStorageImp.CallOpts)
+}
+
+// GetBallotDurationMin is a free data retrieval call binding the contract method 0x33be496e.
+//
+// Solidity: function getBallotDurationMin() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBallotDurationMin() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBallotDurationMin(&_EnvStorageImp.CallOpts)
+}
+
+// GetBallotDurationMinMax is a free data retrieval call binding the contract method 0xcdb9f643.
+//
+// Solidity: function getBallotDurationMinMax() view returns(uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBallotDurationMinMax(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBallotDurationMinMax")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetBallotDurationMinMax is a free data retrieval call binding the contract method 0xcdb9f643.
+//
+// Solidity: function getBallotDurationMinMax() view returns(uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetBallotDurationMinMax() (*big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetBallotDurationMinMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetBallotDurationMinMax is a free data retrieval call binding the contract method 0xcdb9f643.
+//
+// Solidity: function getBallotDurationMinMax() view returns(uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBallotDurationMinMax() (*big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetBallotDurationMinMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlockCreationTime is a free data retrieval call binding the contract method 0x33e31184.
+//
+// Solidity: function getBlockCreationTime() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBlockCreationTime(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBlockCreationTime")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlockCreationTime is a free data retrieval call binding the contract method 0x33e31184.
+//
+// Solidity: function getBlockCreationTime() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetBlockCreationTime() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlockCreationTime(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlockCreationTime is a free data retrieval call binding the contract method 0x33e31184.
+//
+// Solidity: function getBlockCreationTime() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBlockCreationTime() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlockCreationTime(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlockRewardAmount is a free data retrieval call binding the contract method 0x5dba8c4a.
+//
+// Solidity: function getBlockRewardAmount() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBlockRewardAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBlockRewardAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlockRewardAmount is a free data retrieval call binding the contract method 0x5dba8c4a.
+//
+// Solidity: function getBlockRewardAmount() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetBlockRewardAmount() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlockRewardAmount(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlockRewardAmount is a free data retrieval call binding the contract method 0x5dba8c4a.
+//
+// Solidity: function getBlockRewardAmount() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBlockRewardAmount() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlockRewardAmount(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlockRewardDistributionMethod is a free data retrieval call binding the contract method 0x8f6fe725.
+//
+// Solidity: function getBlockRewardDistributionMethod() view returns(uint256, uint256, uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBlockRewardDistributionMethod(opts *bind.CallOpts) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBlockRewardDistributionMethod")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, err
+
+}
+
+// GetBlockRewardDistributionMethod is a free data retrieval call binding the contract method 0x8f6fe725.
+//
+// Solidity: function getBlockRewardDistributionMethod() view returns(uint256, uint256, uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetBlockRewardDistributionMethod() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlockRewardDistributionMethod(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlockRewardDistributionMethod is a free data retrieval call binding the contract method 0x8f6fe725.
+//
+// Solidity: function getBlockRewardDistributionMethod() view returns(uint256, uint256, uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBlockRewardDistributionMethod() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlockRewardDistributionMethod(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlocksPer is a free data retrieval call binding the contract method 0x3690057a.
+//
+// Solidity: function getBlocksPer() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBlocksPer(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBlocksPer")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlocksPer is a free data retrieval call binding the contract method 0x3690057a.
+//
+// Solidity: function getBlocksPer() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetBlocksPer() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlocksPer(&_EnvStorageImp.CallOpts)
+}
+
+// GetBlocksPer is a free data retrieval call binding the contract method 0x3690057a.
+//
+// Solidity: function getBlocksPer() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBlocksPer() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetBlocksPer(&_EnvStorageImp.CallOpts)
+}
+
+// GetBoolean is a free data retrieval call binding the contract method 0x3848207a.
+//
+// Solidity: function getBoolean(bytes32 h) view returns(bool)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBoolean(opts *bind.CallOpts, h [32]byte) (bool, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBoolean", h)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GetBoolean is a free data retrieval call binding the contract method 0x3848207a.
+//
+// Solidity: function getBoolean(bytes32 h) view returns(bool)
+func (_EnvStorageImp *EnvStorageImpSession) GetBoolean(h [32]byte) (bool, error) {
+	return _EnvStorageImp.Contract.GetBoolean(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetBoolean is a free data retrieval call binding the contract method 0x3848207a.
+//
+// Solidity: function getBoolean(bytes32 h) view returns(bool)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBoolean(h [32]byte) (bool, error) {
+	return _EnvStorageImp.Contract.GetBoolean(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetBytes is a free data retrieval call binding the contract method 0xc031a180.
+//
+// Solidity: function getBytes(bytes32 h) view returns(bytes)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBytes(opts *bind.CallOpts, h [32]byte) ([]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBytes", h)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetBytes is a free data retrieval call binding the contract method 0xc031a180.
+//
+// Solidity: function getBytes(bytes32 h) view returns(bytes)
+func (_EnvStorageImp *EnvStorageImpSession) GetBytes(h [32]byte) ([]byte, error) {
+	return _EnvStorageImp.Contract.GetBytes(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetBytes is a free data retrieval call binding the contract method 0xc031a180.
+//
+// Solidity: function getBytes(bytes32 h) view returns(bytes)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBytes(h [32]byte) ([]byte, error) {
+	return _EnvStorageImp.Contract.GetBytes(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetBytes32 is a free data retrieval call binding the contract method 0xa6ed563e.
+//
+// Solidity: function getBytes32(bytes32 h) view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) GetBytes32(opts *bind.CallOpts, h [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getBytes32", h)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetBytes32 is a free data retrieval call binding the contract method 0xa6ed563e.
+//
+// Solidity: function getBytes32(bytes32 h) view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) GetBytes32(h [32]byte) ([32]byte, error) {
+	return _EnvStorageImp.Contract.GetBytes32(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetBytes32 is a free data retrieval call binding the contract method 0xa6ed563e.
+//
+// Solidity: function getBytes32(bytes32 h) view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetBytes32(h [32]byte) ([32]byte, error) {
+	return _EnvStorageImp.Contract.GetBytes32(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetGasLimitAndBaseFee is a free data retrieval call binding the contract method 0x67d1a2e0.
+//
+// Solidity: function getGasLimitAndBaseFee() view returns(uint256, uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetGasLimitAndBaseFee(opts *bind.CallOpts) (*big.Int, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getGasLimitAndBaseFee")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, err
+
+}
+
+// GetGasLimitAndBaseFee is a free data retrieval call binding the contract method 0x67d1a2e0.
+//
+// Solidity: function getGasLimitAndBaseFee() view returns(uint256, uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetGasLimitAndBaseFee() (*big.Int, *big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetGasLimitAndBaseFee(&_EnvStorageImp.CallOpts)
+}
+
+// GetGasLimitAndBaseFee is a free data retrieval call binding the contract method 0x67d1a2e0.
+//
+// Solidity: function getGasLimitAndBaseFee() view returns(uint256, uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetGasLimitAndBaseFee() (*big.Int, *big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetGasLimitAndBaseFee(&_EnvStorageImp.CallOpts)
+}
+
+// GetInt is a free data retrieval call binding the contract method 0xdc97d962.
+//
+// Solidity: function getInt(bytes32 h) view returns(int256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetInt(opts *bind.CallOpts, h [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getInt", h)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetInt is a free data retrieval call binding the contract method 0xdc97d962.
+//
+// Solidity: function getInt(bytes32 h) view returns(int256)
+func (_EnvStorageImp *EnvStorageImpSession) GetInt(h [32]byte) (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetInt(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetInt is a free data retrieval call binding the contract method 0xdc97d962.
+//
+// Solidity: function getInt(bytes32 h) view returns(int256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetInt(h [32]byte) (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetInt(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetMaxBaseFee is a free data retrieval call binding the contract method 0xc95437be.
+//
+// Solidity: function getMaxBaseFee() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetMaxBaseFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getMaxBaseFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxBaseFee is a free data retrieval call binding the contract method 0xc95437be.
+//
+// Solidity: function getMaxBaseFee() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetMaxBaseFee() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetMaxBaseFee(&_EnvStorageImp.CallOpts)
+}
+
+// GetMaxBaseFee is a free data retrieval call binding the contract method 0xc95437be.
+//
+// Solidity: function getMaxBaseFee() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetMaxBaseFee() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetMaxBaseFee(&_EnvStorageImp.CallOpts)
+}
+
+// GetMaxIdleBlockInterval is a free data retrieval call binding the contract method 0x185582f1.
+//
+// Solidity: function getMaxIdleBlockInterval() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetMaxIdleBlockInterval(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getMaxIdleBlockInterval")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxIdleBlockInterval is a free data retrieval call binding the contract method 0x185582f1.
+//
+// Solidity: function getMaxIdleBlockInterval() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetMaxIdleBlockInterval() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetMaxIdleBlockInterval(&_EnvStorageImp.CallOpts)
+}
+
+// GetMaxIdleBlockInterval is a free data retrieval call binding the contract method 0x185582f1.
+//
+// Solidity: function getMaxIdleBlockInterval() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetMaxIdleBlockInterval() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetMaxIdleBlockInterval(&_EnvStorageImp.CallOpts)
+}
+
+// GetMaxPriorityFeePerGas is a free data retrieval call binding the contract method 0x2b2eaa92.
+//
+// Solidity: function getMaxPriorityFeePerGas() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetMaxPriorityFeePerGas(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getMaxPriorityFeePerGas")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxPriorityFeePerGas is a free data retrieval call binding the contract method 0x2b2eaa92.
+//
+// Solidity: function getMaxPriorityFeePerGas() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetMaxPriorityFeePerGas() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetMaxPriorityFeePerGas(&_EnvStorageImp.CallOpts)
+}
+
+// GetMaxPriorityFeePerGas is a free data retrieval call binding the contract method 0x2b2eaa92.
+//
+// Solidity: function getMaxPriorityFeePerGas() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetMaxPriorityFeePerGas() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetMaxPriorityFeePerGas(&_EnvStorageImp.CallOpts)
+}
+
+// GetStakingMax is a free data retrieval call binding the contract method 0x737c59b8.
+//
+// Solidity: function getStakingMax() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetStakingMax(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getStakingMax")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetStakingMax is a free data retrieval call binding the contract method 0x737c59b8.
+//
+// Solidity: function getStakingMax() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetStakingMax() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetStakingMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetStakingMax is a free data retrieval call binding the contract method 0x737c59b8.
+//
+// Solidity: function getStakingMax() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetStakingMax() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetStakingMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetStakingMin is a free data retrieval call binding the contract method 0x076cd77f.
+//
+// Solidity: function getStakingMin() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetStakingMin(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getStakingMin")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetStakingMin is a free data retrieval call binding the contract method 0x076cd77f.
+//
+// Solidity: function getStakingMin() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetStakingMin() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetStakingMin(&_EnvStorageImp.CallOpts)
+}
+
+// GetStakingMin is a free data retrieval call binding the contract method 0x076cd77f.
+//
+// Solidity: function getStakingMin() view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetStakingMin() (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetStakingMin(&_EnvStorageImp.CallOpts)
+}
+
+// GetStakingMinMax is a free data retrieval call binding the contract method 0xaba01fee.
+//
+// Solidity: function getStakingMinMax() view returns(uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetStakingMinMax(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getStakingMinMax")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetStakingMinMax is a free data retrieval call binding the contract method 0xaba01fee.
+//
+// Solidity: function getStakingMinMax() view returns(uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetStakingMinMax() (*big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetStakingMinMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetStakingMinMax is a free data retrieval call binding the contract method 0xaba01fee.
+//
+// Solidity: function getStakingMinMax() view returns(uint256, uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetStakingMinMax() (*big.Int, *big.Int, error) {
+	return _EnvStorageImp.Contract.GetStakingMinMax(&_EnvStorageImp.CallOpts)
+}
+
+// GetString is a free data retrieval call binding the contract method 0x986e791a.
+//
+// Solidity: function getString(bytes32 h) view returns(string)
+func (_EnvStorageImp *EnvStorageImpCaller) GetString(opts *bind.CallOpts, h [32]byte) (string, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getString", h)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetString is a free data retrieval call binding the contract method 0x986e791a.
+//
+// Solidity: function getString(bytes32 h) view returns(string)
+func (_EnvStorageImp *EnvStorageImpSession) GetString(h [32]byte) (string, error) {
+	return _EnvStorageImp.Contract.GetString(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetString is a free data retrieval call binding the contract method 0x986e791a.
+//
+// Solidity: function getString(bytes32 h) view returns(string)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetString(h [32]byte) (string, error) {
+	return _EnvStorageImp.Contract.GetString(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetUint is a free data retrieval call binding the contract method 0xbd02d0f5.
+//
+// Solidity: function getUint(bytes32 h) view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCaller) GetUint(opts *bind.CallOpts, h [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "getUint", h)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetUint is a free data retrieval call binding the contract method 0xbd02d0f5.
+//
+// Solidity: function getUint(bytes32 h) view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpSession) GetUint(h [32]byte) (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetUint(&_EnvStorageImp.CallOpts, h)
+}
+
+// GetUint is a free data retrieval call binding the contract method 0xbd02d0f5.
+//
+// Solidity: function getUint(bytes32 h) view returns(uint256)
+func (_EnvStorageImp *EnvStorageImpCallerSession) GetUint(h [32]byte) (*big.Int, error) {
+	return _EnvStorageImp.Contract.GetUint(&_EnvStorageImp.CallOpts, h)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_EnvStorageImp *EnvStorageImpCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_EnvStorageImp *EnvStorageImpSession) Owner() (common.Address, error) {
+	return _EnvStorageImp.Contract.Owner(&_EnvStorageImp.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_EnvStorageImp *EnvStorageImpCallerSession) Owner() (common.Address, error) {
+	return _EnvStorageImp.Contract.Owner(&_EnvStorageImp.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpSession) ProxiableUUID() ([32]byte, error) {
+	return _EnvStorageImp.Contract.ProxiableUUID(&_EnvStorageImp.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_EnvStorageImp *EnvStorageImpCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _EnvStorageImp.Contract.ProxiableUUID(&_EnvStorageImp.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_EnvStorageImp *EnvStorageImpCaller) Reg(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EnvStorageImp.contract.Call(opts, &out, "reg")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_EnvStorageImp *EnvStorageImpSession) Reg() (common.Address, error) {
+	return _EnvStorageImp.Contract.Reg(&_EnvStorageImp.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_EnvStorageImp *EnvStorageImpCallerSession) Reg() (common.Address, error) {
+	return _EnvStorageImp.Contract.Reg(&_EnvStorageImp.CallOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x539927be.
+//
+// Solidity: function initialize(address _registry, bytes32[] names, uint256[] infos) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) Initialize(opts *bind.TransactOpts, _registry common.Address, names [][32]byte, infos []*big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "initialize", _registry, names, infos)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x539927be.
+//
+// Solidity: function initialize(address _registry, bytes32[] names, uint256[] infos) returns()
+func (_EnvStorageImp *EnvStorageImpSession) Initialize(_registry common.Address, names [][32]byte, infos []*big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.Initialize(&_EnvStorageImp.TransactOpts, _registry, names, infos)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x539927be.
+//
+// Solidity: function initialize(address _registry, bytes32[] names, uint256[] infos) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) Initialize(_registry common.Address, names [][32]byte, infos []*big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.Initialize(&_EnvStorageImp.TransactOpts, _registry, names, infos)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_EnvStorageImp *EnvStorageImpSession) RenounceOwnership() (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.RenounceOwnership(&_EnvStorageImp.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.RenounceOwnership(&_EnvStorageImp.TransactOpts)
+}
+
+// SetBallotDurationMax is a paid mutator transaction binding the contract method 0x852cf38f.
+//
+// Solidity: function setBallotDurationMax(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBallotDurationMax(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBallotDurationMax", _value)
+}
+
+// SetBallotDurationMax is a paid mutator transaction binding the contract method 0x852cf38f.
+//
+// Solidity: function setBallotDurationMax(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBallotDurationMax(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMax(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMax is a paid mutator transaction binding the contract method 0x852cf38f.
+//
+// Solidity: function setBallotDurationMax(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBallotDurationMax(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMax(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMaxByBytes is a paid mutator transaction binding the contract method 0xa5d6a581.
+//
+// Solidity: function setBallotDurationMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBallotDurationMaxByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBallotDurationMaxByBytes", _value)
+}
+
+// SetBallotDurationMaxByBytes is a paid mutator transaction binding the contract method 0xa5d6a581.
+//
+// Solidity: function setBallotDurationMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBallotDurationMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMaxByBytes is a paid mutator transaction binding the contract method 0xa5d6a581.
+//
+// Solidity: function setBallotDurationMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBallotDurationMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMin is a paid mutator transaction binding the contract method 0xa36f259b.
+//
+// Solidity: function setBallotDurationMin(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBallotDurationMin(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBallotDurationMin", _value)
+}
+
+// SetBallotDurationMin is a paid mutator transaction binding the contract method 0xa36f259b.
+//
+// Solidity: function setBallotDurationMin(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBallotDurationMin(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMin(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMin is a paid mutator transaction binding the contract method 0xa36f259b.
+//
+// Solidity: function setBallotDurationMin(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBallotDurationMin(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMin(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMinByBytes is a paid mutator transaction binding the contract method 0x124cea37.
+//
+// Solidity: function setBallotDurationMinByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBallotDurationMinByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBallotDurationMinByBytes", _value)
+}
+
+// SetBallotDurationMinByBytes is a paid mutator transaction binding the contract method 0x124cea37.
+//
+// Solidity: function setBallotDurationMinByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBallotDurationMinByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMinByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMinByBytes is a paid mutator transaction binding the contract method 0x124cea37.
+//
+// Solidity: function setBallotDurationMinByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBallotDurationMinByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMinByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMinMax is a paid mutator transaction binding the contract method 0xb4d6bd3b.
+//
+// Solidity: function setBallotDurationMinMax(uint256 _min, uint256 _max) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBallotDurationMinMax(opts *bind.TransactOpts, _min *big.Int, _max *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBallotDurationMinMax", _min, _max)
+}
+
+// SetBallotDurationMinMax is a paid mutator transaction binding the contract method 0xb4d6bd3b.
+//
+// Solidity: function setBallotDurationMinMax(uint256 _min, uint256 _max) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBallotDurationMinMax(_min *big.Int, _max *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMinMax(&_EnvStorageImp.TransactOpts, _min, _max)
+}
+
+// SetBallotDurationMinMax is a paid mutator transaction binding the contract method 0xb4d6bd3b.
+//
+// Solidity: function setBallotDurationMinMax(uint256 _min, uint256 _max) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBallotDurationMinMax(_min *big.Int, _max *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMinMax(&_EnvStorageImp.TransactOpts, _min, _max)
+}
+
+// SetBallotDurationMinMaxByBytes is a paid mutator transaction binding the contract method 0x45b5ec29.
+//
+// Solidity: function setBallotDurationMinMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBallotDurationMinMaxByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBallotDurationMinMaxByBytes", _value)
+}
+
+// SetBallotDurationMinMaxByBytes is a paid mutator transaction binding the contract method 0x45b5ec29.
+//
+// Solidity: function setBallotDurationMinMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBallotDurationMinMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMinMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBallotDurationMinMaxByBytes is a paid mutator transaction binding the contract method 0x45b5ec29.
+//
+// Solidity: function setBallotDurationMinMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBallotDurationMinMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBallotDurationMinMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockCreationTime is a paid mutator transaction binding the contract method 0x3305508e.
+//
+// Solidity: function setBlockCreationTime(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlockCreationTime(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlockCreationTime", _value)
+}
+
+// SetBlockCreationTime is a paid mutator transaction binding the contract method 0x3305508e.
+//
+// Solidity: function setBlockCreationTime(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlockCreationTime(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockCreationTime(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockCreationTime is a paid mutator transaction binding the contract method 0x3305508e.
+//
+// Solidity: function setBlockCreationTime(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlockCreationTime(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockCreationTime(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockCreationTimeByBytes is a paid mutator transaction binding the contract method 0x44b89914.
+//
+// Solidity: function setBlockCreationTimeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlockCreationTimeByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlockCreationTimeByBytes", _value)
+}
+
+// SetBlockCreationTimeByBytes is a paid mutator transaction binding the contract method 0x44b89914.
+//
+// Solidity: function setBlockCreationTimeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlockCreationTimeByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockCreationTimeByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockCreationTimeByBytes is a paid mutator transaction binding the contract method 0x44b89914.
+//
+// Solidity: function setBlockCreationTimeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlockCreationTimeByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockCreationTimeByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockRewardAmount is a paid mutator transaction binding the contract method 0x2ed19cd5.
+//
+// Solidity: function setBlockRewardAmount(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlockRewardAmount(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlockRewardAmount", _value)
+}
+
+// SetBlockRewardAmount is a paid mutator transaction binding the contract method 0x2ed19cd5.
+//
+// Solidity: function setBlockRewardAmount(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlockRewardAmount(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardAmount(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockRewardAmount is a paid mutator transaction binding the contract method 0x2ed19cd5.
+//
+// Solidity: function setBlockRewardAmount(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlockRewardAmount(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardAmount(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockRewardAmountByBytes is a paid mutator transaction binding the contract method 0x3d4c65f3.
+//
+// Solidity: function setBlockRewardAmountByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlockRewardAmountByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlockRewardAmountByBytes", _value)
+}
+
+// SetBlockRewardAmountByBytes is a paid mutator transaction binding the contract method 0x3d4c65f3.
+//
+// Solidity: function setBlockRewardAmountByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlockRewardAmountByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardAmountByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockRewardAmountByBytes is a paid mutator transaction binding the contract method 0x3d4c65f3.
+//
+// Solidity: function setBlockRewardAmountByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlockRewardAmountByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardAmountByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockRewardDistributionMethod is a paid mutator transaction binding the contract method 0x0add66dd.
+//
+// Solidity: function setBlockRewardDistributionMethod(uint256 _block_producer, uint256 _staking_reward, uint256 _ecofund, uint256 _maintanance) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlockRewardDistributionMethod(opts *bind.TransactOpts, _block_producer *big.Int, _staking_reward *big.Int, _ecofund *big.Int, _maintanance *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlockRewardDistributionMethod", _block_producer, _staking_reward, _ecofund, _maintanance)
+}
+
+// SetBlockRewardDistributionMethod is a paid mutator transaction binding the contract method 0x0add66dd.
+//
+// Solidity: function setBlockRewardDistributionMethod(uint256 _block_producer, uint256 _staking_reward, uint256 _ecofund, uint256 _maintanance) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlockRewardDistributionMethod(_block_producer *big.Int, _staking_reward *big.Int, _ecofund *big.Int, _maintanance *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardDistributionMethod(&_EnvStorageImp.TransactOpts, _block_producer, _staking_reward, _ecofund, _maintanance)
+}
+
+// SetBlockRewardDistributionMethod is a paid mutator transaction binding the contract method 0x0add66dd.
+//
+// Solidity: function setBlockRewardDistributionMethod(uint256 _block_producer, uint256 _staking_reward, uint256 _ecofund, uint256 _maintanance) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlockRewardDistributionMethod(_block_producer *big.Int, _staking_reward *big.Int, _ecofund *big.Int, _maintanance *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardDistributionMethod(&_EnvStorageImp.TransactOpts, _block_producer, _staking_reward, _ecofund, _maintanance)
+}
+
+// SetBlockRewardDistributionMethodByBytes is a paid mutator transaction binding the contract method 0x8c8b887e.
+//
+// Solidity: function setBlockRewardDistributionMethodByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlockRewardDistributionMethodByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlockRewardDistributionMethodByBytes", _value)
+}
+
+// SetBlockRewardDistributionMethodByBytes is a paid mutator transaction binding the contract method 0x8c8b887e.
+//
+// Solidity: function setBlockRewardDistributionMethodByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlockRewardDistributionMethodByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardDistributionMethodByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlockRewardDistributionMethodByBytes is a paid mutator transaction binding the contract method 0x8c8b887e.
+//
+// Solidity: function setBlockRewardDistributionMethodByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlockRewardDistributionMethodByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlockRewardDistributionMethodByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlocksPer is a paid mutator transaction binding the contract method 0x79e85859.
+//
+// Solidity: function setBlocksPer(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlocksPer(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlocksPer", _value)
+}
+
+// SetBlocksPer is a paid mutator transaction binding the contract method 0x79e85859.
+//
+// Solidity: function setBlocksPer(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlocksPer(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlocksPer(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlocksPer is a paid mutator transaction binding the contract method 0x79e85859.
+//
+// Solidity: function setBlocksPer(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlocksPer(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlocksPer(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlocksPerByBytes is a paid mutator transaction binding the contract method 0x3e8daafe.
+//
+// Solidity: function setBlocksPerByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetBlocksPerByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setBlocksPerByBytes", _value)
+}
+
+// SetBlocksPerByBytes is a paid mutator transaction binding the contract method 0x3e8daafe.
+//
+// Solidity: function setBlocksPerByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetBlocksPerByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlocksPerByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetBlocksPerByBytes is a paid mutator transaction binding the contract method 0x3e8daafe.
+//
+// Solidity: function setBlocksPerByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetBlocksPerByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetBlocksPerByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetGasLimitAndBaseFee is a paid mutator transaction binding the contract method 0x0fca11d2.
+//
+// Solidity: function setGasLimitAndBaseFee(uint256 _block_GasLimit, uint256 _baseFeeMaxChangeRate, uint256 _gasTargetPercentage, uint256 _maxBaseFee) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetGasLimitAndBaseFee(opts *bind.TransactOpts, _block_GasLimit *big.Int, _baseFeeMaxChangeRate *big.Int, _gasTargetPercentage *big.Int, _maxBaseFee *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setGasLimitAndBaseFee", _block_GasLimit, _baseFeeMaxChangeRate, _gasTargetPercentage, _maxBaseFee)
+}
+
+// SetGasLimitAndBaseFee is a paid mutator transaction binding the contract method 0x0fca11d2.
+//
+// Solidity: function setGasLimitAndBaseFee(uint256 _block_GasLimit, uint256 _baseFeeMaxChangeRate, uint256 _gasTargetPercentage, uint256 _maxBaseFee) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetGasLimitAndBaseFee(_block_GasLimit *big.Int, _baseFeeMaxChangeRate *big.Int, _gasTargetPercentage *big.Int, _maxBaseFee *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetGasLimitAndBaseFee(&_EnvStorageImp.TransactOpts, _block_GasLimit, _baseFeeMaxChangeRate, _gasTargetPercentage, _maxBaseFee)
+}
+
+// SetGasLimitAndBaseFee is a paid mutator transaction binding the contract method 0x0fca11d2.
+//
+// Solidity: function setGasLimitAndBaseFee(uint256 _block_GasLimit, uint256 _baseFeeMaxChangeRate, uint256 _gasTargetPercentage, uint256 _maxBaseFee) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetGasLimitAndBaseFee(_block_GasLimit *big.Int, _baseFeeMaxChangeRate *big.Int, _gasTargetPercentage *big.Int, _maxBaseFee *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetGasLimitAndBaseFee(&_EnvStorageImp.TransactOpts, _block_GasLimit, _baseFeeMaxChangeRate, _gasTargetPercentage, _maxBaseFee)
+}
+
+// SetGasLimitAndBaseFeeByBytes is a paid mutator transaction binding the contract method 0xbe33732a.
+//
+// Solidity: function setGasLimitAndBaseFeeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetGasLimitAndBaseFeeByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setGasLimitAndBaseFeeByBytes", _value)
+}
+
+// SetGasLimitAndBaseFeeByBytes is a paid mutator transaction binding the contract method 0xbe33732a.
+//
+// Solidity: function setGasLimitAndBaseFeeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetGasLimitAndBaseFeeByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetGasLimitAndBaseFeeByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetGasLimitAndBaseFeeByBytes is a paid mutator transaction binding the contract method 0xbe33732a.
+//
+// Solidity: function setGasLimitAndBaseFeeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetGasLimitAndBaseFeeByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetGasLimitAndBaseFeeByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxBaseFee is a paid mutator transaction binding the contract method 0x6fe13177.
+//
+// Solidity: function setMaxBaseFee(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetMaxBaseFee(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setMaxBaseFee", _value)
+}
+
+// SetMaxBaseFee is a paid mutator transaction binding the contract method 0x6fe13177.
+//
+// Solidity: function setMaxBaseFee(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetMaxBaseFee(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxBaseFee(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxBaseFee is a paid mutator transaction binding the contract method 0x6fe13177.
+//
+// Solidity: function setMaxBaseFee(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetMaxBaseFee(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxBaseFee(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxBaseFeeByBytes is a paid mutator transaction binding the contract method 0x408d79cf.
+//
+// Solidity: function setMaxBaseFeeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetMaxBaseFeeByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setMaxBaseFeeByBytes", _value)
+}
+
+// SetMaxBaseFeeByBytes is a paid mutator transaction binding the contract method 0x408d79cf.
+//
+// Solidity: function setMaxBaseFeeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetMaxBaseFeeByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxBaseFeeByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxBaseFeeByBytes is a paid mutator transaction binding the contract method 0x408d79cf.
+//
+// Solidity: function setMaxBaseFeeByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetMaxBaseFeeByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxBaseFeeByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxIdleBlockInterval is a paid mutator transaction binding the contract method 0xf6fd7129.
+//
+// Solidity: function setMaxIdleBlockInterval(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetMaxIdleBlockInterval(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setMaxIdleBlockInterval", _value)
+}
+
+// SetMaxIdleBlockInterval is a paid mutator transaction binding the contract method 0xf6fd7129.
+//
+// Solidity: function setMaxIdleBlockInterval(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetMaxIdleBlockInterval(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxIdleBlockInterval(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxIdleBlockInterval is a paid mutator transaction binding the contract method 0xf6fd7129.
+//
+// Solidity: function setMaxIdleBlockInterval(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetMaxIdleBlockInterval(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxIdleBlockInterval(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxIdleBlockIntervalByBytes is a paid mutator transaction binding the contract method 0x0b90a39a.
+//
+// Solidity: function setMaxIdleBlockIntervalByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetMaxIdleBlockIntervalByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setMaxIdleBlockIntervalByBytes", _value)
+}
+
+// SetMaxIdleBlockIntervalByBytes is a paid mutator transaction binding the contract method 0x0b90a39a.
+//
+// Solidity: function setMaxIdleBlockIntervalByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetMaxIdleBlockIntervalByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxIdleBlockIntervalByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxIdleBlockIntervalByBytes is a paid mutator transaction binding the contract method 0x0b90a39a.
+//
+// Solidity: function setMaxIdleBlockIntervalByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetMaxIdleBlockIntervalByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxIdleBlockIntervalByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxPriorityFeePerGas is a paid mutator transaction binding the contract method 0xec3df879.
+//
+// Solidity: function setMaxPriorityFeePerGas(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetMaxPriorityFeePerGas(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setMaxPriorityFeePerGas", _value)
+}
+
+// SetMaxPriorityFeePerGas is a paid mutator transaction binding the contract method 0xec3df879.
+//
+// Solidity: function setMaxPriorityFeePerGas(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetMaxPriorityFeePerGas(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxPriorityFeePerGas(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxPriorityFeePerGas is a paid mutator transaction binding the contract method 0xec3df879.
+//
+// Solidity: function setMaxPriorityFeePerGas(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetMaxPriorityFeePerGas(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxPriorityFeePerGas(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxPriorityFeePerGasByBytes is a paid mutator transaction binding the contract method 0x0fc238bf.
+//
+// Solidity: function setMaxPriorityFeePerGasByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetMaxPriorityFeePerGasByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setMaxPriorityFeePerGasByBytes", _value)
+}
+
+// SetMaxPriorityFeePerGasByBytes is a paid mutator transaction binding the contract method 0x0fc238bf.
+//
+// Solidity: function setMaxPriorityFeePerGasByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetMaxPriorityFeePerGasByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxPriorityFeePerGasByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetMaxPriorityFeePerGasByBytes is a paid mutator transaction binding the contract method 0x0fc238bf.
+//
+// Solidity: function setMaxPriorityFeePerGasByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetMaxPriorityFeePerGasByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetMaxPriorityFeePerGasByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetRegistry(opts *bind.TransactOpts, _addr common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setRegistry", _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetRegistry(&_EnvStorageImp.TransactOpts, _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetRegistry(&_EnvStorageImp.TransactOpts, _addr)
+}
+
+// SetStakingMax is a paid mutator transaction binding the contract method 0xf8214591.
+//
+// Solidity: function setStakingMax(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetStakingMax(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setStakingMax", _value)
+}
+
+// SetStakingMax is a paid mutator transaction binding the contract method 0xf8214591.
+//
+// Solidity: function setStakingMax(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetStakingMax(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMax(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMax is a paid mutator transaction binding the contract method 0xf8214591.
+//
+// Solidity: function setStakingMax(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetStakingMax(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMax(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMaxByBytes is a paid mutator transaction binding the contract method 0x2eccd832.
+//
+// Solidity: function setStakingMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetStakingMaxByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setStakingMaxByBytes", _value)
+}
+
+// SetStakingMaxByBytes is a paid mutator transaction binding the contract method 0x2eccd832.
+//
+// Solidity: function setStakingMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetStakingMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMaxByBytes is a paid mutator transaction binding the contract method 0x2eccd832.
+//
+// Solidity: function setStakingMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetStakingMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMin is a paid mutator transaction binding the contract method 0x2eb57c65.
+//
+// Solidity: function setStakingMin(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetStakingMin(opts *bind.TransactOpts, _value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setStakingMin", _value)
+}
+
+// SetStakingMin is a paid mutator transaction binding the contract method 0x2eb57c65.
+//
+// Solidity: function setStakingMin(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetStakingMin(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMin(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMin is a paid mutator transaction binding the contract method 0x2eb57c65.
+//
+// Solidity: function setStakingMin(uint256 _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetStakingMin(_value *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMin(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMinByBytes is a paid mutator transaction binding the contract method 0x71c6960d.
+//
+// Solidity: function setStakingMinByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetStakingMinByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setStakingMinByBytes", _value)
+}
+
+// SetStakingMinByBytes is a paid mutator transaction binding the contract method 0x71c6960d.
+//
+// Solidity: function setStakingMinByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetStakingMinByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMinByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMinByBytes is a paid mutator transaction binding the contract method 0x71c6960d.
+//
+// Solidity: function setStakingMinByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetStakingMinByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMinByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMinMax is a paid mutator transaction binding the contract method 0x8d5cdd7e.
+//
+// Solidity: function setStakingMinMax(uint256 _min, uint256 _max) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetStakingMinMax(opts *bind.TransactOpts, _min *big.Int, _max *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setStakingMinMax", _min, _max)
+}
+
+// SetStakingMinMax is a paid mutator transaction binding the contract method 0x8d5cdd7e.
+//
+// Solidity: function setStakingMinMax(uint256 _min, uint256 _max) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetStakingMinMax(_min *big.Int, _max *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMinMax(&_EnvStorageImp.TransactOpts, _min, _max)
+}
+
+// SetStakingMinMax is a paid mutator transaction binding the contract method 0x8d5cdd7e.
+//
+// Solidity: function setStakingMinMax(uint256 _min, uint256 _max) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetStakingMinMax(_min *big.Int, _max *big.Int) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMinMax(&_EnvStorageImp.TransactOpts, _min, _max)
+}
+
+// SetStakingMinMaxByBytes is a paid mutator transaction binding the contract method 0xe078869f.
+//
+// Solidity: function setStakingMinMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetStakingMinMaxByBytes(opts *bind.TransactOpts, _value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setStakingMinMaxByBytes", _value)
+}
+
+// SetStakingMinMaxByBytes is a paid mutator transaction binding the contract method 0xe078869f.
+//
+// Solidity: function setStakingMinMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetStakingMinMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMinMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetStakingMinMaxByBytes is a paid mutator transaction binding the contract method 0xe078869f.
+//
+// Solidity: function setStakingMinMaxByBytes(bytes _value) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetStakingMinMaxByBytes(_value []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetStakingMinMaxByBytes(&_EnvStorageImp.TransactOpts, _value)
+}
+
+// SetVariable is a paid mutator transaction binding the contract method 0x88c28019.
+//
+// Solidity: function setVariable(bytes32 envKey, bytes envVal) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) SetVariable(opts *bind.TransactOpts, envKey [32]byte, envVal []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "setVariable", envKey, envVal)
+}
+
+// SetVariable is a paid mutator transaction binding the contract method 0x88c28019.
+//
+// Solidity: function setVariable(bytes32 envKey, bytes envVal) returns()
+func (_EnvStorageImp *EnvStorageImpSession) SetVariable(envKey [32]byte, envVal []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetVariable(&_EnvStorageImp.TransactOpts, envKey, envVal)
+}
+
+// SetVariable is a paid mutator transaction binding the contract method 0x88c28019.
+//
+// Solidity: function setVariable(bytes32 envKey, bytes envVal) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) SetVariable(envKey [32]byte, envVal []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.SetVariable(&_EnvStorageImp.TransactOpts, envKey, envVal)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_EnvStorageImp *EnvStorageImpSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.TransferOwnership(&_EnvStorageImp.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.TransferOwnership(&_EnvStorageImp.TransactOpts, newOwner)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_EnvStorageImp *EnvStorageImpSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.UpgradeTo(&_EnvStorageImp.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.UpgradeTo(&_EnvStorageImp.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_EnvStorageImp *EnvStorageImpTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_EnvStorageImp *EnvStorageImpSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.UpgradeToAndCall(&_EnvStorageImp.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_EnvStorageImp *EnvStorageImpTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _EnvStorageImp.Contract.UpgradeToAndCall(&_EnvStorageImp.TransactOpts, newImplementation, data)
+}
+
+// EnvStorageImpAddressVarableChangedIterator is returned from FilterAddressVarableChanged and is used to iterate over the raw logs and unpacked data for AddressVarableChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpAddressVarableChangedIterator struct {
+	Event *EnvStorageImpAddressVarableChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpAddressVarableChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpAddressVarableChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpAddressVarableChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpAddressVarableChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpAddressVarableChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpAddressVarableChanged represents a AddressVarableChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpAddressVarableChanged struct {
+	Name  [32]byte
+	Value common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddressVarableChanged is a free log retrieval operation binding the contract event 0x5ba696c1849fe23c6b280f9f6057cb4574c818e0cd542032eed6e211f1e02464.
+//
+// Solidity: event AddressVarableChanged(bytes32 indexed _name, address _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterAddressVarableChanged(opts *bind.FilterOpts, _name [][32]byte) (*EnvStorageImpAddressVarableChangedIterator, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "AddressVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpAddressVarableChangedIterator{contract: _EnvStorageImp.contract, event: "AddressVarableChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAddressVarableChanged is a free log subscription operation binding the contract event 0x5ba696c1849fe23c6b280f9f6057cb4574c818e0cd542032eed6e211f1e02464.
+//
+// Solidity: event AddressVarableChanged(bytes32 indexed _name, address _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchAddressVarableChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpAddressVarableChanged, _name [][32]byte) (event.Subscription, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "AddressVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpAddressVarableChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "AddressVarableChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddressVarableChanged is a log parse operation binding the contract event 0x5ba696c1849fe23c6b280f9f6057cb4574c818e0cd542032eed6e211f1e02464.
+//
+// Solidity: event AddressVarableChanged(bytes32 indexed _name, address _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseAddressVarableChanged(log types.Log) (*EnvStorageImpAddressVarableChanged, error) {
+	event := new(EnvStorageImpAddressVarableChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "AddressVarableChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpAdminChangedIterator struct {
+	Event *EnvStorageImpAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpAdminChanged represents a AdminChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*EnvStorageImpAdminChangedIterator, error) {
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpAdminChangedIterator{contract: _EnvStorageImp.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpAdminChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseAdminChanged(log types.Log) (*EnvStorageImpAdminChanged, error) {
+	event := new(EnvStorageImpAdminChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the EnvStorageImp contract.
+type EnvStorageImpBeaconUpgradedIterator struct {
+	Event *EnvStorageImpBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpBeaconUpgraded represents a BeaconUpgraded event raised by the EnvStorageImp contract.
+type EnvStorageImpBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*EnvStorageImpBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpBeaconUpgradedIterator{contract: _EnvStorageImp.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *EnvStorageImpBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpBeaconUpgraded)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseBeaconUpgraded(log types.Log) (*EnvStorageImpBeaconUpgraded, error) {
+	event := new(EnvStorageImpBeaconUpgraded)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpBytes32VarableChangedIterator is returned from FilterBytes32VarableChanged and is used to iterate over the raw logs and unpacked data for Bytes32VarableChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpBytes32VarableChangedIterator struct {
+	Event *EnvStorageImpBytes32VarableChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpBytes32VarableChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpBytes32VarableChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpBytes32VarableChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpBytes32VarableChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpBytes32VarableChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpBytes32VarableChanged represents a Bytes32VarableChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpBytes32VarableChanged struct {
+	Name  [32]byte
+	Value [32]byte
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterBytes32VarableChanged is a free log retrieval operation binding the contract event 0xde32aec28e018560c62b0217a8e7cfdc094cda68f1fc7ecd4ed833ee1d4862c9.
+//
+// Solidity: event Bytes32VarableChanged(bytes32 indexed _name, bytes32 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterBytes32VarableChanged(opts *bind.FilterOpts, _name [][32]byte) (*EnvStorageImpBytes32VarableChangedIterator, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "Bytes32VarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpBytes32VarableChangedIterator{contract: _EnvStorageImp.contract, event: "Bytes32VarableChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchBytes32VarableChanged is a free log subscription operation binding the contract event 0xde32aec28e018560c62b0217a8e7cfdc094cda68f1fc7ecd4ed833ee1d4862c9.
+//
+// Solidity: event Bytes32VarableChanged(bytes32 indexed _name, bytes32 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchBytes32VarableChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpBytes32VarableChanged, _name [][32]byte) (event.Subscription, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "Bytes32VarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpBytes32VarableChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "Bytes32VarableChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBytes32VarableChanged is a log parse operation binding the contract event 0xde32aec28e018560c62b0217a8e7cfdc094cda68f1fc7ecd4ed833ee1d4862c9.
+//
+// Solidity: event Bytes32VarableChanged(bytes32 indexed _name, bytes32 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseBytes32VarableChanged(log types.Log) (*EnvStorageImpBytes32VarableChanged, error) {
+	event := new(EnvStorageImpBytes32VarableChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "Bytes32VarableChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpBytesVarableChangedIterator is returned from FilterBytesVarableChanged and is used to iterate over the raw logs and unpacked data for BytesVarableChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpBytesVarableChangedIterator struct {
+	Event *EnvStorageImpBytesVarableChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpBytesVarableChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpBytesVarableChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpBytesVarableChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpBytesVarableChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpBytesVarableChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpBytesVarableChanged represents a BytesVarableChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpBytesVarableChanged struct {
+	Name  [32]byte
+	Value []byte
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterBytesVarableChanged is a free log retrieval operation binding the contract event 0x871b63105583c40db6d530b9340369142c5ae265e41a501d300455015dc1283a.
+//
+// Solidity: event BytesVarableChanged(bytes32 indexed _name, bytes _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterBytesVarableChanged(opts *bind.FilterOpts, _name [][32]byte) (*EnvStorageImpBytesVarableChangedIterator, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "BytesVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpBytesVarableChangedIterator{contract: _EnvStorageImp.contract, event: "BytesVarableChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchBytesVarableChanged is a free log subscription operation binding the contract event 0x871b63105583c40db6d530b9340369142c5ae265e41a501d300455015dc1283a.
+//
+// Solidity: event BytesVarableChanged(bytes32 indexed _name, bytes _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchBytesVarableChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpBytesVarableChanged, _name [][32]byte) (event.Subscription, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "BytesVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpBytesVarableChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "BytesVarableChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBytesVarableChanged is a log parse operation binding the contract event 0x871b63105583c40db6d530b9340369142c5ae265e41a501d300455015dc1283a.
+//
+// Solidity: event BytesVarableChanged(bytes32 indexed _name, bytes _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseBytesVarableChanged(log types.Log) (*EnvStorageImpBytesVarableChanged, error) {
+	event := new(EnvStorageImpBytesVarableChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "BytesVarableChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the EnvStorageImp contract.
+type EnvStorageImpInitializedIterator struct {
+	Event *EnvStorageImpInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpInitialized represents a Initialized event raised by the EnvStorageImp contract.
+type EnvStorageImpInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterInitialized(opts *bind.FilterOpts) (*EnvStorageImpInitializedIterator, error) {
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpInitializedIterator{contract: _EnvStorageImp.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *EnvStorageImpInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpInitialized)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseInitialized(log types.Log) (*EnvStorageImpInitialized, error) {
+	event := new(EnvStorageImpInitialized)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpIntVarableChangedIterator is returned from FilterIntVarableChanged and is used to iterate over the raw logs and unpacked data for IntVarableChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpIntVarableChangedIterator struct {
+	Event *EnvStorageImpIntVarableChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpIntVarableChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpIntVarableChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpIntVarableChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpIntVarableChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpIntVarableChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpIntVarableChanged represents a IntVarableChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpIntVarableChanged struct {
+	Name  [32]byte
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterIntVarableChanged is a free log retrieval operation binding the contract event 0x3b274b11383ad4ca069e655ed60df9e4307b7a0f82ba2245a3cb9789ab814bf6.
+//
+// Solidity: event IntVarableChanged(bytes32 indexed _name, int256 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterIntVarableChanged(opts *bind.FilterOpts, _name [][32]byte) (*EnvStorageImpIntVarableChangedIterator, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "IntVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpIntVarableChangedIterator{contract: _EnvStorageImp.contract, event: "IntVarableChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchIntVarableChanged is a free log subscription operation binding the contract event 0x3b274b11383ad4ca069e655ed60df9e4307b7a0f82ba2245a3cb9789ab814bf6.
+//
+// Solidity: event IntVarableChanged(bytes32 indexed _name, int256 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchIntVarableChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpIntVarableChanged, _name [][32]byte) (event.Subscription, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "IntVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpIntVarableChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "IntVarableChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIntVarableChanged is a log parse operation binding the contract event 0x3b274b11383ad4ca069e655ed60df9e4307b7a0f82ba2245a3cb9789ab814bf6.
+//
+// Solidity: event IntVarableChanged(bytes32 indexed _name, int256 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseIntVarableChanged(log types.Log) (*EnvStorageImpIntVarableChanged, error) {
+	event := new(EnvStorageImpIntVarableChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "IntVarableChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the EnvStorageImp contract.
+type EnvStorageImpOwnershipTransferredIterator struct {
+	Event *EnvStorageImpOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpOwnershipTransferred represents a OwnershipTransferred event raised by the EnvStorageImp contract.
+type EnvStorageImpOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*EnvStorageImpOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpOwnershipTransferredIterator{contract: _EnvStorageImp.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *EnvStorageImpOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpOwnershipTransferred)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseOwnershipTransferred(log types.Log) (*EnvStorageImpOwnershipTransferred, error) {
+	event := new(EnvStorageImpOwnershipTransferred)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpStringVarableChangedIterator is returned from FilterStringVarableChanged and is used to iterate over the raw logs and unpacked data for StringVarableChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpStringVarableChangedIterator struct {
+	Event *EnvStorageImpStringVarableChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpStringVarableChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpStringVarableChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpStringVarableChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpStringVarableChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpStringVarableChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpStringVarableChanged represents a StringVarableChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpStringVarableChanged struct {
+	Name  [32]byte
+	Value string
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterStringVarableChanged is a free log retrieval operation binding the contract event 0x43849fbc1e66a5d2cd0ace04916e2e6c8f314c1904d104cad97150428a370a41.
+//
+// Solidity: event StringVarableChanged(bytes32 indexed _name, string _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterStringVarableChanged(opts *bind.FilterOpts, _name [][32]byte) (*EnvStorageImpStringVarableChangedIterator, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "StringVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpStringVarableChangedIterator{contract: _EnvStorageImp.contract, event: "StringVarableChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchStringVarableChanged is a free log subscription operation binding the contract event 0x43849fbc1e66a5d2cd0ace04916e2e6c8f314c1904d104cad97150428a370a41.
+//
+// Solidity: event StringVarableChanged(bytes32 indexed _name, string _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchStringVarableChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpStringVarableChanged, _name [][32]byte) (event.Subscription, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "StringVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpStringVarableChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "StringVarableChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStringVarableChanged is a log parse operation binding the contract event 0x43849fbc1e66a5d2cd0ace04916e2e6c8f314c1904d104cad97150428a370a41.
+//
+// Solidity: event StringVarableChanged(bytes32 indexed _name, string _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseStringVarableChanged(log types.Log) (*EnvStorageImpStringVarableChanged, error) {
+	event := new(EnvStorageImpStringVarableChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "StringVarableChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpUintVarableChangedIterator is returned from FilterUintVarableChanged and is used to iterate over the raw logs and unpacked data for UintVarableChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpUintVarableChangedIterator struct {
+	Event *EnvStorageImpUintVarableChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpUintVarableChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpUintVarableChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpUintVarableChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpUintVarableChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpUintVarableChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpUintVarableChanged represents a UintVarableChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpUintVarableChanged struct {
+	Name  [32]byte
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterUintVarableChanged is a free log retrieval operation binding the contract event 0x58d7c10adfd5778016889c15d422d57f2975b9292415b54fe5d8cd4241200612.
+//
+// Solidity: event UintVarableChanged(bytes32 indexed _name, uint256 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterUintVarableChanged(opts *bind.FilterOpts, _name [][32]byte) (*EnvStorageImpUintVarableChangedIterator, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "UintVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpUintVarableChangedIterator{contract: _EnvStorageImp.contract, event: "UintVarableChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchUintVarableChanged is a free log subscription operation binding the contract event 0x58d7c10adfd5778016889c15d422d57f2975b9292415b54fe5d8cd4241200612.
+//
+// Solidity: event UintVarableChanged(bytes32 indexed _name, uint256 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchUintVarableChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpUintVarableChanged, _name [][32]byte) (event.Subscription, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "UintVarableChanged", _nameRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpUintVarableChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "UintVarableChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUintVarableChanged is a log parse operation binding the contract event 0x58d7c10adfd5778016889c15d422d57f2975b9292415b54fe5d8cd4241200612.
+//
+// Solidity: event UintVarableChanged(bytes32 indexed _name, uint256 _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseUintVarableChanged(log types.Log) (*EnvStorageImpUintVarableChanged, error) {
+	event := new(EnvStorageImpUintVarableChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "UintVarableChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the EnvStorageImp contract.
+type EnvStorageImpUpgradedIterator struct {
+	Event *EnvStorageImpUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpUpgraded represents a Upgraded event raised by the EnvStorageImp contract.
+type EnvStorageImpUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*EnvStorageImpUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpUpgradedIterator{contract: _EnvStorageImp.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *EnvStorageImpUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpUpgraded)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseUpgraded(log types.Log) (*EnvStorageImpUpgraded, error) {
+	event := new(EnvStorageImpUpgraded)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EnvStorageImpVarableChangedIterator is returned from FilterVarableChanged and is used to iterate over the raw logs and unpacked data for VarableChanged events raised by the EnvStorageImp contract.
+type EnvStorageImpVarableChangedIterator struct {
+	Event *EnvStorageImpVarableChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnvStorageImpVarableChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnvStorageImpVarableChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnvStorageImpVarableChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnvStorageImpVarableChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnvStorageImpVarableChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EnvStorageImpVarableChanged represents a VarableChanged event raised by the EnvStorageImp contract.
+type EnvStorageImpVarableChanged struct {
+	Name  [32]byte
+	Type  *big.Int
+	Value string
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterVarableChanged is a free log retrieval operation binding the contract event 0x60841501cd68d1c6414ed8f47a579de24c7e2f9da7e97f10c4932196d92df402.
+//
+// Solidity: event VarableChanged(bytes32 indexed _name, uint256 indexed _type, string _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) FilterVarableChanged(opts *bind.FilterOpts, _name [][32]byte, _type []*big.Int) (*EnvStorageImpVarableChangedIterator, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+	var _typeRule []interface{}
+	for _, _typeItem := range _type {
+		_typeRule = append(_typeRule, _typeItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.FilterLogs(opts, "VarableChanged", _nameRule, _typeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvStorageImpVarableChangedIterator{contract: _EnvStorageImp.contract, event: "VarableChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchVarableChanged is a free log subscription operation binding the contract event 0x60841501cd68d1c6414ed8f47a579de24c7e2f9da7e97f10c4932196d92df402.
+//
+// Solidity: event VarableChanged(bytes32 indexed _name, uint256 indexed _type, string _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) WatchVarableChanged(opts *bind.WatchOpts, sink chan<- *EnvStorageImpVarableChanged, _name [][32]byte, _type []*big.Int) (event.Subscription, error) {
+
+	var _nameRule []interface{}
+	for _, _nameItem := range _name {
+		_nameRule = append(_nameRule, _nameItem)
+	}
+	var _typeRule []interface{}
+	for _, _typeItem := range _type {
+		_typeRule = append(_typeRule, _typeItem)
+	}
+
+	logs, sub, err := _EnvStorageImp.contract.WatchLogs(opts, "VarableChanged", _nameRule, _typeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EnvStorageImpVarableChanged)
+				if err := _EnvStorageImp.contract.UnpackLog(event, "VarableChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVarableChanged is a log parse operation binding the contract event 0x60841501cd68d1c6414ed8f47a579de24c7e2f9da7e97f10c4932196d92df402.
+//
+// Solidity: event VarableChanged(bytes32 indexed _name, uint256 indexed _type, string _value)
+func (_EnvStorageImp *EnvStorageImpFilterer) ParseVarableChanged(log types.Log) (*EnvStorageImpVarableChanged, error) {
+	event := new(EnvStorageImpVarableChanged)
+	if err := _EnvStorageImp.contract.UnpackLog(event, "VarableChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovMetaData contains all meta data concerning the Gov contract.
+var GovMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_imp\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"implementation\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x608060405234801561001057600080fd5b506040516106e83803806106e883398101604081905261002f916102f7565b80604051806020016040528060008152506100528282600061005a60201b60201c565b50505061039b565b61006383610090565b6000825111806100705750805b1561008b5761008983836100d060201b61008b1760201c565b505b505050565b610099816100fc565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b60606100f583836040518060600160405280602781526020016106c1602791396101ce565b9392505050565b61010f816102ac60201b6100b71760201c565b6101765760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b60648201526084015b60405180910390fd5b806101ad7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc60001b6102bb60201b6100c61760201c565b80546001600160a01b0319166001600160a01b039290921691909117905550565b60606001600160a01b0384163b6102365760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b606482015260840161016d565b600080856001600160a01b0316856040516102519190610320565b600060405180830381855af49150503d806000811461028c576040519150601f19603f3d011682016040523d82523d6000602084013e610291565b606091505b5090925090506102a28282866102be565b9695505050505050565b6001600160a01b03163b151590565b90565b606083156102cd5750816100f5565b8251156102dd5782518084602001fd5b8160405162461bcd60e51b815260040161016d919061033c565b60006020828403121561030957600080fd5b81516001600160a01b03811681146100f557600080fd5b6000825161033281846020870161036f565b9190910192915050565b602081526000825180602084015261035b81604085016020870161036f565b601f01601f19169190910160400192915050565b60005b8381101561038a578181015183820152602001610372565b838111156100895750506000910152565b610317806103aa6000396000f3fe6080604052600436106100225760003560e01c80635c60da1b1461003957610031565b366100315761002f61006a565b005b61002f61006a565b34801561004557600080fd5b5061004e61007c565b6040516001600160a01b03909116815260200160405180910390f35b61007a6100756100c9565b6100fc565b565b60006100866100c9565b905090565b60606100b083836040518060600160405280602781526020016102bb60279139610120565b9392505050565b6001600160a01b03163b151590565b90565b60006100867f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc546001600160a01b031690565b3660008037600080366000845af43d6000803e80801561011b573d6000f35b3d6000fd5b60606001600160a01b0384163b61018d5760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b60648201526084015b60405180910390fd5b600080856001600160a01b0316856040516101a8919061023b565b600060405180830381855af49150503d80600081146101e3576040519150601f19603f3d011682016040523d82523d6000602084013e6101e8565b606091505b50915091506101f8828286610202565b9695505050505050565b606083156102115750816100b0565b8251156102215782518084602001fd5b8160405162461bcd60e51b81526004016101849190610257565b6000825161024d81846020870161028a565b9190910192915050565b602081526000825180602084015261027681604085016020870161028a565b601f01601f19169190910160400192915050565b60005b838110156102a557818101518382015260200161028d565b838111156102b4576000848401525b5050505056fe416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a2646970667358221220a87b6cbdb1d13462063f4eb23d79e1f1d995d27c9c3820da1b0ccc8d4103131364736f6c63430008060033416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564",
+}
+
+// GovABI is the input ABI used to generate the binding from.
+// Deprecated: Use GovMetaData.ABI instead.
+var GovABI = GovMetaData.ABI
+
+// GovBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use GovMetaData.Bin instead.
+var GovBin = GovMetaData.Bin
+
+// DeployGov deploys a new Ethereum contract, binding an instance of Gov to it.
+func DeployGov(auth *bind.TransactOpts, backend bind.ContractBackend, _imp common.Address) (common.Address, *types.Transaction, *Gov, error) {
+	parsed, err := GovMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GovBin), backend, _imp)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Gov{GovCaller: GovCaller{contract: contract}, GovTransactor: GovTransactor{contract: contract}, GovFilterer: GovFilterer{contract: contract}}, nil
+}
+
+// Gov is an auto generated Go binding around an Ethereum contract.
+type Gov struct {
+	GovCaller     // Read-only binding to the contract
+	GovTransactor // Write-only binding to the contract
+	GovFilterer   // Log filterer for contract events
+}
+
+// GovCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovSession struct {
+	Contract     *Gov              // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovCallerSession struct {
+	Contract *GovCaller    // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// GovTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovTransactorSession struct {
+	Contract     *GovTransactor    // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovRaw struct {
+	Contract *Gov // Generic contract binding to access the raw methods on
+}
+
+// GovCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovCallerRaw struct {
+	Contract *GovCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovTransactorRaw struct {
+	Contract *GovTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGov creates a new instance of Gov, bound to a specific deployed contract.
+func NewGov(address common.Address, backend bind.ContractBackend) (*Gov, error) {
+	contract, err := bindGov(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Gov{GovCaller: GovCaller{contract: contract}, GovTransactor: GovTransactor{contract: contract}, GovFilterer: GovFilterer{contract: contract}}, nil
+}
+
+// NewGovCaller creates a new read-only instance of Gov, bound to a specific deployed contract.
+func NewGovCaller(address common.Address, caller bind.ContractCaller) (*GovCaller, error) {
+	contract, err := bindGov(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovCaller{contract: contract}, nil
+}
+
+// NewGovTransactor creates a new write-only instance of Gov, bound to a specific deployed contract.
+func NewGovTransactor(address common.Address, transactor bind.ContractTransactor) (*GovTransactor, error) {
+	contract, err := bindGov(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovTransactor{contract: contract}, nil
+}
+
+// NewGovFilterer creates a new log filterer instance of Gov, bound to a specific deployed contract.
+func NewGovFilterer(address common.Address, filterer bind.ContractFilterer) (*GovFilterer, error) {
+	contract, err := bindGov(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovFilterer{contract: contract}, nil
+}
+
+// bindGov binds a generic wrapper to an already deployed contract.
+func bindGov(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gov *GovRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gov.Contract.GovCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gov *GovRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gov.Contract.GovTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gov *GovRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gov.Contract.GovTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gov *GovCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gov.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gov *GovTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gov.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gov *GovTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gov.Contract.contract.Transact(opts, method, params...)
+}
+
+// Implementation is a free data retrieval call binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() view returns(address)
+func (_Gov *GovCaller) Implementation(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Gov.contract.Call(opts, &out, "implementation")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Implementation is a free data retrieval call binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() view returns(address)
+func (_Gov *GovSession) Implementation() (common.Address, error) {
+	return _Gov.Contract.Implementation(&_Gov.CallOpts)
+}
+
+// Implementation is a free data retrieval call binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() view returns(address)
+func (_Gov *GovCallerSession) Implementation() (common.Address, error) {
+	return _Gov.Contract.Implementation(&_Gov.CallOpts)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_Gov *GovTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _Gov.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_Gov *GovSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _Gov.Contract.Fallback(&_Gov.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_Gov *GovTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _Gov.Contract.Fallback(&_Gov.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Gov *GovTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gov.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Gov *GovSession) Receive() (*types.Transaction, error) {
+	return _Gov.Contract.Receive(&_Gov.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Gov *GovTransactorSession) Receive() (*types.Transaction, error) {
+	return _Gov.Contract.Receive(&_Gov.TransactOpts)
+}
+
+// GovAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the Gov contract.
+type GovAdminChangedIterator struct {
+	Event *GovAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovAdminChanged represents a AdminChanged event raised by the Gov contract.
+type GovAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_Gov *GovFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*GovAdminChangedIterator, error) {
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &GovAdminChangedIterator{contract: _Gov.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_Gov *GovFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *GovAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovAdminChanged)
+				if err := _Gov.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_Gov *GovFilterer) ParseAdminChanged(log types.Log) (*GovAdminChanged, error) {
+	event := new(GovAdminChanged)
+	if err := _Gov.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the Gov contract.
+type GovBeaconUpgradedIterator struct {
+	Event *GovBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovBeaconUpgraded represents a BeaconUpgraded event raised by the Gov contract.
+type GovBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_Gov *GovFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*GovBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovBeaconUpgradedIterator{contract: _Gov.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_Gov *GovFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *GovBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovBeaconUpgraded)
+				if err := _Gov.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_Gov *GovFilterer) ParseBeaconUpgraded(log types.Log) (*GovBeaconUpgraded, error) {
+	event := new(GovBeaconUpgraded)
+	if err := _Gov.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the Gov contract.
+type GovUpgradedIterator struct {
+	Event *GovUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovUpgraded represents a Upgraded event raised by the Gov contract.
+type GovUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_Gov *GovFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*GovUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovUpgradedIterator{contract: _Gov.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_Gov *GovFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *GovUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovUpgraded)
+				if err := _Gov.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_Gov *GovFilterer) ParseUpgraded(log types.Log) (*GovUpgraded, error) {
+	event := new(GovUpgraded)
+	if err := _Gov.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpMetaData contains all meta data concerning the GovImp contract.
+var GovImpMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"envName\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"envType\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"envVal\",\"type\":\"bytes\"}],\"name\":\"EnvChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"}],\"name\":\"MemberAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldAddr\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newAddr\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newVoter\",\"type\":\"address\"}],\"name\":\"MemberChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"}],\"name\":\"MemberRemoved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"}],\"name\":\"MemberUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"ballotId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"NotApplicable\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BALLOT_DURATION_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BALLOT_DURATION_MIN_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BALLOT_DURATION_MIN_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BALLOT_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BASE_FEE_MAX_CHANGE_RATE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCKS_PER_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_CREATION_TIME_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_GASLIMIT_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_AMOUNT_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_METHOD_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DENOMINATOR\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ENV_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GASLIMIT_AND_BASE_FEE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GAS_TARGET_PERCENTAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GOV_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAINTENANCE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_BASE_FEE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_IDLE_BLOCK_INTERVAL_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_PRIORITY_FEE_PER_GAS_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REWARD_POOL_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_MIN_MAX_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_MIN_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"reward\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"name\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"enode\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ip\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"port\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockAmount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"memo\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"internalType\":\"structGovImp.MemberInfo\",\"name\":\"info\",\"type\":\"tuple\"}],\"name\":\"addProposalToAddMember\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"ballotIdx\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"envName\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"envType\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"envVal\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"memo\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"name\":\"addProposalToChangeEnv\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"ballotIdx\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newGovAddr\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"memo\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"name\":\"addProposalToChangeGov\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"ballotIdx\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"reward\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"name\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"enode\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ip\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"port\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockAmount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"memo\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"internalType\":\"structGovImp.MemberInfo\",\"name\":\"newInfo\",\"type\":\"tuple\"},{\"internalType\":\"address\",\"name\":\"oldStaker\",\"type\":\"address\"}],\"name\":\"addProposalToChangeMember\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"ballotIdx\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"lockAmount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"memo\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"name\":\"addProposalToRemoveMember\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"ballotIdx\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ballotLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBallotInVoting\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxStaking\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxVotingDuration\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"idx\",\"type\":\"uint256\"}],\"name\":\"getMember\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"idx\",\"type\":\"uint256\"}],\"name\":\"getMemberFromNodeIdx\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMemberLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinStaking\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinVotingDuration\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"idx\",\"type\":\"uint256\"}],\"name\":\"getNode\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"name\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"enode\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ip\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"port\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"getNodeIdxFromMember\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNodeLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"idx\",\"type\":\"uint256\"}],\"name\":\"getReward\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"idx\",\"type\":\"uint256\"}],\"name\":\"getVoter\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"registry\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"lockAmount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"name\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"enode\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"ip\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"port\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"registry\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"initOnce\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isMember\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isStaker\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isVoter\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"modifiedBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"reg\",\"outputs\":[{\"internalType\":\"contractIRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"rewardIdx\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"setRegistry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"stakerIdx\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"ballotIdx\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"approval\",\"type\":\"bool\"}],\"name\":\"vote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"voteLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"voterIdx\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x60a06040523060601b60805234801561001757600080fd5b5060805160601c615f50620000536000396000818161151f0152818161155f01528181611e1901528181611e590152611ed50152615f506000f3fe6080604052600436106103e35760003560e01c80637d10dd1b11610208578063c42a0abc11610118578063d07bff0c116100ab578063de09b3771161007a578063de09b37714610da4578063e75235b814610db9578063e9523fb514610dce578063f2fde38b14610de4578063f38ecf4714610e0457600080fd5b8063d07bff0c14610d23578063d13a174414610d59578063d6f9cfce14610d79578063d965ea0014610d8f57600080fd5b8063ce04b9d4116100e7578063ce04b9d414610c8b578063ce6a54ff14610ca0578063ce93a23614610cd6578063cec5b62214610cf657600080fd5b8063c42a0abc14610bcf578063c6713baf14610c03578063c7d3da3414610c37578063c9d27afe14610c6b57600080fd5b8063a8915a3e1161019b578063ab3545e51161016a578063ab3545e514610ae8578063af6af2ff14610b1e578063b128f88014610b33578063c00ace6c14610b67578063c0b4fe1514610b9b57600080fd5b8063a8915a3e14610a47578063a91ee0dc14610a67578063a9b629b214610a87578063aaf0dd3614610abb57600080fd5b8063a0c12683116101d7578063a0c12683146109a6578063a230c524146109d3578063a6868b7d146109f3578063a7771ee314610a2757600080fd5b80637d10dd1b146109385780638da5cb5b1461094e578063918f86741461096c5780639986e4b91461098257600080fd5b80634bd1ed76116103035780636c78d2cf11610296578063715018a611610265578063715018a61461089957806372016f75146108ae578063738fdd1a146108c35780637b2bfb01146108e35780637bf465301461091757600080fd5b80636c78d2cf146107d85780636d583ca7146108015780636f1e8533146108355780636fde207a1461086557600080fd5b806352d1902d116102d257806352d1902d146107465780635aaa40401461075b5780636167eb4514610770578063656e3052146107a457600080fd5b80634bd1ed76146106ad5780634d273e28146106cf5780634f0f4aa9146107035780634f1ef2861461073357600080fd5b8063278bb12a1161037b57806336e83d831161034a57806336e83d831461060557806338294419146106255780633f35c8fe14610659578063406903531461068d57600080fd5b8063278bb12a1461056e5780632a203623146105a25780632f40992e146105c45780633659cfe6146105e557600080fd5b80631c150171116103b75780631c150171146104d15780631c4b774b146104e65780631e0cba0d1461051c578063238737b61461053a57600080fd5b806215a73b146103e85780630b1d39b81461042f5780630efa49091461046357806315bf6b4d14610483575b600080fd5b3480156103f457600080fd5b5061041c7f0c4fbe9dc9de15dd7c0d064975ee1a2f2f9b954fa0e65d4f6cddba94884bdc3e81565b6040519081526020015b60405180910390f35b34801561043b57600080fd5b5061041c7fdd5a41a7fc01f5c6d30816b17f638d6531625f1e1eaa599673ab2f6079f2dd9d81565b34801561046f57600080fd5b5061041c61047e366004615580565b610e38565b34801561048f57600080fd5b506104b961049e36600461586c565b6000908152607060205260409020546001600160a01b031690565b6040516001600160a01b039091168152602001610426565b3480156104dd57600080fd5b5061041c6111c2565b3480156104f257600080fd5b506104b961050136600461586c565b6000908152606a60205260409020546001600160a01b031690565b34801561052857600080fd5b5061041c665374616b696e6760c81b81565b34801561054657600080fd5b5061041c7f1d36f8ce53f59e624857e1d8dc7932d19981a2ea1b8faa4eb8ff843fc3e5a27881565b34801561057a57600080fd5b5061041c7f9b2e0c7fdae148f225bae7deb92d7e7bd24bb77edb12956e8fa7204900dd8a2281565b3480156105ae57600080fd5b506105c26105bd366004615531565b611241565b005b3480156105d057600080fd5b5061041c6914995dd85c99141bdbdb60b21b81565b3480156105f157600080fd5b506105c2610600366004615419565b611514565b34801561061157600080fd5b5061041c6106203660046157e7565b6115fa565b34801561063157600080fd5b5061041c7fbe90e461bbdb9a95a694f7796912ea04244caf7f5b60ad7ded17e16821d3e44c81565b34801561066557600080fd5b5061041c7f2a268972a70c8c688b62366bdfdd9bb09cf19d3e5b6e7e7bb158e671ffdcedd281565b34801561069957600080fd5b5061041c6106a8366004615769565b6119ac565b3480156106b957600080fd5b5061041c6a4d61696e74656e616e636560a81b81565b3480156106db57600080fd5b5061041c7f77884798208df1e64f70968be41ef2d3211ec53613397ca59313416813df088881565b34801561070f57600080fd5b5061072361071e36600461586c565b611c31565b604051610426949392919061599a565b6105c2610741366004615531565b611e0e565b34801561075257600080fd5b5061041c611ec8565b34801561076757600080fd5b5061041c611f7b565b34801561077c57600080fd5b5061041c7f9f1de481f899d76889aa8a2b44cc7b604d42691aa93d4ba618a1a1fd439f505081565b3480156107b057600080fd5b5061041c7fe10074dceffb75f13bf0ce50145afd35182d63796823f1280ce40e01c19109e781565b3480156107e457600080fd5b5061041c7111dbdd995c9b985b98d950dbdb9d1c9858dd60721b81565b34801561080d57600080fd5b5061041c7fc69fc6b7d0efc934fd5a3581c7253a7107a952526bb6dbcd814ef8d8dae1f44a81565b34801561084157600080fd5b50610855610850366004615419565b611fbd565b6040519015158152602001610426565b34801561087157600080fd5b5061041c7f0b09c9badbbeb6c813a598ee910770a39ccda797a1940439bb6e47fc6c87548b81565b3480156108a557600080fd5b506105c2611fda565b3480156108ba57600080fd5b5060715461041c565b3480156108cf57600080fd5b506065546104b9906001600160a01b031681565b3480156108ef57600080fd5b5061041c7f9346226931826838eedd13d9677fa33551e7c81f604b171ef3fac355458da9aa81565b34801561092357600080fd5b5061041c69456e7653746f7261676560b01b81565b34801561094457600080fd5b5061041c60665481565b34801561095a57600080fd5b506033546001600160a01b03166104b9565b34801561097857600080fd5b5061041c61271081565b34801561098e57600080fd5b5061041c6c42616c6c6f7453746f7261676560981b81565b3480156109b257600080fd5b5061041c6109c1366004615419565b606d6020526000908152604090205481565b3480156109df57600080fd5b506108556109ee366004615419565b611fee565b3480156109ff57600080fd5b5061041c7f6c6f69f426081752a5d3e73746599acd2a4cb145d5de4203ca1e3473b281680b81565b348015610a3357600080fd5b50610855610a42366004615419565b61200e565b348015610a5357600080fd5b506105c2610a623660046155d8565b61202b565b348015610a7357600080fd5b506105c2610a82366004615419565b6123ee565b348015610a9357600080fd5b5061041c7f89dd490ecaf395283ed4ff2fd9557ca767fc425dce063451a9b0da6d72f600c381565b348015610ac757600080fd5b5061041c610ad6366004615419565b606b6020526000908152604090205481565b348015610af457600080fd5b506104b9610b0336600461586c565b6000908152606c60205260409020546001600160a01b031690565b348015610b2a57600080fd5b5061041c61246e565b348015610b3f57600080fd5b5061041c7f829561ab7af084b7efc6600518d2df79b8d95f3f4c3a550f54f8f7ec7d2b805781565b348015610b7357600080fd5b5061041c7f18ad4415ef4a621ce1a136395c51ab6c3712bb2e24b79d526059925cea58dcb881565b348015610ba757600080fd5b5061041c7f8086da5becff4dfac91a3105821b361078d2d4abba0ccc2401b974cf0dcf05c181565b348015610bdb57600080fd5b5061041c7fb38b2c133e931937bd95337c65c8aefa7040ed64bbed732e3e29a4944c65747381565b348015610c0f57600080fd5b5061041c7fc9e15e34073efbcd0328740feaf503caac9124b55b38c73d1a97b53da80a2f6081565b348015610c4357600080fd5b5061041c7f04f7b94450bbcad85f37ea47cd1062728f884bb2040e357738f8fd53056134bc81565b348015610c7757600080fd5b506105c2610c86366004615885565b6124b0565b348015610c9757600080fd5b5061041c6126ab565b348015610cac57600080fd5b5061041c610cbb366004615419565b6001600160a01b03166000908152606f602052604090205490565b348015610ce257600080fd5b5061041c610cf136600461581b565b6126ed565b348015610d0257600080fd5b5061041c610d11366004615419565b60686020526000908152604090205481565b348015610d2f57600080fd5b506104b9610d3e36600461586c565b6000908152606760205260409020546001600160a01b031690565b348015610d6557600080fd5b5061041c610d74366004615684565b612b67565b348015610d8557600080fd5b5061041c60725481565b348015610d9b57600080fd5b5060695461041c565b348015610db057600080fd5b5060745461041c565b348015610dc557600080fd5b506113ec61041c565b348015610dda57600080fd5b5061041c60735481565b348015610df057600080fd5b506105c2610dff366004615419565b612e9a565b348015610e1057600080fd5b5061041c7f7c1150f0e1a39ff55552d52764f97e6c387e2a247e1df344369f122c4254be2f81565b6000610e42612f10565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b158015610e8357600080fd5b505afa158015610e97573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610ebb91906156e4565b610ee05760405162461bcd60e51b8152600401610ed790615b6e565b60405180910390fd5b6000610eeb33611fbd565b15610ef7575033610f2d565b610f003361200e565b15610f2d5750336000908152606860209081526040808320548352606c9091529020546001600160a01b03165b610f35611f7b565b610f3e82612f30565b11158015610f5b5750610f4f61246e565b610f5882612f30565b10155b610f775760405162461bcd60e51b8152600401610ed790615be3565b6001600160a01b038516610fcd5760405162461bcd60e51b815260206004820152601d60248201527f496d706c656d656e746174696f6e2063616e6e6f74206265207a65726f0000006044820152606401610ed7565b610fd5612fb6565b6001600160a01b0316856001600160a01b0316141561102e5760405162461bcd60e51b815260206004820152601560248201527453616d6520636f6e7472616374206164647265737360581b6044820152606401610ed7565b846001600160a01b03166352d1902d6040518163ffffffff1660e01b815260040160206040518083038186803b15801561106757600080fd5b505afa925050508015611097575060408051601f3d908101601f1916820190925261109491810190615701565b60015b6110b35760405162461bcd60e51b8152600401610ed790615b95565b600080516020615eb483398151915281146110e05760405162461bcd60e51b8152600401610ed790615ad7565b506072546110ef906001615dce565b91506110f9612fd2565b6001600160a01b0316630a3a63fe60725460016111169190615dce565b60046040516001600160e01b031960e085901b16815260048101929092526024820152604481018690523360648201526001600160a01b038816608482015260a401602060405180830381600087803b15801561117257600080fd5b505af1158015611186573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906111aa9190615701565b506111b58285612fed565b5060728190559392505050565b60006111cc613058565b6001600160a01b03166333be496e6040518163ffffffff1660e01b815260040160206040518083038186803b15801561120457600080fd5b505afa158015611218573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061123c9190615701565b905090565b600054610100900460ff16158080156112615750600054600160ff909116105b8061127b5750303b15801561127b575060005460ff166001145b6112975760405162461bcd60e51b8152600401610ed790615b20565b6000805460ff1916600117905580156112ba576000805461ff0019166101001790555b6112c2613070565b6112cb836123ee565b4360665581516000906060908190819084908190602089019082906112f09083615dce565b90505b808210156114b8578151975061130a602083615dce565b915080821061131857600080fd5b819650865160206113299190615dce565b6113339083615dce565b915080821061134157600080fd5b819550855160206113529190615dce565b61135c9083615dce565b915080821061136a57600080fd5b8194508451602061137b9190615dce565b6113859083615dce565b915080821061139357600080fd5b815193506113a2602083615dce565b91506113af600184615dce565b600081815260676020908152604080832080546001600160a01b038e166001600160a01b0319918216811790925581855260688452828520869055858552606a84528285208054821683179055818552606b8452828520869055858552606c84528285208054909116821790558352606d8252808320849055838352606e8252909120895192955091611447918391908b0190615103565b50865161145d90600183019060208a0190615103565b5085516114739060028301906020890190615103565b50600301849055600083815260706020908152604080832080546001600160a01b0319166001600160a01b038d169081179091558352606f90915290208390556112f3565b5050606981905560715550508315925061150f915050576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b505050565b306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016141561155d5760405162461bcd60e51b8152600401610ed7906159f8565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661158f612fb6565b6001600160a01b0316146115b55760405162461bcd60e51b8152600401610ed790615a8b565b6115be8161309f565b6115f78160005b6040519080825280601f01601f1916602001820160405280156115ef576020820181803683370190505b50600061313c565b50565b6000611604612f10565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b15801561164557600080fd5b505afa158015611659573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061167d91906156e4565b6116995760405162461bcd60e51b8152600401610ed790615b6e565b60006116a433611fbd565b156116b05750336116e6565b6116b93361200e565b156116e65750336000908152606860209081526040808320548352606c9091529020546001600160a01b03165b6116ee611f7b565b6116f782612f30565b11158015611714575061170861246e565b61171182612f30565b10155b6117305760405162461bcd60e51b8152600401610ed790615be3565b602083015183906001600160a01b031661177c5760405162461bcd60e51b815260206004820152600d60248201526c24b73b30b634b2103b37ba32b960991b6044820152606401610ed7565b6000816060015151116117c55760405162461bcd60e51b8152602060048201526011602482015270496e76616c6964206e6f6465206e616d6560781b6044820152606401610ed7565b60008160a00151511161180c5760405162461bcd60e51b815260206004820152600f60248201526e0496e76616c6964206e6f646520495608c1b6044820152606401610ed7565b60008160c00151116118545760405162461bcd60e51b8152602060048201526011602482015270125b9d985b1a59081b9bd919481c1bdc9d607a1b6044820152606401610ed7565b61185c61246e565b8160e001511015801561187a5750611872611f7b565b8160e0015111155b6118bc5760405162461bcd60e51b8152602060048201526013602482015272125b9d985b1a59081b1bd8dac8105b5bdd5b9d606a1b6044820152606401610ed7565b83516118c790611fee565b156119055760405162461bcd60e51b815260206004820152600e60248201526d20b63932b0b23c9036b2b6b132b960911b6044820152606401610ed7565b83602001516001600160a01b031684600001516001600160a01b0316146119645760405162461bcd60e51b815260206004820152601360248201527229ba30b5b2b91034b9903737ba103b37ba32b960691b6044820152606401610ed7565b607254611972906001615dce565b92506119838360013360008861322d565b611991838560e001516132d0565b6119a083856101000151612fed565b50506072819055919050565b60006119b6612f10565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b1580156119f757600080fd5b505afa158015611a0b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611a2f91906156e4565b611a4b5760405162461bcd60e51b8152600401610ed790615b6e565b6000611a5633611fbd565b15611a62575033611a98565b611a6b3361200e565b15611a985750336000908152606860209081526040808320548352606c9091529020546001600160a01b03165b611aa0611f7b565b611aa982612f30565b11158015611ac65750611aba61246e565b611ac382612f30565b10155b611ae25760405162461bcd60e51b8152600401610ed790615be3565b85600111158015611af4575060098611155b611b2f5760405162461bcd60e51b815260206004820152600c60248201526b496e76616c6964207479706560a01b6044820152606401610ed7565b611b39878661330e565b611b755760405162461bcd60e51b815260206004820152600d60248201526c496e76616c69642076616c756560981b6044820152606401610ed7565b607254611b83906001615dce565b9150611b8d612fd2565b6001600160a01b0316634a57823e83600586338c8c8c6040518863ffffffff1660e01b8152600401611bc59796959493929190615d02565b602060405180830381600087803b158015611bdf57600080fd5b505af1158015611bf3573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611c179190615701565b50611c228285612fed565b50607281905595945050505050565b6000818152606e6020526040812060038101548154606093849384939192909160018301916002840191908490611c6790615e29565b80601f0160208091040260200160405190810160405280929190818152602001828054611c9390615e29565b8015611ce05780601f10611cb557610100808354040283529160200191611ce0565b820191906000526020600020905b815481529060010190602001808311611cc357829003601f168201915b50505050509350828054611cf390615e29565b80601f0160208091040260200160405190810160405280929190818152602001828054611d1f90615e29565b8015611d6c5780601f10611d4157610100808354040283529160200191611d6c565b820191906000526020600020905b815481529060010190602001808311611d4f57829003601f168201915b50505050509250818054611d7f90615e29565b80601f0160208091040260200160405190810160405280929190818152602001828054611dab90615e29565b8015611df85780601f10611dcd57610100808354040283529160200191611df8565b820191906000526020600020905b815481529060010190602001808311611ddb57829003601f168201915b5050505050915093509350935093509193509193565b306001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161415611e575760405162461bcd60e51b8152600401610ed7906159f8565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316611e89612fb6565b6001600160a01b031614611eaf5760405162461bcd60e51b8152600401610ed790615a8b565b611eb88261309f565b611ec48282600161313c565b5050565b6000306001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614611f685760405162461bcd60e51b815260206004820152603860248201527f555550535570677261646561626c653a206d757374206e6f742062652063616c60448201527f6c6564207468726f7567682064656c656761746563616c6c00000000000000006064820152608401610ed7565b50600080516020615eb483398151915290565b6000611f85613058565b6001600160a01b031663737c59b86040518163ffffffff1660e01b815260040160206040518083038186803b15801561120457600080fd5b6001600160a01b03166000908152606d6020526040902054151590565b611fe261339c565b611fec60006133f6565b565b6000611ff982611fbd565b8061200857506120088261200e565b92915050565b6001600160a01b0316600090815260686020526040902054151590565b600054610100900460ff161580801561204b5750600054600160ff909116105b806120655750303b158015612065575060005460ff166001145b6120815760405162461bcd60e51b8152600401610ed790615b20565b6000805460ff1916600117905580156120a4576000805461ff0019166101001790555b600086116121005760405162461bcd60e51b815260206004820152602360248201527f6c6f636b416d6f756e742073686f756c64206265206d6f7265207468656e207a60448201526265726f60e81b6064820152608401610ed7565b612108613070565b612111876123ee565b600061211b613448565b6040516325d998bb60e01b815233600482015290915087906001600160a01b038316906325d998bb9060240160206040518083038186803b15801561215f57600080fd5b505afa158015612173573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906121979190615701565b10156121dc5760405162461bcd60e51b8152602060048201526014602482015273496e73756666696369656e74207374616b696e6760601b6044820152606401610ed7565b60405163282d3fdf60e01b8152336004820152602481018890526001600160a01b0382169063282d3fdf90604401600060405180830381600087803b15801561222457600080fd5b505af1158015612238573d6000803e3d6000fd5b5050600160698190557f6bee784efeb983674392298ab585b22866bedf00ebb0eea949d1e66f3f50e71d8054336001600160a01b0319918216811790925560008281526068602090815260408083208690557ff585789965ba69220d5ce3dc1b444eb22ff546f2650694fef8fafe9c26560af98054851686179055606b82528083208690557fdcf345d7f6a8deb7427d0fee62009fa15100353a1c666b51bb5387b25addcfa98054909416909417909255606d82529182208390556071839055919052606e815288517f44e4f44bb0aae4b5d1e07207f82567d4201c1d09f6b5859dddcfb50647f55a709350612333925083918a0190615103565b5085516123499060018301906020890190615103565b50845161235f9060028301906020880190615103565b5060030183905550607154336000818152606f60209081526040808320859055938252607090529190912080546001600160a01b03191690911790554360665580156123e5576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b50505050505050565b6123f661339c565b6001600160a01b03811661244c5760405162461bcd60e51b815260206004820152601a60248201527f416464726573732073686f756c64206265206e6f6e2d7a65726f0000000000006044820152606401610ed7565b606580546001600160a01b0319166001600160a01b0392909216919091179055565b6000612478613058565b6001600160a01b031663076cd77f6040518163ffffffff1660e01b815260040160206040518083038186803b15801561120457600080fd5b6124b8612f10565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b1580156124f957600080fd5b505afa15801561250d573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061253191906156e4565b61254d5760405162461bcd60e51b8152600401610ed790615b6e565b600260755414156125a05760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c006044820152606401610ed7565b600260755560006125b033611fbd565b156125bc5750336125f2565b6125c53361200e565b156125f25750336000908152606860209081526040808320548352606c9091529020546001600160a01b03165b6125fa611f7b565b61260382612f30565b11158015612620575061261461246e565b61261d82612f30565b10155b61263c5760405162461bcd60e51b8152600401610ed790615be3565b6126458361345d565b60006126508461350d565b905061265c84846135e1565b60008061266886613771565b925092505060006126786113ec90565b905080831015806126895750808210155b1561269d5761269d87858486116000613808565b505060016075555050505050565b60006126b5613058565b6001600160a01b0316631b27e01b6040518163ffffffff1660e01b815260040160206040518083038186803b15801561120457600080fd5b60006126f7612f10565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b15801561273857600080fd5b505afa15801561274c573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061277091906156e4565b61278c5760405162461bcd60e51b8152600401610ed790615b6e565b600061279733611fbd565b156127a35750336127d9565b6127ac3361200e565b156127d95750336000908152606860209081526040808320548352606c9091529020546001600160a01b03165b6127e1611f7b565b6127ea82612f30565b1115801561280757506127fb61246e565b61280482612f30565b10155b6128235760405162461bcd60e51b8152600401610ed790615be3565b602084015184906001600160a01b031661286f5760405162461bcd60e51b815260206004820152600d60248201526c24b73b30b634b2103b37ba32b960991b6044820152606401610ed7565b6000816060015151116128b85760405162461bcd60e51b8152602060048201526011602482015270496e76616c6964206e6f6465206e616d6560781b6044820152606401610ed7565b60008160a0015151116128ff5760405162461bcd60e51b815260206004820152600f60248201526e0496e76616c6964206e6f646520495608c1b6044820152606401610ed7565b60008160c00151116129475760405162461bcd60e51b8152602060048201526011602482015270125b9d985b1a59081b9bd919481c1bdc9d607a1b6044820152606401610ed7565b61294f61246e565b8160e001511015801561296d5750612965611f7b565b8160e0015111155b6129af5760405162461bcd60e51b8152602060048201526013602482015272125b9d985b1a59081b1bd8dac8105b5bdd5b9d606a1b6044820152606401610ed7565b6001600160a01b0384166129fb5760405162461bcd60e51b8152602060048201526013602482015272496e76616c6964206f6c64204164647265737360681b6044820152606401610ed7565b612a0484611fee565b612a3d5760405162461bcd60e51b815260206004820152600a6024820152692737b716b6b2b6b132b960b11b6044820152606401610ed7565b6020808601516001600160a01b038681166000908152606d8452604080822054825260679094529290922054821691161480612a835750612a818560200151611fee565b155b612ac15760405162461bcd60e51b815260206004820152600f60248201526e20b63932b0b23c9030903b37ba32b960891b6044820152606401610ed7565b607254612acf906001615dce565b9250612adf83600333878961322d565b612aed838660e001516132d0565b612afc83866101000151612fed565b6072839055336001600160a01b038516148015612b25575084516001600160a01b038581169116145b15612b5f576000612b3584613891565b92505050612b4f84428342612b4a9190615dce565b6138cb565b612b5d846003600180613808565b505b505092915050565b6000612b71612f10565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b158015612bb257600080fd5b505afa158015612bc6573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612bea91906156e4565b612c065760405162461bcd60e51b8152600401610ed790615b6e565b6000612c1133611fbd565b15612c1d575033612c53565b612c263361200e565b15612c535750336000908152606860209081526040808320548352606c9091529020546001600160a01b03165b612c5b611f7b565b612c6482612f30565b11158015612c815750612c7561246e565b612c7e82612f30565b10155b612c9d5760405162461bcd60e51b8152600401610ed790615be3565b6001600160a01b038616612ce55760405162461bcd60e51b815260206004820152600f60248201526e496e76616c6964206164647265737360881b6044820152606401610ed7565b612cee86611fee565b612d275760405162461bcd60e51b815260206004820152600a6024820152692737b716b6b2b6b132b960b11b6044820152606401610ed7565b6001612d3260695490565b11612d7f5760405162461bcd60e51b815260206004820152601b60248201527f43616e6e6f742072656d6f7665206120736f6c65206d656d62657200000000006044820152606401610ed7565b84612d8987612f30565b1015612dea5760405162461bcd60e51b815260206004820152602a60248201527f496e73756666696369656e742062616c616e636520746861742063616e206265604482015269103ab73637b1b5b2b21760b11b6064820152608401610ed7565b607254612df8906001615dce565b604080516101408101825260008082526020808301829052828401829052835182815280820185526060840152835182815280820185526080840152835182815290810190935260a082019290925260c081019190915260e0810187905261010081018690526101208101859052909250612e77836002338a8561322d565b612e8183876132d0565b612e8b8386612fed565b50506072819055949350505050565b612ea261339c565b6001600160a01b038116612f075760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610ed7565b6115f7816133f6565b600061123c7111dbdd995c9b985b98d950dbdb9d1c9858dd60721b613939565b6000612f3a613448565b604051632c9aab9b60e11b81526001600160a01b03848116600483015291909116906359355736906024015b60206040518083038186803b158015612f7e57600080fd5b505afa158015612f92573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906120089190615701565b600080516020615eb4833981519152546001600160a01b031690565b600061123c6c42616c6c6f7453746f7261676560981b613939565b612ff5612fd2565b6001600160a01b031663bce0dbc183836040518363ffffffff1660e01b8152600401613022929190615959565b600060405180830381600087803b15801561303c57600080fd5b505af1158015613050573d6000803e3d6000fd5b505050505050565b600061123c69456e7653746f7261676560b01b613939565b600054610100900460ff166130975760405162461bcd60e51b8152600401610ed790615c1a565b611fec6139b6565b6130a7612f10565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b1580156130e857600080fd5b505afa1580156130fc573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061312091906156e4565b6115f75760405162461bcd60e51b8152600401610ed790615b6e565b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd91435460ff161561316f5761150f836139e6565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b815260040160206040518083038186803b1580156131a857600080fd5b505afa9250505080156131d8575060408051601f3d908101601f191682019092526131d591810190615701565b60015b6131f45760405162461bcd60e51b8152600401610ed790615b95565b600080516020615eb483398151915281146132215760405162461bcd60e51b8152600401610ed790615ad7565b5061150f838383613a82565b613235612fd2565b6001600160a01b031663daacbb95868684610120015187878760000151886020015189604001518a606001518b608001518c60a001518d60c001516040518d63ffffffff1660e01b81526004016132979c9b9a99989796959493929190615c65565b600060405180830381600087803b1580156132b157600080fd5b505af11580156132c5573d6000803e3d6000fd5b505050505050505050565b6132d8612fd2565b604051633968764960e11b815260048101849052602481018390526001600160a01b0391909116906372d0ec9290604401613022565b6000613318613058565b6001600160a01b0316639801bff984846040518363ffffffff1660e01b8152600401613345929190615959565b60206040518083038186803b15801561335d57600080fd5b505afa158015613371573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061339591906156e4565b9392505050565b6033546001600160a01b03163314611fec5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610ed7565b603380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600061123c665374616b696e6760c81b613939565b607454156115f7576000613472607454613aad565b509150506000613483607454613891565b50915050600282141561150f57428110156134b0576074546134a6906004613b37565b6000607455505050565b607454831461150f5760405162461bcd60e51b815260206004820152602360248201527f4e6f7720696e20766f74696e67207769746820646966666572656e742062616c6044820152621b1bdd60ea1b6064820152608401610ed7565b600080600061351b84613aad565b509092509050600181141561359a57600061353585613891565b925050506135416111c2565b8110156135645761355f85426135556111c2565b612b4a9042615dce565b61358f565b8061356d6126ab565b10156135805761355f85426135556126ab565b61358f8542612b4a8482615dce565b5060748490556135da565b60028114156135a8576135da565b60405162461bcd60e51b8152602060048201526007602482015266115e1c1a5c995960ca1b6044820152606401610ed7565b5092915050565b600060735460016135f29190615dce565b905060006135ff33611fbd565b1561360b575033613641565b6136143361200e565b156136415750336000908152606860209081526040808320548352606c9091529020546001600160a01b03165b600061364b613448565b604051637d77a0eb60e01b81526001600160a01b03848116600483015261271060248301529190911690637d77a0eb9060440160206040518083038186803b15801561369657600080fd5b505afa1580156136aa573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906136ce9190615701565b90506000846136de5760026136e1565b60015b90506136eb612fd2565b6040516325918ae760e21b815260048101869052602481018890526001600160a01b038581166044830152606482018490526084820185905291909116906396462b9c9060a401600060405180830381600087803b15801561374c57600080fd5b505af1158015613760573d6000803e3d6000fd5b505050607394909455505050505050565b600080600061377e612fd2565b6001600160a01b03166356ba988e856040518263ffffffff1660e01b81526004016137ab91815260200190565b60606040518083038186803b1580156137c357600080fd5b505afa1580156137d7573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906137fb91906158e3565b9250925092509193909250565b6004821561387b5750600360018414156138325761382585613b75565b61382d575060045b61387b565b60028414156138445761382d85613f91565b600384141561385757613825858361441a565b60048414156138695761382d856149dd565b600584141561387b5761387b85614a9c565b6138858582613b37565b50506000607455505050565b600080600061389e612fd2565b6001600160a01b03166309970688856040518263ffffffff1660e01b81526004016137ab91815260200190565b6138d3612fd2565b60405163605b78c360e11b81526004810185905260248101849052604481018390526001600160a01b03919091169063c0b6f18690606401600060405180830381600087803b15801561392557600080fd5b505af11580156123e5573d6000803e3d6000fd5b606554604051630d2020dd60e01b8152600481018390526000916001600160a01b031690630d2020dd9060240160206040518083038186803b15801561397e57600080fd5b505afa158015613992573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906120089190615436565b600054610100900460ff166139dd5760405162461bcd60e51b8152600401610ed790615c1a565b611fec336133f6565b6001600160a01b0381163b613a535760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b6064820152608401610ed7565b600080516020615eb483398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b613a8b83614bef565b600082511180613a985750805b1561150f57613aa78383614c2f565b50505050565b6000806000613aba612fd2565b6001600160a01b031663688ca5b2856040518263ffffffff1660e01b8152600401613ae791815260200190565b60606040518083038186803b158015613aff57600080fd5b505afa158015613b13573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906137fb91906158aa565b613b3f612fd2565b60405163548f2cdd60e11b815260048101849052602481018390526001600160a01b03919091169063a91e59ba90604401613022565b6000613b82826001614c54565b600080600080600080600080613b978a614d5b565b9850985098509850985098509850985050613bb188611fee565b15613c0f5789600080516020615ed4833981519152604051613bf79060208082526010908201526f20b63932b0b23c90309036b2b6b132b960811b604082015260600190565b60405180910390a25060009998505050505050505050565b613c1761246e565b811080613c2a575080613c28611f7b565b105b15613c735789600080516020615ed4833981519152604051613bf790602080825260139082015272125b9d985b1a59081b1bd8dac8185b5bdd5b9d606a1b604082015260600190565b80613c7d89614e11565b1015613c9f5789600080516020615ed4833981519152604051613bf790615a44565b866001600160a01b0316886001600160a01b031614158015613cd35750856001600160a01b0316886001600160a01b031614155b15613d1f5789600080516020615ed4833981519152604051613bf790602080825260169082015275496e76616c6964206d656d626572206164647265737360501b604082015260600190565b613d298882614e4b565b60006069546001613d3a9190615dce565b9050876067600083815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b0316021790555080606860008a6001600160a01b03166001600160a01b031681526020019081526020016000208190555086606a600083815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b0316021790555080606b6000896001600160a01b03166001600160a01b031681526020019081526020016000208190555088606c600083815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b0316021790555080606d60008b6001600160a01b03166001600160a01b031681526020019081526020016000208190555060006071546001613e739190615dce565b6000818152606e60209081526040909120885192935091613e9c916001840191908a0190615103565b508551613eb29060028301906020890190615103565b508481600301819055508a6070600084815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b0316021790555081606f60008d6001600160a01b03166001600160a01b031681526020019081526020016000208190555087816000019080519060200190613f36929190615103565b5060698390556071829055436066556040516001600160a01b03808c1691908d16907f6a2af11b2d73f347f9d5840aea46899e17609730b5cd91bd9c312098038acba690600090a35060019c9b505050505050505050505050565b613f9c816002614c54565b600080613fa883614d5b565b9850505050505050509150613fbc82611fee565b6140125782600080516020615ed4833981519152604051614005906020808252601490820152732737ba1030b63932b0b23c90309036b2b6b132b960611b604082015260600190565b60405180910390a2505050565b6001600160a01b038083166000818152606d6020818152604080842054606954808652606c8452828620548287526067855283872054606a8652938720549790965293909252909492831693908316921690841461417a57606980546000908152606c6020818152604080842080546001600160a01b038d8116808852606d80875285892080549e8a529787528589209087529b821688528488209c909c559486905581546001600160a01b03199081169092558954821690851617909855888452606b8083528185205486548652606a80855283872080548a881689528487528589208054948a529287528589209487528716808952858920939093559087905580548b16905581548a16179055978352606880825288842054945484526067808352898520805489861687528385528b872080548989529386528c88209490955290941680865299852055929055805486169055805490941685179093559192916141f7565b606980546000908152606c6020908152604080832080546001600160a01b03199081169091556001600160a01b038b81168552606d845282852085905585548552606a84528285208054831690558681168552606b8452828520859055945484526067835281842080549091169055928516825260689052908120555b60016069546142069190615de6565b6069556071546001600160a01b0387166000908152606f60205260409020541461433c576001600160a01b038087166000908152606f60209081526040808320546071548085526070845282852054828652606e909452828520908552919093208054939850919093169550829161427d90615e29565b614288929190615187565b506071546000908152606e6020526040902060019081018054918301916142ae90615e29565b6142b9929190615187565b506071546000908152606e6020526040902060029081018054918301916142df90615e29565b6142ea929190615187565b506071546000908152606e602090815260408083206003908101549401939093558682526070815282822080546001600160a01b0319166001600160a01b0388169081179091558252606f9052208490555b60718054600090815260706020908152604080832080546001600160a01b03191690556001600160a01b038a168352606f825280832083905592548252606e90529081209061438b8282615202565b614399600183016000615202565b6143a7600283016000615202565b6003820160009055505060016071546143c09190615de6565b607155436066556143d18686614e8a565b816001600160a01b0316866001600160a01b03167faa91016c21c52c58ac64f23f71bbe75becc9ada603e18ee671d09ff15492d1c160405160405180910390a350505050505050565b60008161442c5761442c836003614c54565b60008060008060008060008060006144438c614d5b565b98509850985098509850985098509850985061445e89611fee565b6144ca578b600080516020615ed48339815191526040516144b0906020808252601b908201527f4f6c642061646472657373206973206e6f742061206d656d6265720000000000604082015260600190565b60405180910390a260009950505050505050505050612008565b6001600160a01b03808a166000818152606d6020526040902054918a16146146bf576144f589611fee565b15614563578c600080516020615ed4833981519152604051614548906020808252601f908201527f6e6577206164647265737320697320616c72656164792061206d656d62657200604082015260600190565b60405180910390a260009a5050505050505050505050612008565b876001600160a01b0316896001600160a01b0316141580156145975750866001600160a01b0316896001600160a01b031614155b156145e2578c600080516020615ed483398151915260405161454890602080825260159082015274496e76616c696420766f746572206164647265737360581b604082015260600190565b6000818152606c6020908152604080832080546001600160a01b0319166001600160a01b038e81169182179092558452606d909252808320849055908c16825281205561462d61246e565b82108061464057508161463e611f7b565b105b15614689578c600080516020615ed483398151915260405161454890602080825260139082015272125b9d985b1a59081b1bd8dac8185b5bdd5b9d606a1b604082015260600190565b816146938a614e11565b10156146b5578c600080516020615ed483398151915260405161454890615a44565b6146bf8983614e4b565b6001600160a01b038a166000908152606f6020908152604080832054808452606e83529220885190916146f69183918b0190615103565b50865161470c90600183019060208a0190615103565b5085516147229060028301906020890190615103565b5060038101859055436066556000838152606a6020908152604080832080546001600160a01b038e81166001600160a01b031983168117909355918552606b8452828520889055811684528184208490558684526067909252909120548116908b16811480159061479757506147978b61200e565b156147fa578f600080516020615ed48339815191526040516147dc906020808252600f908201526e20b63932b0b23c9030903b37ba32b960891b604082015260600190565b60405180910390a260009d5050505050505050505050505050612008565b8a6067600086815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b0316021790555083606860008d6001600160a01b03166001600160a01b0316815260200190815260200160002081905550600060686000836001600160a01b03166001600160a01b0316815260200190815260200160002081905550508a6001600160a01b03168c6001600160a01b031614614987578a6070600084815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b0316021790555081606f60008d6001600160a01b03166001600160a01b03168152602001908152602001600020819055506000606f60008e6001600160a01b03166001600160a01b03168152602001908152602001600020819055506149388c85614e8a565b896001600160a01b03168b6001600160a01b03168d6001600160a01b03167f15f4d750630db473a85edd9d47c500527a2648cc5e676f39645e52790cf07be060405160405180910390a46149c8565b8a6001600160a01b03168c6001600160a01b03167f1feee1b4fcb797c62645da41c5c6edd5f91d4291de0054da625c42b823594c1f60405160405180910390a35b5060019e9d5050505050505050505050505050565b6149e8816004614c54565b60006149f2612fd2565b6001600160a01b0316637efa9ae3836040518263ffffffff1660e01b8152600401614a1f91815260200190565b60206040518083038186803b158015614a3757600080fd5b505afa158015614a4b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190614a6f9190615436565b90506001600160a01b03811615611ec457614a898161309f565b614a948160006115c5565b436066555050565b614aa7816005614c54565b6000806000614ab4612fd2565b6001600160a01b0316631d940da2856040518263ffffffff1660e01b8152600401614ae191815260200190565b60006040518083038186803b158015614af957600080fd5b505afa158015614b0d573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052614b35919081019061571a565b9250925092506000614b45613058565b6040516388c2801960e01b81529091506001600160a01b038216906388c2801990614b769087908690600401615959565b600060405180830381600087803b158015614b9057600080fd5b505af1158015614ba4573d6000803e3d6000fd5b50504360665550506040517f701c16c2519cdb79aaac423a84733590e3510d9552055b6ad6908f0ab12b6c2990614be090869086908690615972565b60405180910390a15050505050565b614bf8816139e6565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b60606133958383604051806060016040528060278152602001615ef460279139614fae565b600080614c6084613aad565b5091509150828214614caa5760405162461bcd60e51b8152602060048201526013602482015272496e76616c696420766f74696e67207479706560681b6044820152606401610ed7565b60028114614cf15760405162461bcd60e51b8152602060048201526014602482015273496e76616c696420766f74696e6720737461746560601b6044820152606401610ed7565b600080614cfd86613771565b9250925050614d0b6113ec90565b82101580614d1b57506113ec8110155b6130505760405162461bcd60e51b8152602060048201526011602482015270139bdd081e595d08199a5b985b1a5e9959607a1b6044820152606401610ed7565b6000806000806060806060600080614d71612fd2565b6001600160a01b03166373df4e018b6040518263ffffffff1660e01b8152600401614d9e91815260200190565b60006040518083038186803b158015614db657600080fd5b505afa158015614dca573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052614df29190810190615453565b9850985098509850985098509850985098509193959799909294969850565b6000614e1b613448565b6040516325d998bb60e01b81526001600160a01b03848116600483015291909116906325d998bb90602401612f66565b614e53613448565b60405163282d3fdf60e01b81526001600160a01b03848116600483015260248201849052919091169063282d3fdf90604401613022565b6000614e94613448565b604051632c9aab9b60e11b81526001600160a01b03858116600483015291925060009183169063593557369060240160206040518083038186803b158015614edb57600080fd5b505afa158015614eef573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190614f139190615701565b905082811115614fa4576001600160a01b038216637756c90885614f378685615de6565b6040516001600160e01b031960e085901b1681526001600160a01b0390921660048301526024820152604401600060405180830381600087803b158015614f7d57600080fd5b505af1158015614f91573d6000803e3d6000fd5b50505050614f9f848461508b565b613aa7565b613aa7848261508b565b60606001600160a01b0384163b6150165760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b6064820152608401610ed7565b600080856001600160a01b031685604051615031919061593d565b600060405180830381855af49150503d806000811461506c576040519150601f19603f3d011682016040523d82523d6000602084013e615071565b606091505b50915091506150818282866150ca565b9695505050505050565b615093613448565b604051637eee288d60e01b81526001600160a01b038481166004830152602482018490529190911690637eee288d90604401613022565b606083156150d9575081613395565b8251156150e95782518084602001fd5b8160405162461bcd60e51b8152600401610ed791906159e5565b82805461510f90615e29565b90600052602060002090601f0160209004810192826151315760008555615177565b82601f1061514a57805160ff1916838001178555615177565b82800160010185558215615177579182015b8281111561517757825182559160200191906001019061515c565b50615183929150615238565b5090565b82805461519390615e29565b90600052602060002090601f0160209004810192826151b55760008555615177565b82601f106151c65780548555615177565b8280016001018555821561517757600052602060002091601f016020900482015b828111156151775782548255916001019190600101906151e7565b50805461520e90615e29565b6000825580601f1061521e575050565b601f0160209004906000526020600020908101906115f791905b5b808211156151835760008155600101615239565b803561525881615e90565b919050565b805161525881615e90565b600082601f83011261527957600080fd5b813561528c61528782615da7565b615d77565b8181528460208386010111156152a157600080fd5b816020850160208301376000918101602001919091529392505050565b600082601f8301126152cf57600080fd5b81516152dd61528782615da7565b8181528460208386010111156152f257600080fd5b615303826020830160208701615dfd565b949350505050565b6000610140828403121561531e57600080fd5b615326615d4e565b90506153318261524d565b815261533f6020830161524d565b60208201526153506040830161524d565b604082015260608201356001600160401b038082111561536f57600080fd5b61537b85838601615268565b6060840152608084013591508082111561539457600080fd5b6153a085838601615268565b608084015260a08401359150808211156153b957600080fd5b6153c585838601615268565b60a084015260c084013560c084015260e084013560e0840152610100915081840135818111156153f457600080fd5b61540086828701615268565b8385015250505061012080830135818301525092915050565b60006020828403121561542b57600080fd5b813561339581615e90565b60006020828403121561544857600080fd5b815161339581615e90565b60008060008060008060008060006101208a8c03121561547257600080fd5b61547b8a61525d565b985061548960208b0161525d565b975061549760408b0161525d565b96506154a560608b0161525d565b955060808a01516001600160401b03808211156154c157600080fd5b6154cd8d838e016152be565b965060a08c01519150808211156154e357600080fd5b6154ef8d838e016152be565b955060c08c015191508082111561550557600080fd5b506155128c828d016152be565b93505060e08a015191506101008a015190509295985092959850929598565b6000806040838503121561554457600080fd5b823561554f81615e90565b915060208301356001600160401b0381111561556a57600080fd5b61557685828601615268565b9150509250929050565b60008060006060848603121561559557600080fd5b83356155a081615e90565b925060208401356001600160401b038111156155bb57600080fd5b6155c786828701615268565b925050604084013590509250925092565b60008060008060008060c087890312156155f157600080fd5b86356155fc81615e90565b95506020870135945060408701356001600160401b038082111561561f57600080fd5b61562b8a838b01615268565b9550606089013591508082111561564157600080fd5b61564d8a838b01615268565b9450608089013591508082111561566357600080fd5b5061567089828a01615268565b92505060a087013590509295509295509295565b6000806000806080858703121561569a57600080fd5b84356156a581615e90565b93506020850135925060408501356001600160401b038111156156c757600080fd5b6156d387828801615268565b949793965093946060013593505050565b6000602082840312156156f657600080fd5b815161339581615ea5565b60006020828403121561571357600080fd5b5051919050565b60008060006060848603121561572f57600080fd5b835192506020840151915060408401516001600160401b0381111561575357600080fd5b61575f868287016152be565b9150509250925092565b600080600080600060a0868803121561578157600080fd5b853594506020860135935060408601356001600160401b03808211156157a657600080fd5b6157b289838a01615268565b945060608801359150808211156157c857600080fd5b506157d588828901615268565b95989497509295608001359392505050565b6000602082840312156157f957600080fd5b81356001600160401b0381111561580f57600080fd5b6153038482850161530b565b6000806040838503121561582e57600080fd5b82356001600160401b0381111561584457600080fd5b6158508582860161530b565b925050602083013561586181615e90565b809150509250929050565b60006020828403121561587e57600080fd5b5035919050565b6000806040838503121561589857600080fd5b82359150602083013561586181615ea5565b6000806000606084860312156158bf57600080fd5b835192506020840151915060408401516158d881615ea5565b809150509250925092565b6000806000606084860312156158f857600080fd5b8351925060208401519150604084015190509250925092565b60008151808452615929816020860160208601615dfd565b601f01601f19169290920160200192915050565b6000825161594f818460208701615dfd565b9190910192915050565b8281526040602082015260006153036040830184615911565b8381528260208201526060604082015260006159916060830184615911565b95945050505050565b6080815260006159ad6080830187615911565b82810360208401526159bf8187615911565b905082810360408401526159d38186615911565b91505082606083015295945050505050565b6020815260006133956020830184615911565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b19195b1959d85d1958d85b1b60a21b606082015260800190565b60208082526027908201527f496e73756666696369656e742062616c616e636520746861742063616e206265604082015266081b1bd8dad95960ca1b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b6163746976652070726f787960a01b606082015260800190565b60208082526029908201527f45524331393637557067726164653a20756e737570706f727465642070726f786040820152681a58589b195555525160ba1b606082015260800190565b6020808252602e908201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160408201526d191e481a5b9a5d1a585b1a5e995960921b606082015260800190565b6020808252600d908201526c2737902832b936b4b9b9b4b7b760991b604082015260600190565b6020808252602e908201527f45524331393637557067726164653a206e657720696d706c656d656e7461746960408201526d6f6e206973206e6f74205555505360901b606082015260800190565b60208082526017908201527f496e76616c6964207374616b696e672062616c616e6365000000000000000000604082015260600190565b6020808252602b908201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960408201526a6e697469616c697a696e6760a81b606082015260800190565b8c8152602081018c9052604081018b90526001600160a01b038a81166060830152898116608083015288811660a083015287811660c0830152861660e0820152600061018080610100840152615cbd81840188615911565b9050828103610120840152615cd28187615911565b9050828103610140840152615ce78186615911565b915050826101608301529d9c50505050505050505050505050565b87815286602082015285604082015260018060a01b03851660608201528360808201528260a082015260e060c08201526000615d4160e0830184615911565b9998505050505050505050565b60405161014081016001600160401b0381118282101715615d7157615d71615e7a565b60405290565b604051601f8201601f191681016001600160401b0381118282101715615d9f57615d9f615e7a565b604052919050565b60006001600160401b03821115615dc057615dc0615e7a565b50601f01601f191660200190565b60008219821115615de157615de1615e64565b500190565b600082821015615df857615df8615e64565b500390565b60005b83811015615e18578181015183820152602001615e00565b83811115613aa75750506000910152565b600181811c90821680615e3d57607f821691505b60208210811415615e5e57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052604160045260246000fd5b6001600160a01b03811681146115f757600080fd5b80151581146115f757600080fdfe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc85e7f4987c0698db47045ad8cea110b51138f0eecbd94915842328cf6c3dc97d416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a2646970667358221220c033ab8875036a6ee9162c7863b06a235967c15ff153c2f7101b3f88131d9d1464736f6c63430008060033",
+}
+
+// GovImpABI is the input ABI used to generate the binding from.
+// Deprecated: Use GovImpMetaData.ABI instead.
+var GovImpABI = GovImpMetaData.ABI
+
+// GovImpBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use GovImpMetaData.Bin instead.
+var GovImpBin = GovImpMetaData.Bin
+
+// DeployGovImp deploys a new Ethereum contract, binding an instance of GovImp to it.
+func DeployGovImp(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *GovImp, error) {
+	parsed, err := GovImpMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GovImpBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &GovImp{GovImpCaller: GovImpCaller{contract: contract}, GovImpTransactor: GovImpTransactor{contract: contract}, GovImpFilterer: GovImpFilterer{contract: contract}}, nil
+}
+
+// GovImp is an auto generated Go binding around an Ethereum contract.
+type GovImp struct {
+	GovImpCaller     // Read-only binding to the contract
+	GovImpTransactor // Write-only binding to the contract
+	GovImpFilterer   // Log filterer for contract events
+}
+
+// GovImpCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovImpCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovImpTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovImpTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovImpFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovImpFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovImpSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovImpSession struct {
+	Contract     *GovImp           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovImpCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovImpCallerSession struct {
+	Contract *GovImpCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// GovImpTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovImpTransactorSession struct {
+	Contract     *GovImpTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovImpRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovImpRaw struct {
+	Contract *GovImp // Generic contract binding to access the raw methods on
+}
+
+// GovImpCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovImpCallerRaw struct {
+	Contract *GovImpCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovImpTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovImpTransactorRaw struct {
+	Contract *GovImpTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGovImp creates a new instance of GovImp, bound to a specific deployed contract.
+func NewGovImp(address common.Address, backend bind.ContractBackend) (*GovImp, error) {
+	contract, err := bindGovImp(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImp{GovImpCaller: GovImpCaller{contract: contract}, GovImpTransactor: GovImpTransactor{contract: contract}, GovImpFilterer: GovImpFilterer{contract: contract}}, nil
+}
+
+// NewGovImpCaller creates a new read-only instance of GovImp, bound to a specific deployed contract.
+func NewGovImpCaller(address common.Address, caller bind.ContractCaller) (*GovImpCaller, error) {
+	contract, err := bindGovImp(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpCaller{contract: contract}, nil
+}
+
+// NewGovImpTransactor creates a new write-only instance of GovImp, bound to a specific deployed contract.
+func NewGovImpTransactor(address common.Address, transactor bind.ContractTransactor) (*GovImpTransactor, error) {
+	contract, err := bindGovImp(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpTransactor{contract: contract}, nil
+}
+
+// NewGovImpFilterer creates a new log filterer instance of GovImp, bound to a specific deployed contract.
+func NewGovImpFilterer(address common.Address, filterer bind.ContractFilterer) (*GovImpFilterer, error) {
+	contract, err := bindGovImp(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpFilterer{contract: contract}, nil
+}
+
+// bindGovImp binds a generic wrapper to an already deployed contract.
+func bindGovImp(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovImpABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovImp *GovImpRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GovImp.Contract.GovImpCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovImp *GovImpRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovImp.Contract.GovImpTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovImp *GovImpRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovImp.Contract.GovImpTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovImp *GovImpCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GovImp.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovImp *GovImpTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovImp.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovImp *GovImpTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovImp.Contract.contract.Transact(opts, method, params...)
+}
+
+// BALLOTDURATIONMAXNAME is a free data retrieval call binding the contract method 0x0015a73b.
+//
+// Solidity: function BALLOT_DURATION_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BALLOTDURATIONMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BALLOT_DURATION_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTDURATIONMAXNAME is a free data retrieval call binding the contract method 0x0015a73b.
+//
+// Solidity: function BALLOT_DURATION_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BALLOTDURATIONMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTDURATIONMAXNAME(&_GovImp.CallOpts)
+}
+
+// BALLOTDURATIONMAXNAME is a free data retrieval call binding the contract method 0x0015a73b.
+//
+// Solidity: function BALLOT_DURATION_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BALLOTDURATIONMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTDURATIONMAXNAME(&_GovImp.CallOpts)
+}
+
+// BALLOTDURATIONMINMAXNAME is a free data retrieval call binding the contract method 0x656e3052.
+//
+// Solidity: function BALLOT_DURATION_MIN_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BALLOTDURATIONMINMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BALLOT_DURATION_MIN_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTDURATIONMINMAXNAME is a free data retrieval call binding the contract method 0x656e3052.
+//
+// Solidity: function BALLOT_DURATION_MIN_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BALLOTDURATIONMINMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTDURATIONMINMAXNAME(&_GovImp.CallOpts)
+}
+
+// BALLOTDURATIONMINMAXNAME is a free data retrieval call binding the contract method 0x656e3052.
+//
+// Solidity: function BALLOT_DURATION_MIN_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BALLOTDURATIONMINMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTDURATIONMINMAXNAME(&_GovImp.CallOpts)
+}
+
+// BALLOTDURATIONMINNAME is a free data retrieval call binding the contract method 0x6d583ca7.
+//
+// Solidity: function BALLOT_DURATION_MIN_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BALLOTDURATIONMINNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BALLOT_DURATION_MIN_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTDURATIONMINNAME is a free data retrieval call binding the contract method 0x6d583ca7.
+//
+// Solidity: function BALLOT_DURATION_MIN_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BALLOTDURATIONMINNAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTDURATIONMINNAME(&_GovImp.CallOpts)
+}
+
+// BALLOTDURATIONMINNAME is a free data retrieval call binding the contract method 0x6d583ca7.
+//
+// Solidity: function BALLOT_DURATION_MIN_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BALLOTDURATIONMINNAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTDURATIONMINNAME(&_GovImp.CallOpts)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BALLOTSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BALLOT_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTSTORAGENAME(&_GovImp.CallOpts)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _GovImp.Contract.BALLOTSTORAGENAME(&_GovImp.CallOpts)
+}
+
+// BASEFEEMAXCHANGERATENAME is a free data retrieval call binding the contract method 0xc42a0abc.
+//
+// Solidity: function BASE_FEE_MAX_CHANGE_RATE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BASEFEEMAXCHANGERATENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BASE_FEE_MAX_CHANGE_RATE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BASEFEEMAXCHANGERATENAME is a free data retrieval call binding the contract method 0xc42a0abc.
+//
+// Solidity: function BASE_FEE_MAX_CHANGE_RATE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BASEFEEMAXCHANGERATENAME() ([32]byte, error) {
+	return _GovImp.Contract.BASEFEEMAXCHANGERATENAME(&_GovImp.CallOpts)
+}
+
+// BASEFEEMAXCHANGERATENAME is a free data retrieval call binding the contract method 0xc42a0abc.
+//
+// Solidity: function BASE_FEE_MAX_CHANGE_RATE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BASEFEEMAXCHANGERATENAME() ([32]byte, error) {
+	return _GovImp.Contract.BASEFEEMAXCHANGERATENAME(&_GovImp.CallOpts)
+}
+
+// BLOCKSPERNAME is a free data retrieval call binding the contract method 0x3f35c8fe.
+//
+// Solidity: function BLOCKS_PER_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKSPERNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCKS_PER_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKSPERNAME is a free data retrieval call binding the contract method 0x3f35c8fe.
+//
+// Solidity: function BLOCKS_PER_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKSPERNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKSPERNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKSPERNAME is a free data retrieval call binding the contract method 0x3f35c8fe.
+//
+// Solidity: function BLOCKS_PER_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKSPERNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKSPERNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKCREATIONTIMENAME is a free data retrieval call binding the contract method 0xc0b4fe15.
+//
+// Solidity: function BLOCK_CREATION_TIME_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKCREATIONTIMENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_CREATION_TIME_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKCREATIONTIMENAME is a free data retrieval call binding the contract method 0xc0b4fe15.
+//
+// Solidity: function BLOCK_CREATION_TIME_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKCREATIONTIMENAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKCREATIONTIMENAME(&_GovImp.CallOpts)
+}
+
+// BLOCKCREATIONTIMENAME is a free data retrieval call binding the contract method 0xc0b4fe15.
+//
+// Solidity: function BLOCK_CREATION_TIME_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKCREATIONTIMENAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKCREATIONTIMENAME(&_GovImp.CallOpts)
+}
+
+// BLOCKGASLIMITNAME is a free data retrieval call binding the contract method 0x238737b6.
+//
+// Solidity: function BLOCK_GASLIMIT_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKGASLIMITNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_GASLIMIT_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKGASLIMITNAME is a free data retrieval call binding the contract method 0x238737b6.
+//
+// Solidity: function BLOCK_GASLIMIT_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKGASLIMITNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKGASLIMITNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKGASLIMITNAME is a free data retrieval call binding the contract method 0x238737b6.
+//
+// Solidity: function BLOCK_GASLIMIT_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKGASLIMITNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKGASLIMITNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDAMOUNTNAME is a free data retrieval call binding the contract method 0xa9b629b2.
+//
+// Solidity: function BLOCK_REWARD_AMOUNT_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKREWARDAMOUNTNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_REWARD_AMOUNT_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDAMOUNTNAME is a free data retrieval call binding the contract method 0xa9b629b2.
+//
+// Solidity: function BLOCK_REWARD_AMOUNT_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKREWARDAMOUNTNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDAMOUNTNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDAMOUNTNAME is a free data retrieval call binding the contract method 0xa9b629b2.
+//
+// Solidity: function BLOCK_REWARD_AMOUNT_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKREWARDAMOUNTNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDAMOUNTNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME is a free data retrieval call binding the contract method 0xc6713baf.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME is a free data retrieval call binding the contract method 0xc6713baf.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME is a free data retrieval call binding the contract method 0xc6713baf.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_BLOCK_PRODUCER_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONBLOCKPRODUCERNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME is a free data retrieval call binding the contract method 0x7b2bfb01.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME is a free data retrieval call binding the contract method 0x7b2bfb01.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME is a free data retrieval call binding the contract method 0x7b2bfb01.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_ECOSYSTEM_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONECOSYSTEMNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMAINTANANCENAME is a free data retrieval call binding the contract method 0x0b1d39b8.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKREWARDDISTRIBUTIONMAINTANANCENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONMAINTANANCENAME is a free data retrieval call binding the contract method 0x0b1d39b8.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKREWARDDISTRIBUTIONMAINTANANCENAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONMAINTANANCENAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMAINTANANCENAME is a free data retrieval call binding the contract method 0x0b1d39b8.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_MAINTANANCE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKREWARDDISTRIBUTIONMAINTANANCENAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONMAINTANANCENAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMETHODNAME is a free data retrieval call binding the contract method 0x278bb12a.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_METHOD_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKREWARDDISTRIBUTIONMETHODNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_METHOD_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONMETHODNAME is a free data retrieval call binding the contract method 0x278bb12a.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_METHOD_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKREWARDDISTRIBUTIONMETHODNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONMETHODNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONMETHODNAME is a free data retrieval call binding the contract method 0x278bb12a.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_METHOD_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKREWARDDISTRIBUTIONMETHODNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONMETHODNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME is a free data retrieval call binding the contract method 0x6167eb45.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME is a free data retrieval call binding the contract method 0x6167eb45.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME(&_GovImp.CallOpts)
+}
+
+// BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME is a free data retrieval call binding the contract method 0x6167eb45.
+//
+// Solidity: function BLOCK_REWARD_DISTRIBUTION_STAKING_REWARD_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME() ([32]byte, error) {
+	return _GovImp.Contract.BLOCKREWARDDISTRIBUTIONSTAKINGREWARDNAME(&_GovImp.CallOpts)
+}
+
+// DENOMINATOR is a free data retrieval call binding the contract method 0x918f8674.
+//
+// Solidity: function DENOMINATOR() view returns(uint256)
+func (_GovImp *GovImpCaller) DENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DENOMINATOR is a free data retrieval call binding the contract method 0x918f8674.
+//
+// Solidity: function DENOMINATOR() view returns(uint256)
+func (_GovImp *GovImpSession) DENOMINATOR() (*big.Int, error) {
+	return _GovImp.Contract.DENOMINATOR(&_GovImp.CallOpts)
+}
+
+// DENOMINATOR is a free data retrieval call binding the contract method 0x918f8674.
+//
+// Solidity: function DENOMINATOR() view returns(uint256)
+func (_GovImp *GovImpCallerSession) DENOMINATOR() (*big.Int, error) {
+	return _GovImp.Contract.DENOMINATOR(&_GovImp.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) ENVSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "ENV_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _GovImp.Contract.ENVSTORAGENAME(&_GovImp.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _GovImp.Contract.ENVSTORAGENAME(&_GovImp.CallOpts)
+}
+
+// GASLIMITANDBASEFEENAME is a free data retrieval call binding the contract method 0xc7d3da34.
+//
+// Solidity: function GASLIMIT_AND_BASE_FEE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) GASLIMITANDBASEFEENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "GASLIMIT_AND_BASE_FEE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GASLIMITANDBASEFEENAME is a free data retrieval call binding the contract method 0xc7d3da34.
+//
+// Solidity: function GASLIMIT_AND_BASE_FEE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) GASLIMITANDBASEFEENAME() ([32]byte, error) {
+	return _GovImp.Contract.GASLIMITANDBASEFEENAME(&_GovImp.CallOpts)
+}
+
+// GASLIMITANDBASEFEENAME is a free data retrieval call binding the contract method 0xc7d3da34.
+//
+// Solidity: function GASLIMIT_AND_BASE_FEE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) GASLIMITANDBASEFEENAME() ([32]byte, error) {
+	return _GovImp.Contract.GASLIMITANDBASEFEENAME(&_GovImp.CallOpts)
+}
+
+// GASTARGETPERCENTAGENAME is a free data retrieval call binding the contract method 0x4d273e28.
+//
+// Solidity: function GAS_TARGET_PERCENTAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) GASTARGETPERCENTAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "GAS_TARGET_PERCENTAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GASTARGETPERCENTAGENAME is a free data retrieval call binding the contract method 0x4d273e28.
+//
+// Solidity: function GAS_TARGET_PERCENTAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) GASTARGETPERCENTAGENAME() ([32]byte, error) {
+	return _GovImp.Contract.GASTARGETPERCENTAGENAME(&_GovImp.CallOpts)
+}
+
+// GASTARGETPERCENTAGENAME is a free data retrieval call binding the contract method 0x4d273e28.
+//
+// Solidity: function GAS_TARGET_PERCENTAGE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) GASTARGETPERCENTAGENAME() ([32]byte, error) {
+	return _GovImp.Contract.GASTARGETPERCENTAGENAME(&_GovImp.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) GOVNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "GOV_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) GOVNAME() ([32]byte, error) {
+	return _GovImp.Contract.GOVNAME(&_GovImp.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) GOVNAME() ([32]byte, error) {
+	return _GovImp.Contract.GOVNAME(&_GovImp.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) MAINTENANCENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "MAINTENANCE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) MAINTENANCENAME() ([32]byte, error) {
+	return _GovImp.Contract.MAINTENANCENAME(&_GovImp.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) MAINTENANCENAME() ([32]byte, error) {
+	return _GovImp.Contract.MAINTENANCENAME(&_GovImp.CallOpts)
+}
+
+// MAXBASEFEENAME is a free data retrieval call binding the contract method 0xf38ecf47.
+//
+// Solidity: function MAX_BASE_FEE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) MAXBASEFEENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "MAX_BASE_FEE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAXBASEFEENAME is a free data retrieval call binding the contract method 0xf38ecf47.
+//
+// Solidity: function MAX_BASE_FEE_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) MAXBASEFEENAME() ([32]byte, error) {
+	return _GovImp.Contract.MAXBASEFEENAME(&_GovImp.CallOpts)
+}
+
+// MAXBASEFEENAME is a free data retrieval call binding the contract method 0xf38ecf47.
+//
+// Solidity: function MAX_BASE_FEE_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) MAXBASEFEENAME() ([32]byte, error) {
+	return _GovImp.Contract.MAXBASEFEENAME(&_GovImp.CallOpts)
+}
+
+// MAXIDLEBLOCKINTERVALNAME is a free data retrieval call binding the contract method 0xb128f880.
+//
+// Solidity: function MAX_IDLE_BLOCK_INTERVAL_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) MAXIDLEBLOCKINTERVALNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "MAX_IDLE_BLOCK_INTERVAL_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAXIDLEBLOCKINTERVALNAME is a free data retrieval call binding the contract method 0xb128f880.
+//
+// Solidity: function MAX_IDLE_BLOCK_INTERVAL_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) MAXIDLEBLOCKINTERVALNAME() ([32]byte, error) {
+	return _GovImp.Contract.MAXIDLEBLOCKINTERVALNAME(&_GovImp.CallOpts)
+}
+
+// MAXIDLEBLOCKINTERVALNAME is a free data retrieval call binding the contract method 0xb128f880.
+//
+// Solidity: function MAX_IDLE_BLOCK_INTERVAL_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) MAXIDLEBLOCKINTERVALNAME() ([32]byte, error) {
+	return _GovImp.Contract.MAXIDLEBLOCKINTERVALNAME(&_GovImp.CallOpts)
+}
+
+// MAXPRIORITYFEEPERGASNAME is a free data retrieval call binding the contract method 0x38294419.
+//
+// Solidity: function MAX_PRIORITY_FEE_PER_GAS_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) MAXPRIORITYFEEPERGASNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "MAX_PRIORITY_FEE_PER_GAS_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAXPRIORITYFEEPERGASNAME is a free data retrieval call binding the contract method 0x38294419.
+//
+// Solidity: function MAX_PRIORITY_FEE_PER_GAS_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) MAXPRIORITYFEEPERGASNAME() ([32]byte, error) {
+	return _GovImp.Contract.MAXPRIORITYFEEPERGASNAME(&_GovImp.CallOpts)
+}
+
+// MAXPRIORITYFEEPERGASNAME is a free data retrieval call binding the contract method 0x38294419.
+//
+// Solidity: function MAX_PRIORITY_FEE_PER_GAS_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) MAXPRIORITYFEEPERGASNAME() ([32]byte, error) {
+	return _GovImp.Contract.MAXPRIORITYFEEPERGASNAME(&_GovImp.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) REWARDPOOLNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "REWARD_POOL_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _GovImp.Contract.REWARDPOOLNAME(&_GovImp.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _GovImp.Contract.REWARDPOOLNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGMAXNAME is a free data retrieval call binding the contract method 0xc00ace6c.
+//
+// Solidity: function STAKING_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) STAKINGMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "STAKING_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGMAXNAME is a free data retrieval call binding the contract method 0xc00ace6c.
+//
+// Solidity: function STAKING_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) STAKINGMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGMAXNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGMAXNAME is a free data retrieval call binding the contract method 0xc00ace6c.
+//
+// Solidity: function STAKING_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) STAKINGMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGMAXNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGMINMAXNAME is a free data retrieval call binding the contract method 0xa6868b7d.
+//
+// Solidity: function STAKING_MIN_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) STAKINGMINMAXNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "STAKING_MIN_MAX_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGMINMAXNAME is a free data retrieval call binding the contract method 0xa6868b7d.
+//
+// Solidity: function STAKING_MIN_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) STAKINGMINMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGMINMAXNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGMINMAXNAME is a free data retrieval call binding the contract method 0xa6868b7d.
+//
+// Solidity: function STAKING_MIN_MAX_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) STAKINGMINMAXNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGMINMAXNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGMINNAME is a free data retrieval call binding the contract method 0x6fde207a.
+//
+// Solidity: function STAKING_MIN_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) STAKINGMINNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "STAKING_MIN_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGMINNAME is a free data retrieval call binding the contract method 0x6fde207a.
+//
+// Solidity: function STAKING_MIN_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) STAKINGMINNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGMINNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGMINNAME is a free data retrieval call binding the contract method 0x6fde207a.
+//
+// Solidity: function STAKING_MIN_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) STAKINGMINNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGMINNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_GovImp *GovImpCaller) STAKINGNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "STAKING_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_GovImp *GovImpSession) STAKINGNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGNAME(&_GovImp.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) STAKINGNAME() ([32]byte, error) {
+	return _GovImp.Contract.STAKINGNAME(&_GovImp.CallOpts)
+}
+
+// BallotLength is a free data retrieval call binding the contract method 0xd6f9cfce.
+//
+// Solidity: function ballotLength() view returns(uint256)
+func (_GovImp *GovImpCaller) BallotLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "ballotLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BallotLength is a free data retrieval call binding the contract method 0xd6f9cfce.
+//
+// Solidity: function ballotLength() view returns(uint256)
+func (_GovImp *GovImpSession) BallotLength() (*big.Int, error) {
+	return _GovImp.Contract.BallotLength(&_GovImp.CallOpts)
+}
+
+// BallotLength is a free data retrieval call binding the contract method 0xd6f9cfce.
+//
+// Solidity: function ballotLength() view returns(uint256)
+func (_GovImp *GovImpCallerSession) BallotLength() (*big.Int, error) {
+	return _GovImp.Contract.BallotLength(&_GovImp.CallOpts)
+}
+
+// GetBallotInVoting is a free data retrieval call binding the contract method 0xde09b377.
+//
+// Solidity: function getBallotInVoting() view returns(uint256)
+func (_GovImp *GovImpCaller) GetBallotInVoting(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getBallotInVoting")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBallotInVoting is a free data retrieval call binding the contract method 0xde09b377.
+//
+// Solidity: function getBallotInVoting() view returns(uint256)
+func (_GovImp *GovImpSession) GetBallotInVoting() (*big.Int, error) {
+	return _GovImp.Contract.GetBallotInVoting(&_GovImp.CallOpts)
+}
+
+// GetBallotInVoting is a free data retrieval call binding the contract method 0xde09b377.
+//
+// Solidity: function getBallotInVoting() view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetBallotInVoting() (*big.Int, error) {
+	return _GovImp.Contract.GetBallotInVoting(&_GovImp.CallOpts)
+}
+
+// GetMaxStaking is a free data retrieval call binding the contract method 0x5aaa4040.
+//
+// Solidity: function getMaxStaking() view returns(uint256)
+func (_GovImp *GovImpCaller) GetMaxStaking(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getMaxStaking")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxStaking is a free data retrieval call binding the contract method 0x5aaa4040.
+//
+// Solidity: function getMaxStaking() view returns(uint256)
+func (_GovImp *GovImpSession) GetMaxStaking() (*big.Int, error) {
+	return _GovImp.Contract.GetMaxStaking(&_GovImp.CallOpts)
+}
+
+// GetMaxStaking is a free data retrieval call binding the contract method 0x5aaa4040.
+//
+// Solidity: function getMaxStaking() view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetMaxStaking() (*big.Int, error) {
+	return _GovImp.Contract.GetMaxStaking(&_GovImp.CallOpts)
+}
+
+// GetMaxVotingDuration is a free data retrieval call binding the contract method 0xce04b9d4.
+//
+// Solidity: function getMaxVotingDuration() view returns(uint256)
+func (_GovImp *GovImpCaller) GetMaxVotingDuration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getMaxVotingDuration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxVotingDuration is a free data retrieval call binding the contract method 0xce04b9d4.
+//
+// Solidity: function getMaxVotingDuration() view returns(uint256)
+func (_GovImp *GovImpSession) GetMaxVotingDuration() (*big.Int, error) {
+	return _GovImp.Contract.GetMaxVotingDuration(&_GovImp.CallOpts)
+}
+
+// GetMaxVotingDuration is a free data retrieval call binding the contract method 0xce04b9d4.
+//
+// Solidity: function getMaxVotingDuration() view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetMaxVotingDuration() (*big.Int, error) {
+	return _GovImp.Contract.GetMaxVotingDuration(&_GovImp.CallOpts)
+}
+
+// GetMember is a free data retrieval call binding the contract method 0xab3545e5.
+//
+// Solidity: function getMember(uint256 idx) view returns(address)
+func (_GovImp *GovImpCaller) GetMember(opts *bind.CallOpts, idx *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getMember", idx)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetMember is a free data retrieval call binding the contract method 0xab3545e5.
+//
+// Solidity: function getMember(uint256 idx) view returns(address)
+func (_GovImp *GovImpSession) GetMember(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetMember(&_GovImp.CallOpts, idx)
+}
+
+// GetMember is a free data retrieval call binding the contract method 0xab3545e5.
+//
+// Solidity: function getMember(uint256 idx) view returns(address)
+func (_GovImp *GovImpCallerSession) GetMember(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetMember(&_GovImp.CallOpts, idx)
+}
+
+// GetMemberFromNodeIdx is a free data retrieval call binding the contract method 0x15bf6b4d.
+//
+// Solidity: function getMemberFromNodeIdx(uint256 idx) view returns(address)
+func (_GovImp *GovImpCaller) GetMemberFromNodeIdx(opts *bind.CallOpts, idx *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getMemberFromNodeIdx", idx)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetMemberFromNodeIdx is a free data retrieval call binding the contract method 0x15bf6b4d.
+//
+// Solidity: function getMemberFromNodeIdx(uint256 idx) view returns(address)
+func (_GovImp *GovImpSession) GetMemberFromNodeIdx(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetMemberFromNodeIdx(&_GovImp.CallOpts, idx)
+}
+
+// GetMemberFromNodeIdx is a free data retrieval call binding the contract method 0x15bf6b4d.
+//
+// Solidity: function getMemberFromNodeIdx(uint256 idx) view returns(address)
+func (_GovImp *GovImpCallerSession) GetMemberFromNodeIdx(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetMemberFromNodeIdx(&_GovImp.CallOpts, idx)
+}
+
+// GetMemberLength is a free data retrieval call binding the contract method 0xd965ea00.
+//
+// Solidity: function getMemberLength() view returns(uint256)
+func (_GovImp *GovImpCaller) GetMemberLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getMemberLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMemberLength is a free data retrieval call binding the contract method 0xd965ea00.
+//
+// Solidity: function getMemberLength() view returns(uint256)
+func (_GovImp *GovImpSession) GetMemberLength() (*big.Int, error) {
+	return _GovImp.Contract.GetMemberLength(&_GovImp.CallOpts)
+}
+
+// GetMemberLength is a free data retrieval call binding the contract method 0xd965ea00.
+//
+// Solidity: function getMemberLength() view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetMemberLength() (*big.Int, error) {
+	return _GovImp.Contract.GetMemberLength(&_GovImp.CallOpts)
+}
+
+// GetMinStaking is a free data retrieval call binding the contract method 0xaf6af2ff.
+//
+// Solidity: function getMinStaking() view returns(uint256)
+func (_GovImp *GovImpCaller) GetMinStaking(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getMinStaking")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinStaking is a free data retrieval call binding the contract method 0xaf6af2ff.
+//
+// Solidity: function getMinStaking() view returns(uint256)
+func (_GovImp *GovImpSession) GetMinStaking() (*big.Int, error) {
+	return _GovImp.Contract.GetMinStaking(&_GovImp.CallOpts)
+}
+
+// GetMinStaking is a free data retrieval call binding the contract method 0xaf6af2ff.
+//
+// Solidity: function getMinStaking() view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetMinStaking() (*big.Int, error) {
+	return _GovImp.Contract.GetMinStaking(&_GovImp.CallOpts)
+}
+
+// GetMinVotingDuration is a free data retrieval call binding the contract method 0x1c150171.
+//
+// Solidity: function getMinVotingDuration() view returns(uint256)
+func (_GovImp *GovImpCaller) GetMinVotingDuration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getMinVotingDuration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinVotingDuration is a free data retrieval call binding the contract method 0x1c150171.
+//
+// Solidity: function getMinVotingDuration() view returns(uint256)
+func (_GovImp *GovImpSession) GetMinVotingDuration() (*big.Int, error) {
+	return _GovImp.Contract.GetMinVotingDuration(&_GovImp.CallOpts)
+}
+
+// GetMinVotingDuration is a free data retrieval call binding the contract method 0x1c150171.
+//
+// Solidity: function getMinVotingDuration() view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetMinVotingDuration() (*big.Int, error) {
+	return _GovImp.Contract.GetMinVotingDuration(&_GovImp.CallOpts)
+}
+
+// GetNode is a free data retrieval call binding the contract method 0x4f0f4aa9.
+//
+// Solidity: function getNode(uint256 idx) view returns(bytes name, bytes enode, bytes ip, uint256 port)
+func (_GovImp *GovImpCaller) GetNode(opts *bind.CallOpts, idx *big.Int) (struct {
+	Name  []byte
+	Enode []byte
+	Ip    []byte
+	Port  *big.Int
+}, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getNode", idx)
+
+	outstruct := new(struct {
+		Name  []byte
+		Enode []byte
+		Ip    []byte
+		Port  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Name = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	outstruct.Enode = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	outstruct.Ip = *abi.ConvertType(out[2], new([]byte)).(*[]byte)
+	outstruct.Port = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetNode is a free data retrieval call binding the contract method 0x4f0f4aa9.
+//
+// Solidity: function getNode(uint256 idx) view returns(bytes name, bytes enode, bytes ip, uint256 port)
+func (_GovImp *GovImpSession) GetNode(idx *big.Int) (struct {
+	Name  []byte
+	Enode []byte
+	Ip    []byte
+	Port  *big.Int
+}, error) {
+	return _GovImp.Contract.GetNode(&_GovImp.CallOpts, idx)
+}
+
+// GetNode is a free data retrieval call binding the contract method 0x4f0f4aa9.
+//
+// Solidity: function getNode(uint256 idx) view returns(bytes name, bytes enode, bytes ip, uint256 port)
+func (_GovImp *GovImpCallerSession) GetNode(idx *big.Int) (struct {
+	Name  []byte
+	Enode []byte
+	Ip    []byte
+	Port  *big.Int
+}, error) {
+	return _GovImp.Contract.GetNode(&_GovImp.CallOpts, idx)
+}
+
+// GetNodeIdxFromMember is a free data retrieval call binding the contract method 0xce6a54ff.
+//
+// Solidity: function getNodeIdxFromMember(address addr) view returns(uint256)
+func (_GovImp *GovImpCaller) GetNodeIdxFromMember(opts *bind.CallOpts, addr common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getNodeIdxFromMember", addr)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNodeIdxFromMember is a free data retrieval call binding the contract method 0xce6a54ff.
+//
+// Solidity: function getNodeIdxFromMember(address addr) view returns(uint256)
+func (_GovImp *GovImpSession) GetNodeIdxFromMember(addr common.Address) (*big.Int, error) {
+	return _GovImp.Contract.GetNodeIdxFromMember(&_GovImp.CallOpts, addr)
+}
+
+// GetNodeIdxFromMember is a free data retrieval call binding the contract method 0xce6a54ff.
+//
+// Solidity: function getNodeIdxFromMember(address addr) view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetNodeIdxFromMember(addr common.Address) (*big.Int, error) {
+	return _GovImp.Contract.GetNodeIdxFromMember(&_GovImp.CallOpts, addr)
+}
+
+// GetNodeLength is a free data retrieval call binding the contract method 0x72016f75.
+//
+// Solidity: function getNodeLength() view returns(uint256)
+func (_GovImp *GovImpCaller) GetNodeLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getNodeLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNodeLength is a free data retrieval call binding the contract method 0x72016f75.
+//
+// Solidity: function getNodeLength() view returns(uint256)
+func (_GovImp *GovImpSession) GetNodeLength() (*big.Int, error) {
+	return _GovImp.Contract.GetNodeLength(&_GovImp.CallOpts)
+}
+
+// GetNodeLength is a free data retrieval call binding the contract method 0x72016f75.
+//
+// Solidity: function getNodeLength() view returns(uint256)
+func (_GovImp *GovImpCallerSession) GetNodeLength() (*big.Int, error) {
+	return _GovImp.Contract.GetNodeLength(&_GovImp.CallOpts)
+}
+
+// GetReward is a free data retrieval call binding the contract method 0x1c4b774b.
+//
+// Solidity: function getReward(uint256 idx) view returns(address)
+func (_GovImp *GovImpCaller) GetReward(opts *bind.CallOpts, idx *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getReward", idx)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetReward is a free data retrieval call binding the contract method 0x1c4b774b.
+//
+// Solidity: function getReward(uint256 idx) view returns(address)
+func (_GovImp *GovImpSession) GetReward(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetReward(&_GovImp.CallOpts, idx)
+}
+
+// GetReward is a free data retrieval call binding the contract method 0x1c4b774b.
+//
+// Solidity: function getReward(uint256 idx) view returns(address)
+func (_GovImp *GovImpCallerSession) GetReward(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetReward(&_GovImp.CallOpts, idx)
+}
+
+// GetThreshold is a free data retrieval call binding the contract method 0xe75235b8.
+//
+// Solidity: function getThreshold() pure returns(uint256)
+func (_GovImp *GovImpCaller) GetThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetThreshold is a free data retrieval call binding the contract method 0xe75235b8.
+//
+// Solidity: function getThreshold() pure returns(uint256)
+func (_GovImp *GovImpSession) GetThreshold() (*big.Int, error) {
+	return _GovImp.Contract.GetThreshold(&_GovImp.CallOpts)
+}
+
+// GetThreshold is a free data retrieval call binding the contract method 0xe75235b8.
+//
+// Solidity: function getThreshold() pure returns(uint256)
+func (_GovImp *GovImpCallerSession) GetThreshold() (*big.Int, error) {
+	return _GovImp.Contract.GetThreshold(&_GovImp.CallOpts)
+}
+
+// GetVoter is a free data retrieval call binding the contract method 0xd07bff0c.
+//
+// Solidity: function getVoter(uint256 idx) view returns(address)
+func (_GovImp *GovImpCaller) GetVoter(opts *bind.CallOpts, idx *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "getVoter", idx)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetVoter is a free data retrieval call binding the contract method 0xd07bff0c.
+//
+// Solidity: function getVoter(uint256 idx) view returns(address)
+func (_GovImp *GovImpSession) GetVoter(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetVoter(&_GovImp.CallOpts, idx)
+}
+
+// GetVoter is a free data retrieval call binding the contract method 0xd07bff0c.
+//
+// Solidity: function getVoter(uint256 idx) view returns(address)
+func (_GovImp *GovImpCallerSession) GetVoter(idx *big.Int) (common.Address, error) {
+	return _GovImp.Contract.GetVoter(&_GovImp.CallOpts, idx)
+}
+
+// IsMember is a free data retrieval call binding the contract method 0xa230c524.
+//
+// Solidity: function isMember(address addr) view returns(bool)
+func (_GovImp *GovImpCaller) IsMember(opts *bind.CallOpts, addr common.Address) (bool, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "isMember", addr)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsMember is a free data retrieval call binding the contract method 0xa230c524.
+//
+// Solidity: function isMember(address addr) view returns(bool)
+func (_GovImp *GovImpSession) IsMember(addr common.Address) (bool, error) {
+	return _GovImp.Contract.IsMember(&_GovImp.CallOpts, addr)
+}
+
+// IsMember is a free data retrieval call binding the contract method 0xa230c524.
+//
+// Solidity: function isMember(address addr) view returns(bool)
+func (_GovImp *GovImpCallerSession) IsMember(addr common.Address) (bool, error) {
+	return _GovImp.Contract.IsMember(&_GovImp.CallOpts, addr)
+}
+
+// IsStaker is a free data retrieval call binding the contract method 0x6f1e8533.
+//
+// Solidity: function isStaker(address addr) view returns(bool)
+func (_GovImp *GovImpCaller) IsStaker(opts *bind.CallOpts, addr common.Address) (bool, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "isStaker", addr)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsStaker is a free data retrieval call binding the contract method 0x6f1e8533.
+//
+// Solidity: function isStaker(address addr) view returns(bool)
+func (_GovImp *GovImpSession) IsStaker(addr common.Address) (bool, error) {
+	return _GovImp.Contract.IsStaker(&_GovImp.CallOpts, addr)
+}
+
+// IsStaker is a free data retrieval call binding the contract method 0x6f1e8533.
+//
+// Solidity: function isStaker(address addr) view returns(bool)
+func (_GovImp *GovImpCallerSession) IsStaker(addr common.Address) (bool, error) {
+	return _GovImp.Contract.IsStaker(&_GovImp.CallOpts, addr)
+}
+
+// IsVoter is a free data retrieval call binding the contract method 0xa7771ee3.
+//
+// Solidity: function isVoter(address addr) view returns(bool)
+func (_GovImp *GovImpCaller) IsVoter(opts *bind.CallOpts, addr common.Address) (bool, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "isVoter", addr)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsVoter is a free data retrieval call binding the contract method 0xa7771ee3.
+//
+// Solidity: function isVoter(address addr) view returns(bool)
+func (_GovImp *GovImpSession) IsVoter(addr common.Address) (bool, error) {
+	return _GovImp.Contract.IsVoter(&_GovImp.CallOpts, addr)
+}
+
+// IsVoter is a free data retrieval call binding the contract method 0xa7771ee3.
+//
+// Solidity: function isVoter(address addr) view returns(bool)
+func (_GovImp *GovImpCallerSession) IsVoter(addr common.Address) (bool, error) {
+	return _GovImp.Contract.IsVoter(&_GovImp.CallOpts, addr)
+}
+
+// ModifiedBlock is a free data retrieval call binding the contract method 0x7d10dd1b.
+//
+// Solidity: function modifiedBlock() view returns(uint256)
+func (_GovImp *GovImpCaller) ModifiedBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "modifiedBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ModifiedBlock is a free data retrieval call binding the contract method 0x7d10dd1b.
+//
+// Solidity: function modifiedBlock() view returns(uint256)
+func (_GovImp *GovImpSession) ModifiedBlock() (*big.Int, error) {
+	return _GovImp.Contract.ModifiedBlock(&_GovImp.CallOpts)
+}
+
+// ModifiedBlock is a free data retrieval call binding the contract method 0x7d10dd1b.
+//
+// Solidity: function modifiedBlock() view returns(uint256)
+func (_GovImp *GovImpCallerSession) ModifiedBlock() (*big.Int, error) {
+	return _GovImp.Contract.ModifiedBlock(&_GovImp.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_GovImp *GovImpCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_GovImp *GovImpSession) Owner() (common.Address, error) {
+	return _GovImp.Contract.Owner(&_GovImp.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_GovImp *GovImpCallerSession) Owner() (common.Address, error) {
+	return _GovImp.Contract.Owner(&_GovImp.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_GovImp *GovImpCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_GovImp *GovImpSession) ProxiableUUID() ([32]byte, error) {
+	return _GovImp.Contract.ProxiableUUID(&_GovImp.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_GovImp *GovImpCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _GovImp.Contract.ProxiableUUID(&_GovImp.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_GovImp *GovImpCaller) Reg(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "reg")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_GovImp *GovImpSession) Reg() (common.Address, error) {
+	return _GovImp.Contract.Reg(&_GovImp.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_GovImp *GovImpCallerSession) Reg() (common.Address, error) {
+	return _GovImp.Contract.Reg(&_GovImp.CallOpts)
+}
+
+// RewardIdx is a free data retrieval call binding the contract method 0xaaf0dd36.
+//
+// Solidity: function rewardIdx(address ) view returns(uint256)
+func (_GovImp *GovImpCaller) RewardIdx(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "rewardIdx", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RewardIdx is a free data retrieval call binding the contract method 0xaaf0dd36.
+//
+// Solidity: function rewardIdx(address ) view returns(uint256)
+func (_GovImp *GovImpSession) RewardIdx(arg0 common.Address) (*big.Int, error) {
+	return _GovImp.Contract.RewardIdx(&_GovImp.CallOpts, arg0)
+}
+
+// RewardIdx is a free data retrieval call binding the contract method 0xaaf0dd36.
+//
+// Solidity: function rewardIdx(address ) view returns(uint256)
+func (_GovImp *GovImpCallerSession) RewardIdx(arg0 common.Address) (*big.Int, error) {
+	return _GovImp.Contract.RewardIdx(&_GovImp.CallOpts, arg0)
+}
+
+// StakerIdx is a free data retrieval call binding the contract method 0xa0c12683.
+//
+// Solidity: function stakerIdx(address ) view returns(uint256)
+func (_GovImp *GovImpCaller) StakerIdx(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "stakerIdx", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StakerIdx is a free data retrieval call binding the contract method 0xa0c12683.
+//
+// Solidity: function stakerIdx(address ) view returns(uint256)
+func (_GovImp *GovImpSession) StakerIdx(arg0 common.Address) (*big.Int, error) {
+	return _GovImp.Contract.StakerIdx(&_GovImp.CallOpts, arg0)
+}
+
+// StakerIdx is a free data retrieval call binding the contract method 0xa0c12683.
+//
+// Solidity: function stakerIdx(address ) view returns(uint256)
+func (_GovImp *GovImpCallerSession) StakerIdx(arg0 common.Address) (*big.Int, error) {
+	return _GovImp.Contract.StakerIdx(&_GovImp.CallOpts, arg0)
+}
+
+// VoteLength is a free data retrieval call binding the contract method 0xe9523fb5.
+//
+// Solidity: function voteLength() view returns(uint256)
+func (_GovImp *GovImpCaller) VoteLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "voteLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VoteLength is a free data retrieval call binding the contract method 0xe9523fb5.
+//
+// Solidity: function voteLength() view returns(uint256)
+func (_GovImp *GovImpSession) VoteLength() (*big.Int, error) {
+	return _GovImp.Contract.VoteLength(&_GovImp.CallOpts)
+}
+
+// VoteLength is a free data retrieval call binding the contract method 0xe9523fb5.
+//
+// Solidity: function voteLength() view returns(uint256)
+func (_GovImp *GovImpCallerSession) VoteLength() (*big.Int, error) {
+	return _GovImp.Contract.VoteLength(&_GovImp.CallOpts)
+}
+
+// VoterIdx is a free data retrieval call binding the contract method 0xcec5b622.
+//
+// Solidity: function voterIdx(address ) view returns(uint256)
+func (_GovImp *GovImpCaller) VoterIdx(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _GovImp.contract.Call(opts, &out, "voterIdx", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VoterIdx is a free data retrieval call binding the contract method 0xcec5b622.
+//
+// Solidity: function voterIdx(address ) view returns(uint256)
+func (_GovImp *GovImpSession) VoterIdx(arg0 common.Address) (*big.Int, error) {
+	return _GovImp.Contract.VoterIdx(&_GovImp.CallOpts, arg0)
+}
+
+// VoterIdx is a free data retrieval call binding the contract method 0xcec5b622.
+//
+// Solidity: function voterIdx(address ) view returns(uint256)
+func (_GovImp *GovImpCallerSession) VoterIdx(arg0 common.Address) (*big.Int, error) {
+	return _GovImp.Contract.VoterIdx(&_GovImp.CallOpts, arg0)
+}
+
+// AddProposalToAddMember is a paid mutator transaction binding the contract method 0x36e83d83.
+//
+// Solidity: function addProposalToAddMember((address,address,address,bytes,bytes,bytes,uint256,uint256,bytes,uint256) info) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactor) AddProposalToAddMember(opts *bind.TransactOpts, info GovImpMemberInfo) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "addProposalToAddMember", info)
+}
+
+// AddProposalToAddMember is a paid mutator transaction binding the contract method 0x36e83d83.
+//
+// Solidity: function addProposalToAddMember((address,address,address,bytes,bytes,bytes,uint256,uint256,bytes,uint256) info) returns(uint256 ballotIdx)
+func (_GovImp *GovImpSession) AddProposalToAddMember(info GovImpMemberInfo) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToAddMember(&_GovImp.TransactOpts, info)
+}
+
+// AddProposalToAddMember is a paid mutator transaction binding the contract method 0x36e83d83.
+//
+// Solidity: function addProposalToAddMember((address,address,address,bytes,bytes,bytes,uint256,uint256,bytes,uint256) info) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactorSession) AddProposalToAddMember(info GovImpMemberInfo) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToAddMember(&_GovImp.TransactOpts, info)
+}
+
+// AddProposalToChangeEnv is a paid mutator transaction binding the contract method 0x40690353.
+//
+// Solidity: function addProposalToChangeEnv(bytes32 envName, uint256 envType, bytes envVal, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactor) AddProposalToChangeEnv(opts *bind.TransactOpts, envName [32]byte, envType *big.Int, envVal []byte, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "addProposalToChangeEnv", envName, envType, envVal, memo, duration)
+}
+
+// AddProposalToChangeEnv is a paid mutator transaction binding the contract method 0x40690353.
+//
+// Solidity: function addProposalToChangeEnv(bytes32 envName, uint256 envType, bytes envVal, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpSession) AddProposalToChangeEnv(envName [32]byte, envType *big.Int, envVal []byte, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToChangeEnv(&_GovImp.TransactOpts, envName, envType, envVal, memo, duration)
+}
+
+// AddProposalToChangeEnv is a paid mutator transaction binding the contract method 0x40690353.
+//
+// Solidity: function addProposalToChangeEnv(bytes32 envName, uint256 envType, bytes envVal, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactorSession) AddProposalToChangeEnv(envName [32]byte, envType *big.Int, envVal []byte, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToChangeEnv(&_GovImp.TransactOpts, envName, envType, envVal, memo, duration)
+}
+
+// AddProposalToChangeGov is a paid mutator transaction binding the contract method 0x0efa4909.
+//
+// Solidity: function addProposalToChangeGov(address newGovAddr, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactor) AddProposalToChangeGov(opts *bind.TransactOpts, newGovAddr common.Address, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "addProposalToChangeGov", newGovAddr, memo, duration)
+}
+
+// AddProposalToChangeGov is a paid mutator transaction binding the contract method 0x0efa4909.
+//
+// Solidity: function addProposalToChangeGov(address newGovAddr, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpSession) AddProposalToChangeGov(newGovAddr common.Address, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToChangeGov(&_GovImp.TransactOpts, newGovAddr, memo, duration)
+}
+
+// AddProposalToChangeGov is a paid mutator transaction binding the contract method 0x0efa4909.
+//
+// Solidity: function addProposalToChangeGov(address newGovAddr, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactorSession) AddProposalToChangeGov(newGovAddr common.Address, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToChangeGov(&_GovImp.TransactOpts, newGovAddr, memo, duration)
+}
+
+// AddProposalToChangeMember is a paid mutator transaction binding the contract method 0xce93a236.
+//
+// Solidity: function addProposalToChangeMember((address,address,address,bytes,bytes,bytes,uint256,uint256,bytes,uint256) newInfo, address oldStaker) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactor) AddProposalToChangeMember(opts *bind.TransactOpts, newInfo GovImpMemberInfo, oldStaker common.Address) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "addProposalToChangeMember", newInfo, oldStaker)
+}
+
+// AddProposalToChangeMember is a paid mutator transaction binding the contract method 0xce93a236.
+//
+// Solidity: function addProposalToChangeMember((address,address,address,bytes,bytes,bytes,uint256,uint256,bytes,uint256) newInfo, address oldStaker) returns(uint256 ballotIdx)
+func (_GovImp *GovImpSession) AddProposalToChangeMember(newInfo GovImpMemberInfo, oldStaker common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToChangeMember(&_GovImp.TransactOpts, newInfo, oldStaker)
+}
+
+// AddProposalToChangeMember is a paid mutator transaction binding the contract method 0xce93a236.
+//
+// Solidity: function addProposalToChangeMember((address,address,address,bytes,bytes,bytes,uint256,uint256,bytes,uint256) newInfo, address oldStaker) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactorSession) AddProposalToChangeMember(newInfo GovImpMemberInfo, oldStaker common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToChangeMember(&_GovImp.TransactOpts, newInfo, oldStaker)
+}
+
+// AddProposalToRemoveMember is a paid mutator transaction binding the contract method 0xd13a1744.
+//
+// Solidity: function addProposalToRemoveMember(address staker, uint256 lockAmount, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactor) AddProposalToRemoveMember(opts *bind.TransactOpts, staker common.Address, lockAmount *big.Int, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "addProposalToRemoveMember", staker, lockAmount, memo, duration)
+}
+
+// AddProposalToRemoveMember is a paid mutator transaction binding the contract method 0xd13a1744.
+//
+// Solidity: function addProposalToRemoveMember(address staker, uint256 lockAmount, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpSession) AddProposalToRemoveMember(staker common.Address, lockAmount *big.Int, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToRemoveMember(&_GovImp.TransactOpts, staker, lockAmount, memo, duration)
+}
+
+// AddProposalToRemoveMember is a paid mutator transaction binding the contract method 0xd13a1744.
+//
+// Solidity: function addProposalToRemoveMember(address staker, uint256 lockAmount, bytes memo, uint256 duration) returns(uint256 ballotIdx)
+func (_GovImp *GovImpTransactorSession) AddProposalToRemoveMember(staker common.Address, lockAmount *big.Int, memo []byte, duration *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.AddProposalToRemoveMember(&_GovImp.TransactOpts, staker, lockAmount, memo, duration)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xa8915a3e.
+//
+// Solidity: function init(address registry, uint256 lockAmount, bytes name, bytes enode, bytes ip, uint256 port) returns()
+func (_GovImp *GovImpTransactor) Init(opts *bind.TransactOpts, registry common.Address, lockAmount *big.Int, name []byte, enode []byte, ip []byte, port *big.Int) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "init", registry, lockAmount, name, enode, ip, port)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xa8915a3e.
+//
+// Solidity: function init(address registry, uint256 lockAmount, bytes name, bytes enode, bytes ip, uint256 port) returns()
+func (_GovImp *GovImpSession) Init(registry common.Address, lockAmount *big.Int, name []byte, enode []byte, ip []byte, port *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.Init(&_GovImp.TransactOpts, registry, lockAmount, name, enode, ip, port)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xa8915a3e.
+//
+// Solidity: function init(address registry, uint256 lockAmount, bytes name, bytes enode, bytes ip, uint256 port) returns()
+func (_GovImp *GovImpTransactorSession) Init(registry common.Address, lockAmount *big.Int, name []byte, enode []byte, ip []byte, port *big.Int) (*types.Transaction, error) {
+	return _GovImp.Contract.Init(&_GovImp.TransactOpts, registry, lockAmount, name, enode, ip, port)
+}
+
+// InitOnce is a paid mutator transaction binding the contract method 0x2a203623.
+//
+// Solidity: function initOnce(address registry, bytes data) returns()
+func (_GovImp *GovImpTransactor) InitOnce(opts *bind.TransactOpts, registry common.Address, data []byte) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "initOnce", registry, data)
+}
+
+// InitOnce is a paid mutator transaction binding the contract method 0x2a203623.
+//
+// Solidity: function initOnce(address registry, bytes data) returns()
+func (_GovImp *GovImpSession) InitOnce(registry common.Address, data []byte) (*types.Transaction, error) {
+	return _GovImp.Contract.InitOnce(&_GovImp.TransactOpts, registry, data)
+}
+
+// InitOnce is a paid mutator transaction binding the contract method 0x2a203623.
+//
+// Solidity: function initOnce(address registry, bytes data) returns()
+func (_GovImp *GovImpTransactorSession) InitOnce(registry common.Address, data []byte) (*types.Transaction, error) {
+	return _GovImp.Contract.InitOnce(&_GovImp.TransactOpts, registry, data)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_GovImp *GovImpTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_GovImp *GovImpSession) RenounceOwnership() (*types.Transaction, error) {
+	return _GovImp.Contract.RenounceOwnership(&_GovImp.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_GovImp *GovImpTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _GovImp.Contract.RenounceOwnership(&_GovImp.TransactOpts)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_GovImp *GovImpTransactor) SetRegistry(opts *bind.TransactOpts, _addr common.Address) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "setRegistry", _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_GovImp *GovImpSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.SetRegistry(&_GovImp.TransactOpts, _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_GovImp *GovImpTransactorSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.SetRegistry(&_GovImp.TransactOpts, _addr)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_GovImp *GovImpTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_GovImp *GovImpSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.TransferOwnership(&_GovImp.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_GovImp *GovImpTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.TransferOwnership(&_GovImp.TransactOpts, newOwner)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_GovImp *GovImpTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_GovImp *GovImpSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.UpgradeTo(&_GovImp.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_GovImp *GovImpTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _GovImp.Contract.UpgradeTo(&_GovImp.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_GovImp *GovImpTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_GovImp *GovImpSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _GovImp.Contract.UpgradeToAndCall(&_GovImp.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_GovImp *GovImpTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _GovImp.Contract.UpgradeToAndCall(&_GovImp.TransactOpts, newImplementation, data)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xc9d27afe.
+//
+// Solidity: function vote(uint256 ballotIdx, bool approval) returns()
+func (_GovImp *GovImpTransactor) Vote(opts *bind.TransactOpts, ballotIdx *big.Int, approval bool) (*types.Transaction, error) {
+	return _GovImp.contract.Transact(opts, "vote", ballotIdx, approval)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xc9d27afe.
+//
+// Solidity: function vote(uint256 ballotIdx, bool approval) returns()
+func (_GovImp *GovImpSession) Vote(ballotIdx *big.Int, approval bool) (*types.Transaction, error) {
+	return _GovImp.Contract.Vote(&_GovImp.TransactOpts, ballotIdx, approval)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xc9d27afe.
+//
+// Solidity: function vote(uint256 ballotIdx, bool approval) returns()
+func (_GovImp *GovImpTransactorSession) Vote(ballotIdx *big.Int, approval bool) (*types.Transaction, error) {
+	return _GovImp.Contract.Vote(&_GovImp.TransactOpts, ballotIdx, approval)
+}
+
+// GovImpAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the GovImp contract.
+type GovImpAdminChangedIterator struct {
+	Event *GovImpAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpAdminChanged represents a AdminChanged event raised by the GovImp contract.
+type GovImpAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_GovImp *GovImpFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*GovImpAdminChangedIterator, error) {
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpAdminChangedIterator{contract: _GovImp.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_GovImp *GovImpFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *GovImpAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpAdminChanged)
+				if err := _GovImp.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_GovImp *GovImpFilterer) ParseAdminChanged(log types.Log) (*GovImpAdminChanged, error) {
+	event := new(GovImpAdminChanged)
+	if err := _GovImp.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the GovImp contract.
+type GovImpBeaconUpgradedIterator struct {
+	Event *GovImpBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpBeaconUpgraded represents a BeaconUpgraded event raised by the GovImp contract.
+type GovImpBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_GovImp *GovImpFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*GovImpBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpBeaconUpgradedIterator{contract: _GovImp.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_GovImp *GovImpFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *GovImpBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpBeaconUpgraded)
+				if err := _GovImp.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_GovImp *GovImpFilterer) ParseBeaconUpgraded(log types.Log) (*GovImpBeaconUpgraded, error) {
+	event := new(GovImpBeaconUpgraded)
+	if err := _GovImp.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpEnvChangedIterator is returned from FilterEnvChanged and is used to iterate over the raw logs and unpacked data for EnvChanged events raised by the GovImp contract.
+type GovImpEnvChangedIterator struct {
+	Event *GovImpEnvChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpEnvChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpEnvChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpEnvChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpEnvChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpEnvChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpEnvChanged represents a EnvChanged event raised by the GovImp contract.
+type GovImpEnvChanged struct {
+	EnvName [32]byte
+	EnvType *big.Int
+	EnvVal  []byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterEnvChanged is a free log retrieval operation binding the contract event 0x701c16c2519cdb79aaac423a84733590e3510d9552055b6ad6908f0ab12b6c29.
+//
+// Solidity: event EnvChanged(bytes32 envName, uint256 envType, bytes envVal)
+func (_GovImp *GovImpFilterer) FilterEnvChanged(opts *bind.FilterOpts) (*GovImpEnvChangedIterator, error) {
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "EnvChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpEnvChangedIterator{contract: _GovImp.contract, event: "EnvChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEnvChanged is a free log subscription operation binding the contract event 0x701c16c2519cdb79aaac423a84733590e3510d9552055b6ad6908f0ab12b6c29.
+//
+// Solidity: event EnvChanged(bytes32 envName, uint256 envType, bytes envVal)
+func (_GovImp *GovImpFilterer) WatchEnvChanged(opts *bind.WatchOpts, sink chan<- *GovImpEnvChanged) (event.Subscription, error) {
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "EnvChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpEnvChanged)
+				if err := _GovImp.contract.UnpackLog(event, "EnvChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEnvChanged is a log parse operation binding the contract event 0x701c16c2519cdb79aaac423a84733590e3510d9552055b6ad6908f0ab12b6c29.
+//
+// Solidity: event EnvChanged(bytes32 envName, uint256 envType, bytes envVal)
+func (_GovImp *GovImpFilterer) ParseEnvChanged(log types.Log) (*GovImpEnvChanged, error) {
+	event := new(GovImpEnvChanged)
+	if err := _GovImp.contract.UnpackLog(event, "EnvChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the GovImp contract.
+type GovImpInitializedIterator struct {
+	Event *GovImpInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpInitialized represents a Initialized event raised by the GovImp contract.
+type GovImpInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_GovImp *GovImpFilterer) FilterInitialized(opts *bind.FilterOpts) (*GovImpInitializedIterator, error) {
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpInitializedIterator{contract: _GovImp.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_GovImp *GovImpFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *GovImpInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpInitialized)
+				if err := _GovImp.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_GovImp *GovImpFilterer) ParseInitialized(log types.Log) (*GovImpInitialized, error) {
+	event := new(GovImpInitialized)
+	if err := _GovImp.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpMemberAddedIterator is returned from FilterMemberAdded and is used to iterate over the raw logs and unpacked data for MemberAdded events raised by the GovImp contract.
+type GovImpMemberAddedIterator struct {
+	Event *GovImpMemberAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpMemberAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpMemberAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpMemberAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpMemberAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpMemberAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpMemberAdded represents a MemberAdded event raised by the GovImp contract.
+type GovImpMemberAdded struct {
+	Addr  common.Address
+	Voter common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterMemberAdded is a free log retrieval operation binding the contract event 0x6a2af11b2d73f347f9d5840aea46899e17609730b5cd91bd9c312098038acba6.
+//
+// Solidity: event MemberAdded(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) FilterMemberAdded(opts *bind.FilterOpts, addr []common.Address, voter []common.Address) (*GovImpMemberAddedIterator, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "MemberAdded", addrRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpMemberAddedIterator{contract: _GovImp.contract, event: "MemberAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchMemberAdded is a free log subscription operation binding the contract event 0x6a2af11b2d73f347f9d5840aea46899e17609730b5cd91bd9c312098038acba6.
+//
+// Solidity: event MemberAdded(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) WatchMemberAdded(opts *bind.WatchOpts, sink chan<- *GovImpMemberAdded, addr []common.Address, voter []common.Address) (event.Subscription, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "MemberAdded", addrRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpMemberAdded)
+				if err := _GovImp.contract.UnpackLog(event, "MemberAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMemberAdded is a log parse operation binding the contract event 0x6a2af11b2d73f347f9d5840aea46899e17609730b5cd91bd9c312098038acba6.
+//
+// Solidity: event MemberAdded(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) ParseMemberAdded(log types.Log) (*GovImpMemberAdded, error) {
+	event := new(GovImpMemberAdded)
+	if err := _GovImp.contract.UnpackLog(event, "MemberAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpMemberChangedIterator is returned from FilterMemberChanged and is used to iterate over the raw logs and unpacked data for MemberChanged events raised by the GovImp contract.
+type GovImpMemberChangedIterator struct {
+	Event *GovImpMemberChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpMemberChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpMemberChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpMemberChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpMemberChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpMemberChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpMemberChanged represents a MemberChanged event raised by the GovImp contract.
+type GovImpMemberChanged struct {
+	OldAddr  common.Address
+	NewAddr  common.Address
+	NewVoter common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterMemberChanged is a free log retrieval operation binding the contract event 0x15f4d750630db473a85edd9d47c500527a2648cc5e676f39645e52790cf07be0.
+//
+// Solidity: event MemberChanged(address indexed oldAddr, address indexed newAddr, address indexed newVoter)
+func (_GovImp *GovImpFilterer) FilterMemberChanged(opts *bind.FilterOpts, oldAddr []common.Address, newAddr []common.Address, newVoter []common.Address) (*GovImpMemberChangedIterator, error) {
+
+	var oldAddrRule []interface{}
+	for _, oldAddrItem := range oldAddr {
+		oldAddrRule = append(oldAddrRule, oldAddrItem)
+	}
+	var newAddrRule []interface{}
+	for _, newAddrItem := range newAddr {
+		newAddrRule = append(newAddrRule, newAddrItem)
+	}
+	var newVoterRule []interface{}
+	for _, newVoterItem := range newVoter {
+		newVoterRule = append(newVoterRule, newVoterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "MemberChanged", oldAddrRule, newAddrRule, newVoterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpMemberChangedIterator{contract: _GovImp.contract, event: "MemberChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchMemberChanged is a free log subscription operation binding the contract event 0x15f4d750630db473a85edd9d47c500527a2648cc5e676f39645e52790cf07be0.
+//
+// Solidity: event MemberChanged(address indexed oldAddr, address indexed newAddr, address indexed newVoter)
+func (_GovImp *GovImpFilterer) WatchMemberChanged(opts *bind.WatchOpts, sink chan<- *GovImpMemberChanged, oldAddr []common.Address, newAddr []common.Address, newVoter []common.Address) (event.Subscription, error) {
+
+	var oldAddrRule []interface{}
+	for _, oldAddrItem := range oldAddr {
+		oldAddrRule = append(oldAddrRule, oldAddrItem)
+	}
+	var newAddrRule []interface{}
+	for _, newAddrItem := range newAddr {
+		newAddrRule = append(newAddrRule, newAddrItem)
+	}
+	var newVoterRule []interface{}
+	for _, newVoterItem := range newVoter {
+		newVoterRule = append(newVoterRule, newVoterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "MemberChanged", oldAddrRule, newAddrRule, newVoterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpMemberChanged)
+				if err := _GovImp.contract.UnpackLog(event, "MemberChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMemberChanged is a log parse operation binding the contract event 0x15f4d750630db473a85edd9d47c500527a2648cc5e676f39645e52790cf07be0.
+//
+// Solidity: event MemberChanged(address indexed oldAddr, address indexed newAddr, address indexed newVoter)
+func (_GovImp *GovImpFilterer) ParseMemberChanged(log types.Log) (*GovImpMemberChanged, error) {
+	event := new(GovImpMemberChanged)
+	if err := _GovImp.contract.UnpackLog(event, "MemberChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpMemberRemovedIterator is returned from FilterMemberRemoved and is used to iterate over the raw logs and unpacked data for MemberRemoved events raised by the GovImp contract.
+type GovImpMemberRemovedIterator struct {
+	Event *GovImpMemberRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpMemberRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpMemberRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpMemberRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpMemberRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpMemberRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpMemberRemoved represents a MemberRemoved event raised by the GovImp contract.
+type GovImpMemberRemoved struct {
+	Addr  common.Address
+	Voter common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterMemberRemoved is a free log retrieval operation binding the contract event 0xaa91016c21c52c58ac64f23f71bbe75becc9ada603e18ee671d09ff15492d1c1.
+//
+// Solidity: event MemberRemoved(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) FilterMemberRemoved(opts *bind.FilterOpts, addr []common.Address, voter []common.Address) (*GovImpMemberRemovedIterator, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "MemberRemoved", addrRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpMemberRemovedIterator{contract: _GovImp.contract, event: "MemberRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchMemberRemoved is a free log subscription operation binding the contract event 0xaa91016c21c52c58ac64f23f71bbe75becc9ada603e18ee671d09ff15492d1c1.
+//
+// Solidity: event MemberRemoved(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) WatchMemberRemoved(opts *bind.WatchOpts, sink chan<- *GovImpMemberRemoved, addr []common.Address, voter []common.Address) (event.Subscription, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "MemberRemoved", addrRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpMemberRemoved)
+				if err := _GovImp.contract.UnpackLog(event, "MemberRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMemberRemoved is a log parse operation binding the contract event 0xaa91016c21c52c58ac64f23f71bbe75becc9ada603e18ee671d09ff15492d1c1.
+//
+// Solidity: event MemberRemoved(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) ParseMemberRemoved(log types.Log) (*GovImpMemberRemoved, error) {
+	event := new(GovImpMemberRemoved)
+	if err := _GovImp.contract.UnpackLog(event, "MemberRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpMemberUpdatedIterator is returned from FilterMemberUpdated and is used to iterate over the raw logs and unpacked data for MemberUpdated events raised by the GovImp contract.
+type GovImpMemberUpdatedIterator struct {
+	Event *GovImpMemberUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpMemberUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpMemberUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpMemberUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpMemberUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpMemberUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpMemberUpdated represents a MemberUpdated event raised by the GovImp contract.
+type GovImpMemberUpdated struct {
+	Addr  common.Address
+	Voter common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterMemberUpdated is a free log retrieval operation binding the contract event 0x1feee1b4fcb797c62645da41c5c6edd5f91d4291de0054da625c42b823594c1f.
+//
+// Solidity: event MemberUpdated(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) FilterMemberUpdated(opts *bind.FilterOpts, addr []common.Address, voter []common.Address) (*GovImpMemberUpdatedIterator, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "MemberUpdated", addrRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpMemberUpdatedIterator{contract: _GovImp.contract, event: "MemberUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchMemberUpdated is a free log subscription operation binding the contract event 0x1feee1b4fcb797c62645da41c5c6edd5f91d4291de0054da625c42b823594c1f.
+//
+// Solidity: event MemberUpdated(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) WatchMemberUpdated(opts *bind.WatchOpts, sink chan<- *GovImpMemberUpdated, addr []common.Address, voter []common.Address) (event.Subscription, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "MemberUpdated", addrRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpMemberUpdated)
+				if err := _GovImp.contract.UnpackLog(event, "MemberUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMemberUpdated is a log parse operation binding the contract event 0x1feee1b4fcb797c62645da41c5c6edd5f91d4291de0054da625c42b823594c1f.
+//
+// Solidity: event MemberUpdated(address indexed addr, address indexed voter)
+func (_GovImp *GovImpFilterer) ParseMemberUpdated(log types.Log) (*GovImpMemberUpdated, error) {
+	event := new(GovImpMemberUpdated)
+	if err := _GovImp.contract.UnpackLog(event, "MemberUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpNotApplicableIterator is returned from FilterNotApplicable and is used to iterate over the raw logs and unpacked data for NotApplicable events raised by the GovImp contract.
+type GovImpNotApplicableIterator struct {
+	Event *GovImpNotApplicable // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpNotApplicableIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpNotApplicable)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpNotApplicable)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpNotApplicableIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpNotApplicableIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpNotApplicable represents a NotApplicable event raised by the GovImp contract.
+type GovImpNotApplicable struct {
+	BallotId *big.Int
+	Reason   string
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterNotApplicable is a free log retrieval operation binding the contract event 0x85e7f4987c0698db47045ad8cea110b51138f0eecbd94915842328cf6c3dc97d.
+//
+// Solidity: event NotApplicable(uint256 indexed ballotId, string reason)
+func (_GovImp *GovImpFilterer) FilterNotApplicable(opts *bind.FilterOpts, ballotId []*big.Int) (*GovImpNotApplicableIterator, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "NotApplicable", ballotIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpNotApplicableIterator{contract: _GovImp.contract, event: "NotApplicable", logs: logs, sub: sub}, nil
+}
+
+// WatchNotApplicable is a free log subscription operation binding the contract event 0x85e7f4987c0698db47045ad8cea110b51138f0eecbd94915842328cf6c3dc97d.
+//
+// Solidity: event NotApplicable(uint256 indexed ballotId, string reason)
+func (_GovImp *GovImpFilterer) WatchNotApplicable(opts *bind.WatchOpts, sink chan<- *GovImpNotApplicable, ballotId []*big.Int) (event.Subscription, error) {
+
+	var ballotIdRule []interface{}
+	for _, ballotIdItem := range ballotId {
+		ballotIdRule = append(ballotIdRule, ballotIdItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "NotApplicable", ballotIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpNotApplicable)
+				if err := _GovImp.contract.UnpackLog(event, "NotApplicable", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNotApplicable is a log parse operation binding the contract event 0x85e7f4987c0698db47045ad8cea110b51138f0eecbd94915842328cf6c3dc97d.
+//
+// Solidity: event NotApplicable(uint256 indexed ballotId, string reason)
+func (_GovImp *GovImpFilterer) ParseNotApplicable(log types.Log) (*GovImpNotApplicable, error) {
+	event := new(GovImpNotApplicable)
+	if err := _GovImp.contract.UnpackLog(event, "NotApplicable", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the GovImp contract.
+type GovImpOwnershipTransferredIterator struct {
+	Event *GovImpOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpOwnershipTransferred represents a OwnershipTransferred event raised by the GovImp contract.
+type GovImpOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_GovImp *GovImpFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*GovImpOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpOwnershipTransferredIterator{contract: _GovImp.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_GovImp *GovImpFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *GovImpOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpOwnershipTransferred)
+				if err := _GovImp.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_GovImp *GovImpFilterer) ParseOwnershipTransferred(log types.Log) (*GovImpOwnershipTransferred, error) {
+	event := new(GovImpOwnershipTransferred)
+	if err := _GovImp.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovImpUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the GovImp contract.
+type GovImpUpgradedIterator struct {
+	Event *GovImpUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovImpUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovImpUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovImpUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovImpUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovImpUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovImpUpgraded represents a Upgraded event raised by the GovImp contract.
+type GovImpUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_GovImp *GovImpFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*GovImpUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _GovImp.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovImpUpgradedIterator{contract: _GovImp.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_GovImp *GovImpFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *GovImpUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _GovImp.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovImpUpgraded)
+				if err := _GovImp.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_GovImp *GovImpFilterer) ParseUpgraded(log types.Log) (*GovImpUpgraded, error) {
+	event := new(GovImpUpgraded)
+	if err := _GovImp.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RegistryMetaData contains all meta data concerning the Registry contract.
+var RegistryMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"setter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"name\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"SetContractDomain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_contract\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"granted\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"status\",\"type\":\"bool\"}],\"name\":\"SetPermission\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"contracts\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"}],\"name\":\"getContractAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_contract\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"_granted\",\"type\":\"address\"}],\"name\":\"getPermission\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"found\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"magic\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"modifiedBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"permissions\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_name\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"setContractDomain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_contract\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"_granted\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_status\",\"type\":\"bool\"}],\"name\":\"setPermission\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040526d57656d697820526567697374727960015534801561002257600080fd5b5061002c33610031565b610081565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6105f8806100906000396000f3fe608060405234801561001057600080fd5b50600436106100a95760003560e01c806360d6c7cf1161007157806360d6c7cf14610159578063715018a6146101925780637d10dd1b1461019c5780638da5cb5b146101a5578063ec56a373146101b6578063f2fde38b146101df57600080fd5b806304af66ad146100ae5780630d2020dd146100d65780630d854646146101015780633ec50c6c14610118578063599e4c7014610146575b600080fd5b6100c16100bc36600461051a565b6101f2565b60405190151581526020015b60405180910390f35b6100e96100e4366004610501565b610298565b6040516001600160a01b0390911681526020016100cd565b61010a60015481565b6040519081526020016100cd565b6100c161012636600461051a565b600460209081526000928352604080842090915290825290205460ff1681565b6100c1610154366004610546565b6102e8565b6100c161016736600461051a565b60009182526004602090815260408084206001600160a01b0393909316845291905290205460ff1690565b61019a61038c565b005b61010a60025481565b6000546001600160a01b03166100e9565b6100e96101c4366004610501565b6003602052600090815260409020546001600160a01b031681565b61019a6101ed3660046104df565b6103a0565b60006101fc610419565b6001600160a01b03821661022b5760405162461bcd60e51b81526004016102229061058b565b60405180910390fd5b60008381526003602090815260409182902080546001600160a01b0319166001600160a01b03861690811790915543600255915133815285917f37724a4a9968ac9654e6ee52f3d0c93e5ef8863e057254ee2e36e8ad3e8429db910160405180910390a350600192915050565b6000818152600360205260408120546001600160a01b03166102cc5760405162461bcd60e51b81526004016102229061058b565b506000908152600360205260409020546001600160a01b031690565b60006102f2610419565b6001600160a01b0383166103185760405162461bcd60e51b81526004016102229061058b565b60008481526004602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915543600255905190815286917fe9f5231bbfb4b32867755b94562215cff6c8998489de8ba20926f8d0980e7818910160405180910390a35060019392505050565b610394610419565b61039e6000610473565b565b6103a8610419565b6001600160a01b03811661040d5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610222565b61041681610473565b50565b6000546001600160a01b0316331461039e5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610222565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b80356001600160a01b03811681146104da57600080fd5b919050565b6000602082840312156104f157600080fd5b6104fa826104c3565b9392505050565b60006020828403121561051357600080fd5b5035919050565b6000806040838503121561052d57600080fd5b8235915061053d602084016104c3565b90509250929050565b60008060006060848603121561055b57600080fd5b8335925061056b602085016104c3565b91506040840135801515811461058057600080fd5b809150509250925092565b6020808252601a908201527f616464726573732073686f756c64206265206e6f6e2d7a65726f00000000000060408201526060019056fea2646970667358221220eeee967a13ae1cd939ec0547b9b2872ee4c97c7b25c4fee1c22c3354fc2b565c64736f6c63430008060033",
+}
+
+// RegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use RegistryMetaData.ABI instead.
+var RegistryABI = RegistryMetaData.ABI
+
+// RegistryBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use RegistryMetaData.Bin instead.
+var RegistryBin = RegistryMetaData.Bin
+
+// DeployRegistry deploys a new Ethereum contract, binding an instance of Registry to it.
+func DeployRegistry(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Registry, error) {
+	parsed, err := RegistryMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(RegistryBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Registry{RegistryCaller: RegistryCaller{contract: contract}, RegistryTransactor: RegistryTransactor{contract: contract}, RegistryFilterer: RegistryFilterer{contract: contract}}, nil
+}
+
+// Registry is an auto generated Go binding around an Ethereum contract.
+type Registry struct {
+	RegistryCaller     // Read-only binding to the contract
+	RegistryTransactor // Write-only binding to the contract
+	RegistryFilterer   // Log filterer for contract events
+}
+
+// RegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RegistrySession struct {
+	Contract     *Registry         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// RegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RegistryCallerSession struct {
+	Contract *RegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// RegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RegistryTransactorSession struct {
+	Contract     *RegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// RegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RegistryRaw struct {
+	Contract *Registry // Generic contract binding to access the raw methods on
+}
+
+// RegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RegistryCallerRaw struct {
+	Contract *RegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RegistryTransactorRaw struct {
+	Contract *RegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRegistry creates a new instance of Registry, bound to a specific deployed contract.
+func NewRegistry(address common.Address, backend bind.ContractBackend) (*Registry, error) {
+	contract, err := bindRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{RegistryCaller: RegistryCaller{contract: contract}, RegistryTransactor: RegistryTransactor{contract: contract}, RegistryFilterer: RegistryFilterer{contract: contract}}, nil
+}
+
+// NewRegistryCaller creates a new read-only instance of Registry, bound to a specific deployed contract.
+func NewRegistryCaller(address common.Address, caller bind.ContractCaller) (*RegistryCaller, error) {
+	contract, err := bindRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryCaller{contract: contract}, nil
+}
+
+// NewRegistryTransactor creates a new write-only instance of Registry, bound to a specific deployed contract.
+func NewRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*RegistryTransactor, error) {
+	contract, err := bindRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryTransactor{contract: contract}, nil
+}
+
+// NewRegistryFilterer creates a new log filterer instance of Registry, bound to a specific deployed contract.
+func NewRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*RegistryFilterer, error) {
+	contract, err := bindRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryFilterer{contract: contract}, nil
+}
+
+// bindRegistry binds a generic wrapper to an already deployed contract.
+func bindRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(RegistryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Registry *RegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Registry.Contract.RegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Registry *RegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.Contract.RegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Registry *RegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Registry.Contract.RegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Registry *RegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Registry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Registry *RegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Registry *RegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Registry.Contract.contract.Transact(opts, method, params...)
+}
+
+// Contracts is a free data retrieval call binding the contract method 0xec56a373.
+//
+// Solidity: function contracts(bytes32 ) view returns(address)
+func (_Registry *RegistryCaller) Contracts(opts *bind.CallOpts, arg0 [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "contracts", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Contracts is a free data retrieval call binding the contract method 0xec56a373.
+//
+// Solidity: function contracts(bytes32 ) view returns(address)
+func (_Registry *RegistrySession) Contracts(arg0 [32]byte) (common.Address, error) {
+	return _Registry.Contract.Contracts(&_Registry.CallOpts, arg0)
+}
+
+// Contracts is a free data retrieval call binding the contract method 0xec56a373.
+//
+// Solidity: function contracts(bytes32 ) view returns(address)
+func (_Registry *RegistryCallerSession) Contracts(arg0 [32]byte) (common.Address, error) {
+	return _Registry.Contract.Contracts(&_Registry.CallOpts, arg0)
+}
+
+// GetContractAddress is a free data retrieval call binding the contract method 0x0d2020dd.
+//
+// Solidity: function getContractAddress(bytes32 _name) view returns(address addr)
+func (_Registry *RegistryCaller) GetContractAddress(opts *bind.CallOpts, _name [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getContractAddress", _name)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetContractAddress is a free data retrieval call binding the contract method 0x0d2020dd.
+//
+// Solidity: function getContractAddress(bytes32 _name) view returns(address addr)
+func (_Registry *RegistrySession) GetContractAddress(_name [32]byte) (common.Address, error) {
+	return _Registry.Contract.GetContractAddress(&_Registry.CallOpts, _name)
+}
+
+// GetContractAddress is a free data retrieval call binding the contract method 0x0d2020dd.
+//
+// Solidity: function getContractAddress(bytes32 _name) view returns(address addr)
+func (_Registry *RegistryCallerSession) GetContractAddress(_name [32]byte) (common.Address, error) {
+	return _Registry.Contract.GetContractAddress(&_Registry.CallOpts, _name)
+}
+
+// GetPermission is a free data retrieval call binding the contract method 0x60d6c7cf.
+//
+// Solidity: function getPermission(bytes32 _contract, address _granted) view returns(bool found)
+func (_Registry *RegistryCaller) GetPermission(opts *bind.CallOpts, _contract [32]byte, _granted common.Address) (bool, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getPermission", _contract, _granted)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GetPermission is a free data retrieval call binding the contract method 0x60d6c7cf.
+//
+// Solidity: function getPermission(bytes32 _contract, address _granted) view returns(bool found)
+func (_Registry *RegistrySession) GetPermission(_contract [32]byte, _granted common.Address) (bool, error) {
+	return _Registry.Contract.GetPermission(&_Registry.CallOpts, _contract, _granted)
+}
+
+// GetPermission is a free data retrieval call binding the contract method 0x60d6c7cf.
+//
+// Solidity: function getPermission(bytes32 _contract, address _granted) view returns(bool found)
+func (_Registry *RegistryCallerSession) GetPermission(_contract [32]byte, _granted common.Address) (bool, error) {
+	return _Registry.Contract.GetPermission(&_Registry.CallOpts, _contract, _granted)
+}
+
+// Magic is a free data retrieval call binding the contract method 0x0d854646.
+//
+// Solidity: function magic() view returns(uint256)
+func (_Registry *RegistryCaller) Magic(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "magic")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Magic is a free data retrieval call binding the contract method 0x0d854646.
+//
+// Solidity: function magic() view returns(uint256)
+func (_Registry *RegistrySession) Magic() (*big.Int, error) {
+	return _Registry.Contract.Magic(&_Registry.CallOpts)
+}
+
+// Magic is a free data retrieval call binding the contract method 0x0d854646.
+//
+// Solidity: function magic() view returns(uint256)
+func (_Registry *RegistryCallerSession) Magic() (*big.Int, error) {
+	return _Registry.Contract.Magic(&_Registry.CallOpts)
+}
+
+// ModifiedBlock is a free data retrieval call binding the contract method 0x7d10dd1b.
+//
+// Solidity: function modifiedBlock() view returns(uint256)
+func (_Registry *RegistryCaller) ModifiedBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "modifiedBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ModifiedBlock is a free data retrieval call binding the contract method 0x7d10dd1b.
+//
+// Solidity: function modifiedBlock() view returns(uint256)
+func (_Registry *RegistrySession) ModifiedBlock() (*big.Int, error) {
+	return _Registry.Contract.ModifiedBlock(&_Registry.CallOpts)
+}
+
+// ModifiedBlock is a free data retrieval call binding the contract method 0x7d10dd1b.
+//
+// Solidity: function modifiedBlock() view returns(uint256)
+func (_Registry *RegistryCallerSession) ModifiedBlock() (*big.Int, error) {
+	return _Registry.Contract.ModifiedBlock(&_Registry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistrySession) Owner() (common.Address, error) {
+	return _Registry.Contract.Owner(&_Registry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistryCallerSession) Owner() (common.Address, error) {
+	return _Registry.Contract.Owner(&_Registry.CallOpts)
+}
+
+// Permissions is a free data retrieval call binding the contract method 0x3ec50c6c.
+//
+// Solidity: function permissions(bytes32 , address ) view returns(bool)
+func (_Registry *RegistryCaller) Permissions(opts *bind.CallOpts, arg0 [32]byte, arg1 common.Address) (bool, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "permissions", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Permissions is a free data retrieval call binding the contract method 0x3ec50c6c.
+//
+// Solidity: function permissions(bytes32 , address ) view returns(bool)
+func (_Registry *RegistrySession) Permissions(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _Registry.Contract.Permissions(&_Registry.CallOpts, arg0, arg1)
+}
+
+// Permissions is a free data retrieval call binding the contract method 0x3ec50c6c.
+//
+// Solidity: function permissions(bytes32 , address ) view returns(bool)
+func (_Registry *RegistryCallerSession) Permissions(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _Registry.Contract.Permissions(&_Registry.CallOpts, arg0, arg1)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Registry *RegistryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Registry *RegistrySession) RenounceOwnership() (*types.Transaction, error) {
+	return _Registry.Contract.RenounceOwnership(&_Registry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Registry *RegistryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Registry.Contract.RenounceOwnership(&_Registry.TransactOpts)
+}
+
+// SetContractDomain is a paid mutator transaction binding the contract method 0x04af66ad.
+//
+// Solidity: function setContractDomain(bytes32 _name, address _addr) returns(bool success)
+func (_Registry *RegistryTransactor) SetContractDomain(opts *bind.TransactOpts, _name [32]byte, _addr common.Address) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "setContractDomain", _name, _addr)
+}
+
+// SetContractDomain is a paid mutator transaction binding the contract method 0x04af66ad.
+//
+// Solidity: function setContractDomain(bytes32 _name, address _addr) returns(bool success)
+func (_Registry *RegistrySession) SetContractDomain(_name [32]byte, _addr common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.SetContractDomain(&_Registry.TransactOpts, _name, _addr)
+}
+
+// SetContractDomain is a paid mutator transaction binding the contract method 0x04af66ad.
+//
+// Solidity: function setContractDomain(bytes32 _name, address _addr) returns(bool success)
+func (_Registry *RegistryTransactorSession) SetContractDomain(_name [32]byte, _addr common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.SetContractDomain(&_Registry.TransactOpts, _name, _addr)
+}
+
+// SetPermission is a paid mutator transaction binding the contract method 0x599e4c70.
+//
+// Solidity: function setPermission(bytes32 _contract, address _granted, bool _status) returns(bool success)
+func (_Registry *RegistryTransactor) SetPermission(opts *bind.TransactOpts, _contract [32]byte, _granted common.Address, _status bool) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "setPermission", _contract, _granted, _status)
+}
+
+// SetPermission is a paid mutator transaction binding the contract method 0x599e4c70.
+//
+// Solidity: function setPermission(bytes32 _contract, address _granted, bool _status) returns(bool success)
+func (_Registry *RegistrySession) SetPermission(_contract [32]byte, _granted common.Address, _status bool) (*types.Transaction, error) {
+	return _Registry.Contract.SetPermission(&_Registry.TransactOpts, _contract, _granted, _status)
+}
+
+// SetPermission is a paid mutator transaction binding the contract method 0x599e4c70.
+//
+// Solidity: function setPermission(bytes32 _contract, address _granted, bool _status) returns(bool success)
+func (_Registry *RegistryTransactorSession) SetPermission(_contract [32]byte, _granted common.Address, _status bool) (*types.Transaction, error) {
+	return _Registry.Contract.SetPermission(&_Registry.TransactOpts, _contract, _granted, _status)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Registry *RegistryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Registry *RegistrySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.TransferOwnership(&_Registry.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Registry *RegistryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.TransferOwnership(&_Registry.TransactOpts, newOwner)
+}
+
+// RegistryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Registry contract.
+type RegistryOwnershipTransferredIterator struct {
+	Event *RegistryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistryOwnershipTransferred represents a OwnershipTransferred event raised by the Registry contract.
+type RegistryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Registry *RegistryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*RegistryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryOwnershipTransferredIterator{contract: _Registry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Registry *RegistryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *RegistryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistryOwnershipTransferred)
+				if err := _Registry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Registry *RegistryFilterer) ParseOwnershipTransferred(log types.Log) (*RegistryOwnershipTransferred, error) {
+	event := new(RegistryOwnershipTransferred)
+	if err := _Registry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RegistrySetContractDomainIterator is returned from FilterSetContractDomain and is used to iterate over the raw logs and unpacked data for SetContractDomain events raised by the Registry contract.
+type RegistrySetContractDomainIterator struct {
+	Event *RegistrySetContractDomain // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistrySetContractDomainIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistrySetContractDomain)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistrySetContractDomain)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistrySetContractDomainIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistrySetContractDomainIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistrySetContractDomain represents a SetContractDomain event raised by the Registry contract.
+type RegistrySetContractDomain struct {
+	Setter common.Address
+	Name   [32]byte
+	Addr   common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetContractDomain is a free log retrieval operation binding the contract event 0x37724a4a9968ac9654e6ee52f3d0c93e5ef8863e057254ee2e36e8ad3e8429db.
+//
+// Solidity: event SetContractDomain(address setter, bytes32 indexed name, address indexed addr)
+func (_Registry *RegistryFilterer) FilterSetContractDomain(opts *bind.FilterOpts, name [][32]byte, addr []common.Address) (*RegistrySetContractDomainIterator, error) {
+
+	var nameRule []interface{}
+	for _, nameItem := range name {
+		nameRule = append(nameRule, nameItem)
+	}
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "SetContractDomain", nameRule, addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistrySetContractDomainIterator{contract: _Registry.contract, event: "SetContractDomain", logs: logs, sub: sub}, nil
+}
+
+// WatchSetContractDomain is a free log subscription operation binding the contract event 0x37724a4a9968ac9654e6ee52f3d0c93e5ef8863e057254ee2e36e8ad3e8429db.
+//
+// Solidity: event SetContractDomain(address setter, bytes32 indexed name, address indexed addr)
+func (_Registry *RegistryFilterer) WatchSetContractDomain(opts *bind.WatchOpts, sink chan<- *RegistrySetContractDomain, name [][32]byte, addr []common.Address) (event.Subscription, error) {
+
+	var nameRule []interface{}
+	for _, nameItem := range name {
+		nameRule = append(nameRule, nameItem)
+	}
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "SetContractDomain", nameRule, addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistrySetContractDomain)
+				if err := _Registry.contract.UnpackLog(event, "SetContractDomain", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetContractDomain is a log parse operation binding the contract event 0x37724a4a9968ac9654e6ee52f3d0c93e5ef8863e057254ee2e36e8ad3e8429db.
+//
+// Solidity: event SetContractDomain(address setter, bytes32 indexed name, address indexed addr)
+func (_Registry *RegistryFilterer) ParseSetContractDomain(log types.Log) (*RegistrySetContractDomain, error) {
+	event := new(RegistrySetContractDomain)
+	if err := _Registry.contract.UnpackLog(event, "SetContractDomain", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RegistrySetPermissionIterator is returned from FilterSetPermission and is used to iterate over the raw logs and unpacked data for SetPermission events raised by the Registry contract.
+type RegistrySetPermissionIterator struct {
+	Event *RegistrySetPermission // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistrySetPermissionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistrySetPermission)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistrySetPermission)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistrySetPermissionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistrySetPermissionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistrySetPermission represents a SetPermission event raised by the Registry contract.
+type RegistrySetPermission struct {
+	Contract [32]byte
+	Granted  common.Address
+	Status   bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetPermission is a free log retrieval operation binding the contract event 0xe9f5231bbfb4b32867755b94562215cff6c8998489de8ba20926f8d0980e7818.
+//
+// Solidity: event SetPermission(bytes32 indexed _contract, address indexed granted, bool status)
+func (_Registry *RegistryFilterer) FilterSetPermission(opts *bind.FilterOpts, _contract [][32]byte, granted []common.Address) (*RegistrySetPermissionIterator, error) {
+
+	var _contractRule []interface{}
+	for _, _contractItem := range _contract {
+		_contractRule = append(_contractRule, _contractItem)
+	}
+	var grantedRule []interface{}
+	for _, grantedItem := range granted {
+		grantedRule = append(grantedRule, grantedItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "SetPermission", _contractRule, grantedRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistrySetPermissionIterator{contract: _Registry.contract, event: "SetPermission", logs: logs, sub: sub}, nil
+}
+
+// WatchSetPermission is a free log subscription operation binding the contract event 0xe9f5231bbfb4b32867755b94562215cff6c8998489de8ba20926f8d0980e7818.
+//
+// Solidity: event SetPermission(bytes32 indexed _contract, address indexed granted, bool status)
+func (_Registry *RegistryFilterer) WatchSetPermission(opts *bind.WatchOpts, sink chan<- *RegistrySetPermission, _contract [][32]byte, granted []common.Address) (event.Subscription, error) {
+
+	var _contractRule []interface{}
+	for _, _contractItem := range _contract {
+		_contractRule = append(_contractRule, _contractItem)
+	}
+	var grantedRule []interface{}
+	for _, grantedItem := range granted {
+		grantedRule = append(grantedRule, grantedItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "SetPermission", _contractRule, grantedRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistrySetPermission)
+				if err := _Registry.contract.UnpackLog(event, "SetPermission", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetPermission is a log parse operation binding the contract event 0xe9f5231bbfb4b32867755b94562215cff6c8998489de8ba20926f8d0980e7818.
+//
+// Solidity: event SetPermission(bytes32 indexed _contract, address indexed granted, bool status)
+func (_Registry *RegistryFilterer) ParseSetPermission(log types.Log) (*RegistrySetPermission, error) {
+	event := new(RegistrySetPermission)
+	if err := _Registry.contract.UnpackLog(event, "SetPermission", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingMetaData contains all meta data concerning the Staking contract.
+var StakingMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"registry\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"available\",\"type\":\"uint256\"}],\"name\":\"Locked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Revoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"available\",\"type\":\"uint256\"}],\"name\":\"Staked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"available\",\"type\":\"uint256\"}],\"name\":\"TransferLocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"available\",\"type\":\"uint256\"}],\"name\":\"Unlocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"available\",\"type\":\"uint256\"}],\"name\":\"Unstaked\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BALLOT_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ENV_STORAGE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GOV_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAINTENANCE_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REWARD_POOL_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_NAME\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"}],\"name\":\"availableBalanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"}],\"name\":\"calcVotingWeight\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"factor\",\"type\":\"uint32\"}],\"name\":\"calcVotingWeightWithScaleFactor\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isRevoked\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"lockAmount\",\"type\":\"uint256\"}],\"name\":\"lock\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"lockAmount\",\"type\":\"uint256\"}],\"name\":\"lockMore\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"}],\"name\":\"lockedBalanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"reg\",\"outputs\":[{\"internalType\":\"contractIRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"revoke\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"setRegistry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferLocked\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"payee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"unlockAmount\",\"type\":\"uint256\"}],\"name\":\"unlock\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x6080604052606a805460ff191690553480156200001b57600080fd5b506040516200198d3803806200198d8339810160408190526200003e9162000255565b6001606655600060695562000053336200011d565b6200005e826200016f565b80516200006b5762000115565b60008060008060208501915084518262000086919062000355565b90505b80821015620001105781519350620000a360208362000355565b9150808210620000b257600080fd5b81519250620000c360208362000355565b6001600160a01b0385166000908152606760209081526040808320879055606890915281208590556069805492945085929091906200010490849062000355565b90915550620000899050565b505050505b505062000392565b603380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b62000179620001f7565b6001600160a01b038116620001d55760405162461bcd60e51b815260206004820152601a60248201527f416464726573732073686f756c64206265206e6f6e2d7a65726f00000000000060448201526064015b60405180910390fd5b606580546001600160a01b0319166001600160a01b0392909216919091179055565b6033546001600160a01b03163314620002535760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401620001cc565b565b600080604083850312156200026957600080fd5b82516001600160a01b03811681146200028157600080fd5b602084810151919350906001600160401b0380821115620002a157600080fd5b818601915086601f830112620002b657600080fd5b815181811115620002cb57620002cb6200037c565b604051601f8201601f19908116603f01168101908382118183101715620002f657620002f66200037c565b8160405282815289868487010111156200030f57600080fd5b600093505b8284101562000333578484018601518185018701529285019262000314565b82841115620003455760008684830101525b8096505050505050509250929050565b600082198211156200037757634e487b7160e01b600052601160045260246000fd5b500190565b634e487b7160e01b600052604160045260246000fd5b6115eb80620003a26000396000f3fe60806040526004361061014f5760003560e01c8063738fdd1a116100b65780638da5cb5b1161006f5780638da5cb5b146103fa5780639986e4b914610418578063a91ee0dc1461043c578063b6549f751461045c578063d0e30db014610471578063f2fde38b1461047957600080fd5b8063738fdd1a146103215780637756c908146103595780637bf46530146103795780637d77a0eb1461039a5780637eee288d146103ba578063884d97a7146103da57600080fd5b80634bd1ed76116101085780634bd1ed7614610235578063593557361461025757806365c4b87c1461028d5780636c78d2cf146102ad57806370a08231146102d6578063715018a61461030c57600080fd5b80631e0cba0d1461015e57806325d998bb1461018f578063282d3fdf146101af5780632bc9ed02146101d15780632e1a7d4d146101f45780632f40992e1461021457600080fd5b3661015957600080fd5b600080fd5b34801561016a57600080fd5b5061017c665374616b696e6760c81b81565b6040519081526020015b60405180910390f35b34801561019b57600080fd5b5061017c6101aa3660046113d3565b610499565b3480156101bb57600080fd5b506101cf6101ca36600461140d565b6104cd565b005b3480156101dd57600080fd5b50606a5460ff166040519015158152602001610186565b34801561020057600080fd5b506101cf61020f36600461149d565b610513565b34801561022057600080fd5b5061017c6914995dd85c99141bdbdb60b21b81565b34801561024157600080fd5b5061017c6a4d61696e74656e616e636560a81b81565b34801561026357600080fd5b5061017c6102723660046113d3565b6001600160a01b031660009081526068602052604090205490565b34801561029957600080fd5b506101cf6102a836600461140d565b6107d4565b3480156102b957600080fd5b5061017c7111dbdd995c9b985b98d950dbdb9d1c9858dd60721b81565b3480156102e257600080fd5b5061017c6102f13660046113d3565b6001600160a01b031660009081526067602052604090205490565b34801561031857600080fd5b506101cf610871565b34801561032d57600080fd5b50606554610341906001600160a01b031681565b6040516001600160a01b039091168152602001610186565b34801561036557600080fd5b506101cf61037436600461140d565b610885565b34801561038557600080fd5b5061017c69456e7653746f7261676560b01b81565b3480156103a657600080fd5b5061017c6103b5366004611439565b6109ae565b3480156103c657600080fd5b506101cf6103d536600461140d565b610a22565b3480156103e657600080fd5b5061017c6103f53660046113d3565b610a5b565b34801561040657600080fd5b506033546001600160a01b0316610341565b34801561042457600080fd5b5061017c6c42616c6c6f7453746f7261676560981b81565b34801561044857600080fd5b506101cf6104573660046113d3565b610a68565b34801561046857600080fd5b506101cf610ae8565b6101cf610bf2565b34801561048557600080fd5b506101cf6104943660046113d3565b610eca565b6001600160a01b03811660009081526068602090815260408083205460679092528220546104c79190611573565b92915050565b336104d6610f43565b6001600160a01b0316146105055760405162461bcd60e51b81526004016104fc906114cf565b60405180910390fd5b61050f8282610f68565b5050565b600260665414156105665760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016104fc565b6002606655606a5460ff161561058e5760405162461bcd60e51b81526004016104fc906114f6565b600081116105e85760405162461bcd60e51b815260206004820152602160248201527f416d6f756e742073686f756c6420626520626967676572207468616e207a65726044820152606f60f81b60648201526084016104fc565b60006105f26111c6565b6001600160a01b031663076cd77f6040518163ffffffff1660e01b815260040160206040518083038186803b15801561062a57600080fd5b505afa15801561063e573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061066291906114b6565b336000908152606860205260409020549091508110156106a157336000818152606860205260409020546106a1919061069c908490611573565b6111de565b6106aa33610499565b8211156107165760405162461bcd60e51b815260206004820152603460248201527f576974686472617720616d6f756e742073686f756c6420626520657175616c206044820152736f72206c657373207468616e2062616c616e636560601b60648201526084016104fc565b33600090815260676020526040902054610731908390611573565b33600081815260676020526040808220939093559151909184156108fc02918591818181858888f1935050505015801561076f573d6000803e3d6000fd5b50336000818152606760205260409020547f204fccf0d92ed8d48f204adb39b2e81e92bad0dedb93f5716ca9478cfb57de009084906107ad84610499565b6040805193845260208401929092529082015260600160405180910390a250506001606655565b6107dc610f43565b604051636f1e853360e01b81523360048201526001600160a01b039190911690636f1e85339060240160206040518083038186803b15801561081d57600080fd5b505afa158015610831573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610855919061147b565b6105055760405162461bcd60e51b81526004016104fc906114cf565b610879611296565b61088360006112f0565b565b3361088e610f43565b6001600160a01b0316146108b45760405162461bcd60e51b81526004016104fc906114cf565b806108bd575050565b6108c78282610a22565b6001600160a01b0382166000908152606760205260409020546108eb908290611573565b6001600160a01b03831660009081526067602052604081209190915561090f611342565b6001600160a01b03811660009081526067602052604090205490915061093690839061151a565b6001600160a01b0380831660009081526067602052604080822093909355908516808252919020547f2caed32a519a1fd89486d3ffe06202febb5ed51534d571dbab93058545a29e2490849061098b87610499565b6040805193845260208401929092529082015260600160405180910390a2505050565b6001600160a01b03821660009081526068602052604081205415806109d7575063ffffffff8216155b156109e4575060006104c7565b6069546001600160a01b038416600090815260686020526040902054610a119063ffffffff851690611554565b610a1b9190611532565b9392505050565b33610a2b610f43565b6001600160a01b031614610a515760405162461bcd60e51b81526004016104fc906114cf565b61050f82826111de565b60006104c78260646109ae565b610a70611296565b6001600160a01b038116610ac65760405162461bcd60e51b815260206004820152601a60248201527f416464726573732073686f756c64206265206e6f6e2d7a65726f00000000000060448201526064016104fc565b606580546001600160a01b0319166001600160a01b0392909216919091179055565b610af0611296565b606a5460ff1615610b135760405162461bcd60e51b81526004016104fc906114f6565b6000610b276033546001600160a01b031690565b90504780610b655760405162461bcd60e51b815260206004820152600b60248201526a062616c616e6365203d20360ac1b60448201526064016104fc565b6040516001600160a01b0383169082156108fc029083906000818181858888f19350505050158015610b9b573d6000803e3d6000fd5b50606a805460ff191660011790556040516001600160a01b038316907f713b90881ad62c4fa8ab6bd9197fa86481fc0c11b2edba60026514281b2dbac490610be69084815260200190565b60405180910390a25050565b60026066541415610c455760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016104fc565b6002606655606a5460ff1615610c6d5760405162461bcd60e51b81526004016104fc906114f6565b60003411610cd05760405162461bcd60e51b815260206004820152602a60248201527f4465706f73697420616d6f756e742073686f756c642062652067726561746572604482015269207468616e207a65726f60b01b60648201526084016104fc565b33600090815260676020526040902054610ceb90349061151a565b33600090815260676020526040902055610d03610f43565b60405163288c314960e21b81523360048201526001600160a01b03919091169063a230c5249060240160206040518083038186803b158015610d4457600080fd5b505afa158015610d58573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610d7c919061147b565b15610e68576000610d8b6111c6565b6001600160a01b031663076cd77f6040518163ffffffff1660e01b815260040160206040518083038186803b158015610dc357600080fd5b505afa158015610dd7573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610dfb91906114b6565b3360009081526068602052604090205490915081118015610e3d575033600090815260686020526040902054610e319082611573565b610e3a33610499565b10155b15610e665733600081815260686020526040902054610e669190610e619084611573565b610f68565b505b336000818152606760205260409020547fb4caaf29adda3eefee3ad552a8e85058589bf834c7466cae4ee58787f70589ed903490610ea584610499565b6040805193845260208401929092529082015260600160405180910390a26001606655565b610ed2611296565b6001600160a01b038116610f375760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016104fc565b610f40816112f0565b50565b6000610f637111dbdd995c9b985b98d950dbdb9d1c9858dd60721b611356565b905090565b80610f71575050565b6001600160a01b038216600090815260676020526040902054811115610ff25760405162461bcd60e51b815260206004820152603060248201527f4c6f636b20616d6f756e742073686f756c6420626520657175616c206f72206c60448201526f657373207468616e2062616c616e636560801b60648201526084016104fc565b80610ffc83610499565b101561105a5760405162461bcd60e51b815260206004820152602760248201527f496e73756666696369656e742062616c616e636520746861742063616e206265604482015266081b1bd8dad95960ca1b60648201526084016104fc565b60006110646111c6565b6001600160a01b031663737c59b86040518163ffffffff1660e01b815260040160206040518083038186803b15801561109c57600080fd5b505afa1580156110b0573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906110d491906114b6565b6001600160a01b0384166000908152606860205260409020549091506110fb90839061151a565b6001600160a01b038416600090815260686020526040902081905581101561116f5760405162461bcd60e51b815260206004820152602160248201527f4c6f636b65642062616c616e6365206973206c6172676572207468616e206d616044820152600f60fb1b60648201526084016104fc565b8160695461117d919061151a565b6069556001600160a01b0383166000818152606760205260409020547f44cebfefa4561bee5b61d675ccfd8dc9969fff9cc15e7a4eccccd62af94f9c1190849061098b87610499565b6000610f6369456e7653746f7261676560b01b611356565b806111e7575050565b6001600160a01b03821660009081526068602052604090205461120b908290611573565b6001600160a01b038316600090815260686020526040902055606954611232908290611573565b6069556001600160a01b0382166000818152606760205260409020547f5245d528087a96a64f4589a764f00061e4671eab90cb1e019b1a5b24b2e4c2a890839061127b86610499565b60408051938452602084019290925290820152606001610be6565b6033546001600160a01b031633146108835760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016104fc565b603380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6000610f636914995dd85c99141bdbdb60b21b5b606554604051630d2020dd60e01b8152600481018390526000916001600160a01b031690630d2020dd9060240160206040518083038186803b15801561139b57600080fd5b505afa1580156113af573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104c791906113f0565b6000602082840312156113e557600080fd5b8135610a1b816115a0565b60006020828403121561140257600080fd5b8151610a1b816115a0565b6000806040838503121561142057600080fd5b823561142b816115a0565b946020939093013593505050565b6000806040838503121561144c57600080fd5b8235611457816115a0565b9150602083013563ffffffff8116811461147057600080fd5b809150509250929050565b60006020828403121561148d57600080fd5b81518015158114610a1b57600080fd5b6000602082840312156114af57600080fd5b5035919050565b6000602082840312156114c857600080fd5b5051919050565b6020808252600d908201526c2737902832b936b4b9b9b4b7b760991b604082015260600190565b6020808252600a9082015269125cc81c995d9bdad95960b21b604082015260600190565b6000821982111561152d5761152d61158a565b500190565b60008261154f57634e487b7160e01b600052601260045260246000fd5b500490565b600081600019048311821515161561156e5761156e61158a565b500290565b6000828210156115855761158561158a565b500390565b634e487b7160e01b600052601160045260246000fd5b6001600160a01b0381168114610f4057600080fdfea2646970667358221220e9a00fd86269681c892147e2051f33eadfc876e56c55c3f1c63d799f3163ddea64736f6c63430008060033",
+}
+
+// StakingABI is the input ABI used to generate the binding from.
+// Deprecated: Use StakingMetaData.ABI instead.
+var StakingABI = StakingMetaData.ABI
+
+// StakingBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use StakingMetaData.Bin instead.
+var StakingBin = StakingMetaData.Bin
+
+// DeployStaking deploys a new Ethereum contract, binding an instance of Staking to it.
+func DeployStaking(auth *bind.TransactOpts, backend bind.ContractBackend, registry common.Address, data []byte) (common.Address, *types.Transaction, *Staking, error) {
+	parsed, err := StakingMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(StakingBin), backend, registry, data)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Staking{StakingCaller: StakingCaller{contract: contract}, StakingTransactor: StakingTransactor{contract: contract}, StakingFilterer: StakingFilterer{contract: contract}}, nil
+}
+
+// Staking is an auto generated Go binding around an Ethereum contract.
+type Staking struct {
+	StakingCaller     // Read-only binding to the contract
+	StakingTransactor // Write-only binding to the contract
+	StakingFilterer   // Log filterer for contract events
+}
+
+// StakingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StakingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StakingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StakingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StakingSession struct {
+	Contract     *Staking          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StakingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StakingCallerSession struct {
+	Contract *StakingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StakingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StakingTransactorSession struct {
+	Contract     *StakingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StakingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StakingRaw struct {
+	Contract *Staking // Generic contract binding to access the raw methods on
+}
+
+// StakingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StakingCallerRaw struct {
+	Contract *StakingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StakingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StakingTransactorRaw struct {
+	Contract *StakingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStaking creates a new instance of Staking, bound to a specific deployed contract.
+func NewStaking(address common.Address, backend bind.ContractBackend) (*Staking, error) {
+	contract, err := bindStaking(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Staking{StakingCaller: StakingCaller{contract: contract}, StakingTransactor: StakingTransactor{contract: contract}, StakingFilterer: StakingFilterer{contract: contract}}, nil
+}
+
+// NewStakingCaller creates a new read-only instance of Staking, bound to a specific deployed contract.
+func NewStakingCaller(address common.Address, caller bind.ContractCaller) (*StakingCaller, error) {
+	contract, err := bindStaking(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingCaller{contract: contract}, nil
+}
+
+// NewStakingTransactor creates a new write-only instance of Staking, bound to a specific deployed contract.
+func NewStakingTransactor(address common.Address, transactor bind.ContractTransactor) (*StakingTransactor, error) {
+	contract, err := bindStaking(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingTransactor{contract: contract}, nil
+}
+
+// NewStakingFilterer creates a new log filterer instance of Staking, bound to a specific deployed contract.
+func NewStakingFilterer(address common.Address, filterer bind.ContractFilterer) (*StakingFilterer, error) {
+	contract, err := bindStaking(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingFilterer{contract: contract}, nil
+}
+
+// bindStaking binds a generic wrapper to an already deployed contract.
+func bindStaking(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StakingABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Staking *StakingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Staking.Contract.StakingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Staking *StakingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.Contract.StakingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Staking *StakingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Staking.Contract.StakingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Staking *StakingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Staking.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Staking *StakingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Staking *StakingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Staking.Contract.contract.Transact(opts, method, params...)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_Staking *StakingCaller) BALLOTSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "BALLOT_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_Staking *StakingSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _Staking.Contract.BALLOTSTORAGENAME(&_Staking.CallOpts)
+}
+
+// BALLOTSTORAGENAME is a free data retrieval call binding the contract method 0x9986e4b9.
+//
+// Solidity: function BALLOT_STORAGE_NAME() view returns(bytes32)
+func (_Staking *StakingCallerSession) BALLOTSTORAGENAME() ([32]byte, error) {
+	return _Staking.Contract.BALLOTSTORAGENAME(&_Staking.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_Staking *StakingCaller) ENVSTORAGENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "ENV_STORAGE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_Staking *StakingSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _Staking.Contract.ENVSTORAGENAME(&_Staking.CallOpts)
+}
+
+// ENVSTORAGENAME is a free data retrieval call binding the contract method 0x7bf46530.
+//
+// Solidity: function ENV_STORAGE_NAME() view returns(bytes32)
+func (_Staking *StakingCallerSession) ENVSTORAGENAME() ([32]byte, error) {
+	return _Staking.Contract.ENVSTORAGENAME(&_Staking.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_Staking *StakingCaller) GOVNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "GOV_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_Staking *StakingSession) GOVNAME() ([32]byte, error) {
+	return _Staking.Contract.GOVNAME(&_Staking.CallOpts)
+}
+
+// GOVNAME is a free data retrieval call binding the contract method 0x6c78d2cf.
+//
+// Solidity: function GOV_NAME() view returns(bytes32)
+func (_Staking *StakingCallerSession) GOVNAME() ([32]byte, error) {
+	return _Staking.Contract.GOVNAME(&_Staking.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_Staking *StakingCaller) MAINTENANCENAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "MAINTENANCE_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_Staking *StakingSession) MAINTENANCENAME() ([32]byte, error) {
+	return _Staking.Contract.MAINTENANCENAME(&_Staking.CallOpts)
+}
+
+// MAINTENANCENAME is a free data retrieval call binding the contract method 0x4bd1ed76.
+//
+// Solidity: function MAINTENANCE_NAME() view returns(bytes32)
+func (_Staking *StakingCallerSession) MAINTENANCENAME() ([32]byte, error) {
+	return _Staking.Contract.MAINTENANCENAME(&_Staking.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_Staking *StakingCaller) REWARDPOOLNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "REWARD_POOL_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_Staking *StakingSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _Staking.Contract.REWARDPOOLNAME(&_Staking.CallOpts)
+}
+
+// REWARDPOOLNAME is a free data retrieval call binding the contract method 0x2f40992e.
+//
+// Solidity: function REWARD_POOL_NAME() view returns(bytes32)
+func (_Staking *StakingCallerSession) REWARDPOOLNAME() ([32]byte, error) {
+	return _Staking.Contract.REWARDPOOLNAME(&_Staking.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_Staking *StakingCaller) STAKINGNAME(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "STAKING_NAME")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_Staking *StakingSession) STAKINGNAME() ([32]byte, error) {
+	return _Staking.Contract.STAKINGNAME(&_Staking.CallOpts)
+}
+
+// STAKINGNAME is a free data retrieval call binding the contract method 0x1e0cba0d.
+//
+// Solidity: function STAKING_NAME() view returns(bytes32)
+func (_Staking *StakingCallerSession) STAKINGNAME() ([32]byte, error) {
+	return _Staking.Contract.STAKINGNAME(&_Staking.CallOpts)
+}
+
+// AvailableBalanceOf is a free data retrieval call binding the contract method 0x25d998bb.
+//
+// Solidity: function availableBalanceOf(address payee) view returns(uint256)
+func (_Staking *StakingCaller) AvailableBalanceOf(opts *bind.CallOpts, payee common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "availableBalanceOf", payee)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AvailableBalanceOf is a free data retrieval call binding the contract method 0x25d998bb.
+//
+// Solidity: function availableBalanceOf(address payee) view returns(uint256)
+func (_Staking *StakingSession) AvailableBalanceOf(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.AvailableBalanceOf(&_Staking.CallOpts, payee)
+}
+
+// AvailableBalanceOf is a free data retrieval call binding the contract method 0x25d998bb.
+//
+// Solidity: function availableBalanceOf(address payee) view returns(uint256)
+func (_Staking *StakingCallerSession) AvailableBalanceOf(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.AvailableBalanceOf(&_Staking.CallOpts, payee)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address payee) view returns(uint256)
+func (_Staking *StakingCaller) BalanceOf(opts *bind.CallOpts, payee common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "balanceOf", payee)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address payee) view returns(uint256)
+func (_Staking *StakingSession) BalanceOf(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.BalanceOf(&_Staking.CallOpts, payee)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address payee) view returns(uint256)
+func (_Staking *StakingCallerSession) BalanceOf(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.BalanceOf(&_Staking.CallOpts, payee)
+}
+
+// CalcVotingWeight is a free data retrieval call binding the contract method 0x884d97a7.
+//
+// Solidity: function calcVotingWeight(address payee) view returns(uint256)
+func (_Staking *StakingCaller) CalcVotingWeight(opts *bind.CallOpts, payee common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "calcVotingWeight", payee)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CalcVotingWeight is a free data retrieval call binding the contract method 0x884d97a7.
+//
+// Solidity: function calcVotingWeight(address payee) view returns(uint256)
+func (_Staking *StakingSession) CalcVotingWeight(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.CalcVotingWeight(&_Staking.CallOpts, payee)
+}
+
+// CalcVotingWeight is a free data retrieval call binding the contract method 0x884d97a7.
+//
+// Solidity: function calcVotingWeight(address payee) view returns(uint256)
+func (_Staking *StakingCallerSession) CalcVotingWeight(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.CalcVotingWeight(&_Staking.CallOpts, payee)
+}
+
+// CalcVotingWeightWithScaleFactor is a free data retrieval call binding the contract method 0x7d77a0eb.
+//
+// Solidity: function calcVotingWeightWithScaleFactor(address payee, uint32 factor) view returns(uint256)
+func (_Staking *StakingCaller) CalcVotingWeightWithScaleFactor(opts *bind.CallOpts, payee common.Address, factor uint32) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "calcVotingWeightWithScaleFactor", payee, factor)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CalcVotingWeightWithScaleFactor is a free data retrieval call binding the contract method 0x7d77a0eb.
+//
+// Solidity: function calcVotingWeightWithScaleFactor(address payee, uint32 factor) view returns(uint256)
+func (_Staking *StakingSession) CalcVotingWeightWithScaleFactor(payee common.Address, factor uint32) (*big.Int, error) {
+	return _Staking.Contract.CalcVotingWeightWithScaleFactor(&_Staking.CallOpts, payee, factor)
+}
+
+// CalcVotingWeightWithScaleFactor is a free data retrieval call binding the contract method 0x7d77a0eb.
+//
+// Solidity: function calcVotingWeightWithScaleFactor(address payee, uint32 factor) view returns(uint256)
+func (_Staking *StakingCallerSession) CalcVotingWeightWithScaleFactor(payee common.Address, factor uint32) (*big.Int, error) {
+	return _Staking.Contract.CalcVotingWeightWithScaleFactor(&_Staking.CallOpts, payee, factor)
+}
+
+// IsRevoked is a free data retrieval call binding the contract method 0x2bc9ed02.
+//
+// Solidity: function isRevoked() view returns(bool)
+func (_Staking *StakingCaller) IsRevoked(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "isRevoked")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsRevoked is a free data retrieval call binding the contract method 0x2bc9ed02.
+//
+// Solidity: function isRevoked() view returns(bool)
+func (_Staking *StakingSession) IsRevoked() (bool, error) {
+	return _Staking.Contract.IsRevoked(&_Staking.CallOpts)
+}
+
+// IsRevoked is a free data retrieval call binding the contract method 0x2bc9ed02.
+//
+// Solidity: function isRevoked() view returns(bool)
+func (_Staking *StakingCallerSession) IsRevoked() (bool, error) {
+	return _Staking.Contract.IsRevoked(&_Staking.CallOpts)
+}
+
+// LockedBalanceOf is a free data retrieval call binding the contract method 0x59355736.
+//
+// Solidity: function lockedBalanceOf(address payee) view returns(uint256)
+func (_Staking *StakingCaller) LockedBalanceOf(opts *bind.CallOpts, payee common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "lockedBalanceOf", payee)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LockedBalanceOf is a free data retrieval call binding the contract method 0x59355736.
+//
+// Solidity: function lockedBalanceOf(address payee) view returns(uint256)
+func (_Staking *StakingSession) LockedBalanceOf(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.LockedBalanceOf(&_Staking.CallOpts, payee)
+}
+
+// LockedBalanceOf is a free data retrieval call binding the contract method 0x59355736.
+//
+// Solidity: function lockedBalanceOf(address payee) view returns(uint256)
+func (_Staking *StakingCallerSession) LockedBalanceOf(payee common.Address) (*big.Int, error) {
+	return _Staking.Contract.LockedBalanceOf(&_Staking.CallOpts, payee)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Staking *StakingCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Staking *StakingSession) Owner() (common.Address, error) {
+	return _Staking.Contract.Owner(&_Staking.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Staking *StakingCallerSession) Owner() (common.Address, error) {
+	return _Staking.Contract.Owner(&_Staking.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_Staking *StakingCaller) Reg(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "reg")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_Staking *StakingSession) Reg() (common.Address, error) {
+	return _Staking.Contract.Reg(&_Staking.CallOpts)
+}
+
+// Reg is a free data retrieval call binding the contract method 0x738fdd1a.
+//
+// Solidity: function reg() view returns(address)
+func (_Staking *StakingCallerSession) Reg() (common.Address, error) {
+	return _Staking.Contract.Reg(&_Staking.CallOpts)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns()
+func (_Staking *StakingTransactor) Deposit(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "deposit")
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns()
+func (_Staking *StakingSession) Deposit() (*types.Transaction, error) {
+	return _Staking.Contract.Deposit(&_Staking.TransactOpts)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns()
+func (_Staking *StakingTransactorSession) Deposit() (*types.Transaction, error) {
+	return _Staking.Contract.Deposit(&_Staking.TransactOpts)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x282d3fdf.
+//
+// Solidity: function lock(address payee, uint256 lockAmount) returns()
+func (_Staking *StakingTransactor) Lock(opts *bind.TransactOpts, payee common.Address, lockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "lock", payee, lockAmount)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x282d3fdf.
+//
+// Solidity: function lock(address payee, uint256 lockAmount) returns()
+func (_Staking *StakingSession) Lock(payee common.Address, lockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Lock(&_Staking.TransactOpts, payee, lockAmount)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x282d3fdf.
+//
+// Solidity: function lock(address payee, uint256 lockAmount) returns()
+func (_Staking *StakingTransactorSession) Lock(payee common.Address, lockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Lock(&_Staking.TransactOpts, payee, lockAmount)
+}
+
+// LockMore is a paid mutator transaction binding the contract method 0x65c4b87c.
+//
+// Solidity: function lockMore(address payee, uint256 lockAmount) returns()
+func (_Staking *StakingTransactor) LockMore(opts *bind.TransactOpts, payee common.Address, lockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "lockMore", payee, lockAmount)
+}
+
+// LockMore is a paid mutator transaction binding the contract method 0x65c4b87c.
+//
+// Solidity: function lockMore(address payee, uint256 lockAmount) returns()
+func (_Staking *StakingSession) LockMore(payee common.Address, lockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.LockMore(&_Staking.TransactOpts, payee, lockAmount)
+}
+
+// LockMore is a paid mutator transaction binding the contract method 0x65c4b87c.
+//
+// Solidity: function lockMore(address payee, uint256 lockAmount) returns()
+func (_Staking *StakingTransactorSession) LockMore(payee common.Address, lockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.LockMore(&_Staking.TransactOpts, payee, lockAmount)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Staking *StakingTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Staking *StakingSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Staking.Contract.RenounceOwnership(&_Staking.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Staking *StakingTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Staking.Contract.RenounceOwnership(&_Staking.TransactOpts)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xb6549f75.
+//
+// Solidity: function revoke() returns()
+func (_Staking *StakingTransactor) Revoke(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "revoke")
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xb6549f75.
+//
+// Solidity: function revoke() returns()
+func (_Staking *StakingSession) Revoke() (*types.Transaction, error) {
+	return _Staking.Contract.Revoke(&_Staking.TransactOpts)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xb6549f75.
+//
+// Solidity: function revoke() returns()
+func (_Staking *StakingTransactorSession) Revoke() (*types.Transaction, error) {
+	return _Staking.Contract.Revoke(&_Staking.TransactOpts)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_Staking *StakingTransactor) SetRegistry(opts *bind.TransactOpts, _addr common.Address) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "setRegistry", _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_Staking *StakingSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _Staking.Contract.SetRegistry(&_Staking.TransactOpts, _addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address _addr) returns()
+func (_Staking *StakingTransactorSession) SetRegistry(_addr common.Address) (*types.Transaction, error) {
+	return _Staking.Contract.SetRegistry(&_Staking.TransactOpts, _addr)
+}
+
+// TransferLocked is a paid mutator transaction binding the contract method 0x7756c908.
+//
+// Solidity: function transferLocked(address from, uint256 amount) returns()
+func (_Staking *StakingTransactor) TransferLocked(opts *bind.TransactOpts, from common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "transferLocked", from, amount)
+}
+
+// TransferLocked is a paid mutator transaction binding the contract method 0x7756c908.
+//
+// Solidity: function transferLocked(address from, uint256 amount) returns()
+func (_Staking *StakingSession) TransferLocked(from common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.TransferLocked(&_Staking.TransactOpts, from, amount)
+}
+
+// TransferLocked is a paid mutator transaction binding the contract method 0x7756c908.
+//
+// Solidity: function transferLocked(address from, uint256 amount) returns()
+func (_Staking *StakingTransactorSession) TransferLocked(from common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.TransferLocked(&_Staking.TransactOpts, from, amount)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Staking *StakingTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Staking *StakingSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Staking.Contract.TransferOwnership(&_Staking.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Staking *StakingTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Staking.Contract.TransferOwnership(&_Staking.TransactOpts, newOwner)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0x7eee288d.
+//
+// Solidity: function unlock(address payee, uint256 unlockAmount) returns()
+func (_Staking *StakingTransactor) Unlock(opts *bind.TransactOpts, payee common.Address, unlockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "unlock", payee, unlockAmount)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0x7eee288d.
+//
+// Solidity: function unlock(address payee, uint256 unlockAmount) returns()
+func (_Staking *StakingSession) Unlock(payee common.Address, unlockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Unlock(&_Staking.TransactOpts, payee, unlockAmount)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0x7eee288d.
+//
+// Solidity: function unlock(address payee, uint256 unlockAmount) returns()
+func (_Staking *StakingTransactorSession) Unlock(payee common.Address, unlockAmount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Unlock(&_Staking.TransactOpts, payee, unlockAmount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns()
+func (_Staking *StakingTransactor) Withdraw(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "withdraw", amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns()
+func (_Staking *StakingSession) Withdraw(amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Withdraw(&_Staking.TransactOpts, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns()
+func (_Staking *StakingTransactorSession) Withdraw(amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Withdraw(&_Staking.TransactOpts, amount)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Staking *StakingTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Staking *StakingSession) Receive() (*types.Transaction, error) {
+	return _Staking.Contract.Receive(&_Staking.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Staking *StakingTransactorSession) Receive() (*types.Transaction, error) {
+	return _Staking.Contract.Receive(&_Staking.TransactOpts)
+}
+
+// StakingInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the Staking contract.
+type StakingInitializedIterator struct {
+	Event *StakingInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingInitialized represents a Initialized event raised by the Staking contract.
+type StakingInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_Staking *StakingFilterer) FilterInitialized(opts *bind.FilterOpts) (*StakingInitializedIterator, error) {
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &StakingInitializedIterator{contract: _Staking.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_Staking *StakingFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *StakingInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingInitialized)
+				if err := _Staking.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_Staking *StakingFilterer) ParseInitialized(log types.Log) (*StakingInitialized, error) {
+	event := new(StakingInitialized)
+	if err := _Staking.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingLockedIterator is returned from FilterLocked and is used to iterate over the raw logs and unpacked data for Locked events raised by the Staking contract.
+type StakingLockedIterator struct {
+	Event *StakingLocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingLockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingLocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingLocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingLockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingLockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingLocked represents a Locked event raised by the Staking contract.
+type StakingLocked struct {
+	Payee     common.Address
+	Amount    *big.Int
+	Total     *big.Int
+	Available *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLocked is a free log retrieval operation binding the contract event 0x44cebfefa4561bee5b61d675ccfd8dc9969fff9cc15e7a4eccccd62af94f9c11.
+//
+// Solidity: event Locked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) FilterLocked(opts *bind.FilterOpts, payee []common.Address) (*StakingLockedIterator, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Locked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingLockedIterator{contract: _Staking.contract, event: "Locked", logs: logs, sub: sub}, nil
+}
+
+// WatchLocked is a free log subscription operation binding the contract event 0x44cebfefa4561bee5b61d675ccfd8dc9969fff9cc15e7a4eccccd62af94f9c11.
+//
+// Solidity: event Locked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) WatchLocked(opts *bind.WatchOpts, sink chan<- *StakingLocked, payee []common.Address) (event.Subscription, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Locked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingLocked)
+				if err := _Staking.contract.UnpackLog(event, "Locked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLocked is a log parse operation binding the contract event 0x44cebfefa4561bee5b61d675ccfd8dc9969fff9cc15e7a4eccccd62af94f9c11.
+//
+// Solidity: event Locked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) ParseLocked(log types.Log) (*StakingLocked, error) {
+	event := new(StakingLocked)
+	if err := _Staking.contract.UnpackLog(event, "Locked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Staking contract.
+type StakingOwnershipTransferredIterator struct {
+	Event *StakingOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingOwnershipTransferred represents a OwnershipTransferred event raised by the Staking contract.
+type StakingOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Staking *StakingFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*StakingOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingOwnershipTransferredIterator{contract: _Staking.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Staking *StakingFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *StakingOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingOwnershipTransferred)
+				if err := _Staking.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Staking *StakingFilterer) ParseOwnershipTransferred(log types.Log) (*StakingOwnershipTransferred, error) {
+	event := new(StakingOwnershipTransferred)
+	if err := _Staking.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingRevokedIterator is returned from FilterRevoked and is used to iterate over the raw logs and unpacked data for Revoked events raised by the Staking contract.
+type StakingRevokedIterator struct {
+	Event *StakingRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingRevoked represents a Revoked event raised by the Staking contract.
+type StakingRevoked struct {
+	Owner  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRevoked is a free log retrieval operation binding the contract event 0x713b90881ad62c4fa8ab6bd9197fa86481fc0c11b2edba60026514281b2dbac4.
+//
+// Solidity: event Revoked(address indexed owner, uint256 amount)
+func (_Staking *StakingFilterer) FilterRevoked(opts *bind.FilterOpts, owner []common.Address) (*StakingRevokedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Revoked", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingRevokedIterator{contract: _Staking.contract, event: "Revoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRevoked is a free log subscription operation binding the contract event 0x713b90881ad62c4fa8ab6bd9197fa86481fc0c11b2edba60026514281b2dbac4.
+//
+// Solidity: event Revoked(address indexed owner, uint256 amount)
+func (_Staking *StakingFilterer) WatchRevoked(opts *bind.WatchOpts, sink chan<- *StakingRevoked, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Revoked", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingRevoked)
+				if err := _Staking.contract.UnpackLog(event, "Revoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRevoked is a log parse operation binding the contract event 0x713b90881ad62c4fa8ab6bd9197fa86481fc0c11b2edba60026514281b2dbac4.
+//
+// Solidity: event Revoked(address indexed owner, uint256 amount)
+func (_Staking *StakingFilterer) ParseRevoked(log types.Log) (*StakingRevoked, error) {
+	event := new(StakingRevoked)
+	if err := _Staking.contract.UnpackLog(event, "Revoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingStakedIterator is returned from FilterStaked and is used to iterate over the raw logs and unpacked data for Staked events raised by the Staking contract.
+type StakingStakedIterator struct {
+	Event *StakingStaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingStakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingStaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingStaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingStakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingStakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingStaked represents a Staked event raised by the Staking contract.
+type StakingStaked struct {
+	Payee     common.Address
+	Amount    *big.Int
+	Total     *big.Int
+	Available *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterStaked is a free log retrieval operation binding the contract event 0xb4caaf29adda3eefee3ad552a8e85058589bf834c7466cae4ee58787f70589ed.
+//
+// Solidity: event Staked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) FilterStaked(opts *bind.FilterOpts, payee []common.Address) (*StakingStakedIterator, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Staked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingStakedIterator{contract: _Staking.contract, event: "Staked", logs: logs, sub: sub}, nil
+}
+
+// WatchStaked is a free log subscription operation binding the contract event 0xb4caaf29adda3eefee3ad552a8e85058589bf834c7466cae4ee58787f70589ed.
+//
+// Solidity: event Staked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) WatchStaked(opts *bind.WatchOpts, sink chan<- *StakingStaked, payee []common.Address) (event.Subscription, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Staked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingStaked)
+				if err := _Staking.contract.UnpackLog(event, "Staked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStaked is a log parse operation binding the contract event 0xb4caaf29adda3eefee3ad552a8e85058589bf834c7466cae4ee58787f70589ed.
+//
+// Solidity: event Staked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) ParseStaked(log types.Log) (*StakingStaked, error) {
+	event := new(StakingStaked)
+	if err := _Staking.contract.UnpackLog(event, "Staked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingTransferLockedIterator is returned from FilterTransferLocked and is used to iterate over the raw logs and unpacked data for TransferLocked events raised by the Staking contract.
+type StakingTransferLockedIterator struct {
+	Event *StakingTransferLocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingTransferLockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingTransferLocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingTransferLocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingTransferLockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingTransferLockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingTransferLocked represents a TransferLocked event raised by the Staking contract.
+type StakingTransferLocked struct {
+	Payee     common.Address
+	Amount    *big.Int
+	Total     *big.Int
+	Available *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferLocked is a free log retrieval operation binding the contract event 0x2caed32a519a1fd89486d3ffe06202febb5ed51534d571dbab93058545a29e24.
+//
+// Solidity: event TransferLocked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) FilterTransferLocked(opts *bind.FilterOpts, payee []common.Address) (*StakingTransferLockedIterator, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "TransferLocked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingTransferLockedIterator{contract: _Staking.contract, event: "TransferLocked", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferLocked is a free log subscription operation binding the contract event 0x2caed32a519a1fd89486d3ffe06202febb5ed51534d571dbab93058545a29e24.
+//
+// Solidity: event TransferLocked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) WatchTransferLocked(opts *bind.WatchOpts, sink chan<- *StakingTransferLocked, payee []common.Address) (event.Subscription, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "TransferLocked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingTransferLocked)
+				if err := _Staking.contract.UnpackLog(event, "TransferLocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferLocked is a log parse operation binding the contract event 0x2caed32a519a1fd89486d3ffe06202febb5ed51534d571dbab93058545a29e24.
+//
+// Solidity: event TransferLocked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) ParseTransferLocked(log types.Log) (*StakingTransferLocked, error) {
+	event := new(StakingTransferLocked)
+	if err := _Staking.contract.UnpackLog(event, "TransferLocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingUnlockedIterator is returned from FilterUnlocked and is used to iterate over the raw logs and unpacked data for Unlocked events raised by the Staking contract.
+type StakingUnlockedIterator struct {
+	Event *StakingUnlocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingUnlockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingUnlocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingUnlocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingUnlockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingUnlockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingUnlocked represents a Unlocked event raised by the Staking contract.
+type StakingUnlocked struct {
+	Payee     common.Address
+	Amount    *big.Int
+	Total     *big.Int
+	Available *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnlocked is a free log retrieval operation binding the contract event 0x5245d528087a96a64f4589a764f00061e4671eab90cb1e019b1a5b24b2e4c2a8.
+//
+// Solidity: event Unlocked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) FilterUnlocked(opts *bind.FilterOpts, payee []common.Address) (*StakingUnlockedIterator, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Unlocked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingUnlockedIterator{contract: _Staking.contract, event: "Unlocked", logs: logs, sub: sub}, nil
+}
+
+// WatchUnlocked is a free log subscription operation binding the contract event 0x5245d528087a96a64f4589a764f00061e4671eab90cb1e019b1a5b24b2e4c2a8.
+//
+// Solidity: event Unlocked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) WatchUnlocked(opts *bind.WatchOpts, sink chan<- *StakingUnlocked, payee []common.Address) (event.Subscription, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Unlocked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingUnlocked)
+				if err := _Staking.contract.UnpackLog(event, "Unlocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnlocked is a log parse operation binding the contract event 0x5245d528087a96a64f4589a764f00061e4671eab90cb1e019b1a5b24b2e4c2a8.
+//
+// Solidity: event Unlocked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) ParseUnlocked(log types.Log) (*StakingUnlocked, error) {
+	event := new(StakingUnlocked)
+	if err := _Staking.contract.UnpackLog(event, "Unlocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingUnstakedIterator is returned from FilterUnstaked and is used to iterate over the raw logs and unpacked data for Unstaked events raised by the Staking contract.
+type StakingUnstakedIterator struct {
+	Event *StakingUnstaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingUnstakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingUnstaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingUnstaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingUnstakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingUnstakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingUnstaked represents a Unstaked event raised by the Staking contract.
+type StakingUnstaked struct {
+	Payee     common.Address
+	Amount    *big.Int
+	Total     *big.Int
+	Available *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnstaked is a free log retrieval operation binding the contract event 0x204fccf0d92ed8d48f204adb39b2e81e92bad0dedb93f5716ca9478cfb57de00.
+//
+// Solidity: event Unstaked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) FilterUnstaked(opts *bind.FilterOpts, payee []common.Address) (*StakingUnstakedIterator, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Unstaked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingUnstakedIterator{contract: _Staking.contract, event: "Unstaked", logs: logs, sub: sub}, nil
+}
+
+// WatchUnstaked is a free log subscription operation binding the contract event 0x204fccf0d92ed8d48f204adb39b2e81e92bad0dedb93f5716ca9478cfb57de00.
+//
+// Solidity: event Unstaked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) WatchUnstaked(opts *bind.WatchOpts, sink chan<- *StakingUnstaked, payee []common.Address) (event.Subscription, error) {
+
+	var payeeRule []interface{}
+	for _, payeeItem := range payee {
+		payeeRule = append(payeeRule, payeeItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Unstaked", payeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingUnstaked)
+				if err := _Staking.contract.UnpackLog(event, "Unstaked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnstaked is a log parse operation binding the contract event 0x204fccf0d92ed8d48f204adb39b2e81e92bad0dedb93f5716ca9478cfb57de00.
+//
+// Solidity: event Unstaked(address indexed payee, uint256 amount, uint256 total, uint256 available)
+func (_Staking *StakingFilterer) ParseUnstaked(log types.Log) (*StakingUnstaked, error) {
+	event := new(StakingUnstaked)
+	if err := _Staking.contract.UnpackLog(event, "Unstaked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+// Package governance contains the Go bindings of the wemix governance
+// contracts, i.e. Registry, Gov, GovImp, Staking, BallotStorage, EnvStorage
+// and EnvStorageImp.
+//
+// contracts.json is extracted from wemix/contracts/WemixGovernance.js with
+// "make wemix/governance/contracts.json".
+package governance
+
+//go:generate abigen --combined-json contracts.json --pkg governance --out bindings.go
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Names of the contracts and the accounts in the Registry.
+var (
+	GovernanceContractName = ToBytes32("GovernanceContract")
+	StakingName            = ToBytes32("Staking")
+	BallotStorageName      = ToBytes32("BallotStorage")
+	EnvStorageName         = ToBytes32("EnvStorage")
+	RewardPoolName         = ToBytes32("RewardPool")
+	StakingRewardName      = ToBytes32("StakingReward")
+	EcosystemName          = ToBytes32("Ecosystem")
+	MaintenanceName        = ToBytes32("Maintenance")
+)
+
+// ToBytes32 returns the Registry name of s, left aligned.
+func ToBytes32(s string) [32]byte {
+	var b32 [32]byte
+	if len(s) > len(b32) {
+		s = s[len(s)-len(b32):]
+	}
+	copy(b32[:], s)
+	return b32
+}
+
+// The New*Caller functions parse the contract ABI every time, the following
+// ones reuse the parsed ABI as the governance contracts are read for every
+// block.
+
+// RegistryAt returns the read-only binding of the Registry at address.
+func RegistryAt(address common.Address, caller bind.ContractCaller) *RegistryCaller {
+	return &RegistryCaller{contract: boundAt(RegistryMetaData, address, caller)}
+}
+
+// GovImpAt returns the read-only binding of the GovImp at address, typically
+// the Gov proxy.
+func GovImpAt(address common.Address, caller bind.ContractCaller) *GovImpCaller {
+	return &GovImpCaller{contract: boundAt(GovImpMetaData, address, caller)}
+}
+
+// StakingAt returns the read-only binding of the Staking at address.
+func StakingAt(address common.Address, caller bind.ContractCaller) *StakingCaller {
+	return &StakingCaller{contract: boundAt(StakingMetaData, address, caller)}
+}
+
+// EnvStorageImpAt returns the read-only binding of the EnvStorageImp at
+// address, typically the EnvStorage proxy.
+func EnvStorageImpAt(address common.Address, caller bind.ContractCaller) *EnvStorageImpCaller {
+	return &EnvStorageImpCaller{contract: boundAt(EnvStorageImpMetaData, address, caller)}
+}
+
+func boundAt(meta *bind.MetaData, address common.Address, caller bind.ContractCaller) *bind.BoundContract {
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		// the generated ABIs are valid
+		panic(err)
+	}
+	return bind.NewBoundContract(address, *parsed, caller, nil, nil)
+}
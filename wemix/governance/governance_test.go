@@ -0,0 +1,75 @@
+// Copyright 2018-2022 The go-metadium / go-wemix Authors
+
+package governance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tests that the bindings deploy the contracts, and read them through the
+// proxies.
+func TestBindings(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	balance, _ := new(big.Int).SetString("10000000000000000000000000", 10)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{addr: {Balance: balance}}, 50000000)
+	defer backend.Close()
+
+	opts, _ := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	opts.GasLimit = 20000000
+
+	registry, _, reg, err := DeployRegistry(opts, backend)
+	if err != nil {
+		t.Fatalf("failed to deploy Registry: %v", err)
+	}
+	envStorageImp, _, _, err := DeployEnvStorageImp(opts, backend)
+	if err != nil {
+		t.Fatalf("failed to deploy EnvStorageImp: %v", err)
+	}
+	backend.Commit()
+	envStorage, _, _, err := DeployEnvStorage(opts, backend, envStorageImp)
+	if err != nil {
+		t.Fatalf("failed to deploy EnvStorage: %v", err)
+	}
+	backend.Commit()
+
+	if _, err = reg.SetContractDomain(opts, EnvStorageName, envStorage); err != nil {
+		t.Fatalf("failed to set EnvStorage: %v", err)
+	}
+	env, err := NewEnvStorageImpTransactor(envStorage, backend)
+	if err != nil {
+		t.Fatalf("failed to bind EnvStorage: %v", err)
+	}
+	names := [][32]byte{crypto.Keccak256Hash([]byte("blocksPer"))}
+	if _, err = env.Initialize(opts, registry, names, []*big.Int{big.NewInt(7)}); err != nil {
+		t.Fatalf("failed to initialize EnvStorage: %v", err)
+	}
+	backend.Commit()
+
+	callOpts := &bind.CallOpts{}
+	if magic, err := RegistryAt(registry, backend).Magic(callOpts); err != nil {
+		t.Fatalf("failed to read magic: %v", err)
+	} else if have := string(magic.Bytes()); have != "Wemix Registry" {
+		t.Errorf("magic mismatch: have %q, want %q", have, "Wemix Registry")
+	}
+	if have, err := RegistryAt(registry, backend).GetContractAddress(callOpts, EnvStorageName); err != nil {
+		t.Fatalf("failed to read EnvStorage address: %v", err)
+	} else if have != envStorage {
+		t.Errorf("EnvStorage address mismatch: have %x, want %x", have, envStorage)
+	}
+	if blocksPer, err := EnvStorageImpAt(envStorage, backend).GetBlocksPer(callOpts); err != nil {
+		t.Fatalf("failed to read blocksPer: %v", err)
+	} else if blocksPer.Int64() != 7 {
+		t.Errorf("blocksPer mismatch: have %d, want 7", blocksPer)
+	}
+	if _, err := EnvStorageImpAt(common.Address{}, backend).GetBlocksPer(callOpts); err != bind.ErrNoCode {
+		t.Errorf("missing contract error mismatch: have %v, want %v", err, bind.ErrNoCode)
+	}
+}
@@ -0,0 +1,196 @@
+// history.go
+
+package wemix
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+	"github.com/ethereum/go-ethereum/wemix/governance"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+// the environment variables that are views of the others, not stored
+// themselves
+var combinedEnvNames = map[string]bool{
+	"ballotDurationMinMax":          true,
+	"stakingMinMax":                 true,
+	"blockRewardDistributionMethod": true,
+	"gasLimitAndBaseFee":            true,
+}
+
+// govAt returns the governance contracts at the given block, and the call
+// options to read them at the block, by its hash and state root rather than
+// the canonical block at its height. errStateUnavailable is returned if the
+// state of the block is not available, and ErrNotInitialized if the
+// governance is not established at the block.
+func (ma *wemixAdmin) govAt(ctx context.Context, header *types.Header) (*governance.RegistryCaller, *governance.GovImpCaller, *governance.EnvStorageImpCaller, *bind.CallOpts, error) {
+	ctx = withBlock(ctx, header)
+	reg, gov, env, _, err := ma.getRegGovEnvContracts(ctx, header.Number)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return reg, gov, env, &bind.CallOpts{Context: ctx, BlockNumber: header.Number}, nil
+}
+
+// getMembers returns the governance members at the given block.
+func getMembers(ctx context.Context, header *types.Header) ([]*wemixapi.WemixGovMember, error) {
+	if admin == nil {
+		return nil, ErrNotRunning
+	}
+	reg, gov, _, opts, err := admin.govAt(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	stakingAddr, err := reg.GetContractAddress(opts, governance.StakingName)
+	if err != nil {
+		return nil, err
+	}
+	staking := governance.StakingAt(stakingAddr, admin.caller)
+
+	count, err := gov.GetMemberLength(opts)
+	if err != nil {
+		return nil, err
+	}
+	members := []*wemixapi.WemixGovMember{}
+	for i := int64(1); i <= count.Int64(); i++ {
+		var (
+			idx = big.NewInt(i)
+			m   = &wemixapi.WemixGovMember{}
+		)
+		if m.Staker, err = gov.GetMember(opts, idx); err != nil {
+			return nil, err
+		}
+		if m.Voter, err = gov.GetVoter(opts, idx); err != nil {
+			return nil, err
+		}
+		if m.Reward, err = gov.GetReward(opts, idx); err != nil {
+			return nil, err
+		}
+		if m.Stake, err = staking.LockedBalanceOf(opts, m.Staker); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+// getNodes returns the governance nodes at the given block, sorted by name.
+func getNodes(ctx context.Context, header *types.Header) ([]*wemixapi.WemixGovNode, error) {
+	if admin == nil {
+		return nil, ErrNotRunning
+	}
+	_, gov, _, opts, err := admin.govAt(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	wnodes, err := admin.getWemixNodes(opts.Context, gov, header.Number)
+	if err != nil {
+		return nil, err
+	}
+	nodes := []*wemixapi.WemixGovNode{}
+	for _, n := range wnodes {
+		nodes = append(nodes, &wemixapi.WemixGovNode{
+			Name:   n.Name,
+			Enode:  n.Enode,
+			Id:     n.Id,
+			Ip:     n.Ip,
+			Port:   n.Port,
+			Reward: n.Addr,
+		})
+	}
+	return nodes, nil
+}
+
+// getEnvValues returns the environment variables at the given block by name.
+func getEnvValues(ctx context.Context, header *types.Header) (map[string]*big.Int, error) {
+	if admin == nil {
+		return nil, ErrNotRunning
+	}
+	_, _, env, opts, err := admin.govAt(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]*big.Int)
+	for _, name := range governance.EnvNames {
+		if combinedEnvNames[name] {
+			continue
+		}
+		v, err := env.GetUint(opts, crypto.Keccak256Hash([]byte(name)))
+		if err != nil {
+			return nil, err
+		}
+		values[name] = v
+	}
+	return values, nil
+}
+
+// getRewardParameters returns the parameters the rewards of the block
+// following the given one are distributed by.
+func getRewardParameters(ctx context.Context, header *types.Header) (*wemixapi.WemixRewardParameters, error) {
+	if admin == nil {
+		return nil, ErrNotRunning
+	}
+	rp, err := admin.getRewardParams(withBlock(ctx, header), header.Number)
+	if err != nil {
+		return nil, err
+	}
+	p := &wemixapi.WemixRewardParameters{
+		BlockReward:        rp.rewardAmount,
+		DistributionMethod: rp.distributionMethod,
+		StakingReward:      rp.staker,
+		Ecosystem:          rp.ecoSystem,
+		Maintenance:        rp.maintenance,
+		Members:            []*common.Address{},
+		BlocksPer:          rp.blocksPer,
+	}
+	for _, m := range rp.members {
+		addr := m.Addr
+		p.Members = append(p.Members, &addr)
+	}
+	return p, nil
+}
+
+// getBlockMiner returns the governance node that mined the block, by the
+// node id in the header or the coinbase if it's missing, among the nodes
+// of the parent block.
+func getBlockMiner(ctx context.Context, header *types.Header) (*wemixapi.WemixBlockMiner, error) {
+	m := &wemixapi.WemixBlockMiner{
+		Number:      header.Number,
+		Coinbase:    header.Coinbase,
+		MinerNodeId: header.MinerNodeId,
+	}
+	if header.Number.Sign() == 0 {
+		return m, nil
+	}
+	if admin == nil {
+		return nil, ErrNotRunning
+	}
+	parent, err := admin.caller.headerByHash(ctx, header.ParentHash)
+	if err != nil {
+		return nil, err
+	}
+	// ErrNotInitialized only if the governance is not established at the
+	// parent, see getRegGovEnvContracts, the other failures are returned
+	nodes, err := getNodes(ctx, parent)
+	if err == wemixminer.ErrNotInitialized {
+		// mined by the boot node before the governance
+		return m, nil
+	} else if err != nil {
+		return nil, err
+	}
+	id := hex.EncodeToString(header.MinerNodeId)
+	for _, n := range nodes {
+		if (len(id) > 0 && n.Enode == id) || (len(id) == 0 && n.Reward == header.Coinbase) {
+			m.Node = n
+			break
+		}
+	}
+	return m, nil
+}
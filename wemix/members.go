@@ -0,0 +1,157 @@
+// members.go
+
+package wemix
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	wemixengine "github.com/ethereum/go-ethereum/consensus/wemix"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// signerSet is the set of nodes allowed to sign the blocks following a
+// given block, i.e. the nodes registered in the governance at that block.
+type signerSet struct {
+	// governance is not established yet, only the boot node can sign
+	bootOnly bool
+	// hex encoded public keys of the registered nodes
+	nodes map[string]bool
+	// public keys of the registered nodes in the order of their names, and
+	// the # of blocks each mines in its turn
+	order     [][]byte
+	blocksPer uint64
+}
+
+// signerCacheSize is the # of the recent blocks to cache the signers of.
+const signerCacheSize = 1024
+
+// errStateUnavailable is returned if the state of the block to read the
+// governance at is not available, i.e. not imported yet or pruned.
+var errStateUnavailable = wemixengine.ErrStateUnavailable
+
+// signerCache caches the signer sets by the hash of the block they're read
+// at, so that the blocks of different forks at the same height don't share
+// them.
+type signerCache struct {
+	once  sync.Once
+	cache *lru.Cache // block hash -> *signerSet
+}
+
+func (c *signerCache) get(hash common.Hash) (*signerSet, bool) {
+	c.once.Do(func() { c.cache, _ = lru.New(signerCacheSize) })
+	if v, ok := c.cache.Get(hash); ok {
+		return v.(*signerSet), true
+	}
+	return nil, false
+}
+
+func (c *signerCache) add(hash common.Hash, set *signerSet) {
+	c.once.Do(func() { c.cache, _ = lru.New(signerCacheSize) })
+	c.cache.Add(hash, set)
+}
+
+// getSigners returns the set of nodes registered in the governance at the
+// given block. errStateUnavailable is returned if the block is not imported
+// yet or its state is pruned.
+func (ma *wemixAdmin) getSigners(ctx context.Context, block *types.Header) (*signerSet, error) {
+	hash := block.Hash()
+	if set, ok := ma.signers.get(hash); ok {
+		return set, nil
+	}
+	ctx = withBlock(ctx, block)
+	height := block.Number
+
+	var set *signerSet
+	_, gov, env, _, err := ma.getRegGovEnvContracts(ctx, height)
+	switch err {
+	case nil:
+		nodes, err := ma.getWemixNodes(ctx, gov, height)
+		if err != nil {
+			return nil, err
+		}
+		blocksPer, err := env.GetBlocksPer(&bind.CallOpts{Context: ctx, BlockNumber: height})
+		if err != nil {
+			// tolerated as in getGovData before the strict governance fork
+			if ma.isStrictGovernance(new(big.Int).Add(height, common.Big1)) {
+				return nil, err
+			}
+			blocksPer = big.NewInt(ma.blocksPer)
+		}
+		set = &signerSet{nodes: map[string]bool{}, blocksPer: blocksPer.Uint64()}
+		for _, n := range nodes {
+			id, err := hex.DecodeString(n.Enode)
+			if err != nil {
+				return nil, ErrInvalidEnode
+			}
+			set.nodes[strings.ToLower(n.Enode)] = true
+			set.order = append(set.order, id)
+		}
+	case wemixminer.ErrNotInitialized:
+		set = &signerSet{bootOnly: true}
+	default:
+		return nil, err
+	}
+	ma.signers.add(hash, set)
+	return set, nil
+}
+
+// IsBlockSigner implements wemixengine.Backend, checking if 'nodeId' is
+// allowed to sign the child block of 'parent', i.e. it's registered in the
+// governance at the parent block, or it's the boot node before governance is
+// established. errStateUnavailable is returned if the state of the parent is
+// not available, e.g. it's not imported yet in a batch of headers being
+// verified, or it's pruned.
+func (ma *wemixAdmin) IsBlockSigner(parent *types.Header, nodeId []byte) (bool, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signers, err := ma.getSigners(ctx, parent)
+	if err != nil {
+		return false, err
+	}
+	if signers.bootOnly {
+		return hex.EncodeToString(crypto.Keccak256(nodeId)) == ma.bootNodeId, nil
+	}
+	return signers.nodes[hex.EncodeToString(nodeId)], nil
+}
+
+// NumBlockSigners implements wemixengine.Backend, returning the # of nodes
+// allowed to sign the child block of 'parent'.
+func (ma *wemixAdmin) NumBlockSigners(parent *types.Header) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signers, err := ma.getSigners(ctx, parent)
+	if err != nil {
+		return 0, err
+	}
+	if signers.bootOnly {
+		return 1, nil
+	}
+	return len(signers.nodes), nil
+}
+
+// MinerSchedule implements wemixengine.Backend, returning the nodes
+// registered in the governance at 'parent' in the order of their names, and
+// blocksPer, none before governance is established.
+func (ma *wemixAdmin) MinerSchedule(parent *types.Header) ([][]byte, uint64, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signers, err := ma.getSigners(ctx, parent)
+	if err != nil {
+		return nil, 0, err
+	}
+	return signers.order, signers.blocksPer, nil
+}
+
+// EOF
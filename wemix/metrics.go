@@ -0,0 +1,125 @@
+// metrics.go
+
+package wemix
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// # of the recent leadership events kept for admin_wemixInfo
+const leadershipEventCount = 128
+
+var (
+	leaderGauge         = metrics.NewRegisteredGauge("wemix/leader", nil)
+	leaderSelfGauge     = metrics.NewRegisteredGauge("wemix/leader/self", nil)
+	leaderChangeCounter = metrics.NewRegisteredCounter("wemix/leader/changes", nil)
+
+	logBlockTimer        = metrics.NewRegisteredTimer("wemix/logblock/put", nil)
+	logBlockFailureMeter = metrics.NewRegisteredMeter("wemix/logblock/failures", nil)
+	raftCompactCounter   = metrics.NewRegisteredCounter("wemix/raft/compactions", nil)
+
+	blocksMinedGauge = metrics.NewRegisteredGauge("wemix/miner/blocksmined", nil)
+	turnBlocksHist   = metrics.NewRegisteredHistogram("wemix/miner/turn", nil, metrics.NewExpDecaySample(1028, 0.015))
+	idleGauge        = metrics.NewRegisteredGauge("wemix/miner/idle", nil)
+	maxIdleGauge     = metrics.NewRegisteredGauge("wemix/miner/maxidle", nil)
+)
+
+// leadership event kinds
+const (
+	leaderChanged   = "leader"      // raft elected a new leader, or lost it
+	leaderYielded   = "yield"       // the leader handed over after its turn
+	leaderYieldFail = "yieldFailed" // the hand over after the turn failed
+	leaderPunted    = "punt"        // a new leader out of sync handed over
+	leaderPuntFail  = "puntFailed"  // ditto, but failed and kept the role
+	leaderMember    = "member"      // raft membership change by the leader
+)
+
+// leadershipEvent is an entry of the fail-over timeline
+type leadershipEvent struct {
+	Time   time.Time `json:"time"`
+	Kind   string    `json:"kind"`
+	Name   string    `json:"name,omitempty"`
+	Id     string    `json:"id,omitempty"`
+	Term   uint64    `json:"term,omitempty"`
+	Height int64     `json:"height,omitempty"`
+	Mined  int64     `json:"mined,omitempty"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// leadershipEvents is a ring buffer of the recent leadership events
+type leadershipEvents struct {
+	lock   sync.Mutex
+	events []leadershipEvent
+	next   int
+	full   bool
+}
+
+func newLeadershipEvents(size int) *leadershipEvents {
+	return &leadershipEvents{events: make([]leadershipEvent, size)}
+}
+
+// add records the event, overwriting the oldest one if full
+func (l *leadershipEvents) add(e leadershipEvent) {
+	if l == nil || len(l.events) == 0 {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.events[l.next] = e
+	l.next = (l.next + 1) % len(l.events)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// list returns the events, the oldest first
+func (l *leadershipEvents) list() []leadershipEvent {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	var events []leadershipEvent
+	if l.full {
+		events = append(events, l.events[l.next:]...)
+	}
+	return append(events, l.events[:l.next]...)
+}
+
+// endTurn records the # of blocks mined in the turn that just ended
+func (ma *wemixAdmin) endTurn() {
+	if ma.blocksMined > 0 {
+		turnBlocksHist.Update(ma.blocksMined)
+	}
+	ma.blocksMined = 0
+	blocksMinedGauge.Update(0)
+}
+
+// idleTime returns the seconds elapsed since the latest block
+func (ma *wemixAdmin) idleTime() int64 {
+	if ma.caller == nil || ma.caller.backend == nil {
+		return 0
+	}
+	head := ma.caller.backend.CurrentHeader()
+	if head == nil {
+		return 0
+	}
+	idle := time.Now().Unix() - int64(head.Time)
+	if idle < 0 {
+		idle = 0
+	}
+	return idle
+}
+
+func (ma *wemixAdmin) updateIdleMetrics() {
+	idleGauge.Update(ma.idleTime())
+	maxIdleGauge.Update(ma.maxIdleBlockInterval)
+}
+
+// EOF
@@ -0,0 +1,52 @@
+// metrics_test.go
+
+package wemix
+
+import (
+	"testing"
+)
+
+func TestLeadershipEvents(t *testing.T) {
+	heights := func(events []leadershipEvent) []int64 {
+		var hs []int64
+		for _, e := range events {
+			hs = append(hs, e.Height)
+		}
+		return hs
+	}
+	check := func(got, want []int64) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+		}
+	}
+
+	l := newLeadershipEvents(3)
+	check(heights(l.list()), nil)
+	l.add(leadershipEvent{Kind: leaderChanged, Height: 1})
+	l.add(leadershipEvent{Kind: leaderYielded, Height: 2})
+	check(heights(l.list()), []int64{1, 2})
+	l.add(leadershipEvent{Kind: leaderChanged, Height: 3})
+	check(heights(l.list()), []int64{1, 2, 3})
+	l.add(leadershipEvent{Kind: leaderPunted, Height: 4})
+	l.add(leadershipEvent{Kind: leaderChanged, Height: 5})
+	check(heights(l.list()), []int64{3, 4, 5})
+
+	if e := l.list()[0]; e.Time.IsZero() || e.Kind != leaderChanged {
+		t.Fatalf("unexpected event %+v", e)
+	}
+
+	// a nil buffer, e.g. admin not started, ignores events
+	var nl *leadershipEvents
+	nl.add(leadershipEvent{Kind: leaderChanged})
+	if events := nl.list(); events != nil {
+		t.Fatalf("got %v from nil buffer", events)
+	}
+}
+
+// EOF
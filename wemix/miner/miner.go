@@ -17,11 +17,8 @@ var (
 	AmPartnerFunc               func() bool
 	IsPartnerFunc               func(string) bool
 	AmHubFunc                   func(string) int
+	GovernanceNodesFunc         func() []string
 	LogBlockFunc                func(int64, common.Hash)
-	CalculateRewardsFunc        func(*big.Int, *big.Int, *big.Int, func(common.Address, *big.Int)) (*common.Address, []byte, error)
-	VerifyRewardsFunc           func(*big.Int, string) error
-	SignBlockFunc               func(hash common.Hash) (nodeid, sig []byte, err error)
-	VerifyBlockSigFunc          func(height *big.Int, nodeId []byte, hash common.Hash, sig []byte) bool
 	RequirePendingTxsFunc       func() bool
 	VerifyBlockRewardsFunc      func(height *big.Int) interface{}
 	SuggestGasPriceFunc         func() *big.Int
@@ -60,6 +57,16 @@ func AmHub(id string) int {
 	}
 }
 
+// GovernanceNodes returns the ids of the governance nodes in the order of
+// their names
+func GovernanceNodes() []string {
+	if GovernanceNodesFunc == nil {
+		return nil
+	} else {
+		return GovernanceNodesFunc()
+	}
+}
+
 func LogBlock(height int64, hash common.Hash) {
 	if LogBlockFunc != nil {
 		LogBlockFunc(height, hash)
@@ -70,39 +77,6 @@ func IsPoW() bool {
 	return params.ConsensusMethod == params.ConsensusPoW
 }
 
-func CalculateRewards(num, blockReward, fees *big.Int, addBalance func(common.Address, *big.Int)) (*common.Address, []byte, error) {
-	if CalculateRewardsFunc == nil {
-		return nil, nil, ErrNotInitialized
-	} else {
-		return CalculateRewardsFunc(num, blockReward, fees, addBalance)
-	}
-}
-
-func VerifyRewards(num *big.Int, rewards string) error {
-	if VerifyRewardsFunc == nil {
-		return ErrNotInitialized
-	} else {
-		return VerifyRewardsFunc(num, rewards)
-	}
-}
-
-func SignBlock(hash common.Hash) (nodeId, sig []byte, err error) {
-	if SignBlockFunc == nil {
-		err = ErrNotInitialized
-	} else {
-		nodeId, sig, err = SignBlockFunc(hash)
-	}
-	return
-}
-
-func VerifyBlockSig(height *big.Int, nodeId []byte, hash common.Hash, sig []byte) bool {
-	if VerifyBlockSigFunc == nil {
-		return false
-	} else {
-		return VerifyBlockSigFunc(height, nodeId, hash, sig)
-	}
-}
-
 func RequirePendingTxs() bool {
 	if RequirePendingTxsFunc == nil {
 		return false
@@ -0,0 +1,1319 @@
+/* raftutil.go */
+
+package wemix
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/raft/v3/tracker"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
+	wemixminer "github.com/ethereum/go-ethereum/wemix/miner"
+)
+
+const (
+	raftTickInterval   = 100 * time.Millisecond
+	raftElectionTicks  = 10
+	raftHeartbeatTicks = 1
+
+	// the log is compacted every raftSnapshotCount entries, keeping the
+	// last raftSnapshotCatchUp entries for the slow followers
+	raftSnapshotCount   = 100
+	raftSnapshotCatchUp = 50
+
+	// a learner within this many entries of the commit index is caught up
+	raftCatchUpLag = 5
+
+	// the first governance node bootstraps the cluster if it hasn't heard
+	// from an existing one for this long
+	raftBootstrapDelay = 10 * time.Second
+
+	// incoming messages beyond this many queued are dropped, as raft
+	// tolerates lost messages
+	raftRecvQueue = 256
+
+	// the state file has the snapshot and the log as of the last snapshot,
+	// and the log file the entries and hard states appended since
+	raftStateFile = "raft.state"
+	raftLogFile   = "raft.log"
+)
+
+var (
+	raftLock = &SpinLock{0}
+
+	errRaftStopped     = errors.New("raft stopped")
+	errRaftSender      = errors.New("raft message from a wrong sender")
+	errRaftUnknownPeer = errors.New("raft message from a non-governance node")
+	errRaftBusy        = errors.New("raft message queue full")
+)
+
+// raftOp is an update of the replicated key-value state
+type raftOp struct {
+	Id    uint64 `json:"id"`
+	Op    string `json:"op"` // "put" or "delete"
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// raftState is the persisted raft state, marshaled raftpb structures. The
+// records of the log file are of the same type without the snapshot.
+type raftState struct {
+	HardState []byte
+	Snapshot  []byte
+	Entries   [][]byte
+}
+
+// raftNode replicates a small key-value state, i.e. the latest work, among
+// the governance nodes, and elects the leader who mines. The messages are
+// carried by the devp2p connections among them with send.
+type raftNode struct {
+	id       uint64
+	dir      string // persisted state, in memory only if empty
+	send     func(to uint64, data []byte) error
+	onLeader func(lead, term uint64) // called on leader changes
+
+	node    raft.Node
+	storage *raft.MemoryStorage
+	lead    uint64 // atomic
+	term    uint64 // used in run only
+	recvc   chan raftpb.Message
+
+	// used in run only
+	saved   bool     // whether the state file is written
+	logFile *os.File // opened on the first append
+
+	lock      sync.Mutex
+	kv        map[string]string
+	confState raftpb.ConfState
+	applied   uint64
+	snapIndex uint64
+	appliedCh chan struct{}          // closed and renewed on every apply
+	waiters   map[uint64]chan uint64 // proposals waiting to be applied
+	reads     map[string]chan uint64 // read index requests
+
+	quit chan struct{}
+	done chan struct{}
+}
+
+// raftID returns the raft member id of the node with the given v4 id
+func raftID(id string) uint64 {
+	b, err := hex.DecodeString(id)
+	if err != nil || len(b) < 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b[:8])
+}
+
+func hasRaftState(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, raftStateFile))
+	return err == nil
+}
+
+// newRaftNode starts a raft node. It restarts from the state persisted in
+// dir if any, bootstraps a new cluster of the given peers if not empty, or
+// waits for the leader of an existing cluster to add it otherwise.
+func newRaftNode(id uint64, dir string, peers []uint64, send func(uint64, []byte) error, onLeader func(lead, term uint64)) (*raftNode, error) {
+	rn := &raftNode{
+		id:        id,
+		dir:       dir,
+		send:      send,
+		onLeader:  onLeader,
+		storage:   raft.NewMemoryStorage(),
+		recvc:     make(chan raftpb.Message, raftRecvQueue),
+		kv:        map[string]string{},
+		appliedCh: make(chan struct{}),
+		waiters:   map[uint64]chan uint64{},
+		reads:     map[string]chan uint64{},
+		quit:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	c := &raft.Config{
+		ID:              id,
+		ElectionTick:    raftElectionTicks,
+		HeartbeatTick:   raftHeartbeatTicks,
+		Storage:         rn.storage,
+		MaxSizePerMsg:   1024 * 1024,
+		MaxInflightMsgs: 256,
+		CheckQuorum:     true,
+		PreVote:         true,
+		Logger:          raftLogger{},
+	}
+
+	if dir != "" && hasRaftState(dir) {
+		if err := rn.load(); err != nil {
+			return nil, err
+		}
+		rn.saved = true
+		c.Applied = rn.applied
+		rn.node = raft.RestartNode(c)
+	} else if len(peers) > 0 {
+		var rps []raft.Peer
+		for _, i := range peers {
+			rps = append(rps, raft.Peer{ID: i})
+		}
+		rn.node = raft.StartNode(c, rps)
+	} else {
+		rn.node = raft.RestartNode(c)
+	}
+
+	go rn.run()
+	return rn, nil
+}
+
+// run drives the raft node. The updates are persisted before the messages
+// are sent, and the node stops if they can't be, as it can't be a member
+// without keeping its promises.
+func (rn *raftNode) run() {
+	defer close(rn.done)
+	defer rn.closeLog()
+
+	ticker := time.NewTicker(raftTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			rn.node.Tick()
+
+		case m := <-rn.recvc:
+			rn.node.Step(context.Background(), m)
+
+		case rd := <-rn.node.Ready():
+			if err := rn.persist(rd); err != nil {
+				rn.fail(err)
+				return
+			}
+
+			if rd.SoftState != nil {
+				lead := rd.SoftState.Lead
+				if old := atomic.SwapUint64(&rn.lead, lead); old != lead {
+					log.Info("raft leader changed",
+						"leader", fmt.Sprintf("%x", lead), "self", fmt.Sprintf("%x", rn.id),
+						"term", rn.term)
+					if rn.onLeader != nil {
+						rn.onLeader(lead, rn.term)
+					}
+				}
+			}
+
+			rn.sendMessages(rd.Messages)
+
+			if !raft.IsEmptySnap(rd.Snapshot) {
+				rn.restoreSnapshot(rd.Snapshot)
+			}
+			rn.apply(rd.CommittedEntries)
+			rn.readIndex(rd.ReadStates)
+			if err := rn.maybeSnapshot(); err != nil {
+				rn.fail(err)
+				return
+			}
+			rn.node.Advance()
+
+		case <-rn.quit:
+			rn.node.Stop()
+			return
+		}
+	}
+}
+
+// fail stops the node on a persistence failure, giving up the leadership
+func (rn *raftNode) fail(err error) {
+	log.Error("failed to save raft state, stopping raft", "error", err)
+	rn.node.Stop()
+	if old := atomic.SwapUint64(&rn.lead, 0); old != 0 && rn.onLeader != nil {
+		rn.onLeader(0, rn.term)
+	}
+}
+
+// stopped returns true if the node has stopped, by stop or on a failure
+func (rn *raftNode) stopped() bool {
+	select {
+	case <-rn.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (rn *raftNode) stop() {
+	select {
+	case <-rn.quit:
+	default:
+		close(rn.quit)
+	}
+	<-rn.done
+}
+
+func (rn *raftNode) sendMessages(msgs []raftpb.Message) {
+	for _, m := range msgs {
+		if m.To == 0 {
+			continue
+		}
+		data, err := m.Marshal()
+		if err == nil {
+			err = rn.send(m.To, data)
+		}
+		if err != nil {
+			rn.node.ReportUnreachable(m.To)
+			if m.Type == raftpb.MsgSnap {
+				rn.node.ReportSnapshot(m.To, raft.SnapshotFailure)
+			}
+		} else if m.Type == raftpb.MsgSnap {
+			rn.node.ReportSnapshot(m.To, raft.SnapshotFinish)
+		}
+	}
+}
+
+func (rn *raftNode) apply(ents []raftpb.Entry) {
+	if len(ents) == 0 {
+		return
+	}
+
+	for _, e := range ents {
+		switch e.Type {
+		case raftpb.EntryNormal:
+			if len(e.Data) == 0 {
+				// empty entry of a new leader
+				break
+			}
+			var op raftOp
+			if err := json.Unmarshal(e.Data, &op); err != nil {
+				log.Error("invalid raft entry", "index", e.Index, "error", err)
+				break
+			}
+			rn.lock.Lock()
+			switch op.Op {
+			case "put":
+				rn.kv[op.Key] = op.Value
+			case "delete":
+				delete(rn.kv, op.Key)
+			}
+			rn.lock.Unlock()
+			rn.notify(op.Id, e.Index)
+
+		case raftpb.EntryConfChange:
+			var cc raftpb.ConfChange
+			if err := cc.Unmarshal(e.Data); err != nil {
+				log.Error("invalid raft conf change", "index", e.Index, "error", err)
+				break
+			}
+			cs := rn.node.ApplyConfChange(cc)
+			rn.lock.Lock()
+			rn.confState = *cs
+			rn.lock.Unlock()
+			log.Info("raft membership changed", "change", cc.Type,
+				"member", fmt.Sprintf("%x", cc.NodeID))
+			rn.notify(cc.ID, e.Index)
+		}
+	}
+
+	rn.lock.Lock()
+	rn.applied = ents[len(ents)-1].Index
+	close(rn.appliedCh)
+	rn.appliedCh = make(chan struct{})
+	rn.lock.Unlock()
+}
+
+// notify wakes up the proposer of the applied entry
+func (rn *raftNode) notify(id, index uint64) {
+	if id == 0 {
+		return
+	}
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	if ch, ok := rn.waiters[id]; ok {
+		ch <- index
+		delete(rn.waiters, id)
+	}
+}
+
+func (rn *raftNode) readIndex(rss []raft.ReadState) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	for _, rs := range rss {
+		if ch, ok := rn.reads[string(rs.RequestCtx)]; ok {
+			ch <- rs.Index
+			delete(rn.reads, string(rs.RequestCtx))
+		}
+	}
+}
+
+// maybeSnapshot compacts the log every raftSnapshotCount entries, and
+// rewrites the state file
+func (rn *raftNode) maybeSnapshot() error {
+	rn.lock.Lock()
+	applied, snapIndex, cs := rn.applied, rn.snapIndex, rn.confState
+	if applied-snapIndex < raftSnapshotCount {
+		rn.lock.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(rn.kv)
+	rn.lock.Unlock()
+	if err != nil {
+		return nil
+	}
+
+	if _, err = rn.storage.CreateSnapshot(applied, &cs, data); err != nil {
+		log.Error("failed to create raft snapshot", "index", applied, "error", err)
+		return nil
+	}
+	if applied > raftSnapshotCatchUp {
+		if err = rn.storage.Compact(applied - raftSnapshotCatchUp); err != nil {
+			log.Error("failed to compact raft log", "index", applied, "error", err)
+		} else {
+			raftCompactCounter.Inc(1)
+		}
+	}
+	rn.lock.Lock()
+	rn.snapIndex = applied
+	rn.lock.Unlock()
+	return rn.save()
+}
+
+func (rn *raftNode) restoreSnapshot(snap raftpb.Snapshot) {
+	kv := map[string]string{}
+	if len(snap.Data) > 0 {
+		if err := json.Unmarshal(snap.Data, &kv); err != nil {
+			log.Error("invalid raft snapshot", "index", snap.Metadata.Index, "error", err)
+		}
+	}
+
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	rn.kv = kv
+	rn.confState = snap.Metadata.ConfState
+	rn.applied = snap.Metadata.Index
+	rn.snapIndex = snap.Metadata.Index
+}
+
+// persist saves the updates to the storage and the disk. The entries and the
+// hard state are appended to the log file, and the state file is rewritten
+// only if a snapshot is received or none is written yet.
+func (rn *raftNode) persist(rd raft.Ready) error {
+	if !raft.IsEmptySnap(rd.Snapshot) {
+		rn.storage.ApplySnapshot(rd.Snapshot)
+	}
+	rn.storage.Append(rd.Entries)
+	if !raft.IsEmptyHardState(rd.HardState) {
+		rn.storage.SetHardState(rd.HardState)
+		rn.term = rd.HardState.Term
+	}
+	if !raft.IsEmptySnap(rd.Snapshot) || !rn.saved {
+		return rn.save()
+	} else if len(rd.Entries) > 0 || !raft.IsEmptyHardState(rd.HardState) {
+		return rn.appendLog(rd.HardState, rd.Entries)
+	}
+	return nil
+}
+
+// save writes the hard state, snapshot and log entries to the state file,
+// and truncates the log file
+func (rn *raftNode) save() error {
+	if rn.dir == "" {
+		return nil
+	}
+
+	var (
+		st       raftState
+		hs, _, _ = rn.storage.InitialState()
+		snap, _  = rn.storage.Snapshot()
+		first, _ = rn.storage.FirstIndex()
+		last, _  = rn.storage.LastIndex()
+		ents     []raftpb.Entry
+		err      error
+	)
+	if last >= first {
+		if ents, err = rn.storage.Entries(first, last+1, math.MaxUint64); err != nil {
+			return err
+		}
+	}
+	if st.HardState, err = hs.Marshal(); err != nil {
+		return err
+	}
+	if st.Snapshot, err = snap.Marshal(); err != nil {
+		return err
+	}
+	for _, e := range ents {
+		data, err := e.Marshal()
+		if err != nil {
+			return err
+		}
+		st.Entries = append(st.Entries, data)
+	}
+	data, err := rlp.EncodeToBytes(&st)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(rn.dir, 0700); err != nil {
+		return err
+	}
+	fn := filepath.Join(rn.dir, raftStateFile)
+	f, err := os.OpenFile(fn+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(fn+".tmp", fn); err != nil {
+		return err
+	}
+	rn.saved = true
+
+	// the appended records are in the state file now
+	lf, err := rn.openLog()
+	if err != nil {
+		return err
+	}
+	if err = lf.Truncate(0); err != nil {
+		return err
+	}
+	return lf.Sync()
+}
+
+// openLog opens the log file for appending, once
+func (rn *raftNode) openLog() (*os.File, error) {
+	if rn.logFile == nil {
+		f, err := os.OpenFile(filepath.Join(rn.dir, raftLogFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		rn.logFile = f
+	}
+	return rn.logFile, nil
+}
+
+func (rn *raftNode) closeLog() {
+	if rn.logFile != nil {
+		rn.logFile.Close()
+		rn.logFile = nil
+	}
+}
+
+// appendLog appends a record of the entries and the hard state to the log
+// file. A record is its length and crc32 checksum, followed by the
+// rlp-encoded raftState.
+func (rn *raftNode) appendLog(hs raftpb.HardState, ents []raftpb.Entry) error {
+	if rn.dir == "" {
+		return nil
+	}
+
+	var (
+		rec raftState
+		err error
+	)
+	if !raft.IsEmptyHardState(hs) {
+		if rec.HardState, err = hs.Marshal(); err != nil {
+			return err
+		}
+	}
+	for _, e := range ents {
+		data, err := e.Marshal()
+		if err != nil {
+			return err
+		}
+		rec.Entries = append(rec.Entries, data)
+	}
+	data, err := rlp.EncodeToBytes(&rec)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:], crc32.ChecksumIEEE(data))
+	buf = append(buf, data...)
+
+	f, err := rn.openLog()
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(buf); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// load restores the storage and the key-value state from the state file and
+// the records appended to the log file since
+func (rn *raftNode) load() error {
+	data, err := ioutil.ReadFile(filepath.Join(rn.dir, raftStateFile))
+	if err != nil {
+		return err
+	}
+	var st raftState
+	if err = rlp.DecodeBytes(data, &st); err != nil {
+		return err
+	}
+
+	var (
+		hs   raftpb.HardState
+		snap raftpb.Snapshot
+		ents = make([]raftpb.Entry, len(st.Entries))
+	)
+	if err = hs.Unmarshal(st.HardState); err != nil {
+		return err
+	}
+	if err = snap.Unmarshal(st.Snapshot); err != nil {
+		return err
+	}
+	for i, e := range st.Entries {
+		if err = ents[i].Unmarshal(e); err != nil {
+			return err
+		}
+	}
+
+	if !raft.IsEmptySnap(snap) {
+		if err = rn.storage.ApplySnapshot(snap); err != nil {
+			return err
+		}
+		rn.restoreSnapshot(snap)
+	}
+	if err = rn.storage.SetHardState(hs); err != nil {
+		return err
+	}
+	if err = rn.storage.Append(ents); err != nil {
+		return err
+	}
+	return rn.loadLog()
+}
+
+// loadLog replays the records of the log file. A torn record at the end,
+// i.e. one not synced when the node went down, is truncated.
+func (rn *raftNode) loadLog() error {
+	fn := filepath.Join(rn.dir, raftLogFile)
+	data, err := ioutil.ReadFile(fn)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	off := 0
+	for off+8 <= len(data) {
+		size := int(binary.BigEndian.Uint32(data[off:]))
+		end := off + 8 + size
+		if end > len(data) || crc32.ChecksumIEEE(data[off+8:end]) != binary.BigEndian.Uint32(data[off+4:]) {
+			break
+		}
+		var rec raftState
+		if err = rlp.DecodeBytes(data[off+8:end], &rec); err != nil {
+			return err
+		}
+		ents := make([]raftpb.Entry, len(rec.Entries))
+		for i, e := range rec.Entries {
+			if err = ents[i].Unmarshal(e); err != nil {
+				return err
+			}
+		}
+		if err = rn.storage.Append(ents); err != nil {
+			return err
+		}
+		if len(rec.HardState) > 0 {
+			var hs raftpb.HardState
+			if err = hs.Unmarshal(rec.HardState); err != nil {
+				return err
+			}
+			if err = rn.storage.SetHardState(hs); err != nil {
+				return err
+			}
+		}
+		off = end
+	}
+	if off < len(data) {
+		log.Warn("truncating torn raft log", "offset", off, "size", len(data))
+		return os.Truncate(fn, int64(off))
+	}
+	return nil
+}
+
+func (rn *raftNode) leader() uint64 {
+	return atomic.LoadUint64(&rn.lead)
+}
+
+func (rn *raftNode) isLeader() bool {
+	return rn.leader() == rn.id
+}
+
+// members returns the voters and the learners of the cluster
+func (rn *raftNode) members() (voters, learners []uint64) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	voters = append(voters, rn.confState.Voters...)
+	learners = append(learners, rn.confState.Learners...)
+	return
+}
+
+// step queues a message from the member with the given id for the raft
+// node. It doesn't block, the message is dropped if the queue is full.
+func (rn *raftNode) step(from uint64, data []byte) error {
+	var m raftpb.Message
+	if err := m.Unmarshal(data); err != nil {
+		return err
+	}
+	if m.From != from || m.To != rn.id || raft.IsLocalMsg(m.Type) {
+		return errRaftSender
+	}
+	select {
+	case rn.recvc <- m:
+		return nil
+	case <-rn.done:
+		return errRaftStopped
+	default:
+		return errRaftBusy
+	}
+}
+
+// propose replicates the update, and returns the log index of it once applied
+func (rn *raftNode) propose(ctx context.Context, op *raftOp) (uint64, error) {
+	op.Id = rand.Uint64()
+	data, err := json.Marshal(op)
+	if err != nil {
+		return 0, err
+	}
+
+	ch := make(chan uint64, 1)
+	rn.lock.Lock()
+	rn.waiters[op.Id] = ch
+	rn.lock.Unlock()
+	defer func() {
+		rn.lock.Lock()
+		delete(rn.waiters, op.Id)
+		rn.lock.Unlock()
+	}()
+
+	if err = rn.node.Propose(ctx, data); err != nil {
+		return 0, err
+	}
+	select {
+	case ix := <-ch:
+		return ix, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-rn.done:
+		return 0, errRaftStopped
+	}
+}
+
+func (rn *raftNode) put(ctx context.Context, key, value string) (uint64, error) {
+	return rn.propose(ctx, &raftOp{Op: "put", Key: key, Value: value})
+}
+
+func (rn *raftNode) delete(ctx context.Context, key string) error {
+	_, err := rn.propose(ctx, &raftOp{Op: "delete", Key: key})
+	return err
+}
+
+// get returns the value of the key as of the latest commit of the cluster
+func (rn *raftNode) get(ctx context.Context, key string) (string, error) {
+	rctx := make([]byte, 8)
+	binary.BigEndian.PutUint64(rctx, rand.Uint64())
+
+	ch := make(chan uint64, 1)
+	rn.lock.Lock()
+	rn.reads[string(rctx)] = ch
+	rn.lock.Unlock()
+	defer func() {
+		rn.lock.Lock()
+		delete(rn.reads, string(rctx))
+		rn.lock.Unlock()
+	}()
+
+	if err := rn.node.ReadIndex(ctx, rctx); err != nil {
+		return "", err
+	}
+	var index uint64
+	select {
+	case index = <-ch:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-rn.done:
+		return "", errRaftStopped
+	}
+
+	for {
+		rn.lock.Lock()
+		applied, appliedCh := rn.applied, rn.appliedCh
+		if applied >= index {
+			v := rn.kv[key]
+			rn.lock.Unlock()
+			return v, nil
+		}
+		rn.lock.Unlock()
+
+		select {
+		case <-appliedCh:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-rn.done:
+			return "", errRaftStopped
+		}
+	}
+}
+
+// changeMember proposes a membership change, and waits until it's applied
+func (rn *raftNode) changeMember(ctx context.Context, typ raftpb.ConfChangeType, id uint64) error {
+	cc := raftpb.ConfChange{
+		ID:     rand.Uint64(),
+		Type:   typ,
+		NodeID: id,
+	}
+
+	ch := make(chan uint64, 1)
+	rn.lock.Lock()
+	rn.waiters[cc.ID] = ch
+	rn.lock.Unlock()
+	defer func() {
+		rn.lock.Lock()
+		delete(rn.waiters, cc.ID)
+		rn.lock.Unlock()
+	}()
+
+	if err := rn.node.ProposeConfChange(ctx, cc); err != nil {
+		return err
+	}
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-rn.done:
+		return errRaftStopped
+	}
+}
+
+// transferLeader hands over the leadership to the given member
+func (rn *raftNode) transferLeader(ctx context.Context, to uint64) error {
+	rn.node.TransferLeadership(ctx, rn.leader(), to)
+	for rn.leader() != to {
+		select {
+		case <-time.After(raftTickInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// raftLogger sends the raft logs to the geth logger
+type raftLogger struct{}
+
+func (raftLogger) Debug(v ...interface{})                 { log.Trace(fmt.Sprint(v...)) }
+func (raftLogger) Debugf(format string, v ...interface{}) { log.Trace(fmt.Sprintf(format, v...)) }
+func (raftLogger) Info(v ...interface{})                  { log.Debug(fmt.Sprint(v...)) }
+func (raftLogger) Infof(format string, v ...interface{})  { log.Debug(fmt.Sprintf(format, v...)) }
+func (raftLogger) Warning(v ...interface{})               { log.Warn(fmt.Sprint(v...)) }
+func (raftLogger) Warningf(format string, v ...interface{}) {
+	log.Warn(fmt.Sprintf(format, v...))
+}
+func (raftLogger) Error(v ...interface{})                 { log.Error(fmt.Sprint(v...)) }
+func (raftLogger) Errorf(format string, v ...interface{}) { log.Error(fmt.Sprintf(format, v...)) }
+func (raftLogger) Fatal(v ...interface{})                 { log.Crit(fmt.Sprint(v...)) }
+func (raftLogger) Fatalf(format string, v ...interface{}) { log.Crit(fmt.Sprintf(format, v...)) }
+func (raftLogger) Panic(v ...interface{})                 { panic(fmt.Sprint(v...)) }
+func (raftLogger) Panicf(format string, v ...interface{}) { panic(fmt.Sprintf(format, v...)) }
+
+func (ma *wemixAdmin) raftIsRunning() bool {
+	return ma.raft != nil && !ma.raft.stopped()
+}
+
+// raftBootstrapper returns true if this node is to bootstrap a new cluster,
+// i.e. the first governance node in the mining order
+func (ma *wemixAdmin) raftBootstrapper() bool {
+	if ma.self == nil {
+		return false
+	}
+	nodes := ma.getNodes()
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	return len(nodes) > 0 && nodes[0].Id == ma.self.Id
+}
+
+func (ma *wemixAdmin) raftStart() error {
+	if ma.raftIsRunning() {
+		return ErrAlreadyRunning
+	} else if ma.self == nil {
+		return ErrNotRunning
+	}
+
+	var peers []uint64
+	if !hasRaftState(ma.raftDir) && ma.raftBootstrapper() {
+		// bootstrap a new cluster unless another one is already up
+		if ma.raftWaitSince.IsZero() {
+			ma.raftWaitSince = time.Now()
+		}
+		if atomic.LoadInt32(&ma.raftHeard) != 0 {
+			log.Info("joining the existing raft cluster")
+		} else if time.Since(ma.raftWaitSince) < raftBootstrapDelay {
+			return nil
+		} else {
+			log.Info("bootstrapping a new raft cluster")
+			peers = []uint64{raftID(ma.self.Id)}
+		}
+	}
+
+	self := raftID(ma.self.Id)
+	rn, err := newRaftNode(self, ma.raftDir, peers, ma.raftSend,
+		func(lead, term uint64) {
+			ma.raftLeaderChanged(self, lead, term)
+		})
+	if err != nil {
+		log.Error("failed to start raft", "error", err)
+		return err
+	}
+	log.Info("started raft", "id", fmt.Sprintf("%x", rn.id))
+	ma.raft = rn
+	return nil
+}
+
+// raftLeaderChanged records the leader change, lead being 0 if there is
+// none, and wakes up the miner if this node is the new leader
+func (ma *wemixAdmin) raftLeaderChanged(self, lead, term uint64) {
+	leaderGauge.Update(int64(lead))
+	leaderChangeCounter.Inc(1)
+
+	e := leadershipEvent{Kind: leaderChanged, Term: term}
+	if lead != 0 {
+		e.Id = fmt.Sprintf("%x", lead)
+		peers, _ := ma.raftPeers.Load().(map[uint64]*wemixNode)
+		if n, ok := peers[lead]; ok {
+			e.Name = n.Name
+		}
+	}
+	ma.leaderEvents.add(e)
+
+	if lead == self {
+		leaderSelfGauge.Update(1)
+		wemixminer.FeedLeadership()
+	} else {
+		leaderSelfGauge.Update(0)
+	}
+}
+
+func (ma *wemixAdmin) raftStop() error {
+	if ma.raft == nil {
+		return ErrNotRunning
+	}
+	ma.raft.stop()
+	ma.raft = nil
+	return nil
+}
+
+// raftSend sends a raft message to the member via the devp2p connection
+func (ma *wemixAdmin) raftSend(to uint64, data []byte) error {
+	peers, _ := ma.raftPeers.Load().(map[uint64]*wemixNode)
+	node, ok := peers[to]
+	if !ok || wemixapi.SendRaftMessage == nil {
+		return ethereum.NotFound
+	}
+	return wemixapi.SendRaftMessage(node.Id, data)
+}
+
+// raftSetPeers updates the governance nodes by raft member id, used without
+// ma.lock, as it can be held while waiting for raft, e.g. in LogBlock
+func (ma *wemixAdmin) raftSetPeers(nodes []*wemixNode) {
+	peers := map[uint64]*wemixNode{}
+	for _, i := range nodes {
+		n := new(wemixNode)
+		*n = *i
+		peers[raftID(i.Id)] = n
+	}
+	ma.raftPeers.Store(peers)
+}
+
+// raftMembershipChange is the next step to make the governance nodes the
+// members of the cluster
+type raftMembershipChange struct {
+	typ      raftpb.ConfChangeType
+	id       uint64
+	transfer bool // hand over the leadership to id first, to remove self
+}
+
+// planRaftMembership returns the next membership change for the leader self
+// to make the governance nodes in want the members of the cluster, and the
+// reason if a change is held back. The new nodes that are up join as
+// learners, and become voters once caught up with the commit index. The
+// departed members are removed, learners first, unless the remaining voters
+// would be left without an active quorum.
+func planRaftMembership(self uint64, want []uint64, isUp func(uint64) bool, voters, learners []uint64, progress map[uint64]tracker.Progress, commit uint64) (*raftMembershipChange, string) {
+	if len(want) == 0 {
+		return nil, "no governance nodes"
+	}
+	wanted := map[uint64]bool{}
+	for _, i := range want {
+		wanted[i] = true
+	}
+	isVoter, isLearner := map[uint64]bool{}, map[uint64]bool{}
+	for _, i := range voters {
+		isVoter[i] = true
+	}
+	for _, i := range learners {
+		isLearner[i] = true
+	}
+	caughtUp := func(id uint64) bool {
+		pr, ok := progress[id]
+		return ok && pr.Match+raftCatchUpLag >= commit
+	}
+	active := func(id uint64) bool {
+		if id == self {
+			return true
+		}
+		pr, ok := progress[id]
+		return ok && pr.RecentActive && pr.Match+raftCatchUpLag >= commit
+	}
+	hasQuorum := func(voters []uint64) bool {
+		n := 0
+		for _, i := range voters {
+			if active(i) {
+				n++
+			}
+		}
+		return len(voters) > 0 && n >= len(voters)/2+1
+	}
+
+	// add the new nodes as learners
+	for _, i := range want {
+		if !isVoter[i] && !isLearner[i] && isUp(i) {
+			return &raftMembershipChange{typ: raftpb.ConfChangeAddLearnerNode, id: i}, ""
+		}
+	}
+
+	// promote the learners caught up
+	var reason string
+	for _, i := range want {
+		if !isLearner[i] {
+			continue
+		}
+		if !caughtUp(i) {
+			reason = fmt.Sprintf("learner %x not caught up yet", i)
+			continue
+		}
+		if !hasQuorum(append(append([]uint64{}, voters...), i)) {
+			reason = fmt.Sprintf("promoting learner %x would lose quorum", i)
+			continue
+		}
+		return &raftMembershipChange{typ: raftpb.ConfChangeAddNode, id: i}, ""
+	}
+
+	// remove the departed learners, then voters
+	for _, i := range learners {
+		if !wanted[i] {
+			return &raftMembershipChange{typ: raftpb.ConfChangeRemoveNode, id: i}, ""
+		}
+	}
+	for _, i := range voters {
+		if wanted[i] {
+			continue
+		}
+		var rest []uint64
+		for _, j := range voters {
+			if j != i {
+				rest = append(rest, j)
+			}
+		}
+		if !hasQuorum(rest) {
+			reason = fmt.Sprintf("removing voter %x would lose quorum", i)
+			continue
+		}
+		if i != self {
+			return &raftMembershipChange{typ: raftpb.ConfChangeRemoveNode, id: i}, ""
+		}
+		// the new leader is to remove this node
+		for _, j := range rest {
+			if j != self && caughtUp(j) && active(j) {
+				return &raftMembershipChange{id: j, transfer: true}, ""
+			}
+		}
+		reason = "no voter to hand over the leadership to"
+	}
+	return nil, reason
+}
+
+// raftReconcile makes the governance nodes the members of the cluster, one
+// change at a time by the leader
+func (ma *wemixAdmin) raftReconcile() {
+	if !ma.raftIsRunning() || !ma.raft.isLeader() {
+		return
+	}
+
+	nodes := ma.getNodes()
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	var want []uint64
+	byId := map[uint64]*wemixNode{}
+	for _, i := range nodes {
+		want = append(want, raftID(i.Id))
+		byId[raftID(i.Id)] = i
+	}
+	isUp := func(id uint64) bool {
+		return id == ma.raft.id || ma.isPeerUp(byId[id].Id)
+	}
+	name := func(id uint64) string {
+		if n, ok := byId[id]; ok {
+			return n.Name
+		}
+		return ""
+	}
+
+	status := ma.raft.node.Status()
+	voters, learners := ma.raft.members()
+	change, reason := planRaftMembership(ma.raft.id, want, isUp, voters, learners,
+		status.Progress, status.Commit)
+	if change == nil {
+		if reason != ma.raftHeldBack {
+			if reason != "" {
+				log.Warn("raft membership change held back", "reason", reason)
+			}
+			ma.raftHeldBack = reason
+		}
+		return
+	}
+	ma.raftHeldBack = ""
+
+	ctx, cancel := context.WithTimeout(context.Background(), ma.raftTimeout)
+	defer cancel()
+
+	id := fmt.Sprintf("%x", change.id)
+	if change.transfer {
+		log.Info("raft leader not in governance, handing over the leadership",
+			"to", name(change.id), "id", id)
+		e := leadershipEvent{Kind: leaderMember, Name: name(change.id), Id: id,
+			Detail: "handing over the leadership"}
+		if err := ma.raft.transferLeader(ctx, change.id); err != nil {
+			log.Error("failed to hand over the raft leadership", "to", name(change.id), "id", id, "error", err)
+			e.Detail += ": " + err.Error()
+		}
+		ma.leaderEvents.add(e)
+		return
+	}
+
+	var action string
+	switch change.typ {
+	case raftpb.ConfChangeAddLearnerNode:
+		action = "adding a raft learner"
+	case raftpb.ConfChangeAddNode:
+		action = "promoting a raft learner"
+	case raftpb.ConfChangeRemoveNode:
+		action = "removing a raft member"
+	}
+	log.Info(action, "name", name(change.id), "id", id,
+		"voters", len(voters), "learners", len(learners))
+	e := leadershipEvent{Kind: leaderMember, Name: name(change.id), Id: id, Detail: action}
+	if err := ma.raft.changeMember(ctx, change.typ, change.id); err != nil {
+		log.Error("raft membership change failed", "action", action,
+			"name", name(change.id), "id", id, "error", err)
+		e.Detail += ": " + err.Error()
+	}
+	ma.leaderEvents.add(e)
+}
+
+func (ma *wemixAdmin) raftIsLeader() bool {
+	if !ma.raftIsRunning() {
+		return false
+	} else {
+		return ma.raft.isLeader()
+	}
+}
+
+// returns leader id and node
+func (ma *wemixAdmin) raftLeader(locked bool) (uint64, *wemixNode) {
+	if !ma.raftIsRunning() {
+		return 0, nil
+	}
+
+	lid := ma.raft.leader()
+	if lid == 0 {
+		return 0, nil
+	}
+	if !locked {
+		ma.lock.Lock()
+		defer ma.lock.Unlock()
+	}
+	for _, i := range ma.nodes {
+		if raftID(i.Id) == lid {
+			return lid, i
+		}
+	}
+	return lid, nil
+}
+
+func (ma *wemixAdmin) raftMoveLeader(name string) error {
+	if !ma.raftIsRunning() {
+		return ErrNotRunning
+	}
+
+	var id uint64
+	peers, _ := ma.raftPeers.Load().(map[uint64]*wemixNode)
+	for i, n := range peers {
+		if n.Name == name || n.Id == name {
+			id = i
+			break
+		}
+	}
+	if id == 0 {
+		return ethereum.NotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+	return ma.raft.transferLeader(ctx, id)
+}
+
+func (ma *wemixAdmin) raftPut(key, value string) (uint64, error) {
+	if !ma.raftIsRunning() {
+		return 0, ErrNotRunning
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ma.raftTimeout)
+	defer cancel()
+	return ma.raft.put(ctx, key, value)
+}
+
+func (ma *wemixAdmin) raftGet(key string) (string, error) {
+	if !ma.raftIsRunning() {
+		return "", ErrNotRunning
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	return ma.raft.get(ctx, key)
+}
+
+func (ma *wemixAdmin) raftDelete(key string) error {
+	if !ma.raftIsRunning() {
+		return ErrNotRunning
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ma.raftTimeout)
+	defer cancel()
+	return ma.raft.delete(ctx, key)
+}
+
+func (ma *wemixAdmin) raftInfo() interface{} {
+	if !ma.raftIsRunning() {
+		return ErrNotRunning
+	}
+
+	peers, _ := ma.raftPeers.Load().(map[uint64]*wemixNode)
+	getMemberInfo := func(id uint64) *map[string]interface{} {
+		info := map[string]interface{}{
+			"id": fmt.Sprintf("%x", id),
+		}
+		if n, ok := peers[id]; ok {
+			info["name"] = n.Name
+		}
+		return &info
+	}
+
+	status := ma.raft.node.Status()
+	voters, learners := ma.raft.members()
+	var members []interface{}
+	for _, i := range voters {
+		members = append(members, getMemberInfo(i))
+	}
+	var learnerInfos []interface{}
+	for _, i := range learners {
+		learnerInfos = append(learnerInfos, getMemberInfo(i))
+	}
+
+	info := map[string]interface{}{
+		"self":     getMemberInfo(ma.raft.id),
+		"state":    status.RaftState.String(),
+		"term":     status.Term,
+		"commit":   status.Commit,
+		"applied":  status.Applied,
+		"members":  members,
+		"learners": learnerInfos,
+	}
+	if lead := ma.raft.leader(); lead != 0 {
+		info["leader"] = getMemberInfo(lead)
+	}
+	return info
+}
+
+func RaftStart() {
+	if !raftLock.TryLock() {
+		return
+	}
+	defer raftLock.Unlock()
+	if admin == nil {
+		return
+	}
+	admin.raftStart()
+}
+
+// raftStep handles a raft message from the peer with the given v4 id. The
+// devp2p handshake has authenticated the peer with its node key already, so
+// the peer only needs to be the governance node registered for the sending
+// member. Messages from anyone else, the boot node included, are dropped.
+func (ma *wemixAdmin) raftStep(id string, data []byte) error {
+	from := raftID(id)
+	peers, _ := ma.raftPeers.Load().(map[uint64]*wemixNode)
+	if n, ok := peers[from]; !ok || n.Id != id {
+		log.Debug("raft message from a non-governance node", "id", id)
+		return errRaftUnknownPeer
+	}
+
+	rn := ma.raft
+	if rn == nil {
+		atomic.StoreInt32(&ma.raftHeard, 1)
+		return ErrNotRunning
+	}
+	return rn.step(from, data)
+}
+
+// RaftStep handles a raft message from the peer with the given v4 id
+func RaftStep(id string, data []byte) error {
+	if admin == nil {
+		return ErrNotRunning
+	}
+	return admin.raftStep(id, data)
+}
+
+func RaftMoveLeader(name string) error {
+	if admin == nil {
+		return ErrNotRunning
+	}
+	return admin.raftMoveLeader(name)
+}
+
+func RaftGetWork() (string, error) {
+	if admin == nil {
+		return "", ErrNotRunning
+	}
+	return admin.raftGet("work")
+}
+
+func RaftDeleteWork() error {
+	if admin == nil {
+		return ErrNotRunning
+	}
+	return admin.raftDelete("work")
+}
+
+/* EOF */
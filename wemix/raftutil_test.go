@@ -0,0 +1,432 @@
+// raftutil_test.go
+
+package wemix
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/raft/v3/tracker"
+)
+
+// testRaftCluster connects raft nodes in process, in place of the devp2p
+// connections.
+type testRaftCluster struct {
+	t     *testing.T
+	lock  sync.Mutex
+	nodes map[uint64]*raftNode
+}
+
+func newTestRaftCluster(t *testing.T) *testRaftCluster {
+	return &testRaftCluster{t: t, nodes: map[uint64]*raftNode{}}
+}
+
+func (c *testRaftCluster) start(id uint64, dir string, peers []uint64) *raftNode {
+	send := func(to uint64, data []byte) error {
+		c.lock.Lock()
+		rn := c.nodes[to]
+		c.lock.Unlock()
+		if rn == nil {
+			return fmt.Errorf("%x is down", to)
+		}
+		rn.step(id, data)
+		return nil
+	}
+	rn, err := newRaftNode(id, dir, peers, send, nil)
+	if err != nil {
+		c.t.Fatalf("failed to start raft node %x: %v", id, err)
+	}
+	c.lock.Lock()
+	c.nodes[id] = rn
+	c.lock.Unlock()
+	return rn
+}
+
+func (c *testRaftCluster) stop(id uint64) {
+	c.lock.Lock()
+	rn := c.nodes[id]
+	delete(c.nodes, id)
+	c.lock.Unlock()
+	if rn != nil {
+		rn.stop()
+	}
+}
+
+func (c *testRaftCluster) close() {
+	c.lock.Lock()
+	var ids []uint64
+	for id := range c.nodes {
+		ids = append(ids, id)
+	}
+	c.lock.Unlock()
+	for _, id := range ids {
+		c.stop(id)
+	}
+}
+
+// waitLeader waits until all the given nodes agree on the leader
+func (c *testRaftCluster) waitLeader(ids ...uint64) uint64 {
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		c.lock.Lock()
+		lead := c.nodes[ids[0]].leader()
+		agreed := lead != 0
+		for _, id := range ids[1:] {
+			agreed = agreed && c.nodes[id].leader() == lead
+		}
+		c.lock.Unlock()
+		if agreed {
+			return lead
+		}
+	}
+	c.t.Fatalf("no leader elected among %x", ids)
+	return 0
+}
+
+func testRaftContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 5*time.Second)
+}
+
+// Tests that the leader adds and removes members, and the work and the
+// leadership are shared among them.
+func TestRaftCluster(t *testing.T) {
+	c := newTestRaftCluster(t)
+	defer c.close()
+
+	n1 := c.start(1, "", []uint64{1})
+	n2 := c.start(2, "", nil)
+	n3 := c.start(3, "", nil)
+	if lead := c.waitLeader(1); lead != 1 {
+		t.Fatalf("leader mismatch: have %x, want 1", lead)
+	}
+
+	ctx, cancel := testRaftContext()
+	defer cancel()
+	for _, id := range []uint64{2, 3} {
+		if err := n1.changeMember(ctx, raftpb.ConfChangeAddLearnerNode, id); err != nil {
+			t.Fatalf("failed to add learner %x: %v", id, err)
+		}
+		if _, learners := n1.members(); len(learners) != 1 || learners[0] != id {
+			t.Fatalf("learners mismatch: have %v, want [%x]", learners, id)
+		}
+		if err := n1.changeMember(ctx, raftpb.ConfChangeAddNode, id); err != nil {
+			t.Fatalf("failed to promote learner %x: %v", id, err)
+		}
+	}
+	if voters, _ := n1.members(); len(voters) != 3 {
+		t.Fatalf("voters mismatch: have %v, want 3", voters)
+	}
+	c.waitLeader(1, 2, 3)
+
+	if _, err := n1.put(ctx, "work", "1"); err != nil {
+		t.Fatalf("failed to put: %v", err)
+	}
+	for _, rn := range []*raftNode{n1, n2, n3} {
+		if v, err := rn.get(ctx, "work"); err != nil || v != "1" {
+			t.Fatalf("node %x: work mismatch: have %q, %v, want \"1\"", rn.id, v, err)
+		}
+	}
+	// proposals are forwarded to the leader
+	if _, err := n2.put(ctx, "work", "2"); err != nil {
+		t.Fatalf("failed to put from a follower: %v", err)
+	}
+	if v, err := n3.get(ctx, "work"); err != nil || v != "2" {
+		t.Fatalf("work mismatch: have %q, %v, want \"2\"", v, err)
+	}
+
+	if err := n1.transferLeader(ctx, 3); err != nil {
+		t.Fatalf("failed to transfer leadership: %v", err)
+	}
+	if lead := c.waitLeader(1, 2, 3); lead != 3 {
+		t.Fatalf("leader mismatch: have %x, want 3", lead)
+	}
+
+	if err := n3.changeMember(ctx, raftpb.ConfChangeRemoveNode, 2); err != nil {
+		t.Fatalf("failed to remove member: %v", err)
+	}
+	c.stop(2)
+	if voters, _ := n3.members(); len(voters) != 2 {
+		t.Fatalf("voters mismatch: have %v, want 2", voters)
+	}
+	if err := n3.delete(ctx, "work"); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if v, err := n1.get(ctx, "work"); err != nil || v != "" {
+		t.Fatalf("work mismatch: have %q, %v, want none", v, err)
+	}
+}
+
+// Tests that a node restarts from the persisted state, snapshots included.
+func TestRaftRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := newTestRaftCluster(t)
+	defer c.close()
+
+	rn := c.start(1, dir, []uint64{1})
+	c.waitLeader(1)
+	ctx, cancel := testRaftContext()
+	defer cancel()
+	for i := 0; i < raftSnapshotCount*2+10; i++ {
+		if _, err := rn.put(ctx, "work", fmt.Sprint(i)); err != nil {
+			t.Fatalf("failed to put: %v", err)
+		}
+	}
+	rn.lock.Lock()
+	snapIndex := rn.snapIndex
+	rn.lock.Unlock()
+	if snapIndex == 0 {
+		t.Fatalf("no snapshot taken")
+	}
+	c.stop(1)
+
+	// the peers are ignored on restart
+	rn = c.start(1, dir, []uint64{1, 2})
+	c.waitLeader(1)
+	if voters, _ := rn.members(); len(voters) != 1 {
+		t.Fatalf("voters mismatch: have %v, want 1", voters)
+	}
+	want := fmt.Sprint(raftSnapshotCount*2 + 9)
+	if v, err := rn.get(ctx, "work"); err != nil || v != want {
+		t.Fatalf("work mismatch: have %q, %v, want %q", v, err, want)
+	}
+}
+
+// Tests that the records appended since the last snapshot are replayed on
+// restart, and a torn record at the end is dropped.
+func TestRaftLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := newTestRaftCluster(t)
+	defer c.close()
+
+	rn := c.start(1, dir, []uint64{1})
+	c.waitLeader(1)
+	ctx, cancel := testRaftContext()
+	defer cancel()
+	for i := 0; i < raftSnapshotCount/2; i++ {
+		if _, err := rn.put(ctx, "work", fmt.Sprint(i)); err != nil {
+			t.Fatalf("failed to put: %v", err)
+		}
+	}
+	c.stop(1)
+
+	fn := filepath.Join(dir, raftLogFile)
+	fi, err := os.Stat(fn)
+	if err != nil || fi.Size() == 0 {
+		t.Fatalf("nothing appended to the log: %v", err)
+	}
+	f, err := os.OpenFile(fn, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0, 0, 1, 0, 0xde, 0xad})
+	f.Close()
+
+	rn = c.start(1, dir, nil)
+	c.waitLeader(1)
+	want := fmt.Sprint(raftSnapshotCount/2 - 1)
+	if v, err := rn.get(ctx, "work"); err != nil || v != want {
+		t.Fatalf("work mismatch: have %q, %v, want %q", v, err, want)
+	}
+}
+
+// Tests that a node stops if its state can't be saved.
+func TestRaftSaveFailure(t *testing.T) {
+	f, err := ioutil.TempFile("", "raft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	c := newTestRaftCluster(t)
+	defer c.close()
+
+	// the state directory can't be created under a file
+	rn := c.start(1, filepath.Join(f.Name(), "raft"), []uint64{1})
+	select {
+	case <-rn.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("raft still running")
+	}
+	if lead := rn.leader(); lead != 0 {
+		t.Fatalf("leader mismatch: have %x, want none", lead)
+	}
+	ctx, cancel := testRaftContext()
+	defer cancel()
+	if _, err := rn.put(ctx, "work", "1"); err == nil {
+		t.Fatalf("put succeeded on a stopped node")
+	}
+}
+
+// Tests that stepping doesn't block if the node falls behind.
+func TestRaftStepQueue(t *testing.T) {
+	rn := &raftNode{id: 1, recvc: make(chan raftpb.Message, raftRecvQueue), done: make(chan struct{})}
+	data, _ := (&raftpb.Message{Type: raftpb.MsgHeartbeat, From: 2, To: 1}).Marshal()
+	for i := 0; i < raftRecvQueue; i++ {
+		if err := rn.step(2, data); err != nil {
+			t.Fatalf("failed to queue message %d: %v", i, err)
+		}
+	}
+	if err := rn.step(2, data); err != errRaftBusy {
+		t.Fatalf("error mismatch of a full queue: have %v, want %v", err, errRaftBusy)
+	}
+	close(rn.done)
+	if err := rn.step(2, data); err != errRaftStopped {
+		t.Fatalf("error mismatch of a stopped node: have %v, want %v", err, errRaftStopped)
+	}
+}
+
+// Tests that the messages of other members are rejected.
+func TestRaftStepSender(t *testing.T) {
+	c := newTestRaftCluster(t)
+	defer c.close()
+
+	rn := c.start(1, "", []uint64{1})
+	data, _ := (&raftpb.Message{Type: raftpb.MsgHeartbeat, From: 2, To: 1}).Marshal()
+	if err := rn.step(3, data); err != errRaftSender {
+		t.Fatalf("error mismatch: have %v, want %v", err, errRaftSender)
+	}
+	data, _ = (&raftpb.Message{Type: raftpb.MsgHup, From: 2, To: 1}).Marshal()
+	if err := rn.step(2, data); err != errRaftSender {
+		t.Fatalf("error mismatch: have %v, want %v", err, errRaftSender)
+	}
+}
+
+// Tests the membership changes toward the governance nodes.
+func TestPlanRaftMembership(t *testing.T) {
+	const commit = 100
+	var (
+		active   = tracker.Progress{Match: commit, RecentActive: true}
+		inactive = tracker.Progress{Match: commit - 50}
+		behind   = tracker.Progress{Match: commit - 50, RecentActive: true}
+		idle     = tracker.Progress{Match: commit}
+	)
+	tests := []struct {
+		want     []uint64
+		down     []uint64
+		voters   []uint64
+		learners []uint64
+		progress map[uint64]tracker.Progress
+		change   *raftMembershipChange
+		held     bool
+	}{
+		// no governance, nothing to do
+		{voters: []uint64{1, 2}, held: true},
+		// in sync
+		{want: []uint64{1, 2}, voters: []uint64{1, 2}},
+		// new node joins as a learner once up
+		{
+			want: []uint64{1, 2}, voters: []uint64{1},
+			change: &raftMembershipChange{typ: raftpb.ConfChangeAddLearnerNode, id: 2},
+		},
+		{want: []uint64{1, 2}, down: []uint64{2}, voters: []uint64{1}},
+		// learner is promoted once caught up
+		{
+			want: []uint64{1, 2}, voters: []uint64{1}, learners: []uint64{2},
+			progress: map[uint64]tracker.Progress{2: behind},
+			held:     true,
+		},
+		{
+			want: []uint64{1, 2}, voters: []uint64{1}, learners: []uint64{2},
+			progress: map[uint64]tracker.Progress{2: active},
+			change:   &raftMembershipChange{typ: raftpb.ConfChangeAddNode, id: 2},
+		},
+		// promoting a caught up but idle learner while a voter is down
+		{
+			want: []uint64{1, 2, 3}, voters: []uint64{1, 2}, learners: []uint64{3},
+			progress: map[uint64]tracker.Progress{2: inactive, 3: idle},
+			held:     true,
+		},
+		// departed learner is removed regardless of quorum
+		{
+			want: []uint64{1, 2}, voters: []uint64{1, 2}, learners: []uint64{3},
+			progress: map[uint64]tracker.Progress{2: inactive},
+			change:   &raftMembershipChange{typ: raftpb.ConfChangeRemoveNode, id: 3},
+		},
+		// departed voter is removed if the rest has a quorum
+		{
+			want: []uint64{1, 2}, voters: []uint64{1, 2, 3},
+			progress: map[uint64]tracker.Progress{2: active, 3: inactive},
+			change:   &raftMembershipChange{typ: raftpb.ConfChangeRemoveNode, id: 3},
+		},
+		{
+			want: []uint64{1, 2}, voters: []uint64{1, 2, 3},
+			progress: map[uint64]tracker.Progress{2: inactive, 3: active},
+			held:     true,
+		},
+		// departed leader hands over the leadership first
+		{
+			want: []uint64{2, 3}, voters: []uint64{1, 2, 3},
+			progress: map[uint64]tracker.Progress{2: active, 3: active},
+			change:   &raftMembershipChange{id: 2, transfer: true},
+		},
+		{
+			want: []uint64{2}, voters: []uint64{1, 2},
+			progress: map[uint64]tracker.Progress{2: inactive},
+			held:     true,
+		},
+	}
+	for i, tt := range tests {
+		down := map[uint64]bool{}
+		for _, id := range tt.down {
+			down[id] = true
+		}
+		isUp := func(id uint64) bool { return !down[id] }
+		change, reason := planRaftMembership(1, tt.want, isUp, tt.voters, tt.learners, tt.progress, commit)
+		if tt.change == nil && change != nil {
+			t.Errorf("test %d: unexpected change %+v", i, change)
+		} else if tt.change != nil && (change == nil || *change != *tt.change) {
+			t.Errorf("test %d: change mismatch: have %+v, want %+v", i, change, tt.change)
+		}
+		if held := reason != ""; held != tt.held {
+			t.Errorf("test %d: held back mismatch: have %q, want %v", i, reason, tt.held)
+		}
+	}
+}
+
+// Tests that only the governance nodes registered for the raft members can
+// send raft messages.
+func TestRaftStepPeer(t *testing.T) {
+	c := newTestRaftCluster(t)
+	defer c.close()
+
+	var (
+		gov   = "0000000000000001" + strings.Repeat("ab", 24)
+		other = "0000000000000001" + strings.Repeat("cd", 24)
+		ma    = &wemixAdmin{raft: c.start(2, "", nil)}
+		data  = func(from uint64) []byte {
+			data, _ := (&raftpb.Message{Type: raftpb.MsgHeartbeat, From: from, To: 2}).Marshal()
+			return data
+		}
+	)
+	if err := ma.raftStep(gov, data(1)); err != errRaftUnknownPeer {
+		t.Fatalf("error mismatch before governance: have %v, want %v", err, errRaftUnknownPeer)
+	}
+	ma.raftSetPeers([]*wemixNode{{Name: "gov", Id: gov}})
+	if err := ma.raftStep(other, data(1)); err != errRaftUnknownPeer {
+		t.Fatalf("error mismatch for a colliding id: have %v, want %v", err, errRaftUnknownPeer)
+	}
+	if err := ma.raftStep(gov, data(3)); err != errRaftSender {
+		t.Fatalf("error mismatch for a wrong member: have %v, want %v", err, errRaftSender)
+	}
+	if err := ma.raftStep(gov, data(1)); err != nil {
+		t.Fatalf("failed to step: %v", err)
+	}
+}
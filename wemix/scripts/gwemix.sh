@@ -103,7 +103,7 @@ function init_gov ()
     [ "$PORT" = "" ] && PORT=8588
 
     exec ${GWEMIX} attach http://localhost:${PORT} --preload "$d/conf/WemixGovernance.js,$d/conf/deploy-governance.js" --exec 'GovernanceDeployer.deploy("'${ACCT}'", "", "'${CONFIG}'", '${INIT_ONCE}')'
-#    ${GWEMIX} wemix deploy-governance --url http://localhost:${PORT} --gasprice 1 --gas 0xF000000 "$d/conf/WemixGovernance.js" "$CONFIG" "${ACCT}"
+#    ${GWEMIX} wemix deploy-governance --url http://localhost:${PORT} --gasprice 1 --gas 0xF000000 "$CONFIG" "${ACCT}"
 }
 
 function wipe ()
@@ -116,7 +116,7 @@ function wipe ()
 
     cd $d
     /bin/rm -rf geth/LOCK geth/chaindata geth/ethash geth/lightchaindata \
-	geth/transactions.rlp geth/nodes geth/triecache gwemix.ipc logs/* etcd
+	geth/transactions.rlp geth/nodes geth/triecache gwemix.ipc logs/* etcd raft
 }
 
 function wipe_all ()
@@ -0,0 +1,137 @@
+// statecaller.go
+
+package wemix
+
+import (
+	"context"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// gas limit of governance contract calls
+const stateCallGas = 50000000
+
+// stateBackend is the subset of ethapi.Backend needed to execute calls.
+type stateBackend interface {
+	HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	CurrentHeader() *types.Header
+	StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error)
+	StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error)
+	GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header, vmConfig *vm.Config) (*vm.EVM, func() error, error)
+}
+
+// stateCaller is a bind.ContractCaller executing calls against the state of
+// the local chain directly, instead of eth_call to the node's own RPC
+// server. Governance is read from Finalize, so it should neither depend on
+// the RPC server being up nor pay for the round trip.
+type stateCaller struct {
+	backend stateBackend
+}
+
+// blockContextKey is the context key of the block the calls are pinned to.
+type blockContextKey struct{}
+
+// withBlock returns a context pinning the calls at the height of 'header' to
+// that very block, by its hash and state root. The canonical block at the
+// height is not necessarily the one, e.g. when a side chain block or the
+// blocks of a reorg are verified.
+func withBlock(ctx context.Context, header *types.Header) context.Context {
+	return context.WithValue(ctx, blockContextKey{}, header)
+}
+
+// stateAt returns the state and the header of the block 'number', or the
+// latest block if nil. If a block of the height is pinned in the context, it
+// is used instead of the canonical one. errStateUnavailable is returned if
+// the block is not imported yet or its state is pruned.
+func (c *stateCaller) stateAt(ctx context.Context, number *big.Int) (*state.StateDB, *types.Header, error) {
+	var (
+		statedb *state.StateDB
+		header  *types.Header
+		err     error
+	)
+	if pinned, ok := ctx.Value(blockContextKey{}).(*types.Header); ok && number != nil && pinned.Number.Cmp(number) == 0 {
+		statedb, header, err = c.backend.StateAndHeaderByNumberOrHash(ctx, rpc.BlockNumberOrHashWithHash(pinned.Hash(), false))
+		if err == nil && header != nil && header.Root != pinned.Root {
+			err = errStateUnavailable
+		}
+	} else {
+		bn := rpc.LatestBlockNumber
+		if number != nil {
+			bn = rpc.BlockNumber(number.Int64())
+		}
+		statedb, header, err = c.backend.StateAndHeaderByNumber(ctx, bn)
+	}
+	if err != nil || statedb == nil || header == nil {
+		return nil, nil, errStateUnavailable
+	}
+	return statedb, header, nil
+}
+
+// headerAt returns the header of the block 'number', or the latest block if
+// nil.
+func (c *stateCaller) headerAt(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return c.backend.CurrentHeader(), nil
+	}
+	header, err := c.backend.HeaderByNumber(ctx, rpc.BlockNumber(number.Int64()))
+	if err == nil && header == nil {
+		err = ethereum.NotFound
+	}
+	return header, err
+}
+
+// headerByHash returns the header of the block 'hash', canonical or not.
+func (c *stateCaller) headerByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	header, err := c.backend.HeaderByHash(ctx, hash)
+	if err == nil && header == nil {
+		err = ethereum.NotFound
+	}
+	return header, err
+}
+
+// CodeAt implements bind.ContractCaller.
+func (c *stateCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	statedb, _, err := c.stateAt(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return statedb.GetCode(contract), nil
+}
+
+// CallContract implements bind.ContractCaller.
+func (c *stateCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	statedb, header, err := c.stateAt(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, value := call.Gas, call.Value
+	if gas == 0 {
+		gas = stateCallGas
+	}
+	if value == nil {
+		value = new(big.Int)
+	}
+	msg := types.NewMessage(call.From, call.To, 0, value, gas, new(big.Int), new(big.Int), new(big.Int), call.Data, nil, true)
+	evm, vmError, err := c.backend.GetEVM(ctx, msg, statedb, header, &vm.Config{NoBaseFee: true})
+	if err != nil {
+		return nil, err
+	}
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if err := vmError(); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.Return(), result.Err
+}
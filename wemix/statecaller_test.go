@@ -0,0 +1,130 @@
+// statecaller_test.go
+
+package wemix
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/wemix/governance"
+)
+
+// testStateBackend is a stateBackend over a local chain.
+type testStateBackend struct {
+	chain *core.BlockChain
+}
+
+func (b *testStateBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	if number == rpc.LatestBlockNumber {
+		return b.chain.CurrentHeader(), nil
+	}
+	return b.chain.GetHeaderByNumber(uint64(number)), nil
+}
+
+func (b *testStateBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return b.chain.GetHeaderByHash(hash), nil
+}
+
+func (b *testStateBackend) CurrentHeader() *types.Header {
+	return b.chain.CurrentHeader()
+}
+
+func (b *testStateBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error) {
+	header, _ := b.HeaderByNumber(ctx, number)
+	if header == nil {
+		return nil, nil, nil
+	}
+	statedb, err := b.chain.StateAt(header.Root)
+	return statedb, header, err
+}
+
+func (b *testStateBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	if number, ok := blockNrOrHash.Number(); ok {
+		return b.StateAndHeaderByNumber(ctx, number)
+	}
+	hash, _ := blockNrOrHash.Hash()
+	header := b.chain.GetHeaderByHash(hash)
+	if header == nil {
+		return nil, nil, nil
+	}
+	statedb, err := b.chain.StateAt(header.Root)
+	return statedb, header, err
+}
+
+func (b *testStateBackend) GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header, vmConfig *vm.Config) (*vm.EVM, func() error, error) {
+	context := core.NewEVMBlockContext(header, b.chain, nil)
+	return vm.NewEVM(context, core.NewEVMTxContext(msg), state, b.chain.Config(), *vmConfig), func() error { return nil }, nil
+}
+
+// Tests that governance is read from the state of the given block.
+func TestStateCaller(t *testing.T) {
+	var (
+		key, _ = crypto.GenerateKey()
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		db     = rawdb.NewMemoryDatabase()
+		gspec  = &core.Genesis{
+			Config:   params.TestChainConfig,
+			GasLimit: 10000000,
+			Alloc:    core.GenesisAlloc{addr: {Balance: big.NewInt(1e18)}},
+		}
+		genesis  = gspec.MustCommit(db)
+		signer   = types.LatestSigner(gspec.Config)
+		registry = crypto.CreateAddress(addr, 0)
+	)
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 2, func(i int, b *core.BlockGen) {
+		if i == 1 {
+			tx, _ := types.SignTx(types.NewContractCreation(0, nil, 5000000, b.BaseFee(),
+				common.FromHex(governance.RegistryMetaData.Bin)), signer, key)
+			b.AddTx(tx)
+		}
+	})
+	chain, _ := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	defer chain.Stop()
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+
+	caller := &stateCaller{backend: &testStateBackend{chain: chain}}
+	reg := governance.RegistryAt(registry, caller)
+	if v, err := reg.Magic(&bind.CallOpts{}); err != nil {
+		t.Fatalf("failed to read the latest state: %v", err)
+	} else if v.Cmp(magic) != 0 {
+		t.Errorf("magic mismatch: have %x, want %x", v, magic)
+	}
+	if _, err := reg.Magic(&bind.CallOpts{BlockNumber: big.NewInt(1)}); err != bind.ErrNoCode {
+		t.Errorf("error mismatch before deployment: have %v, want %v", err, bind.ErrNoCode)
+	}
+	if _, err := reg.Magic(&bind.CallOpts{BlockNumber: big.NewInt(3)}); err != errStateUnavailable {
+		t.Errorf("error mismatch of unknown block: have %v, want %v", err, errStateUnavailable)
+	}
+
+	// reorg to a longer fork without the registry, the calls pinned to the
+	// block replaced still read its state
+	forks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 3, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+	if _, err := chain.InsertChain(forks); err != nil {
+		t.Fatalf("failed to insert fork: %v", err)
+	}
+	if _, err := reg.Magic(&bind.CallOpts{BlockNumber: big.NewInt(2)}); err != bind.ErrNoCode {
+		t.Errorf("error mismatch of canonical fork block: have %v, want %v", err, bind.ErrNoCode)
+	}
+	ctx := withBlock(context.Background(), blocks[1].Header())
+	if v, err := reg.Magic(&bind.CallOpts{Context: ctx, BlockNumber: big.NewInt(2)}); err != nil {
+		t.Fatalf("failed to read the state of the replaced block: %v", err)
+	} else if v.Cmp(magic) != 0 {
+		t.Errorf("magic mismatch of the replaced block: have %x, want %x", v, magic)
+	}
+}
@@ -5,14 +5,19 @@ package wemix
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	wemixengine "github.com/ethereum/go-ethereum/consensus/wemix"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	wemixapi "github.com/ethereum/go-ethereum/wemix/api"
@@ -26,46 +31,20 @@ var (
 )
 
 func (ma *wemixAdmin) getLatestBlockInfo(node *wemixNode) (height *big.Int, hash common.Hash, td *big.Int, err error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	if wemixapi.RequestMinerStatus == nil {
+		err = ErrNotRunning
+		return
+	}
 
-	msgch := make(chan interface{}, 16)
-	wemixapi.SetMsgChannel(msgch)
-	defer func() {
-		wemixapi.SetMsgChannel(nil)
-		close(msgch)
-	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
 
-	timer := time.NewTimer(60 * time.Second)
-	err = ma.rpcCli.CallContext(ctx, nil, "admin_requestMinerStatus", &node.Id)
+	s, err := wemixapi.RequestMinerStatus(ctx, node.Id)
 	if err != nil {
 		log.Info("RequestMinerStatus Failed", "id", node.Id, "error", err)
 		return
 	}
-
-	done := false
-	for {
-		if done {
-			break
-		}
-		select {
-		case msg := <-msgch:
-			s, ok := msg.(*wemixapi.WemixMinerStatus)
-			if !ok {
-				continue
-			}
-			if s.NodeName != node.Name {
-				continue
-			}
-			height, hash, td, err = s.LatestBlockHeight, s.LatestBlockHash, s.LatestBlockTd, nil
-			return
-
-		case <-timer.C:
-			err = ErrNotRunning
-			return
-		}
-	}
-	err = ethereum.NotFound
+	height, hash, td = s.LatestBlockHeight, s.LatestBlockHash, s.LatestBlockTd
 	return
 }
 
@@ -102,14 +81,14 @@ func (ma *wemixAdmin) syncWith(node *wemixNode, work *wemixWork) error {
 
 // return true if this node still is the miner after update
 func (ma *wemixAdmin) updateMiner(locked bool) bool {
-	if ma.etcd == nil {
+	if !ma.raftIsRunning() {
 		return false
 	}
 
 	syncLock.Lock()
 	defer syncLock.Unlock()
 
-	lid, lnode := ma.etcdLeader(locked)
+	lid, lnode := ma.raftLeader(locked)
 	if lid == leaderId || lid == 0 {
 		return lnode == ma.self
 	}
@@ -124,7 +103,7 @@ func (ma *wemixAdmin) updateMiner(locked bool) bool {
 		log.Debug("we are the new leader")
 		tstart := time.Now()
 
-		// get the latest work info from etcd
+		// get the latest work info from raft
 		getLatestWork := func() (*wemixWork, error) {
 			var (
 				workInfo string
@@ -134,7 +113,7 @@ func (ma *wemixAdmin) updateMiner(locked bool) bool {
 			)
 
 			for ; retries > 0; retries-- {
-				workInfo, err = ma.etcdGet("work")
+				workInfo, err = ma.raftGet("work")
 				if err != nil {
 					// TODO: ignore if error is not found
 					log.Error("cannot get the latest work info",
@@ -172,7 +151,7 @@ func (ma *wemixAdmin) updateMiner(locked bool) bool {
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
-			cur, err := ma.cli.HeaderByNumber(ctx, big.NewInt(work.Height))
+			cur, err := ma.caller.headerAt(ctx, big.NewInt(work.Height))
 			if err != nil {
 				return
 			}
@@ -202,7 +181,7 @@ func (ma *wemixAdmin) updateMiner(locked bool) bool {
 
 			var err error
 			for i, j := 0, (ix+1)%len(nodes); i < len(nodes)-1; i++ {
-				err = ma.etcdMoveLeader(nodes[j].Name)
+				err = ma.raftMoveLeader(nodes[j].Name)
 				if err == nil {
 					return nil
 				}
@@ -218,8 +197,12 @@ func (ma *wemixAdmin) updateMiner(locked bool) bool {
 			err = puntLeadership()
 			if err != nil {
 				log.Error("leadership yielding failed", "error", err)
+				ma.leaderEvents.add(leadershipEvent{Kind: leaderPuntFail,
+					Detail: "no latest work: " + err.Error()})
 			} else {
 				log.Debug("yielded leadership")
+				ma.leaderEvents.add(leadershipEvent{Kind: leaderPunted,
+					Detail: "no latest work"})
 			}
 		} else if work == nil {
 			// this must be the first block, juts move on
@@ -236,15 +219,19 @@ func (ma *wemixAdmin) updateMiner(locked bool) bool {
 				if err != nil {
 					log.Error("not in sync. Leadership yielding failed",
 						"latest", work.Height, "current", height, "error", err)
+					ma.leaderEvents.add(leadershipEvent{Kind: leaderPuntFail,
+						Height: int64(height), Detail: fmt.Sprintf("not in sync with %d: %v", work.Height, err)})
 				} else {
 					log.Error("not in sync. Yielded leadership",
 						"latest", work.Height, "current", height, "self", ma.self.Name)
+					ma.leaderEvents.add(leadershipEvent{Kind: leaderPunted,
+						Height: int64(height), Detail: fmt.Sprintf("not in sync with %d", work.Height)})
 				}
 			}
 		}
 
 		// update leader info again
-		lid, lnode = ma.etcdLeader(locked)
+		lid, lnode = ma.raftLeader(locked)
 		if lid != leaderId && lid != 0 {
 			leaderId, leader = lid, lnode
 		}
@@ -253,26 +240,97 @@ func (ma *wemixAdmin) updateMiner(locked bool) bool {
 	return leader == ma.self
 }
 
+// poaRecheck is how often to check the schedule again when this node isn't
+// waiting for its turn.
+const poaRecheck = time.Second
+
+// poaSchedule decides if this node is to mine the child block of head, i.e.
+// it's in turn in the governance at head, or the nodes ahead of it in the
+// schedule timed out, and kicks off the miner if it just became so. It
+// returns how long to wait before checking again.
+func (ma *wemixAdmin) poaSchedule(head *types.Header) time.Duration {
+	var (
+		miner bool
+		wait  = poaRecheck
+		self  = ma.self
+	)
+	if schedule, blocksPer, err := ma.MinerSchedule(head); err != nil {
+		log.Error("cannot get the miner schedule", "number", head.Number, "error", err)
+	} else if self != nil {
+		id, _ := hex.DecodeString(self.Enode)
+		offset := wemixengine.TurnOffset(schedule, blocksPer, head.Number.Uint64()+1, id)
+		if offset >= 0 {
+			turn := time.Unix(int64(wemixengine.TurnTime(ma.chainConfig, head, offset)), 0)
+			if until := time.Until(turn); until > 0 {
+				if until < wait {
+					wait = until
+				}
+			} else {
+				miner = true
+			}
+		}
+	}
+
+	var v int32
+	if miner {
+		v = 1
+	}
+	if atomic.SwapInt32(&ma.poaMiner, v) == 0 && miner {
+		log.Debug("our turn to mine", "self", self.Name, "number", head.Number.Uint64()+1)
+		wemixminer.FeedLeadership()
+	}
+	return wait
+}
+
+// poaLoop follows the chain head to decide the miner of the next block in
+// PoA mode, checking again once the nodes ahead of this node in the schedule
+// time out.
+func (ma *wemixAdmin) poaLoop() {
+	heads := make(chan core.ChainHeadEvent, 16)
+	sub := ma.eth.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-heads:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-timer.C:
+		case err := <-sub.Err():
+			log.Error("chain head subscription failed", "error", err)
+			return
+		}
+		timer.Reset(ma.poaSchedule(ma.eth.CurrentHeader()))
+	}
+}
+
 func IsMiner() bool {
 	if params.ConsensusMethod == params.ConsensusPoW {
 		return true
-	} else if params.ConsensusMethod == params.ConsensusETCD {
-		return false
-	} else if params.ConsensusMethod == params.ConsensusPoA {
+	} else if isPoA() || consensusMethod() == params.ConsensusETCD {
 		if admin == nil {
 			return false
 		} else if admin.self == nil || len(admin.nodes) <= 0 {
-			if admin.nodeInfo != nil && admin.nodeInfo.ID == admin.bootNodeId {
+			if admin.isBootNode() {
 				return true
 			} else {
 				return false
 			}
 		}
 
-		if admin.etcdIsLeader() {
+		if isPoA() {
+			// the nodes take turns of blocksPer blocks, no leader election
+			return atomic.LoadInt32(&admin.poaMiner) == 1
+		} else if admin.raftIsLeader() {
 			return admin.updateMiner(false)
 		} else {
-			admin.blocksMined = 0
+			admin.endTurn()
 			return false
 		}
 	} else {